@@ -8,8 +8,13 @@ import (
 	"gitlab.com/gitlab-org/gitaly/v16/internal/log"
 )
 
+// TenantMetadataKey is the gRPC metadata key that clients can use to identify the tenant, for
+// example the namespace or top-level group, that a request is executed on behalf of.
+const TenantMetadataKey = "tenant_id"
+
 type addCommandCfg struct {
-	cgroupKey string
+	cgroupKey    string
+	cgroupTenant string
 }
 
 // CgroupStats stores the current usage statistics of the resources managed by
@@ -54,6 +59,15 @@ func WithCgroupKey(cgroupKey string) AddCommandOption {
 	}
 }
 
+// WithCgroupTenant sets the tenant the command is executed on behalf of. If the manager is
+// configured to place commands by tenant, then the command is added to the tenant's cgroup
+// instead of the cgroup derived from the cgroup key.
+func WithCgroupTenant(tenant string) AddCommandOption {
+	return func(cfg *addCommandCfg) {
+		cfg.cgroupTenant = tenant
+	}
+}
+
 // Manager supplies an interface for interacting with cgroups
 type Manager interface {
 	// Setup creates cgroups and assigns configured limitations.
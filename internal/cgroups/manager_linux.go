@@ -6,26 +6,48 @@ import (
 	"fmt"
 	"hash/crc32"
 	"os/exec"
+	"regexp"
+	"sort"
 	"strings"
+	"sync"
+	"time"
 
 	cgrps "github.com/containerd/cgroups/v3"
 	"github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/prometheus/client_golang/prometheus"
 	cgroupscfg "gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/config/cgroups"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/helper"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/helper/duration"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/log"
+	"golang.org/x/sys/unix"
 )
 
 // cfs_period_us hardcoded to be 100ms.
 const cfsPeriodUs uint64 = 100000
 
+const (
+	// defaultMaxTenants is the maximum number of tenant cgroups if not configured otherwise.
+	defaultMaxTenants = 1000
+	// defaultTenantIdleTimeout is the duration after which unused tenant cgroups are removed if
+	// not configured otherwise.
+	defaultTenantIdleTimeout = 10 * time.Minute
+)
+
+// validTenant matches tenant identifiers that can be used as part of a cgroup name. GitLab
+// namespace paths always match this pattern.
+var validTenant = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]{0,254}$`)
+
 type cgroupHandler interface {
 	setupParent(reposResources *specs.LinuxResources) error
 	setupRepository(reposResources *specs.LinuxResources) error
+	setupCgroup(cgroupPath string, resources *specs.LinuxResources) error
+	deleteCgroup(cgroupPath string) error
 	addToCgroup(pid int, cgroupPath string) error
-	collect(ch chan<- prometheus.Metric)
+	collect(repoPaths []string, ch chan<- prometheus.Metric)
 	cleanup() error
 	currentProcessCgroup() string
 	repoPath(groupID int) string
+	tenantPath(tenant string) string
 	stats() (Stats, error)
 }
 
@@ -36,6 +58,29 @@ type CGroupManager struct {
 	enabled bool
 
 	handler cgroupHandler
+
+	// now returns the current time. It is used to determine when tenant cgroups became idle.
+	now func() time.Time
+	// tenantsMu protects tenants. It is never held while cgroups are created or deleted.
+	tenantsMu sync.Mutex
+	// tenants maps tenants to their cgroups.
+	tenants map[string]*tenantCgroup
+	// sweepTicker triggers the removal of idle tenant cgroups.
+	sweepTicker helper.Ticker
+	sweepDone   chan struct{}
+}
+
+// tenantCgroup tracks the cgroup of a single tenant.
+type tenantCgroup struct {
+	// mu serializes creation, usage and deletion of the tenant's cgroup.
+	mu sync.Mutex
+	// created is set once the cgroup has been created.
+	created bool
+	// removed is set once the tenant cgroup has been removed from the manager. Callers which
+	// observe it must look up the tenant again.
+	removed bool
+	// lastUsed is the time a command has last been added to the cgroup.
+	lastUsed time.Time
 }
 
 func newCgroupManager(cfg cgroupscfg.Config, logger log.Logger, pid int) *CGroupManager {
@@ -55,10 +100,22 @@ func newCgroupManagerWithMode(cfg cgroupscfg.Config, logger log.Logger, pid int,
 		return nil
 	}
 
+	if cfg.Repositories.MaxTenants == 0 {
+		cfg.Repositories.MaxTenants = defaultMaxTenants
+	}
+
+	if cfg.Repositories.TenantIdleTimeout == 0 {
+		cfg.Repositories.TenantIdleTimeout = duration.Duration(defaultTenantIdleTimeout)
+	}
+
 	return &CGroupManager{
-		cfg:     cfg,
-		pid:     pid,
-		handler: handler,
+		cfg:         cfg,
+		pid:         pid,
+		handler:     handler,
+		now:         time.Now,
+		tenants:     map[string]*tenantCgroup{},
+		sweepTicker: helper.NewTimerTicker(cfg.Repositories.TenantIdleTimeout.Duration()),
+		sweepDone:   make(chan struct{}),
 	}
 }
 
@@ -67,11 +124,19 @@ func (cgm *CGroupManager) Setup() error {
 	if err := cgm.handler.setupParent(cgm.configParentResources()); err != nil {
 		return err
 	}
-	if err := cgm.handler.setupRepository(cgm.configRepositoryResources()); err != nil {
+	reposResources, err := cgm.configRepositoryResources()
+	if err != nil {
+		return err
+	}
+	if err := cgm.handler.setupRepository(reposResources); err != nil {
 		return err
 	}
 	cgm.enabled = true
 
+	if cgm.cfg.Repositories.Placement == cgroupscfg.PlacementTenant {
+		go cgm.sweepTenants()
+	}
+
 	return nil
 }
 
@@ -87,27 +152,198 @@ func (cgm *CGroupManager) AddCommand(cmd *exec.Cmd, opts ...AddCommandOption) (s
 		opt(&cfg)
 	}
 
-	key := cfg.cgroupKey
-	if key == "" {
-		key = strings.Join(cmd.Args, "/")
-	}
-
-	checksum := crc32.ChecksumIEEE(
-		[]byte(key),
-	)
-
 	if cmd.Process == nil {
 		return "", fmt.Errorf("cannot add command that has not yet been started")
 	}
 
-	groupID := uint(checksum) % cgm.cfg.Repositories.Count
-	cgroupPath := cgm.handler.repoPath(int(groupID))
+	cgroupPath, err := cgm.tenantCgroupPath(cfg.cgroupTenant)
+	if err != nil {
+		return "", err
+	}
+
+	if cgroupPath == "" {
+		key := cfg.cgroupKey
+		if key == "" {
+			key = strings.Join(cmd.Args, "/")
+		}
+
+		checksum := crc32.ChecksumIEEE(
+			[]byte(key),
+		)
+
+		groupID := uint(checksum) % cgm.cfg.Repositories.Count
+		cgroupPath = cgm.handler.repoPath(int(groupID))
+	}
 
 	return cgroupPath, cgm.handler.addToCgroup(cmd.Process.Pid, cgroupPath)
 }
 
+// tenantCgroupPath returns the path of the given tenant's cgroup and creates the cgroup if it
+// doesn't exist yet. An empty path is returned if the command should be placed into one of the
+// repository cgroups instead, which is the case when placement by tenant is not enabled, when the
+// tenant is not valid or when the maximum number of tenant cgroups has been reached.
+func (cgm *CGroupManager) tenantCgroupPath(tenant string) (string, error) {
+	if cgm.cfg.Repositories.Placement != cgroupscfg.PlacementTenant || !validTenant.MatchString(tenant) {
+		return "", nil
+	}
+
+	cgroupPath := cgm.handler.tenantPath(tenant)
+
+	for {
+		cgm.tenantsMu.Lock()
+		cgroup, ok := cgm.tenants[tenant]
+		if !ok {
+			if uint(len(cgm.tenants)) >= cgm.cfg.Repositories.MaxTenants {
+				cgm.tenantsMu.Unlock()
+				return "", nil
+			}
+
+			cgroup = &tenantCgroup{}
+			cgm.tenants[tenant] = cgroup
+		}
+		cgm.tenantsMu.Unlock()
+
+		cgroup.mu.Lock()
+
+		// The cgroup has been swept or has failed to be created concurrently, so we need to
+		// look it up again.
+		if cgroup.removed {
+			cgroup.mu.Unlock()
+			continue
+		}
+
+		if !cgroup.created {
+			if err := cgm.createTenantCgroup(cgroupPath); err != nil {
+				cgm.removeTenant(tenant, cgroup)
+				cgroup.mu.Unlock()
+				return "", err
+			}
+
+			cgroup.created = true
+		}
+
+		cgroup.lastUsed = cgm.now()
+		cgroup.mu.Unlock()
+
+		return cgroupPath, nil
+	}
+}
+
+func (cgm *CGroupManager) createTenantCgroup(cgroupPath string) error {
+	resources, err := cgm.configRepositoryResources()
+	if err != nil {
+		return err
+	}
+
+	if err := cgm.handler.setupCgroup(cgroupPath, resources); err != nil {
+		return fmt.Errorf("failed creating tenant cgroup: %w", err)
+	}
+
+	return nil
+}
+
+// removeTenant marks the tenant's cgroup as removed and forgets about it. It must be called with
+// the tenant cgroup's lock held.
+func (cgm *CGroupManager) removeTenant(tenant string, cgroup *tenantCgroup) {
+	cgroup.removed = true
+
+	cgm.tenantsMu.Lock()
+	defer cgm.tenantsMu.Unlock()
+
+	if cgm.tenants[tenant] == cgroup {
+		delete(cgm.tenants, tenant)
+	}
+}
+
+// sweepTenants periodically removes idle tenant cgroups until the manager is cleaned up.
+func (cgm *CGroupManager) sweepTenants() {
+	cgm.sweepTicker.Reset()
+
+	for {
+		select {
+		case <-cgm.sweepTicker.C():
+			cgm.sweepIdleTenants()
+			cgm.sweepTicker.Reset()
+		case <-cgm.sweepDone:
+			close(cgm.sweepDone)
+			return
+		}
+	}
+}
+
+// sweepIdleTenants removes the cgroups of tenants that haven't been used for longer than the idle
+// timeout.
+func (cgm *CGroupManager) sweepIdleTenants() {
+	idleTimeout := cgm.cfg.Repositories.TenantIdleTimeout.Duration()
+	now := cgm.now()
+
+	cgm.tenantsMu.Lock()
+	tenants := make(map[string]*tenantCgroup, len(cgm.tenants))
+	for tenant, cgroup := range cgm.tenants {
+		tenants[tenant] = cgroup
+	}
+	cgm.tenantsMu.Unlock()
+
+	for tenant, cgroup := range tenants {
+		cgroup.mu.Lock()
+
+		if !cgroup.created || cgroup.removed || now.Sub(cgroup.lastUsed) < idleTimeout {
+			cgroup.mu.Unlock()
+			continue
+		}
+
+		// Deleting the cgroup fails if it still contains processes, for example because a
+		// long-running command has been spawned a while ago. We keep the cgroup around in that
+		// case and retry with the next sweep.
+		if err := cgm.handler.deleteCgroup(cgm.handler.tenantPath(tenant)); err != nil {
+			cgroup.mu.Unlock()
+			continue
+		}
+
+		cgm.removeTenant(tenant, cgroup)
+		cgroup.mu.Unlock()
+	}
+}
+
+// cgroupPaths returns the paths of all repository and tenant cgroups that currently exist.
+func (cgm *CGroupManager) cgroupPaths() []string {
+	paths := make([]string, 0, cgm.cfg.Repositories.Count)
+	for i := 0; i < int(cgm.cfg.Repositories.Count); i++ {
+		paths = append(paths, cgm.handler.repoPath(i))
+	}
+
+	cgm.tenantsMu.Lock()
+	cgroups := make(map[string]*tenantCgroup, len(cgm.tenants))
+	for tenant, cgroup := range cgm.tenants {
+		cgroups[tenant] = cgroup
+	}
+	cgm.tenantsMu.Unlock()
+
+	tenants := make([]string, 0, len(cgroups))
+	for tenant, cgroup := range cgroups {
+		cgroup.mu.Lock()
+		if cgroup.created && !cgroup.removed {
+			tenants = append(tenants, tenant)
+		}
+		cgroup.mu.Unlock()
+	}
+	sort.Strings(tenants)
+
+	for _, tenant := range tenants {
+		paths = append(paths, cgm.handler.tenantPath(tenant))
+	}
+
+	return paths
+}
+
 // Cleanup cleans up cgroups created in Setup.
 func (cgm *CGroupManager) Cleanup() error {
+	if cgm.enabled && cgm.cfg.Repositories.Placement == cgroupscfg.PlacementTenant {
+		cgm.sweepTicker.Stop()
+		cgm.sweepDone <- struct{}{}
+		<-cgm.sweepDone
+	}
+
 	return cgm.handler.cleanup()
 }
 
@@ -118,7 +354,7 @@ func (cgm *CGroupManager) Describe(ch chan<- *prometheus.Desc) {
 
 // Collect is used to collect the current values of all CGroupManager prometheus metrics
 func (cgm *CGroupManager) Collect(ch chan<- prometheus.Metric) {
-	cgm.handler.collect(ch)
+	cgm.handler.collect(cgm.cgroupPaths(), ch)
 }
 
 // Stats returns cgroup accounting statistics collected by reading
@@ -152,7 +388,7 @@ func (cgm *CGroupManager) configParentResources() *specs.LinuxResources {
 	return &parentResources
 }
 
-func (cgm *CGroupManager) configRepositoryResources() *specs.LinuxResources {
+func (cgm *CGroupManager) configRepositoryResources() (*specs.LinuxResources, error) {
 	cfsPeriodUs := cfsPeriodUs
 	var reposResources specs.LinuxResources
 	// Leave them `nil` so it takes kernel default unless cfg value above `0`.
@@ -170,7 +406,60 @@ func (cgm *CGroupManager) configRepositoryResources() *specs.LinuxResources {
 	if cgm.cfg.Repositories.MemoryBytes > 0 {
 		reposResources.Memory = &specs.LinuxMemory{Limit: &cgm.cfg.Repositories.MemoryBytes}
 	}
-	return &reposResources
+
+	if cgm.cfg.Repositories.PidsMax > 0 {
+		reposResources.Pids = &specs.LinuxPids{Limit: cgm.cfg.Repositories.PidsMax}
+	}
+
+	if cgm.cfg.Repositories.IOLimited() {
+		blockIO, err := configBlockIO(cgm.cfg.Repositories)
+		if err != nil {
+			return nil, err
+		}
+		reposResources.BlockIO = blockIO
+	}
+
+	return &reposResources, nil
+}
+
+func configBlockIO(cfg cgroupscfg.Repositories) (*specs.LinuxBlockIO, error) {
+	var blockIO specs.LinuxBlockIO
+	if cfg.IOWeight > 0 {
+		weight := cfg.IOWeight
+		blockIO.Weight = &weight
+	}
+
+	for _, deviceCfg := range cfg.IODevices {
+		var stat unix.Stat_t
+		if err := unix.Stat(deviceCfg.Path, &stat); err != nil {
+			return nil, fmt.Errorf("stat I/O device %q: %w", deviceCfg.Path, err)
+		}
+
+		//nolint:unconvert // Rdev is not an uint64 on all architectures.
+		device := specs.LinuxBlockIODevice{
+			Major: int64(unix.Major(uint64(stat.Rdev))),
+			Minor: int64(unix.Minor(uint64(stat.Rdev))),
+		}
+
+		for _, limit := range []struct {
+			rate    uint64
+			devices *[]specs.LinuxThrottleDevice
+		}{
+			{rate: deviceCfg.ReadBps, devices: &blockIO.ThrottleReadBpsDevice},
+			{rate: deviceCfg.WriteBps, devices: &blockIO.ThrottleWriteBpsDevice},
+			{rate: deviceCfg.ReadIops, devices: &blockIO.ThrottleReadIOPSDevice},
+			{rate: deviceCfg.WriteIops, devices: &blockIO.ThrottleWriteIOPSDevice},
+		} {
+			if limit.rate > 0 {
+				*limit.devices = append(*limit.devices, specs.LinuxThrottleDevice{
+					LinuxBlockIODevice: device,
+					Rate:               limit.rate,
+				})
+			}
+		}
+	}
+
+	return &blockIO, nil
 }
 
 func pruneOldCgroups(cfg cgroupscfg.Config, logger log.Logger) {
@@ -11,6 +11,9 @@ type cgroupsMetrics struct {
 	cpuCFSThrottledPeriods     *prometheus.Desc
 	cpuCFSThrottledTime        *prometheus.Desc
 	procs                      *prometheus.GaugeVec
+	ioBytes                    *prometheus.Desc
+	ioOperations               *prometheus.Desc
+	pidsCurrent                *prometheus.Desc
 }
 
 func newV1CgroupsMetrics() *cgroupsMetrics {
@@ -51,6 +54,21 @@ func newV1CgroupsMetrics() *cgroupsMetrics {
 			},
 			[]string{"path", "subsystem"},
 		),
+		ioBytes: prometheus.NewDesc(
+			"gitaly_cgroup_io_bytes_total",
+			"Total number of bytes transferred from and to block devices",
+			[]string{"path", "op"}, nil,
+		),
+		ioOperations: prometheus.NewDesc(
+			"gitaly_cgroup_io_operations_total",
+			"Total number of I/O operations performed on block devices",
+			[]string{"path", "op"}, nil,
+		),
+		pidsCurrent: prometheus.NewDesc(
+			"gitaly_cgroup_pids_current",
+			"Number of processes currently in the Cgroup",
+			[]string{"path"}, nil,
+		),
 	}
 }
 
@@ -85,5 +103,40 @@ func newV2CgroupsMetrics() *cgroupsMetrics {
 			},
 			[]string{"path", "subsystem"},
 		),
+		ioBytes: prometheus.NewDesc(
+			"gitaly_cgroup_io_bytes_total",
+			"Total number of bytes transferred from and to block devices",
+			[]string{"path", "op"}, nil,
+		),
+		ioOperations: prometheus.NewDesc(
+			"gitaly_cgroup_io_operations_total",
+			"Total number of I/O operations performed on block devices",
+			[]string{"path", "op"}, nil,
+		),
+		pidsCurrent: prometheus.NewDesc(
+			"gitaly_cgroup_pids_current",
+			"Number of processes currently in the Cgroup",
+			[]string{"path"}, nil,
+		),
+	}
+}
+
+func (m *cgroupsMetrics) collectIO(ch chan<- prometheus.Metric, path string, readBytes, writeBytes, readOps, writeOps uint64) {
+	for _, metric := range []struct {
+		desc  *prometheus.Desc
+		value uint64
+		op    string
+	}{
+		{desc: m.ioBytes, value: readBytes, op: "read"},
+		{desc: m.ioBytes, value: writeBytes, op: "write"},
+		{desc: m.ioOperations, value: readOps, op: "read"},
+		{desc: m.ioOperations, value: writeOps, op: "write"},
+	} {
+		ch <- prometheus.MustNewConstMetric(
+			metric.desc,
+			prometheus.CounterValue,
+			float64(metric.value),
+			path, metric.op,
+		)
 	}
 }
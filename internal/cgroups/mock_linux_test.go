@@ -93,12 +93,27 @@ nr_throttled 20
 throttled_time 1000000`,
 }
 
+var defaultV1MockBlkioFiles = map[string]string{
+	"cgroup.procs":                    "",
+	"blkio.throttle.io_serviced":      "",
+	"blkio.throttle.io_service_bytes": "",
+}
+
+var defaultV1MockPidsFiles = map[string]string{
+	"cgroup.procs": "",
+	"pids.current": "0",
+	"pids.max":     "max",
+}
+
 func (m *mockCgroup) setupMockCgroupFiles(
 	t *testing.T,
 	manager *CGroupManager,
 	inputContent ...mockCgroupFile,
 ) {
-	for _, s := range m.subsystems {
+	subsystems, err := configuredSubsystems(manager.cfg)
+	require.NoError(t, err)
+
+	for _, s := range subsystems {
 		cgroupPath := filepath.Join(m.root, string(s.Name()), manager.currentProcessCgroup())
 		require.NoError(t, os.MkdirAll(cgroupPath, perm.SharedDir))
 
@@ -120,6 +135,18 @@ func (m *mockCgroup) setupMockCgroupFiles(
 					content[key] = value
 				}
 			}
+		case "blkio":
+			for key, value := range defaultV1MockBlkioFiles {
+				if _, exist := content[key]; !exist {
+					content[key] = value
+				}
+			}
+		case "pids":
+			for key, value := range defaultV1MockPidsFiles {
+				if _, exist := content[key]; !exist {
+					content[key] = value
+				}
+			}
 		default:
 			require.FailNow(t, "cannot set up subsystem", "unknown subsystem %q", s.Name())
 		}
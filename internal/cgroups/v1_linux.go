@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/containerd/cgroups/v3/cgroup1"
+	v1 "github.com/containerd/cgroups/v3/cgroup1/stats"
 	specs "github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/prometheus/client_golang/prometheus"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/config"
@@ -31,7 +32,7 @@ func newV1Handler(cfg cgroupscfg.Config, logger log.Logger, pid int) *cgroupV1Ha
 		logger: logger,
 		pid:    pid,
 		hierarchy: func() ([]cgroup1.Subsystem, error) {
-			return defaultSubsystems(cfg.Mountpoint)
+			return configuredSubsystems(cfg)
 		},
 		cgroupsMetrics: newV1CgroupsMetrics(),
 	}
@@ -50,17 +51,40 @@ func (cvh *cgroupV1Handler) setupParent(parentResources *specs.LinuxResources) e
 
 func (cvh *cgroupV1Handler) setupRepository(reposResources *specs.LinuxResources) error {
 	for i := 0; i < int(cvh.cfg.Repositories.Count); i++ {
-		if _, err := cgroup1.New(
-			cgroup1.StaticPath(cvh.repoPath(i)),
-			reposResources,
-			cgroup1.WithHiearchy(cvh.hierarchy),
-		); err != nil {
+		if err := cvh.setupCgroup(cvh.repoPath(i), reposResources); err != nil {
 			return fmt.Errorf("failed creating repository cgroup: %w", err)
 		}
 	}
 	return nil
 }
 
+func (cvh *cgroupV1Handler) setupCgroup(cgroupPath string, resources *specs.LinuxResources) error {
+	if _, err := cgroup1.New(
+		cgroup1.StaticPath(cgroupPath),
+		resources,
+		cgroup1.WithHiearchy(cvh.hierarchy),
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (cvh *cgroupV1Handler) deleteCgroup(cgroupPath string) error {
+	control, err := cgroup1.Load(
+		cgroup1.StaticPath(cgroupPath),
+		cgroup1.WithHiearchy(cvh.hierarchy),
+	)
+	if err != nil {
+		return fmt.Errorf("failed loading cgroup %s: %w", cgroupPath, err)
+	}
+
+	if err := control.Delete(); err != nil {
+		return fmt.Errorf("failed deleting cgroup %s: %w", cgroupPath, err)
+	}
+
+	return nil
+}
+
 func (cvh *cgroupV1Handler) addToCgroup(pid int, cgroupPath string) error {
 	control, err := cgroup1.Load(
 		cgroup1.StaticPath(cgroupPath),
@@ -82,13 +106,12 @@ func (cvh *cgroupV1Handler) addToCgroup(pid int, cgroupPath string) error {
 	return nil
 }
 
-func (cvh *cgroupV1Handler) collect(ch chan<- prometheus.Metric) {
+func (cvh *cgroupV1Handler) collect(repoPaths []string, ch chan<- prometheus.Metric) {
 	if !cvh.cfg.MetricsEnabled {
 		return
 	}
 
-	for i := 0; i < int(cvh.cfg.Repositories.Count); i++ {
-		repoPath := cvh.repoPath(i)
+	for _, repoPath := range repoPaths {
 		logger := cvh.logger.WithField("cgroup_path", repoPath)
 		control, err := cgroup1.Load(
 			cgroup1.StaticPath(repoPath),
@@ -134,6 +157,21 @@ func (cvh *cgroupV1Handler) collect(ch chan<- prometheus.Metric) {
 			cpuKernelMetric := cvh.cpuUsage.WithLabelValues(repoPath, "kernel")
 			cpuKernelMetric.Set(float64(metrics.CPU.Usage.Kernel))
 			ch <- cpuKernelMetric
+
+			if metrics.Blkio != nil {
+				readBytes, writeBytes := sumBlkioEntries(metrics.Blkio.IoServiceBytesRecursive)
+				readOps, writeOps := sumBlkioEntries(metrics.Blkio.IoServicedRecursive)
+				cvh.collectIO(ch, repoPath, readBytes, writeBytes, readOps, writeOps)
+			}
+
+			if metrics.Pids != nil {
+				ch <- prometheus.MustNewConstMetric(
+					cvh.pidsCurrent,
+					prometheus.GaugeValue,
+					float64(metrics.Pids.Current),
+					repoPath,
+				)
+			}
 		}
 
 		if subsystems, err := cvh.hierarchy(); err != nil {
@@ -178,6 +216,10 @@ func (cvh *cgroupV1Handler) repoPath(groupID int) string {
 	return filepath.Join(cvh.currentProcessCgroup(), fmt.Sprintf("repos-%d", groupID))
 }
 
+func (cvh *cgroupV1Handler) tenantPath(tenant string) string {
+	return filepath.Join(cvh.currentProcessCgroup(), "tenant-"+tenant)
+}
+
 func (cvh *cgroupV1Handler) currentProcessCgroup() string {
 	return config.GetGitalyProcessTempDir(cvh.cfg.HierarchyRoot, cvh.pid)
 }
@@ -219,20 +261,54 @@ func defaultSubsystems(root string) ([]cgroup1.Subsystem, error) {
 	return subsystems, nil
 }
 
+// configuredSubsystems returns the default subsystems plus the blkio and pids subsystems if the
+// configuration sets limits for them. The optional subsystems are not managed unconditionally so
+// that setups which only delegated the memory and cpu hierarchies keep working.
+func configuredSubsystems(cfg cgroupscfg.Config) ([]cgroup1.Subsystem, error) {
+	subsystems, err := defaultSubsystems(cfg.Mountpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.Repositories.IOLimited() {
+		subsystems = append(subsystems, cgroup1.NewBlkio(cfg.Mountpoint))
+	}
+
+	if cfg.Repositories.PidsMax > 0 {
+		subsystems = append(subsystems, cgroup1.NewPids(cfg.Mountpoint))
+	}
+
+	return subsystems, nil
+}
+
+// sumBlkioEntries sums up the read and write values of the per-device blkio statistics.
+func sumBlkioEntries(entries []*v1.BlkIOEntry) (read uint64, write uint64) {
+	for _, entry := range entries {
+		switch strings.ToLower(entry.Op) {
+		case "read":
+			read += entry.Value
+		case "write":
+			write += entry.Value
+		}
+	}
+
+	return read, write
+}
+
 func pruneOldCgroupsV1(cfg cgroupscfg.Config, logger log.Logger) {
-	if err := config.PruneOldGitalyProcessDirectories(
-		logger,
-		filepath.Join(cfg.Mountpoint, "memory",
-			cfg.HierarchyRoot),
-	); err != nil {
-		logger.WithError(err).Error("failed to clean up memory cgroups")
+	subsystems, err := configuredSubsystems(cfg)
+	if err != nil {
+		logger.WithError(err).Error("failed to get cgroup subsystems")
+		return
 	}
 
-	if err := config.PruneOldGitalyProcessDirectories(
-		logger,
-		filepath.Join(cfg.Mountpoint, "cpu",
-			cfg.HierarchyRoot),
-	); err != nil {
-		logger.WithError(err).Error("failed to clean up cpu cgroups")
+	for _, subsystem := range subsystems {
+		if err := config.PruneOldGitalyProcessDirectories(
+			logger,
+			filepath.Join(cfg.Mountpoint, string(subsystem.Name()),
+				cfg.HierarchyRoot),
+		); err != nil {
+			logger.WithError(err).WithField("subsystem", subsystem.Name()).Error("failed to clean up cgroups")
+		}
 	}
 }
@@ -130,12 +130,16 @@ func TestSetup_ParentCgroups(t *testing.T) {
 
 func TestSetup_RepoCgroups(t *testing.T) {
 	tests := []struct {
-		name            string
-		cfg             cgroups.Repositories
-		wantMemoryBytes int
-		wantCPUShares   int
-		wantCPUQuotaUs  int
-		wantCFSPeriod   int
+		name                string
+		cfg                 cgroups.Repositories
+		wantMemoryBytes     int
+		wantCPUShares       int
+		wantCPUQuotaUs      int
+		wantCFSPeriod       int
+		wantPidsMax         int
+		wantBlkioWeight     int
+		wantReadBpsDevice   string
+		wantWriteIopsDevice string
 	}{
 		{
 			name:            "all config specified",
@@ -167,6 +171,25 @@ func TestSetup_RepoCgroups(t *testing.T) {
 			wantCPUQuotaUs: 100,
 			wantCFSPeriod:  int(cfsPeriodUs),
 		},
+		{
+			name: "only pids limit set",
+			cfg: cgroups.Repositories{
+				PidsMax: 128,
+			},
+			wantPidsMax: 128,
+		},
+		{
+			name: "only io limits set",
+			cfg: cgroups.Repositories{
+				IOWeight: 100,
+				IODevices: []cgroups.IODevice{
+					{Path: "/dev/null", ReadBps: 1048576, WriteIops: 200},
+				},
+			},
+			wantBlkioWeight:     100,
+			wantReadBpsDevice:   "1:3 1048576",
+			wantWriteIopsDevice: "1:3 200",
+		},
 	}
 
 	for _, tt := range tests {
@@ -209,6 +232,27 @@ func TestSetup_RepoCgroups(t *testing.T) {
 					mock.root, "cpu", "gitaly", fmt.Sprintf("gitaly-%d", pid), fmt.Sprintf("repos-%d", i), "cpu.cfs_period_us",
 				)
 				requireCgroup(t, cpuCFSPeriodPath, tt.wantCFSPeriod)
+
+				if tt.wantPidsMax > 0 {
+					pidsMaxPath := filepath.Join(
+						mock.root, "pids", "gitaly", fmt.Sprintf("gitaly-%d", pid), fmt.Sprintf("repos-%d", i), "pids.max",
+					)
+					requireCgroup(t, pidsMaxPath, tt.wantPidsMax)
+				} else {
+					require.NoDirExists(t, filepath.Join(mock.root, "pids", "gitaly"))
+				}
+
+				if tt.wantBlkioWeight > 0 {
+					blkioPath := filepath.Join(
+						mock.root, "blkio", "gitaly", fmt.Sprintf("gitaly-%d", pid), fmt.Sprintf("repos-%d", i),
+					)
+					requireCgroup(t, filepath.Join(blkioPath, "blkio.weight"), tt.wantBlkioWeight)
+					requireCgroupWithString(t, filepath.Join(blkioPath, "blkio.throttle.read_bps_device"), tt.wantReadBpsDevice)
+					requireCgroupWithString(t, filepath.Join(blkioPath, "blkio.throttle.write_iops_device"), tt.wantWriteIopsDevice)
+					require.NoFileExists(t, filepath.Join(blkioPath, "blkio.throttle.write_bps_device"))
+				} else {
+					require.NoDirExists(t, filepath.Join(mock.root, "blkio", "gitaly"))
+				}
 			}
 		})
 	}
@@ -383,6 +427,53 @@ gitaly_cgroup_cpu_cfs_throttled_seconds_total{path="%s"} 0.001
 	}
 }
 
+func TestMetrics_IOAndPids(t *testing.T) {
+	t.Parallel()
+
+	mock := newMock(t)
+
+	config := defaultCgroupsConfig()
+	config.Repositories.Count = 1
+	config.Repositories.PidsMax = 64
+	config.Repositories.IOWeight = 100
+	config.Mountpoint = mock.root
+	config.MetricsEnabled = true
+
+	v1Manager := mock.newCgroupManager(config, testhelper.SharedLogger(t), 1)
+	mock.setupMockCgroupFiles(t, v1Manager,
+		mockCgroupFile{"blkio.throttle.io_service_bytes", `8:0 Read 4096
+8:0 Write 1024
+8:16 Read 2048
+8:16 Sync 512
+Total 7680`},
+		mockCgroupFile{"blkio.throttle.io_serviced", `8:0 Read 4
+8:0 Write 1
+Total 5`},
+		mockCgroupFile{"pids.current", "3"},
+	)
+	require.NoError(t, v1Manager.Setup())
+
+	repoCgroupPath := filepath.Join(v1Manager.currentProcessCgroup(), "repos-0")
+	expected := strings.NewReader(strings.ReplaceAll(`# HELP gitaly_cgroup_io_bytes_total Total number of bytes transferred from and to block devices
+# TYPE gitaly_cgroup_io_bytes_total counter
+gitaly_cgroup_io_bytes_total{op="read",path="%s"} 6144
+gitaly_cgroup_io_bytes_total{op="write",path="%s"} 1024
+# HELP gitaly_cgroup_io_operations_total Total number of I/O operations performed on block devices
+# TYPE gitaly_cgroup_io_operations_total counter
+gitaly_cgroup_io_operations_total{op="read",path="%s"} 4
+gitaly_cgroup_io_operations_total{op="write",path="%s"} 1
+# HELP gitaly_cgroup_pids_current Number of processes currently in the Cgroup
+# TYPE gitaly_cgroup_pids_current gauge
+gitaly_cgroup_pids_current{path="%s"} 3
+`, "%s", repoCgroupPath))
+
+	assert.NoError(t, testutil.CollectAndCompare(v1Manager, expected,
+		"gitaly_cgroup_io_bytes_total",
+		"gitaly_cgroup_io_operations_total",
+		"gitaly_cgroup_pids_current",
+	))
+}
+
 func TestPruneOldCgroups(t *testing.T) {
 	t.Parallel()
 
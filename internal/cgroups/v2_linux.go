@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"io/fs"
+	"os"
 	"path/filepath"
 	"strings"
 	"time"
@@ -15,6 +16,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/config"
 	cgroupscfg "gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/config/cgroups"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/helper/perm"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/log"
 )
 
@@ -45,17 +47,63 @@ func (cvh *cgroupV2Handler) setupParent(parentResources *specs.LinuxResources) e
 
 func (cvh *cgroupV2Handler) setupRepository(reposResources *specs.LinuxResources) error {
 	for i := 0; i < int(cvh.cfg.Repositories.Count); i++ {
-		if _, err := cgroup2.NewManager(
-			cvh.cfg.Mountpoint,
-			"/"+cvh.repoPath(i),
-			cgroup2.ToResources(reposResources),
-		); err != nil {
+		if err := cvh.setupCgroup(cvh.repoPath(i), reposResources); err != nil {
 			return fmt.Errorf("failed creating repository cgroup: %w", err)
 		}
 	}
 	return nil
 }
 
+func (cvh *cgroupV2Handler) setupCgroup(cgroupPath string, resources *specs.LinuxResources) error {
+	v2Resources := cgroup2.ToResources(resources)
+
+	// The weight is converted into `io.bfq.weight` by `ToResources()`, which only applies to the
+	// BFQ scheduler and overflows for most values. We thus write `io.weight` ourselves instead.
+	var ioWeight uint64
+	if resources.BlockIO != nil && resources.BlockIO.Weight != nil {
+		ioWeight = convertBlkioWeight(*resources.BlockIO.Weight)
+		v2Resources.IO.BFQ.Weight = 0
+	}
+
+	if _, err := cgroup2.NewManager(cvh.cfg.Mountpoint, "/"+cgroupPath, v2Resources); err != nil {
+		return err
+	}
+
+	if ioWeight > 0 {
+		if err := os.WriteFile(
+			filepath.Join(cvh.cfg.Mountpoint, cgroupPath, "io.weight"),
+			[]byte(fmt.Sprintf("default %d", ioWeight)),
+			perm.PrivateFile,
+		); err != nil {
+			return fmt.Errorf("setting I/O weight: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (cvh *cgroupV2Handler) deleteCgroup(cgroupPath string) error {
+	control, err := cgroup2.Load("/"+cgroupPath, cgroup2.WithMountpoint(cvh.cfg.Mountpoint))
+	if err != nil {
+		return fmt.Errorf("failed loading cgroup %s: %w", cgroupPath, err)
+	}
+
+	if err := control.Delete(); err != nil {
+		return fmt.Errorf("failed deleting cgroup %s: %w", cgroupPath, err)
+	}
+
+	return nil
+}
+
+// convertBlkioWeight converts a Cgroup V1 `blkio.weight` in the range of [10, 1000] to a Cgroup V2
+// `io.weight` in the range of [1, 10000].
+func convertBlkioWeight(weight uint16) uint64 {
+	if weight == 0 {
+		return 0
+	}
+	return 1 + (uint64(weight)-10)*9999/990
+}
+
 func (cvh *cgroupV2Handler) addToCgroup(pid int, cgroupPath string) error {
 	control, err := cgroup2.Load("/"+cgroupPath, cgroup2.WithMountpoint(cvh.cfg.Mountpoint))
 	if err != nil {
@@ -74,13 +122,12 @@ func (cvh *cgroupV2Handler) addToCgroup(pid int, cgroupPath string) error {
 	return nil
 }
 
-func (cvh *cgroupV2Handler) collect(ch chan<- prometheus.Metric) {
+func (cvh *cgroupV2Handler) collect(repoPaths []string, ch chan<- prometheus.Metric) {
 	if !cvh.cfg.MetricsEnabled {
 		return
 	}
 
-	for i := 0; i < int(cvh.cfg.Repositories.Count); i++ {
-		repoPath := cvh.repoPath(i)
+	for _, repoPath := range repoPaths {
 		logger := cvh.logger.WithField("cgroup_path", repoPath)
 		control, err := cgroup2.Load("/"+repoPath, cgroup2.WithMountpoint(cvh.cfg.Mountpoint))
 		if err != nil {
@@ -119,6 +166,26 @@ func (cvh *cgroupV2Handler) collect(ch chan<- prometheus.Metric) {
 			cpuKernelMetric := cvh.cpuUsage.WithLabelValues(repoPath, "kernel")
 			cpuKernelMetric.Set(float64(metrics.CPU.SystemUsec))
 			ch <- cpuKernelMetric
+
+			if cvh.cfg.Repositories.IOLimited() && metrics.Io != nil {
+				var readBytes, writeBytes, readOps, writeOps uint64
+				for _, entry := range metrics.Io.Usage {
+					readBytes += entry.Rbytes
+					writeBytes += entry.Wbytes
+					readOps += entry.Rios
+					writeOps += entry.Wios
+				}
+				cvh.collectIO(ch, repoPath, readBytes, writeBytes, readOps, writeOps)
+			}
+
+			if cvh.cfg.Repositories.PidsMax > 0 && metrics.Pids != nil {
+				ch <- prometheus.MustNewConstMetric(
+					cvh.pidsCurrent,
+					prometheus.GaugeValue,
+					float64(metrics.Pids.Current),
+					repoPath,
+				)
+			}
 		}
 
 		if subsystems, err := control.Controllers(); err != nil {
@@ -159,6 +226,10 @@ func (cvh *cgroupV2Handler) repoPath(groupID int) string {
 	return filepath.Join(cvh.currentProcessCgroup(), fmt.Sprintf("repos-%d", groupID))
 }
 
+func (cvh *cgroupV2Handler) tenantPath(tenant string) string {
+	return filepath.Join(cvh.currentProcessCgroup(), "tenant-"+tenant)
+}
+
 func (cvh *cgroupV2Handler) currentProcessCgroup() string {
 	return config.GetGitalyProcessTempDir(cvh.cfg.HierarchyRoot, cvh.pid)
 }
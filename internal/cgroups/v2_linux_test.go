@@ -12,12 +12,15 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	cgrps "github.com/containerd/cgroups/v3"
 	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/config/cgroups"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/helper"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/helper/duration"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/helper/perm"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
 )
@@ -126,6 +129,9 @@ func TestSetup_RepoCgroupsV2(t *testing.T) {
 		wantMemoryBytes int
 		wantCPUWeight   int
 		wantCPUMax      string
+		wantPidsMax     int
+		wantIOWeight    string
+		wantIOMax       string
 	}{
 		{
 			name:            "all config specified",
@@ -158,6 +164,26 @@ func TestSetup_RepoCgroupsV2(t *testing.T) {
 			},
 			wantCPUMax: "1000 100000",
 		},
+		{
+			name: "only pids limit set",
+			cfg: cgroups.Repositories{
+				Count:   3,
+				PidsMax: 128,
+			},
+			wantPidsMax: 128,
+		},
+		{
+			name: "only io limits set",
+			cfg: cgroups.Repositories{
+				Count:    3,
+				IOWeight: 100,
+				IODevices: []cgroups.IODevice{
+					{Path: "/dev/null", WriteBps: 1048576},
+				},
+			},
+			wantIOWeight: "default 910",
+			wantIOMax:    "1:3 wbps=1048576",
+		},
 	}
 
 	for _, tt := range tests {
@@ -195,6 +221,21 @@ func TestSetup_RepoCgroupsV2(t *testing.T) {
 					mock.root, "gitaly", fmt.Sprintf("gitaly-%d", pid), fmt.Sprintf("repos-%d", i), "cpu.max",
 				)
 				requireCgroupWithString(t, cpuMaxPath, tt.wantCPUMax)
+
+				pidsMaxPath := filepath.Join(
+					mock.root, "gitaly", fmt.Sprintf("gitaly-%d", pid), fmt.Sprintf("repos-%d", i), "pids.max",
+				)
+				requireCgroupWithInt(t, pidsMaxPath, tt.wantPidsMax)
+
+				ioWeightPath := filepath.Join(
+					mock.root, "gitaly", fmt.Sprintf("gitaly-%d", pid), fmt.Sprintf("repos-%d", i), "io.weight",
+				)
+				requireCgroupWithString(t, ioWeightPath, tt.wantIOWeight)
+
+				ioMaxPath := filepath.Join(
+					mock.root, "gitaly", fmt.Sprintf("gitaly-%d", pid), fmt.Sprintf("repos-%d", i), "io.max",
+				)
+				requireCgroupWithString(t, ioMaxPath, tt.wantIOMax)
 			}
 		})
 	}
@@ -257,6 +298,107 @@ func TestAddCommandV2(t *testing.T) {
 	})
 }
 
+func TestAddCommandV2_TenantPlacement(t *testing.T) {
+	t.Parallel()
+
+	mock := newMockV2(t)
+
+	config := defaultCgroupsV2Config()
+	config.Mountpoint = mock.root
+	config.Repositories.Placement = cgroups.PlacementTenant
+	config.Repositories.MaxTenants = 2
+	config.Repositories.TenantIdleTimeout = duration.Duration(time.Minute)
+
+	pid := 1
+	v2Manager := mock.newCgroupManager(config, testhelper.SharedLogger(t), pid)
+	mock.setupMockCgroupFiles(t, v2Manager)
+
+	now := time.Now()
+	v2Manager.now = func() time.Time { return now }
+
+	ticker := helper.NewManualTicker()
+	swept := make(chan struct{})
+	ticker.ResetFunc = func() { swept <- struct{}{} }
+	v2Manager.sweepTicker = ticker
+
+	require.NoError(t, v2Manager.Setup())
+	defer func() {
+		// Removing the mocked cgroups fails because they still list processes, but the
+		// background sweep is stopped regardless.
+		_ = v2Manager.Cleanup()
+	}()
+	<-swept
+
+	ctx := testhelper.Context(t)
+	cmd := exec.CommandContext(ctx, "ls", "-hal", ".")
+	require.NoError(t, cmd.Run())
+
+	processCgroupPath := filepath.Join(mock.root, "gitaly", fmt.Sprintf("gitaly-%d", pid))
+	checksum := crc32.ChecksumIEEE([]byte("foobar"))
+	repoCgroupPath := filepath.Join(v2Manager.currentProcessCgroup(), fmt.Sprintf("repos-%d", uint(checksum)%config.Repositories.Count))
+
+	requireProcs := func(t *testing.T, cgroupPath string) {
+		t.Helper()
+
+		cmdPid, err := strconv.Atoi(string(readCgroupFile(t, filepath.Join(mock.root, cgroupPath, "cgroup.procs"))))
+		require.NoError(t, err)
+		require.Equal(t, cmd.Process.Pid, cmdPid)
+	}
+
+	t.Run("tenant cgroup is created", func(t *testing.T) {
+		cgroupPath, err := v2Manager.AddCommand(cmd, WithCgroupKey("foobar"), WithCgroupTenant("gitlab-org"))
+		require.NoError(t, err)
+		require.Equal(t, filepath.Join(v2Manager.currentProcessCgroup(), "tenant-gitlab-org"), cgroupPath)
+
+		requireProcs(t, cgroupPath)
+		requireCgroupWithInt(t, filepath.Join(processCgroupPath, "tenant-gitlab-org", "memory.max"), 1024000)
+	})
+
+	t.Run("invalid tenant falls back to repository cgroup", func(t *testing.T) {
+		cgroupPath, err := v2Manager.AddCommand(cmd, WithCgroupKey("foobar"), WithCgroupTenant("../gitlab-org"))
+		require.NoError(t, err)
+		require.Equal(t, repoCgroupPath, cgroupPath)
+		requireProcs(t, cgroupPath)
+	})
+
+	t.Run("tenants beyond the maximum fall back to repository cgroup", func(t *testing.T) {
+		cgroupPath, err := v2Manager.AddCommand(cmd, WithCgroupKey("foobar"), WithCgroupTenant("gitlab-com"))
+		require.NoError(t, err)
+		require.Equal(t, filepath.Join(v2Manager.currentProcessCgroup(), "tenant-gitlab-com"), cgroupPath)
+
+		cgroupPath, err = v2Manager.AddCommand(cmd, WithCgroupKey("foobar"), WithCgroupTenant("other"))
+		require.NoError(t, err)
+		require.Equal(t, repoCgroupPath, cgroupPath)
+		require.NoDirExists(t, filepath.Join(processCgroupPath, "tenant-other"))
+	})
+
+	t.Run("idle tenant cgroups are removed", func(t *testing.T) {
+		// Simulate that the process in the cgroup of "gitlab-com" has exited, whereas the one in
+		// "gitlab-org" is still running.
+		require.NoError(t, os.WriteFile(filepath.Join(processCgroupPath, "tenant-gitlab-com", "cgroup.procs"), nil, perm.PublicFile))
+
+		now = now.Add(2 * time.Minute)
+
+		// Tenant cgroups are only swept in the background, so adding a command doesn't
+		// remove them.
+		cgroupPath, err := v2Manager.AddCommand(cmd, WithCgroupKey("foobar"), WithCgroupTenant("other"))
+		require.NoError(t, err)
+		require.Equal(t, repoCgroupPath, cgroupPath)
+		require.DirExists(t, filepath.Join(processCgroupPath, "tenant-gitlab-com"))
+
+		ticker.Tick()
+		<-swept
+
+		require.NoDirExists(t, filepath.Join(processCgroupPath, "tenant-gitlab-com"))
+		require.DirExists(t, filepath.Join(processCgroupPath, "tenant-gitlab-org"))
+
+		cgroupPath, err = v2Manager.AddCommand(cmd, WithCgroupKey("foobar"), WithCgroupTenant("other"))
+		require.NoError(t, err)
+		require.Equal(t, filepath.Join(v2Manager.currentProcessCgroup(), "tenant-other"), cgroupPath)
+		require.DirExists(t, filepath.Join(processCgroupPath, "tenant-other"))
+	})
+}
+
 func TestCleanupV2(t *testing.T) {
 	mock := newMockV2(t)
 
@@ -363,6 +505,47 @@ gitaly_cgroup_procs_total{path="%s",subsystem="memory"} 1
 	}
 }
 
+func TestMetricsV2_IOAndPids(t *testing.T) {
+	t.Parallel()
+
+	mock := newMockV2(t)
+
+	config := defaultCgroupsV2Config()
+	config.Repositories.Count = 1
+	config.Repositories.PidsMax = 64
+	config.Repositories.IOWeight = 100
+	config.Mountpoint = mock.root
+	config.MetricsEnabled = true
+
+	v2Manager := mock.newCgroupManager(config, testhelper.SharedLogger(t), 1)
+	mock.setupMockCgroupFiles(t, v2Manager,
+		mockCgroupFile{"io.stat", `8:0 rbytes=4096 wbytes=1024 rios=4 wios=1 dbytes=0 dios=0
+8:16 rbytes=2048 wbytes=0 rios=2 wios=0 dbytes=0 dios=0`},
+		mockCgroupFile{"pids.current", "3"},
+	)
+	require.NoError(t, v2Manager.Setup())
+
+	repoCgroupPath := filepath.Join(v2Manager.currentProcessCgroup(), "repos-0")
+	expected := strings.NewReader(strings.ReplaceAll(`# HELP gitaly_cgroup_io_bytes_total Total number of bytes transferred from and to block devices
+# TYPE gitaly_cgroup_io_bytes_total counter
+gitaly_cgroup_io_bytes_total{op="read",path="%s"} 6144
+gitaly_cgroup_io_bytes_total{op="write",path="%s"} 1024
+# HELP gitaly_cgroup_io_operations_total Total number of I/O operations performed on block devices
+# TYPE gitaly_cgroup_io_operations_total counter
+gitaly_cgroup_io_operations_total{op="read",path="%s"} 6
+gitaly_cgroup_io_operations_total{op="write",path="%s"} 1
+# HELP gitaly_cgroup_pids_current Number of processes currently in the Cgroup
+# TYPE gitaly_cgroup_pids_current gauge
+gitaly_cgroup_pids_current{path="%s"} 3
+`, "%s", repoCgroupPath))
+
+	assert.NoError(t, testutil.CollectAndCompare(v2Manager, expected,
+		"gitaly_cgroup_io_bytes_total",
+		"gitaly_cgroup_io_operations_total",
+		"gitaly_cgroup_pids_current",
+	))
+}
+
 func TestPruneOldCgroupsV2(t *testing.T) {
 	t.Parallel()
 
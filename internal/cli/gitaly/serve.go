@@ -17,6 +17,7 @@ import (
 	"gitlab.com/gitlab-org/gitaly/v16/internal/bootstrap/starter"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/cache"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/cgroups"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/command/accounting"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/git"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/git/catfile"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/git/housekeeping"
@@ -212,8 +213,13 @@ func run(cfg config.Cfg, logger log.Logger) error {
 	}
 	logger.WithField("duration_ms", time.Since(began).Milliseconds()).Info("finished initializing bootstrap")
 
+	resourceTracker := accounting.NewTracker(accounting.DefaultWindow, accounting.DefaultBuckets, accounting.DefaultMaxKeys, accounting.DefaultMetricsTopN)
+	prometheus.MustRegister(resourceTracker)
+
 	skipHooks, _ := env.GetBool("GITALY_TESTING_NO_GIT_HOOKS", false)
-	var commandFactoryOpts []git.ExecCommandFactoryOption
+	commandFactoryOpts := []git.ExecCommandFactoryOption{
+		git.WithResourceTracker(resourceTracker),
+	}
 	if skipHooks {
 		commandFactoryOpts = append(commandFactoryOpts, git.WithSkipHooks())
 	}
@@ -299,7 +305,27 @@ func run(cfg config.Cfg, logger log.Logger) error {
 	// List of tracking adaptive limits. They will be calibrated by the adaptive calculator
 	adaptiveLimits := []limiter.AdaptiveLimiter{}
 
-	perRPCLimits, setupPerRPCConcurrencyLimiters := limithandler.WithConcurrencyLimiters(cfg)
+	// The load shedder observes the queues of all concurrency limiters so that it can detect
+	// when the server as a whole is overloaded.
+	var concurrencyMonitors []limiter.ConcurrencyMonitor
+	loadShedder := limiter.NewLoadShedder(
+		cfg.LoadShedding.Target.Duration(),
+		cfg.LoadShedding.Interval.Duration(),
+		cfg.LoadShedding.Percentile,
+	)
+	if cfg.LoadShedding.Enabled {
+		concurrencyMonitors = append(concurrencyMonitors, loadShedder)
+		prometheus.MustRegister(loadShedder)
+	}
+
+	loadSheddingHandler := limithandler.New(
+		cfg,
+		limithandler.LimitConcurrencyByRepo,
+		limithandler.WithLoadShedding(loadShedder),
+	)
+	prometheus.MustRegister(loadSheddingHandler)
+
+	perRPCLimits, setupPerRPCConcurrencyLimiters := limithandler.WithConcurrencyLimiters(cfg, concurrencyMonitors...)
 	for _, concurrency := range cfg.Concurrency {
 		// Connect adaptive limits to the adaptive calculator
 		if concurrency.Adaptive {
@@ -342,7 +368,7 @@ func run(cfg config.Cfg, logger log.Logger) error {
 		packObjectLimit,
 		cfg.PackObjectsLimiting.MaxQueueLength,
 		cfg.PackObjectsLimiting.MaxQueueWait.Duration(),
-		packObjectsMonitor,
+		limiter.NewMultiConcurrencyMonitor(append([]limiter.ConcurrencyMonitor{packObjectsMonitor}, concurrencyMonitors...)...),
 	)
 	prometheus.MustRegister(packObjectsMonitor)
 
@@ -371,7 +397,8 @@ func run(cfg config.Cfg, logger log.Logger) error {
 		logger,
 		registry,
 		diskCache,
-		[]*limithandler.LimiterMiddleware{perRPCLimitHandler, rateLimitHandler},
+		// Load shedding comes first so that shed requests don't occupy the queues of other limiters.
+		[]*limithandler.LimiterMiddleware{loadSheddingHandler, perRPCLimitHandler, rateLimitHandler},
 	)
 	defer gitalyServerFactory.Stop()
 
@@ -433,6 +460,7 @@ func run(cfg config.Cfg, logger log.Logger) error {
 			HousekeepingManager: housekeepingManager,
 			BackupSink:          backupSink,
 			BackupLocator:       backupLocator,
+			ResourceTracker:     resourceTracker,
 		})
 		b.RegisterStarter(starter.New(c, srv, logger))
 	}
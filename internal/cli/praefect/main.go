@@ -72,6 +72,10 @@ func NewApp() *cli.App {
 			newSQLMigrateStatusCommand(),
 			newRemoveRepositoryCommand(),
 			newSetReplicationFactorCommand(),
+			newRebalanceCommand(),
+			newReplicationQueueCommand(),
+			newNodeCommand(),
+			newAuditCommand(),
 		},
 		Flags: []cli.Flag{
 			&cli.StringFlag{
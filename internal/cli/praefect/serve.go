@@ -25,11 +25,13 @@ import (
 	"gitlab.com/gitlab-org/gitaly/v16/internal/log"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/config"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/datalossrecovery"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/datastore"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/datastore/glsql"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/metrics"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/nodes"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/nodes/tracker"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/rebalancer"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/reconciler"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/repocleaner"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/service"
@@ -179,6 +181,12 @@ func server(
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
+	if conf.AuditLog.LogChanges {
+		// The repository metadata changes made by the background workers are sent to the Praefects
+		// logging them.
+		ctx = datastore.WithAuditLogNotifications(ctx)
+	}
+
 	var db *sql.DB
 	if conf.NeedsSQL() {
 		logger.WithField("database_address", fmt.Sprintf("%s:%d", conf.DB.Host, conf.DB.Port)).Info("establishing database connection")
@@ -191,6 +199,21 @@ func server(
 		logger.Info("database connection established")
 	}
 
+	var embeddedDB *datastore.BadgerDB
+	if conf.EmbeddedDB.Enabled() {
+		logger.WithField("path", conf.EmbeddedDB.Path).Info("opening embedded database")
+		embeddedDB, err = datastore.OpenBadgerDB(logger, conf.EmbeddedDB.Path)
+		if err != nil {
+			return fmt.Errorf("open embedded database: %w", err)
+		}
+		defer func() {
+			if err := embeddedDB.Close(); err != nil {
+				logger.WithError(err).Error("embedded database close failed")
+			}
+		}()
+		logger.Info("embedded database opened")
+	}
+
 	var queue datastore.ReplicationEventQueue
 	var rs datastore.RepositoryStore
 	var csg datastore.ConsistentStoragesGetter
@@ -201,6 +224,11 @@ func server(
 		rs = datastore.MockRepositoryStore{}
 		csg = rs
 		logger.Info("reads distribution caching is disabled for in memory storage")
+	} else if embeddedDB != nil {
+		// The repository store depends on the health manager which is only set up once the nodes
+		// have been dialed. It is created alongside the elector below.
+		queue = datastore.NewBadgerReplicationEventQueue(embeddedDB)
+		logger.Info("reads distribution caching is disabled for embedded database")
 	} else {
 		queue = datastore.NewPostgresReplicationEventQueue(db)
 		rs = datastore.NewPostgresRepositoryStore(db, conf.StorageNames())
@@ -224,6 +252,19 @@ func server(
 				}
 			}()
 
+			if conf.AuditLog.LogChanges {
+				// The changes recorded in the repository audit log are streamed into the log. The
+				// listener's metrics are not collected as they would collide with the ones of the
+				// notifications listener above.
+				auditLogListener := datastore.NewResilientListener(conf.DB, helper.NewTimerTicker(5*time.Second), logger)
+				go func() {
+					err := auditLogListener.Listen(ctx, datastore.NewAuditLogger(logger), datastore.RepositoryAuditLogChannel)
+					if err != nil && !errors.Is(err, context.Canceled) {
+						logger.WithError(err).Error("repository audit log listener terminated")
+					}
+				}()
+			}
+
 			metricsCollectors = append(metricsCollectors, storagesCached, notificationsListener)
 			csg = storagesCached
 			logger.Info("reads distribution caching is enabled by configuration")
@@ -270,6 +311,7 @@ func server(
 		nodeSet       praefect.NodeSet
 		router        praefect.Router
 		primaryGetter praefect.PrimaryGetter
+		drainStore    datastore.DrainStore
 	)
 	if conf.Failover.ElectionStrategy == config.ElectionStrategyPerRepository {
 		nodeSet, err = praefect.DialNodes(
@@ -286,7 +328,12 @@ func server(
 		}
 		defer nodeSet.Close()
 
-		healthManager := nodes.NewHealthManager(logger, db, nodes.GeneratePraefectName(conf, logger), nodeSet.HealthClients())
+		var healthDB glsql.Querier
+		if db != nil {
+			healthDB = db
+		}
+
+		healthManager := nodes.NewHealthManager(logger, healthDB, nodes.GeneratePraefectName(conf, logger), nodeSet.HealthClients())
 		go func() {
 			if err := healthManager.Run(ctx, helper.NewTimerTicker(time.Second)); err != nil {
 				logger.WithError(err).Error("health manager exited")
@@ -299,31 +346,53 @@ func server(
 		// before the router is ready with the health status of the nodes.
 		<-healthManager.Updated()
 
-		elector := nodes.NewPerRepositoryElector(logger, db)
+		if embeddedDB != nil {
+			badgerRS := datastore.NewBadgerRepositoryStore(embeddedDB, conf.StorageNames(), healthManager)
+			rs = badgerRS
+			csg = badgerRS
+			primaryGetter = nodes.NewBadgerPerRepositoryElector(logger, badgerRS)
+			assignmentStore = datastore.NewBadgerAssignmentStore(embeddedDB, conf.StorageNames(), conf.FailureDomains())
+			drainStore = datastore.NewBadgerDrainStore(embeddedDB)
+		} else {
+			primaryGetter = nodes.NewPerRepositoryElector(logger, db)
+			assignmentStore = datastore.NewAssignmentStore(db, conf.StorageNames(), conf.FailureDomains())
+			drainStore = datastore.NewPostgresDrainStore(db)
+		}
 
-		primaryGetter = elector
-		assignmentStore = datastore.NewAssignmentStore(db, conf.StorageNames())
+		drainMonitor := praefect.NewDrainMonitor(logger, drainStore)
+		go func() {
+			if err := drainMonitor.Run(ctx, helper.NewTimerTicker(5*time.Second)); err != nil {
+				logger.WithError(err).Error("drain monitor exited")
+			}
+		}()
 
 		router = praefect.NewPerRepositoryRouter(
 			nodeSet.Connections(),
-			elector,
+			primaryGetter,
 			healthManager,
 			praefect.NewLockedRandom(rand.New(rand.NewSource(time.Now().UnixNano()))),
 			csg,
 			assignmentStore,
 			rs,
 			conf.DefaultReplicationFactors(),
+			conf.ReplicaSelections(),
+			conf.FailureDomains(),
+			drainMonitor,
 		)
 
-		if conf.BackgroundVerification.VerificationInterval > 0 {
+		if conf.BackgroundVerification.VerificationInterval > 0 && embeddedDB != nil {
+			logger.Warn("Disabled background verifier as it is only implemented using SQL database and embedded database is configured.")
+		} else if conf.BackgroundVerification.VerificationInterval > 0 {
 			logger.WithField("config", conf.BackgroundVerification).Info("background verifier started")
 			verifier := praefect.NewMetadataVerifier(
 				logger,
 				db,
+				queue,
 				nodeSet.Connections(),
 				healthManager,
 				conf.BackgroundVerification.VerificationInterval.Duration(),
 				conf.BackgroundVerification.DeleteInvalidRecords,
+				conf.BackgroundVerification.VerifyChecksums,
 			)
 			promreg.MustRegister(verifier)
 
@@ -399,6 +468,7 @@ func server(
 			Queue:           queue,
 			RepositoryStore: rs,
 			AssignmentStore: assignmentStore,
+			DrainStore:      drainStore,
 			Router:          router,
 			Registry:        protoregistry.GitalyProtoPreregistered,
 			Conns:           nodeSet.Connections(),
@@ -489,12 +559,16 @@ func server(
 	if interval := conf.Reconciliation.SchedulingInterval.Duration(); interval > 0 {
 		if conf.MemoryQueueEnabled {
 			logger.Warn("Disabled automatic reconciliation as it is only implemented using SQL queue and in-memory queue is configured.")
+		} else if embeddedDB != nil {
+			logger.Warn("Disabled automatic reconciliation as it is only implemented using SQL queue and embedded database is configured.")
 		} else {
 			r := reconciler.NewReconciler(
 				logger,
 				db,
 				healthChecker,
 				conf.StorageNames(),
+				conf.FailureDomains(),
+				conf.Reconciliation.MaxAssignmentMoves,
 				conf.Reconciliation.HistogramBuckets,
 			)
 			promreg.MustRegister(r)
@@ -506,6 +580,61 @@ func server(
 		}
 	}
 
+	if interval := conf.Rebalancing.RunInterval.Duration(); interval > 0 {
+		if conf.MemoryQueueEnabled {
+			logger.Warn("Disabled automatic rebalancing as it is only implemented using SQL queue and in-memory queue is configured.")
+		} else if embeddedDB != nil {
+			logger.Warn("Disabled automatic rebalancing as it is only implemented using SQL queue and embedded database is configured.")
+		} else {
+			r := rebalancer.NewRebalancer(
+				logger,
+				db,
+				healthChecker,
+				conf.StorageNames(),
+				conf.FailureDomains(),
+				conf.Rebalancing.Strategy,
+				conf.Rebalancing.MaxMoves,
+				rebalancer.NewRepositorySizer(nodeSet.Connections()),
+			)
+			go func() {
+				if err := r.Run(ctx, helper.NewTimerTicker(interval)); err != nil {
+					logger.WithError(err).Error("rebalancer finished execution")
+				}
+			}()
+		}
+	}
+
+	if interval := conf.DatalossRecovery.RunInterval.Duration(); interval > 0 {
+		if embeddedDB != nil {
+			logger.Warn("Disabled dataloss recovery as it is only implemented using SQL database and embedded database is configured.")
+		} else if db == nil {
+			logger.Warn("Disabled dataloss recovery as there is no database connection configured.")
+		} else {
+			r := datalossrecovery.NewRecoverer(
+				logger,
+				db,
+				conf.StorageNames(),
+				conf.DatalossRecovery,
+				datalossrecovery.NewRefLister(nodeSet.Connections()),
+			)
+			promreg.MustRegister(r)
+			go func() {
+				if err := r.Run(ctx, helper.NewTimerTicker(interval)); err != nil {
+					logger.WithError(err).Error("dataloss recoverer finished execution")
+				}
+			}()
+		}
+	}
+
+	if retention := conf.AuditLog.Retention.Duration(); retention > 0 && db != nil && embeddedDB == nil {
+		purger := datastore.NewAuditLogPurger(logger, db, retention)
+		go func() {
+			if err := purger.Run(ctx, helper.NewTimerTicker(time.Hour)); err != nil && !errors.Is(err, context.Canceled) {
+				logger.WithError(err).Error("repository audit log purger finished execution")
+			}
+		}()
+	}
+
 	if interval := conf.RepositoriesCleanup.RunInterval.Duration(); interval > 0 {
 		if db != nil {
 			go func() {
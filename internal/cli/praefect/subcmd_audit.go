@@ -0,0 +1,155 @@
+package praefect
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"github.com/urfave/cli/v2"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/log"
+	"gitlab.com/gitlab-org/gitaly/v16/proto/go/gitalypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	auditCmdName      = "audit"
+	paramRepositoryID = "repository-id"
+	paramActor        = "actor"
+	paramSince        = "since"
+	paramUntil        = "until"
+)
+
+func newAuditCommand() *cli.Command {
+	return &cli.Command{
+		Name:  auditCmdName,
+		Usage: "show the repository audit log",
+		Description: `Show the changes made to the repositories' metadata ordered by the time they were made.
+
+Every change to a repository's generation, primary, assignments or existence is recorded in the audit log. The
+entries can be filtered by the virtual storage, relative path or ID of the repository, by the actor that made the
+changes, and by time. For each entry, the following information is displayed:
+
+- ID.
+- Time of the change.
+- ID, virtual storage and relative path of the repository.
+- Storage the change concerns, if any.
+- Type of change, for example generation_changed or assignment_removed.
+- Values before and after the change.
+- Actor that made the change, for example grpc or reconciler.
+- Reason for the change, for example the RPC that triggered it.
+
+Changes made in the same database transaction share a transaction ID. The most recent entries are shown if the
+number of matching entries exceeds the limit.
+
+Example: praefect --config praefect.config.toml audit --virtual-storage default --relative-path repo.git --since 2023-08-30T10:00:00Z`,
+		HideHelpCommand: true,
+		Action:          auditAction,
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:  paramVirtualStorage,
+				Usage: "name of the repositories' virtual storage",
+			},
+			&cli.StringFlag{
+				Name:  paramRelativePath,
+				Usage: "relative path on the virtual storage of the repositories",
+			},
+			&cli.Int64Flag{
+				Name:  paramRepositoryID,
+				Usage: "ID of the repository",
+			},
+			&cli.StringFlag{
+				Name:  paramActor,
+				Usage: "actor that made the changes, for example grpc or reconciler",
+			},
+			&cli.TimestampFlag{
+				Name:   paramSince,
+				Layout: time.RFC3339,
+				Usage:  "earliest time of the changes in RFC 3339 format",
+			},
+			&cli.TimestampFlag{
+				Name:   paramUntil,
+				Layout: time.RFC3339,
+				Usage:  "latest time of the changes in RFC 3339 format",
+			},
+			&cli.UintFlag{
+				Name:  paramLimit,
+				Value: 100,
+				Usage: "maximum number of entries to show, 0 shows all entries",
+			},
+		},
+		Before: rejectPositionalArgs,
+	}
+}
+
+func auditAction(appCtx *cli.Context) error {
+	log.ConfigureCommand()
+
+	request := &gitalypb.ListRepositoryAuditLogRequest{
+		VirtualStorage: appCtx.String(paramVirtualStorage),
+		RelativePath:   appCtx.String(paramRelativePath),
+		RepositoryId:   appCtx.Int64(paramRepositoryID),
+		Actor:          appCtx.String(paramActor),
+		Limit:          uint32(appCtx.Uint(paramLimit)),
+	}
+
+	if since := appCtx.Timestamp(paramSince); since != nil {
+		request.Since = timestamppb.New(*since)
+	}
+
+	if until := appCtx.Timestamp(paramUntil); until != nil {
+		request.Until = timestamppb.New(*until)
+	}
+
+	client, conn, err := dialPraefectInfoService(appCtx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	stream, err := client.ListRepositoryAuditLog(appCtx.Context, request)
+	if err != nil {
+		return fmt.Errorf("list audit log: %w", err)
+	}
+
+	var entries []*gitalypb.RepositoryAuditLogEntry
+	for {
+		response, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+
+			return fmt.Errorf("list audit log: %w", err)
+		}
+
+		entries = append(entries, response.GetEntries()...)
+	}
+
+	if len(entries) == 0 {
+		fmt.Fprintln(appCtx.App.Writer, "No audit log entries found.")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(appCtx.App.Writer, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tTIME\tTRANSACTION\tREPOSITORY ID\tVIRTUAL STORAGE\tRELATIVE PATH\tSTORAGE\tCHANGE\tOLD\tNEW\tACTOR\tREASON")
+	for _, entry := range entries {
+		fmt.Fprintf(w, "%d\t%s\t%d\t%d\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			entry.Id,
+			entry.OccurredAt.AsTime().UTC().Format(time.RFC3339Nano),
+			entry.TransactionId,
+			entry.RepositoryId,
+			entry.VirtualStorage,
+			entry.RelativePath,
+			entry.Storage,
+			entry.Change,
+			entry.OldValue,
+			entry.NewValue,
+			entry.Actor,
+			entry.Reason,
+		)
+	}
+
+	return w.Flush()
+}
@@ -0,0 +1,112 @@
+package praefect
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/config"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/datastore"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/service/info"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper/testdb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestAuditSubcommand(t *testing.T) {
+	t.Parallel()
+
+	db := testdb.New(t)
+
+	// The entries are inserted directly so their times and transaction IDs are deterministic.
+	db.MustExec(t, `
+		INSERT INTO repository_audit_log (id, occurred_at, transaction_id, repository_id, virtual_storage, relative_path, storage, change, old_value, new_value, actor, reason)
+		VALUES
+			(1, '2023-08-30T10:00:00Z', 100, 1, 'virtual-storage', 'repository-1', 'gitaly-1', 'repository_created', NULL, '0', 'grpc', '/gitaly.RepositoryService/CreateRepository'),
+			(2, '2023-08-30T11:00:00Z', 101, 1, 'virtual-storage', 'repository-1', 'gitaly-2', 'primary_changed', 'gitaly-1', 'gitaly-2', 'unknown', ''),
+			(3, '2023-08-30T12:00:00Z', 102, 2, 'virtual-storage', 'repository-2', NULL, 'generation_changed', '0', '1', 'reconciler', 'reconcile replicas')
+	`)
+
+	newConfig := func(t *testing.T, rs datastore.RepositoryStore) string {
+		ln, clean := listenAndServe(t, []svcRegistrar{
+			registerPraefectInfoServer(info.NewServer(config.Config{}, testhelper.NewLogger(t), rs, nil, nil, nil, nil, nil)),
+		})
+		t.Cleanup(clean)
+
+		return writeConfigToFile(t, config.Config{SocketPath: ln.Addr().String()})
+	}
+
+	confPath := newConfig(t, datastore.NewPostgresRepositoryStore(db, nil))
+
+	const (
+		header       = "ID  TIME                  TRANSACTION  REPOSITORY ID  VIRTUAL STORAGE  RELATIVE PATH  STORAGE   CHANGE              OLD       NEW       ACTOR       REASON\n"
+		created      = "1   2023-08-30T10:00:00Z  100          1              virtual-storage  repository-1   gitaly-1  repository_created            0         grpc        /gitaly.RepositoryService/CreateRepository\n"
+		primary      = "2   2023-08-30T11:00:00Z  101          1              virtual-storage  repository-1   gitaly-2  primary_changed     gitaly-1  gitaly-2  unknown     \n"
+		reconciled   = "3   2023-08-30T12:00:00Z  102          2              virtual-storage  repository-2             generation_changed  0         1         reconciler  reconcile replicas\n"
+		singleHeader = "ID  TIME                  TRANSACTION  REPOSITORY ID  VIRTUAL STORAGE  RELATIVE PATH  STORAGE   CHANGE           OLD       NEW       ACTOR    REASON\n"
+	)
+
+	for _, tc := range []struct {
+		desc           string
+		args           []string
+		expectedErr    error
+		expectedStdout string
+	}{
+		{
+			desc:        "positional arguments",
+			args:        []string{"positional-arg"},
+			expectedErr: cli.Exit(unexpectedPositionalArgsError{Command: auditCmdName}, 1),
+		},
+		{
+			desc:           "all entries",
+			expectedStdout: header + created + primary + reconciled,
+		},
+		{
+			desc: "most recent entry of a repository",
+			args: []string{"-virtual-storage=virtual-storage", "-relative-path=repository-1", "-limit=1"},
+			expectedStdout: singleHeader +
+				"2   2023-08-30T11:00:00Z  101          1              virtual-storage  repository-1   gitaly-2  primary_changed  gitaly-1  gitaly-2  unknown  \n",
+		},
+		{
+			desc: "time range",
+			args: []string{"-since=2023-08-30T10:30:00Z", "-until=2023-08-30T11:30:00Z"},
+			expectedStdout: singleHeader +
+				"2   2023-08-30T11:00:00Z  101          1              virtual-storage  repository-1   gitaly-2  primary_changed  gitaly-1  gitaly-2  unknown  \n",
+		},
+		{
+			desc: "actor",
+			args: []string{"-actor=reconciler", "-repository-id=2"},
+			expectedStdout: "ID  TIME                  TRANSACTION  REPOSITORY ID  VIRTUAL STORAGE  RELATIVE PATH  STORAGE  CHANGE              OLD  NEW  ACTOR       REASON\n" +
+				"3   2023-08-30T12:00:00Z  102          2              virtual-storage  repository-2            generation_changed  0    1    reconciler  reconcile replicas\n",
+		},
+		{
+			desc:           "no matching entries",
+			args:           []string{"-relative-path=unknown"},
+			expectedStdout: "No audit log entries found.\n",
+		},
+	} {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			stdout, stderr, err := runApp(append([]string{"-config", confPath, auditCmdName}, tc.args...))
+			assert.Empty(t, stderr)
+			require.Equal(t, tc.expectedErr, err)
+			if tc.expectedErr != nil {
+				return
+			}
+
+			require.Equal(t, tc.expectedStdout, stdout)
+		})
+	}
+
+	t.Run("unsupported datastore", func(t *testing.T) {
+		t.Parallel()
+
+		_, _, err := runApp([]string{"-config", newConfig(t, nil), auditCmdName})
+		require.Equal(t, fmt.Errorf("list audit log: %w", status.Error(codes.FailedPrecondition, "the repository audit log is only recorded in the Postgres database")), err)
+	})
+}
@@ -28,9 +28,10 @@ func TestMetadataSubcommand(t *testing.T) {
 		"virtual-storage": {"primary", "secondary-1", "secondary-2"},
 	})
 	require.NoError(t, rs.CreateRepository(ctx, 1, "virtual-storage", "relative-path", "replica-path", "primary", []string{"secondary-1"}, []string{"secondary-2"}, true, true))
-	require.NoError(t, rs.IncrementGeneration(ctx, 1, "primary", nil))
+	_, err := rs.IncrementGeneration(ctx, 1, "primary", nil)
+	require.NoError(t, err)
 
-	_, err := db.ExecContext(ctx, "UPDATE storage_repositories SET verified_at = $1 WHERE storage = 'primary'",
+	_, err = db.ExecContext(ctx, "UPDATE storage_repositories SET verified_at = $1 WHERE storage = 'primary'",
 		time.Date(2021, time.April, 1, 10, 4, 20, 64, time.UTC),
 	)
 	require.NoError(t, err)
@@ -104,7 +105,7 @@ func TestMetadataSubcommand(t *testing.T) {
 			})
 
 			ln, clean := listenAndServe(t, []svcRegistrar{
-				registerPraefectInfoServer(info.NewServer(config.Config{}, testhelper.NewLogger(t), rs, nil, nil, nil)),
+				registerPraefectInfoServer(info.NewServer(config.Config{}, testhelper.NewLogger(t), rs, nil, nil, nil, nil, nil)),
 			})
 			t.Cleanup(clean)
 
@@ -0,0 +1,130 @@
+package praefect
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli/v2"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/log"
+	"gitlab.com/gitlab-org/gitaly/v16/proto/go/gitalypb"
+)
+
+const (
+	nodeCmdName = "node"
+	paramNode   = "storage"
+)
+
+func newNodeCommand() *cli.Command {
+	return &cli.Command{
+		Name:  nodeCmdName,
+		Usage: "manage Gitaly nodes",
+		Description: `Manage the Gitaly nodes behind Praefect.
+
+Provides the following subcommands:
+
+- drain
+- undrain`,
+		HideHelpCommand: true,
+		Subcommands: []*cli.Command{
+			newNodeDrainCommand(),
+			newNodeUndrainCommand(),
+		},
+	}
+}
+
+func nodeFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:     paramVirtualStorage,
+			Usage:    "name of the node's virtual storage",
+			Required: true,
+		},
+		&cli.StringFlag{
+			Name:     paramNode,
+			Usage:    "name of the node's storage",
+			Required: true,
+		},
+	}
+}
+
+func newNodeDrainCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "drain",
+		Usage: "put a node into maintenance mode",
+		Description: `Drain a Gitaly node so it can be taken down for maintenance.
+
+A drained node:
+
+- Receives no new reads.
+- Is not elected as the primary of any repository. Repositories the node is currently the primary of fail over to
+  another up to date node the next time their primary is elected.
+- Keeps receiving writes and replication jobs so it stays up to date. Replication jobs in flight are finished.
+
+The drain state is stored in the database and applies to every Praefect. If all up to date nodes of a repository are
+drained, reads are still served by the drained nodes.
+
+Example: praefect --config praefect.config.toml node drain --virtual-storage default --storage gitaly-1`,
+		HideHelpCommand: true,
+		Action:          nodeDrainAction,
+		Flags:           nodeFlags(),
+		Before:          rejectPositionalArgs,
+	}
+}
+
+func nodeDrainAction(appCtx *cli.Context) error {
+	log.ConfigureCommand()
+
+	client, conn, err := dialPraefectInfoService(appCtx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	virtualStorage, storage := appCtx.String(paramVirtualStorage), appCtx.String(paramNode)
+	if _, err := client.DrainStorage(appCtx.Context, &gitalypb.DrainStorageRequest{
+		VirtualStorage: virtualStorage,
+		Storage:        storage,
+	}); err != nil {
+		return fmt.Errorf("drain storage: %w", err)
+	}
+
+	fmt.Fprintf(appCtx.App.Writer, "Drained storage %q on virtual storage %q.\n", storage, virtualStorage)
+
+	return nil
+}
+
+func newNodeUndrainCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "undrain",
+		Usage: "take a node out of maintenance mode",
+		Description: `Take a drained Gitaly node back into rotation. The node starts receiving reads and is eligible to
+become a primary again.
+
+Example: praefect --config praefect.config.toml node undrain --virtual-storage default --storage gitaly-1`,
+		HideHelpCommand: true,
+		Action:          nodeUndrainAction,
+		Flags:           nodeFlags(),
+		Before:          rejectPositionalArgs,
+	}
+}
+
+func nodeUndrainAction(appCtx *cli.Context) error {
+	log.ConfigureCommand()
+
+	client, conn, err := dialPraefectInfoService(appCtx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	virtualStorage, storage := appCtx.String(paramVirtualStorage), appCtx.String(paramNode)
+	if _, err := client.UndrainStorage(appCtx.Context, &gitalypb.UndrainStorageRequest{
+		VirtualStorage: virtualStorage,
+		Storage:        storage,
+	}); err != nil {
+		return fmt.Errorf("undrain storage: %w", err)
+	}
+
+	fmt.Fprintf(appCtx.App.Writer, "Undrained storage %q on virtual storage %q.\n", storage, virtualStorage)
+
+	return nil
+}
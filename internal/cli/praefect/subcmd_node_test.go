@@ -0,0 +1,115 @@
+package praefect
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/config"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/datastore"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/service/info"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestNodeSubcommand(t *testing.T) {
+	t.Parallel()
+	ctx := testhelper.Context(t)
+
+	db, err := datastore.OpenBadgerDB(testhelper.NewLogger(t), t.TempDir())
+	require.NoError(t, err)
+	defer func() { require.NoError(t, db.Close()) }()
+
+	store := datastore.NewBadgerDrainStore(db)
+
+	conf := config.Config{
+		VirtualStorages: []*config.VirtualStorage{
+			{
+				Name: "virtual-storage",
+				Nodes: []*config.Node{
+					{Storage: "gitaly-1", Address: "tcp://1.2.3.4"},
+					{Storage: "gitaly-2", Address: "tcp://1.2.3.5"},
+				},
+			},
+		},
+	}
+
+	newConfig := func(t *testing.T, drainStore datastore.DrainStore) string {
+		ln, clean := listenAndServe(t, []svcRegistrar{
+			registerPraefectInfoServer(info.NewServer(conf, testhelper.NewLogger(t), nil, nil, nil, nil, nil, drainStore)),
+		})
+		t.Cleanup(clean)
+
+		conf := conf
+		conf.SocketPath = ln.Addr().String()
+		return writeConfigToFile(t, conf)
+	}
+
+	confPath := newConfig(t, store)
+
+	for _, tc := range []struct {
+		desc            string
+		args            []string
+		expectedErr     error
+		expectedStdout  string
+		expectedDrained map[string][]string
+	}{
+		{
+			desc:        "positional arguments",
+			args:        []string{"drain", "-virtual-storage=virtual-storage", "-storage=gitaly-1", "positional-arg"},
+			expectedErr: cli.Exit(unexpectedPositionalArgsError{Command: "drain"}, 1),
+		},
+		{
+			desc:        "unknown virtual storage",
+			args:        []string{"drain", "-virtual-storage=unknown", "-storage=gitaly-1"},
+			expectedErr: fmt.Errorf("drain storage: %w", status.Error(codes.InvalidArgument, `unknown virtual storage: "unknown"`)),
+		},
+		{
+			desc:        "unknown storage",
+			args:        []string{"drain", "-virtual-storage=virtual-storage", "-storage=unknown"},
+			expectedErr: fmt.Errorf("drain storage: %w", status.Error(codes.InvalidArgument, `unknown storage: "unknown"`)),
+		},
+		{
+			desc:            "drain",
+			args:            []string{"drain", "-virtual-storage=virtual-storage", "-storage=gitaly-1"},
+			expectedStdout:  "Drained storage \"gitaly-1\" on virtual storage \"virtual-storage\".\n",
+			expectedDrained: map[string][]string{"virtual-storage": {"gitaly-1"}},
+		},
+		{
+			desc:            "drain already drained storage",
+			args:            []string{"drain", "-virtual-storage=virtual-storage", "-storage=gitaly-1"},
+			expectedStdout:  "Drained storage \"gitaly-1\" on virtual storage \"virtual-storage\".\n",
+			expectedDrained: map[string][]string{"virtual-storage": {"gitaly-1"}},
+		},
+		{
+			desc:            "undrain",
+			args:            []string{"undrain", "-virtual-storage=virtual-storage", "-storage=gitaly-1"},
+			expectedStdout:  "Undrained storage \"gitaly-1\" on virtual storage \"virtual-storage\".\n",
+			expectedDrained: map[string][]string{},
+		},
+	} {
+		// The test cases modify the drain state and thus can't run in parallel.
+		t.Run(tc.desc, func(t *testing.T) {
+			stdout, stderr, err := runApp(append([]string{"-config", confPath, nodeCmdName}, tc.args...))
+			assert.Empty(t, stderr)
+			require.Equal(t, tc.expectedErr, err)
+			if tc.expectedErr != nil {
+				return
+			}
+
+			require.Equal(t, tc.expectedStdout, stdout)
+
+			drained, err := store.GetDrainedStorages(ctx)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedDrained, drained)
+		})
+	}
+
+	t.Run("unsupported election strategy", func(t *testing.T) {
+		_, _, err := runApp([]string{"-config", newConfig(t, nil), nodeCmdName, "drain", "-virtual-storage=virtual-storage", "-storage=gitaly-1"})
+		require.Equal(t, fmt.Errorf("drain storage: %w", status.Error(codes.FailedPrecondition, "storages can only be drained with the per_repository election strategy")), err)
+	})
+}
@@ -0,0 +1,206 @@
+package praefect
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+
+	"github.com/urfave/cli/v2"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/log"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/config"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/rebalancer"
+)
+
+const (
+	rebalanceCmdName = "rebalance"
+	paramStrategy    = "strategy"
+	paramMaxMoves    = "max-moves"
+	paramDryRun      = "dry-run"
+)
+
+func newRebalanceCommand() *cli.Command {
+	return &cli.Command{
+		Name:  rebalanceCmdName,
+		Usage: "balance repository assignments across physical storages",
+		Description: `Move repository assignments between the physical storages of a virtual storage so that the storages
+are evenly loaded.
+
+Repositories with a replication factor set are only replicated to their assigned physical storages. Storages added to
+a virtual storage are therefore not assigned any existing repositories. Use the rebalance subcommand to move
+assignments from the most loaded storages to the least loaded ones.
+
+The load of a storage is measured either by:
+
+- The number of repositories assigned to it (repository_count strategy).
+- The on-disk size of the repositories assigned to it (repository_size strategy).
+
+For every moved assignment, a replication job is scheduled to create the replica on the new storage. The replica on
+the old storage is removed by the reconciler after all of the assigned replicas are up to date. Only storages
+considered healthy by the running Praefect nodes are assigned repositories. The primary's assignment is never moved,
+and repositories with outdated replicas are skipped.
+
+The subcommand prints the current and planned load of each storage and the moved assignments. Use --dry-run to only
+print the plan without moving any assignments.
+
+Example: praefect --config praefect.config.toml rebalance --virtual-storage default --max-moves 100 --dry-run`,
+		HideHelpCommand: true,
+		Action:          rebalanceAction,
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:  paramVirtualStorage,
+				Usage: "name of the virtual storage to rebalance, all virtual storages are rebalanced if not set",
+			},
+			&cli.StringFlag{
+				Name:  paramStrategy,
+				Usage: "strategy to measure the load of the storages with, either repository_count or repository_size (default: from configuration)",
+			},
+			&cli.UintFlag{
+				Name:  paramMaxMoves,
+				Usage: "maximum number of assignments to move per virtual storage (default: from configuration)",
+			},
+			&cli.BoolFlag{
+				Name:  paramDryRun,
+				Usage: "print the plan without moving any assignments",
+			},
+		},
+		Before: func(ctx *cli.Context) error {
+			if ctx.Args().Present() {
+				_ = cli.ShowSubcommandHelp(ctx)
+				return cli.Exit(unexpectedPositionalArgsError{Command: ctx.Command.Name}, 1)
+			}
+			return nil
+		},
+	}
+}
+
+func rebalanceAction(appCtx *cli.Context) error {
+	logger := log.ConfigureCommand()
+
+	conf, err := readConfig(appCtx.String(configFlagName))
+	if err != nil {
+		return err
+	}
+
+	strategy := conf.Rebalancing.Strategy
+	if appCtx.IsSet(paramStrategy) {
+		strategy = config.RebalanceStrategy(appCtx.String(paramStrategy))
+	}
+	switch strategy {
+	case config.RebalanceStrategyRepositoryCount, config.RebalanceStrategyRepositorySize:
+	default:
+		return fmt.Errorf("unsupported strategy %q", strategy)
+	}
+
+	maxMoves := conf.Rebalancing.MaxMoves
+	if appCtx.IsSet(paramMaxMoves) {
+		maxMoves = appCtx.Uint(paramMaxMoves)
+	}
+
+	virtualStorages := make([]string, 0, len(conf.VirtualStorages))
+	if virtualStorage := appCtx.String(paramVirtualStorage); virtualStorage != "" {
+		virtualStorages = append(virtualStorages, virtualStorage)
+	} else {
+		for _, virtualStorage := range conf.VirtualStorages {
+			virtualStorages = append(virtualStorages, virtualStorage.Name)
+		}
+	}
+
+	ctx := appCtx.Context
+
+	db, clean, err := openDB(conf.DB, appCtx.App.ErrWriter)
+	if err != nil {
+		return err
+	}
+	defer clean()
+
+	healthyStorages, err := getHealthyStorages(ctx, db)
+	if err != nil {
+		return fmt.Errorf("healthy storages: %w", err)
+	}
+
+	var sizer rebalancer.RepositorySizer
+	if strategy == config.RebalanceStrategyRepositorySize {
+		nodeSet, err := dialGitalyStorages(ctx, conf, defaultDialTimeout)
+		if err != nil {
+			return fmt.Errorf("dial nodes: %w", err)
+		}
+		defer nodeSet.Close()
+
+		sizer = rebalancer.NewRepositorySizer(nodeSet.Connections())
+	}
+
+	r := rebalancer.NewRebalancer(
+		logger,
+		db,
+		praefect.StaticHealthChecker(healthyStorages),
+		conf.StorageNames(),
+		conf.FailureDomains(),
+		strategy,
+		maxMoves,
+		sizer,
+	)
+
+	plans, err := r.Plan(ctx, virtualStorages)
+	if err != nil {
+		return fmt.Errorf("plan: %w", err)
+	}
+
+	for _, plan := range plans {
+		moves := plan.Moves
+		if !appCtx.Bool(paramDryRun) {
+			moves, err = r.Apply(ctx, plan)
+			if err != nil {
+				return fmt.Errorf("apply: %w", err)
+			}
+		}
+
+		printRebalancePlan(appCtx.App.Writer, plan, moves, appCtx.Bool(paramDryRun))
+	}
+
+	return nil
+}
+
+func getHealthyStorages(ctx context.Context, db *sql.DB) (map[string][]string, error) {
+	rows, err := db.QueryContext(ctx, `SELECT virtual_storage, storage FROM healthy_storages`)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+	defer rows.Close()
+
+	healthyStorages := map[string][]string{}
+	for rows.Next() {
+		var virtualStorage, storage string
+		if err := rows.Scan(&virtualStorage, &storage); err != nil {
+			return nil, fmt.Errorf("scan: %w", err)
+		}
+
+		healthyStorages[virtualStorage] = append(healthyStorages[virtualStorage], storage)
+	}
+
+	return healthyStorages, rows.Err()
+}
+
+func printRebalancePlan(w io.Writer, plan rebalancer.Plan, moves []rebalancer.Move, dryRun bool) {
+	fmt.Fprintf(w, "Virtual storage: %s\n", plan.VirtualStorage)
+	fmt.Fprintln(w, "  Storage loads:")
+	for _, storage := range plan.SortedStorages() {
+		fmt.Fprintf(w, "    %s: %d -> %d\n", storage, plan.CurrentLoads[storage], plan.PlannedLoads[storage])
+	}
+
+	if len(moves) == 0 {
+		fmt.Fprintln(w, "  No assignments to move.")
+		return
+	}
+
+	if dryRun {
+		fmt.Fprintln(w, "  Assignments to move:")
+	} else {
+		fmt.Fprintln(w, "  Moved assignments:")
+	}
+
+	for _, move := range moves {
+		fmt.Fprintf(w, "    %s: %s -> %s\n", move.RelativePath, move.SourceStorage, move.TargetStorage)
+	}
+}
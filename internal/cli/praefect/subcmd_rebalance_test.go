@@ -0,0 +1,108 @@
+package praefect
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/config"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/datastore"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper/testdb"
+)
+
+func TestRebalanceSubcommand(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	db := testdb.New(t)
+
+	cfg := config.Config{
+		ListenAddr: "/dev/null",
+		VirtualStorages: []*config.VirtualStorage{
+			{
+				Name: "virtual-storage",
+				Nodes: []*config.Node{
+					{Storage: "gitaly-1", Address: "tcp://localhost:1"},
+					{Storage: "gitaly-2", Address: "tcp://localhost:2"},
+					{Storage: "gitaly-3", Address: "tcp://localhost:3"},
+				},
+			},
+		},
+		DB:          testdb.GetConfig(t, db.Name),
+		Rebalancing: config.DefaultRebalancingConfig(),
+	}
+	confPath := writeConfigToFile(t, cfg)
+
+	rs := datastore.NewPostgresRepositoryStore(db, cfg.StorageNames())
+	require.NoError(t, rs.CreateRepository(ctx, 1, "virtual-storage", "relative-path-1", "replica-path-1", "gitaly-1", []string{"gitaly-2"}, nil, true, true))
+	require.NoError(t, rs.CreateRepository(ctx, 2, "virtual-storage", "relative-path-2", "replica-path-2", "gitaly-2", []string{"gitaly-1"}, nil, true, true))
+
+	// Mark all of the storages as healthy.
+	_, err := db.ExecContext(ctx, `
+		INSERT INTO node_status (praefect_name, shard_name, node_name, last_contact_attempt_at, last_seen_active_at)
+		SELECT 'praefect', 'virtual-storage', storage, NOW(), NOW()
+		FROM unnest($1::text[]) AS storage
+	`, []string{"gitaly-1", "gitaly-2", "gitaly-3"})
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		desc           string
+		args           []string
+		expectedErr    error
+		expectedStdout string
+	}{
+		{
+			desc:        "unexpected positional arguments",
+			args:        []string{"positional-arg"},
+			expectedErr: cli.Exit(unexpectedPositionalArgsError{Command: "rebalance"}, 1),
+		},
+		{
+			desc:        "unsupported strategy",
+			args:        []string{"-strategy=unknown"},
+			expectedErr: errors.New(`unsupported strategy "unknown"`),
+		},
+		{
+			desc: "dry run",
+			args: []string{"-dry-run"},
+			expectedStdout: `Virtual storage: virtual-storage
+  Storage loads:
+    gitaly-1: 2 -> 2
+    gitaly-2: 2 -> 1
+    gitaly-3: 0 -> 1
+  Assignments to move:
+    relative-path-1: gitaly-2 -> gitaly-3
+`,
+		},
+		{
+			desc: "rebalance",
+			args: []string{"-max-moves=1"},
+			expectedStdout: `Virtual storage: virtual-storage
+  Storage loads:
+    gitaly-1: 2 -> 2
+    gitaly-2: 2 -> 1
+    gitaly-3: 0 -> 1
+  Moved assignments:
+    relative-path-1: gitaly-2 -> gitaly-3
+`,
+		},
+		{
+			desc: "already balanced",
+			args: []string{"-virtual-storage=virtual-storage"},
+			expectedStdout: `Virtual storage: virtual-storage
+  Storage loads:
+    gitaly-1: 2 -> 2
+    gitaly-2: 1 -> 1
+    gitaly-3: 1 -> 1
+  No assignments to move.
+`,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			stdout, _, err := runApp(append([]string{"-config", confPath, rebalanceCmdName}, tc.args...))
+			require.Equal(t, tc.expectedErr, err)
+			require.Equal(t, tc.expectedStdout, stdout)
+		})
+	}
+}
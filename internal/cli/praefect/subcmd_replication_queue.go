@@ -0,0 +1,414 @@
+package praefect
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/urfave/cli/v2"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/log"
+	"gitlab.com/gitlab-org/gitaly/v16/proto/go/gitalypb"
+	"google.golang.org/grpc"
+)
+
+const (
+	replicationQueueCmdName = "replication-queue"
+	paramJobID              = "id"
+	paramTargetStorage      = "target-storage"
+	paramChange             = "change"
+	paramState              = "state"
+	paramLimit              = "limit"
+)
+
+var replicationJobStates = map[string]gitalypb.ReplicationJobState{
+	"ready":       gitalypb.ReplicationJobState_REPLICATION_JOB_STATE_READY,
+	"in_progress": gitalypb.ReplicationJobState_REPLICATION_JOB_STATE_IN_PROGRESS,
+	"failed":      gitalypb.ReplicationJobState_REPLICATION_JOB_STATE_FAILED,
+	"dead":        gitalypb.ReplicationJobState_REPLICATION_JOB_STATE_DEAD,
+}
+
+func replicationJobStateName(state gitalypb.ReplicationJobState) string {
+	for name, s := range replicationJobStates {
+		if s == state {
+			return name
+		}
+	}
+
+	return "unknown"
+}
+
+func newReplicationQueueCommand() *cli.Command {
+	return &cli.Command{
+		Name:  replicationQueueCmdName,
+		Usage: "manage the replication queue",
+		Description: `Manage the jobs in Praefect's replication queue.
+
+Provides the following subcommands:
+
+- list
+- show
+- retry
+- cancel`,
+		HideHelpCommand: true,
+		Subcommands: []*cli.Command{
+			newReplicationQueueListCommand(),
+			newReplicationQueueShowCommand(),
+			newReplicationQueueRetryCommand(),
+			newReplicationQueueCancelCommand(),
+		},
+	}
+}
+
+func replicationJobFilterFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.Uint64SliceFlag{
+			Name:  paramJobID,
+			Usage: "ID of a job, can be repeated",
+		},
+		&cli.StringFlag{
+			Name:  paramVirtualStorage,
+			Usage: "name of the jobs' virtual storage",
+		},
+		&cli.StringFlag{
+			Name:  paramRelativePath,
+			Usage: "relative path on the virtual storage of the jobs' repository",
+		},
+		&cli.StringFlag{
+			Name:  paramTargetStorage,
+			Usage: "name of the storage the jobs replicate to",
+		},
+		&cli.StringFlag{
+			Name:  paramChange,
+			Usage: "type of change the jobs replicate, for example update or delete_replica",
+		},
+		&cli.StringSliceFlag{
+			Name:  paramState,
+			Usage: "state of the jobs, one of ready, in_progress, failed or dead, can be repeated",
+		},
+	}
+}
+
+func replicationJobFilter(appCtx *cli.Context) (*gitalypb.ReplicationJobFilter, error) {
+	states := make([]gitalypb.ReplicationJobState, 0, len(appCtx.StringSlice(paramState)))
+	for _, name := range appCtx.StringSlice(paramState) {
+		state, ok := replicationJobStates[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown job state %q", name)
+		}
+
+		states = append(states, state)
+	}
+
+	return &gitalypb.ReplicationJobFilter{
+		JobIds:         appCtx.Uint64Slice(paramJobID),
+		VirtualStorage: appCtx.String(paramVirtualStorage),
+		RelativePath:   appCtx.String(paramRelativePath),
+		TargetStorage:  appCtx.String(paramTargetStorage),
+		Change:         appCtx.String(paramChange),
+		States:         states,
+	}, nil
+}
+
+func rejectPositionalArgs(ctx *cli.Context) error {
+	if ctx.Args().Present() {
+		_ = cli.ShowSubcommandHelp(ctx)
+		return cli.Exit(unexpectedPositionalArgsError{Command: ctx.Command.Name}, 1)
+	}
+	return nil
+}
+
+// dialPraefectInfoService dials the Praefect configured in the configuration file passed to the
+// command.
+func dialPraefectInfoService(appCtx *cli.Context) (gitalypb.PraefectInfoServiceClient, *grpc.ClientConn, error) {
+	conf, err := readConfig(appCtx.String(configFlagName))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	nodeAddr, err := getNodeAddress(conf)
+	if err != nil {
+		return nil, nil, fmt.Errorf("get node address: %w", err)
+	}
+
+	conn, err := subCmdDial(appCtx.Context, nodeAddr, conf.Auth.Token, defaultDialTimeout)
+	if err != nil {
+		return nil, nil, fmt.Errorf("dial: %w", err)
+	}
+
+	return gitalypb.NewPraefectInfoServiceClient(conn), conn, nil
+}
+
+func listReplicationJobs(appCtx *cli.Context, client gitalypb.PraefectInfoServiceClient, request *gitalypb.ListReplicationJobsRequest) ([]*gitalypb.ReplicationJob, error) {
+	stream, err := client.ListReplicationJobs(appCtx.Context, request)
+	if err != nil {
+		return nil, fmt.Errorf("list replication jobs: %w", err)
+	}
+
+	var jobs []*gitalypb.ReplicationJob
+	for {
+		response, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return jobs, nil
+			}
+
+			return nil, fmt.Errorf("list replication jobs: %w", err)
+		}
+
+		jobs = append(jobs, response.GetJobs()...)
+	}
+}
+
+func newReplicationQueueListCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "list",
+		Usage: "list replication jobs",
+		Description: `List the jobs in the replication queue ordered by their ID.
+
+The jobs can be filtered by their ID, virtual storage, relative path, target storage, change type and state. For
+each job, the following information is displayed:
+
+- ID.
+- State.
+- Type of change.
+- Virtual storage and relative path of the repository.
+- Source and target storages.
+- Number of attempts left.
+- Whether the job's repository lock is currently held.
+
+Jobs which have no attempts left are kept in the dead state for a week. Use the show subcommand to see why a job
+failed.
+
+Example: praefect --config praefect.config.toml replication-queue list --virtual-storage default --state dead`,
+		HideHelpCommand: true,
+		Action:          replicationQueueListAction,
+		Flags: append(replicationJobFilterFlags(),
+			&cli.UintFlag{
+				Name:  paramLimit,
+				Value: 100,
+				Usage: "maximum number of jobs to list, 0 lists all jobs",
+			},
+		),
+		Before: rejectPositionalArgs,
+	}
+}
+
+func replicationQueueListAction(appCtx *cli.Context) error {
+	log.ConfigureCommand()
+
+	filter, err := replicationJobFilter(appCtx)
+	if err != nil {
+		return err
+	}
+
+	client, conn, err := dialPraefectInfoService(appCtx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	jobs, err := listReplicationJobs(appCtx, client, &gitalypb.ListReplicationJobsRequest{
+		Filter: filter,
+		Limit:  uint32(appCtx.Uint(paramLimit)),
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(jobs) == 0 {
+		fmt.Fprintln(appCtx.App.Writer, "No replication jobs found.")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(appCtx.App.Writer, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tSTATE\tCHANGE\tVIRTUAL STORAGE\tRELATIVE PATH\tSOURCE\tTARGET\tATTEMPTS LEFT\tLOCKED")
+	for _, job := range jobs {
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%s\t%s\t%d\t%v\n",
+			job.Id,
+			replicationJobStateName(job.State),
+			job.Change,
+			job.VirtualStorage,
+			job.RelativePath,
+			job.SourceStorage,
+			job.TargetStorage,
+			job.AttemptsLeft,
+			job.LockAcquired,
+		)
+	}
+
+	return w.Flush()
+}
+
+func newReplicationQueueShowCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "show",
+		Usage: "show details of a replication job",
+		Description: `Show detailed information about a job in the replication queue, including the error the last failed
+attempt to process the job failed with and the state of the job's locks.
+
+Example: praefect --config praefect.config.toml replication-queue show --id 1`,
+		HideHelpCommand: true,
+		Action:          replicationQueueShowAction,
+		Flags: []cli.Flag{
+			&cli.Uint64Flag{
+				Name:     paramJobID,
+				Usage:    "ID of the job",
+				Required: true,
+			},
+		},
+		Before: rejectPositionalArgs,
+	}
+}
+
+func replicationQueueShowAction(appCtx *cli.Context) error {
+	log.ConfigureCommand()
+
+	client, conn, err := dialPraefectInfoService(appCtx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	id := appCtx.Uint64(paramJobID)
+	jobs, err := listReplicationJobs(appCtx, client, &gitalypb.ListReplicationJobsRequest{
+		Filter: &gitalypb.ReplicationJobFilter{JobIds: []uint64{id}},
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(jobs) == 0 {
+		return fmt.Errorf("replication job %d not found", id)
+	}
+
+	printReplicationJob(appCtx.App.Writer, jobs[0])
+
+	return nil
+}
+
+func printReplicationJob(w io.Writer, job *gitalypb.ReplicationJob) {
+	updatedAt := "never"
+	if job.UpdatedAt.IsValid() {
+		updatedAt = job.UpdatedAt.AsTime().String()
+	}
+
+	lockTriggeredAt := "not being processed"
+	if job.LockTriggeredAt.IsValid() {
+		lockTriggeredAt = job.LockTriggeredAt.AsTime().String()
+	}
+
+	fmt.Fprintf(w, "ID: %d\n", job.Id)
+	fmt.Fprintf(w, "State: %s\n", replicationJobStateName(job.State))
+	fmt.Fprintf(w, "Change: %s\n", job.Change)
+	fmt.Fprintf(w, "Repository ID: %d\n", job.RepositoryId)
+	fmt.Fprintf(w, "Virtual Storage: %q\n", job.VirtualStorage)
+	fmt.Fprintf(w, "Relative Path: %q\n", job.RelativePath)
+	fmt.Fprintf(w, "Replica Path: %q\n", job.ReplicaPath)
+	fmt.Fprintf(w, "Source Storage: %q\n", job.SourceStorage)
+	fmt.Fprintf(w, "Target Storage: %q\n", job.TargetStorage)
+	fmt.Fprintf(w, "Attempts Left: %d\n", job.AttemptsLeft)
+	fmt.Fprintf(w, "Created At: %s\n", job.CreatedAt.AsTime().String())
+	fmt.Fprintf(w, "Updated At: %s\n", updatedAt)
+	fmt.Fprintf(w, "Correlation ID: %q\n", job.CorrelationId)
+	fmt.Fprintf(w, "Error: %q\n", job.Error)
+	fmt.Fprintf(w, "Lock ID: %q\n", job.LockId)
+	fmt.Fprintf(w, "Lock Acquired: %v\n", job.LockAcquired)
+	fmt.Fprintf(w, "Lock Triggered At: %s\n", lockTriggeredAt)
+}
+
+func newReplicationQueueRetryCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "retry",
+		Usage: "retry dead replication jobs",
+		Description: `Move dead jobs in the replication queue back into the ready state so they are processed again.
+
+Jobs end up in the dead state when they have failed and have no attempts left. Only dead jobs matching the filter are
+retried. The IDs of the retried jobs are displayed on stdout.
+
+Example: praefect --config praefect.config.toml replication-queue retry --virtual-storage default --target-storage gitaly-1`,
+		HideHelpCommand: true,
+		Action:          replicationQueueRetryAction,
+		Flags:           replicationJobFilterFlags(),
+		Before:          rejectPositionalArgs,
+	}
+}
+
+func replicationQueueRetryAction(appCtx *cli.Context) error {
+	log.ConfigureCommand()
+
+	filter, err := replicationJobFilter(appCtx)
+	if err != nil {
+		return err
+	}
+
+	client, conn, err := dialPraefectInfoService(appCtx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	response, err := client.RetryReplicationJobs(appCtx.Context, &gitalypb.RetryReplicationJobsRequest{Filter: filter})
+	if err != nil {
+		return fmt.Errorf("retry replication jobs: %w", err)
+	}
+
+	fmt.Fprintf(appCtx.App.Writer, "retried jobs: %s\n", formatJobIDs(response.JobIds))
+
+	return nil
+}
+
+func newReplicationQueueCancelCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "cancel",
+		Usage: "cancel replication jobs",
+		Description: `Remove jobs from the replication queue, for example the jobs of a repository that has been deleted.
+
+At least one filter must be provided. Jobs which are being processed are not cancelled. The IDs of the cancelled jobs
+are displayed on stdout.
+
+Example: praefect --config praefect.config.toml replication-queue cancel --virtual-storage default --relative-path <relative_path_on_the_virtual_storage>`,
+		HideHelpCommand: true,
+		Action:          replicationQueueCancelAction,
+		Flags:           replicationJobFilterFlags(),
+		Before:          rejectPositionalArgs,
+	}
+}
+
+func replicationQueueCancelAction(appCtx *cli.Context) error {
+	log.ConfigureCommand()
+
+	filter, err := replicationJobFilter(appCtx)
+	if err != nil {
+		return err
+	}
+
+	client, conn, err := dialPraefectInfoService(appCtx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	response, err := client.CancelReplicationJobs(appCtx.Context, &gitalypb.CancelReplicationJobsRequest{Filter: filter})
+	if err != nil {
+		return fmt.Errorf("cancel replication jobs: %w", err)
+	}
+
+	fmt.Fprintf(appCtx.App.Writer, "cancelled jobs: %s\n", formatJobIDs(response.JobIds))
+
+	return nil
+}
+
+func formatJobIDs(ids []uint64) string {
+	if len(ids) == 0 {
+		return "none"
+	}
+
+	formatted := make([]string, len(ids))
+	for i, id := range ids {
+		formatted[i] = fmt.Sprint(id)
+	}
+
+	return strings.Join(formatted, ", ")
+}
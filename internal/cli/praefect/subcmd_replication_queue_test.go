@@ -0,0 +1,179 @@
+package praefect
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/config"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/datastore"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/service/info"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper/testdb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestReplicationQueueSubcommand(t *testing.T) {
+	t.Parallel()
+	ctx := testhelper.Context(t)
+
+	db := testdb.New(t)
+	queue := datastore.NewPostgresReplicationEventQueue(db)
+
+	ready, err := queue.Enqueue(ctx, datastore.ReplicationEvent{
+		Job: datastore.ReplicationJob{
+			RepositoryID:      1,
+			Change:            datastore.UpdateRepo,
+			VirtualStorage:    "virtual-storage",
+			RelativePath:      "relative-path-1",
+			ReplicaPath:       "replica-path-1",
+			SourceNodeStorage: "gitaly-1",
+			TargetNodeStorage: "gitaly-2",
+		},
+	})
+	require.NoError(t, err)
+
+	dead, err := queue.Enqueue(ctx, datastore.ReplicationEvent{
+		Job: datastore.ReplicationJob{
+			RepositoryID:      2,
+			Change:            datastore.UpdateRepo,
+			VirtualStorage:    "virtual-storage",
+			RelativePath:      "relative-path-2",
+			ReplicaPath:       "replica-path-2",
+			SourceNodeStorage: "gitaly-1",
+			TargetNodeStorage: "gitaly-3",
+		},
+	})
+	require.NoError(t, err)
+
+	_, err = queue.Dequeue(ctx, "virtual-storage", "gitaly-3", 1)
+	require.NoError(t, err)
+	require.NoError(t, queue.RecordError(ctx, dead.ID, "source repository not found"))
+	_, err = queue.Acknowledge(ctx, datastore.JobStateDead, []uint64{dead.ID})
+	require.NoError(t, err)
+
+	newConfig := func(t *testing.T, jobManager info.ReplicationJobManager) string {
+		ln, clean := listenAndServe(t, []svcRegistrar{
+			registerPraefectInfoServer(info.NewServer(config.Config{}, testhelper.NewLogger(t), nil, nil, nil, nil, jobManager, nil)),
+		})
+		t.Cleanup(clean)
+
+		return writeConfigToFile(t, config.Config{
+			SocketPath: ln.Addr().String(),
+			VirtualStorages: []*config.VirtualStorage{
+				{
+					Name:  "vs-1",
+					Nodes: []*config.Node{{Storage: "storage-1", Address: "tcp://1.2.3.4"}},
+				},
+			},
+		})
+	}
+
+	confPath := newConfig(t, queue)
+
+	for _, tc := range []struct {
+		desc           string
+		args           []string
+		expectedErr    error
+		expectedStdout string
+		stdoutContains []string
+	}{
+		{
+			desc:        "positional arguments",
+			args:        []string{"list", "positional-arg"},
+			expectedErr: cli.Exit(unexpectedPositionalArgsError{Command: "list"}, 1),
+		},
+		{
+			desc:        "unknown state",
+			args:        []string{"list", "-state=unknown"},
+			expectedErr: errors.New(`unknown job state "unknown"`),
+		},
+		{
+			desc: "list all jobs",
+			args: []string{"list"},
+			expectedStdout: fmt.Sprintf(`ID  STATE  CHANGE  VIRTUAL STORAGE  RELATIVE PATH    SOURCE    TARGET    ATTEMPTS LEFT  LOCKED
+%d   ready  update  virtual-storage  relative-path-1  gitaly-1  gitaly-2  3              false
+%d   dead   update  virtual-storage  relative-path-2  gitaly-1  gitaly-3  2              false
+`, ready.ID, dead.ID),
+		},
+		{
+			desc:           "list without matching jobs",
+			args:           []string{"list", "-target-storage=gitaly-1"},
+			expectedStdout: "No replication jobs found.\n",
+		},
+		{
+			desc: "show job",
+			args: []string{"show", fmt.Sprintf("-id=%d", dead.ID)},
+			stdoutContains: []string{
+				fmt.Sprintf("ID: %d\n", dead.ID),
+				"State: dead\n",
+				"Repository ID: 2\n",
+				`Relative Path: "relative-path-2"` + "\n",
+				`Target Storage: "gitaly-3"` + "\n",
+				`Error: "source repository not found"` + "\n",
+				"Lock Acquired: false\n",
+				"Lock Triggered At: not being processed\n",
+			},
+		},
+		{
+			desc:        "show missing job",
+			args:        []string{"show", "-id=100"},
+			expectedErr: errors.New("replication job 100 not found"),
+		},
+		{
+			desc:           "retry dead jobs",
+			args:           []string{"retry", "-virtual-storage=virtual-storage"},
+			expectedStdout: fmt.Sprintf("retried jobs: %d\n", dead.ID),
+		},
+		{
+			desc:           "retry without dead jobs",
+			args:           []string{"retry"},
+			expectedStdout: "retried jobs: none\n",
+		},
+		{
+			desc:        "cancel without filter",
+			args:        []string{"cancel"},
+			expectedErr: fmt.Errorf("cancel replication jobs: %w", status.Error(codes.InvalidArgument, "replication job filter must not be empty")),
+		},
+		{
+			desc:           "cancel jobs",
+			args:           []string{"cancel", "-relative-path=relative-path-1"},
+			expectedStdout: fmt.Sprintf("cancelled jobs: %d\n", ready.ID),
+		},
+		{
+			desc: "list remaining jobs",
+			args: []string{"list", "-state=ready", "-state=dead"},
+			expectedStdout: fmt.Sprintf(`ID  STATE  CHANGE  VIRTUAL STORAGE  RELATIVE PATH    SOURCE    TARGET    ATTEMPTS LEFT  LOCKED
+%d   ready  update  virtual-storage  relative-path-2  gitaly-1  gitaly-3  3              false
+`, dead.ID),
+		},
+	} {
+		// The test cases modify the queue and thus can't run in parallel.
+		t.Run(tc.desc, func(t *testing.T) {
+			stdout, stderr, err := runApp(append([]string{"-config", confPath, replicationQueueCmdName}, tc.args...))
+			assert.Empty(t, stderr)
+			require.Equal(t, tc.expectedErr, err)
+			if tc.expectedErr != nil {
+				return
+			}
+
+			if tc.stdoutContains != nil {
+				for _, expected := range tc.stdoutContains {
+					require.Contains(t, stdout, expected)
+				}
+				return
+			}
+
+			require.Equal(t, tc.expectedStdout, stdout)
+		})
+	}
+
+	t.Run("in-memory queue", func(t *testing.T) {
+		_, _, err := runApp([]string{"-config", newConfig(t, nil), replicationQueueCmdName, "list"})
+		require.Equal(t, fmt.Errorf("list replication jobs: %w", status.Error(codes.FailedPrecondition, "replication jobs can only be administered with the SQL replication queue")), err)
+	})
+}
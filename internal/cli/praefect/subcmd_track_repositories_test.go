@@ -104,7 +104,7 @@ func TestTrackRepositoriesSubcommand(t *testing.T) {
 	defer nodeMgr.Stop()
 
 	repositoryStore := datastore.NewPostgresRepositoryStore(db, conf.StorageNames())
-	assignmentStore := datastore.NewAssignmentStore(db, conf.StorageNames())
+	assignmentStore := datastore.NewAssignmentStore(db, conf.StorageNames(), nil)
 
 	t.Run("ok", func(t *testing.T) {
 		testCases := []struct {
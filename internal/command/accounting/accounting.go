@@ -0,0 +1,318 @@
+// Package accounting aggregates the resources consumed by spawned commands per project and per
+// user over a rolling window of time. This allows operators to find out which tenants are
+// currently consuming most of the resources of a node.
+package accounting
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	grpcmwtags "github.com/grpc-ecosystem/go-grpc-middleware/tags"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// DefaultWindow is the default duration over which resource usage is aggregated.
+	DefaultWindow = 10 * time.Minute
+	// DefaultBuckets is the default number of buckets the window is split into. The window moves
+	// forward in steps of `window / buckets`.
+	DefaultBuckets = 10
+	// DefaultMaxKeys is the default maximum number of distinct keys tracked per dimension in a
+	// single bucket. Usage of any further keys is accounted to OverflowKey.
+	DefaultMaxKeys = 10000
+	// DefaultMetricsTopN is the default number of top consumers per dimension that are exposed as
+	// Prometheus metrics.
+	DefaultMetricsTopN = 10
+
+	// OverflowKey is the key that resource usage is accounted to when the maximum number of keys
+	// has been reached.
+	OverflowKey = "_overflow"
+)
+
+// Dimension is a dimension by which resource usage is aggregated.
+type Dimension int
+
+const (
+	// DimensionProject aggregates resource usage by the `gl_project_path` of the repository.
+	DimensionProject Dimension = iota
+	// DimensionUser aggregates resource usage by the `gl_id` of the user.
+	DimensionUser
+
+	dimensionCount
+)
+
+// String returns the name of the dimension.
+func (d Dimension) String() string {
+	switch d {
+	case DimensionProject:
+		return "project"
+	case DimensionUser:
+		return "user"
+	default:
+		return "unknown"
+	}
+}
+
+// Resource is a resource by which consumers can be ranked.
+type Resource int
+
+const (
+	// ResourceCPU ranks consumers by the CPU time they have consumed.
+	ResourceCPU Resource = iota
+	// ResourceMemory ranks consumers by the maximum resident set size of their commands.
+	ResourceMemory
+	// ResourceIO ranks consumers by the number of blocks they have read and written.
+	ResourceIO
+)
+
+// Usage describes the resources consumed by commands.
+type Usage struct {
+	// Commands is the number of commands that have finished.
+	Commands uint64
+	// CPUTime is the sum of user and system time of all commands.
+	CPUTime time.Duration
+	// MaxRSS is the maximum resident set size of any single command in bytes.
+	MaxRSS int64
+	// InBlocks is the number of blocks read from the file system.
+	InBlocks int64
+	// OutBlocks is the number of blocks written to the file system.
+	OutBlocks int64
+}
+
+// Add adds the resources consumed by other to the usage.
+func (u *Usage) Add(other Usage) {
+	u.Commands += other.Commands
+	u.CPUTime += other.CPUTime
+	if other.MaxRSS > u.MaxRSS {
+		u.MaxRSS = other.MaxRSS
+	}
+	u.InBlocks += other.InBlocks
+	u.OutBlocks += other.OutBlocks
+}
+
+func (u Usage) value(resource Resource) int64 {
+	switch resource {
+	case ResourceMemory:
+		return u.MaxRSS
+	case ResourceIO:
+		return u.InBlocks + u.OutBlocks
+	default:
+		return int64(u.CPUTime)
+	}
+}
+
+// Consumer is the aggregated resource usage of a single key.
+type Consumer struct {
+	// Key is the project path or user ID the usage was aggregated by.
+	Key string
+	// Usage is the resource usage of the key over the window.
+	Usage Usage
+}
+
+type bucket struct {
+	start time.Time
+	usage [dimensionCount]map[string]*Usage
+}
+
+// Tracker keeps track of resources consumed by commands over a rolling window of time. Usage is
+// recorded into a ring of buckets that each cover a fraction of the window so that old usage
+// expires when the window moves forward.
+type Tracker struct {
+	now         func() time.Time
+	bucketWidth time.Duration
+	maxKeys     int
+	metricsTopN int
+
+	mu      sync.Mutex
+	buckets []bucket
+
+	cpuSecondsDesc *prometheus.Desc
+	maxRSSDesc     *prometheus.Desc
+	ioBlocksDesc   *prometheus.Desc
+	commandsDesc   *prometheus.Desc
+}
+
+// NewTracker creates a new Tracker that aggregates resource usage over the given window. The
+// window is split into the given number of buckets. At most maxKeys distinct keys are tracked
+// per dimension and bucket, and the metricsTopN top consumers of each dimension are exposed as
+// metrics.
+func NewTracker(window time.Duration, buckets, maxKeys, metricsTopN int) *Tracker {
+	if buckets <= 0 {
+		buckets = DefaultBuckets
+	}
+	if window <= 0 {
+		window = DefaultWindow
+	}
+
+	return &Tracker{
+		now:         time.Now,
+		bucketWidth: window / time.Duration(buckets),
+		maxKeys:     maxKeys,
+		metricsTopN: metricsTopN,
+		buckets:     make([]bucket, buckets),
+		cpuSecondsDesc: prometheus.NewDesc(
+			"gitaly_top_consumer_cpu_seconds",
+			"CPU time consumed by the commands of a top consumer over the accounting window",
+			[]string{"dimension", "key"}, nil,
+		),
+		maxRSSDesc: prometheus.NewDesc(
+			"gitaly_top_consumer_max_rss_bytes",
+			"Maximum resident set size of a single command of a top consumer over the accounting window",
+			[]string{"dimension", "key"}, nil,
+		),
+		ioBlocksDesc: prometheus.NewDesc(
+			"gitaly_top_consumer_io_blocks",
+			"Blocks read or written by the commands of a top consumer over the accounting window",
+			[]string{"dimension", "key", "op"}, nil,
+		),
+		commandsDesc: prometheus.NewDesc(
+			"gitaly_top_consumer_commands",
+			"Number of commands spawned by a top consumer over the accounting window",
+			[]string{"dimension", "key"}, nil,
+		),
+	}
+}
+
+// Window returns the duration over which resource usage is aggregated.
+func (t *Tracker) Window() time.Duration {
+	return t.bucketWidth * time.Duration(len(t.buckets))
+}
+
+// Record records the resource usage of a command. The keys are derived from the gRPC tags of the
+// request the command was spawned for. Usage of commands not spawned on behalf of a project or a
+// user is not recorded in the respective dimension.
+func (t *Tracker) Record(ctx context.Context, usage Usage) {
+	var keys [dimensionCount]string
+
+	tags := grpcmwtags.Extract(ctx).Values()
+	if projectPath, ok := tags["grpc.request.glProjectPath"].(string); ok {
+		keys[DimensionProject] = projectPath
+	}
+	if glID, ok := tags["grpc.request.glID"].(string); ok {
+		keys[DimensionUser] = glID
+	}
+
+	t.record(keys, usage)
+}
+
+func (t *Tracker) record(keys [dimensionCount]string, usage Usage) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	b := t.currentBucket()
+	for dimension, key := range keys {
+		if key == "" {
+			continue
+		}
+
+		usages := b.usage[dimension]
+		if usages == nil {
+			usages = make(map[string]*Usage)
+			b.usage[dimension] = usages
+		}
+
+		u, ok := usages[key]
+		if !ok {
+			if t.maxKeys > 0 && len(usages) >= t.maxKeys {
+				key = OverflowKey
+				u = usages[key]
+			}
+
+			if u == nil {
+				u = &Usage{}
+				usages[key] = u
+			}
+		}
+
+		u.Add(usage)
+	}
+}
+
+// currentBucket returns the bucket for the current point in time. The bucket is reset in case it
+// still contains usage of a previous window. Must be called with the lock held.
+func (t *Tracker) currentBucket() *bucket {
+	start := t.now().Truncate(t.bucketWidth)
+	b := &t.buckets[int(start.UnixNano()/int64(t.bucketWidth))%len(t.buckets)]
+
+	if !b.start.Equal(start) {
+		*b = bucket{start: start}
+	}
+
+	return b
+}
+
+// Top returns the limit top consumers of the given dimension over the window, ranked by the given
+// resource. All consumers are returned if limit is zero.
+func (t *Tracker) Top(dimension Dimension, resource Resource, limit int) []Consumer {
+	if dimension < 0 || dimension >= dimensionCount {
+		return nil
+	}
+
+	t.mu.Lock()
+	aggregated := make(map[string]Usage)
+	windowStart := t.now().Truncate(t.bucketWidth).Add(-t.Window())
+	for _, b := range t.buckets {
+		if !b.start.After(windowStart) {
+			continue
+		}
+
+		for key, usage := range b.usage[dimension] {
+			u := aggregated[key]
+			u.Add(*usage)
+			aggregated[key] = u
+		}
+	}
+	t.mu.Unlock()
+
+	consumers := make([]Consumer, 0, len(aggregated))
+	for key, usage := range aggregated {
+		consumers = append(consumers, Consumer{Key: key, Usage: usage})
+	}
+
+	return Rank(consumers, resource, limit)
+}
+
+// Rank sorts the consumers by the given resource in descending order and returns the limit top
+// consumers. All consumers are returned if limit is zero.
+func Rank(consumers []Consumer, resource Resource, limit int) []Consumer {
+	sort.Slice(consumers, func(i, j int) bool {
+		vi, vj := consumers[i].Usage.value(resource), consumers[j].Usage.value(resource)
+		if vi != vj {
+			return vi > vj
+		}
+		return consumers[i].Key < consumers[j].Key
+	})
+
+	if limit > 0 && len(consumers) > limit {
+		consumers = consumers[:limit]
+	}
+
+	return consumers
+}
+
+// Describe is used to describe Prometheus metrics.
+func (t *Tracker) Describe(descs chan<- *prometheus.Desc) {
+	prometheus.DescribeByCollect(t, descs)
+}
+
+// Collect is used to collect Prometheus metrics. Only the top consumers by CPU time of each
+// dimension are exposed so that the cardinality of the metrics stays bounded.
+func (t *Tracker) Collect(metrics chan<- prometheus.Metric) {
+	if t.metricsTopN <= 0 {
+		return
+	}
+
+	for dimension := Dimension(0); dimension < dimensionCount; dimension++ {
+		for _, consumer := range t.Top(dimension, ResourceCPU, t.metricsTopN) {
+			labels := []string{dimension.String(), consumer.Key}
+
+			metrics <- prometheus.MustNewConstMetric(t.cpuSecondsDesc, prometheus.GaugeValue, consumer.Usage.CPUTime.Seconds(), labels...)
+			metrics <- prometheus.MustNewConstMetric(t.maxRSSDesc, prometheus.GaugeValue, float64(consumer.Usage.MaxRSS), labels...)
+			metrics <- prometheus.MustNewConstMetric(t.ioBlocksDesc, prometheus.GaugeValue, float64(consumer.Usage.InBlocks), append(labels, "read")...)
+			metrics <- prometheus.MustNewConstMetric(t.ioBlocksDesc, prometheus.GaugeValue, float64(consumer.Usage.OutBlocks), append(labels, "write")...)
+			metrics <- prometheus.MustNewConstMetric(t.commandsDesc, prometheus.GaugeValue, float64(consumer.Usage.Commands), labels...)
+		}
+	}
+}
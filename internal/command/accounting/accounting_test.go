@@ -0,0 +1,163 @@
+package accounting
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	grpcmwtags "github.com/grpc-ecosystem/go-grpc-middleware/tags"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
+)
+
+func TestMain(m *testing.M) {
+	testhelper.Run(m)
+}
+
+func newTestTracker(now *time.Time, maxKeys int) *Tracker {
+	tracker := NewTracker(10*time.Minute, 10, maxKeys, 2)
+	tracker.now = func() time.Time {
+		return *now
+	}
+	return tracker
+}
+
+func TestTracker_Record(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	tracker := newTestTracker(&now, 0)
+
+	tags := grpcmwtags.NewTags()
+	tags.Set("grpc.request.glProjectPath", "group/project")
+	tags.Set("grpc.request.glID", "user-1")
+	tagsCtx := grpcmwtags.SetInContext(ctx, tags)
+
+	tracker.Record(tagsCtx, Usage{Commands: 1, CPUTime: time.Second, MaxRSS: 100, InBlocks: 1, OutBlocks: 2})
+	tracker.Record(tagsCtx, Usage{Commands: 1, CPUTime: 2 * time.Second, MaxRSS: 50, InBlocks: 3, OutBlocks: 4})
+	// Commands spawned outside of an RPC are not attributed to anyone.
+	tracker.Record(ctx, Usage{Commands: 1, CPUTime: time.Hour})
+
+	expected := []Consumer{
+		{Key: "group/project", Usage: Usage{Commands: 2, CPUTime: 3 * time.Second, MaxRSS: 100, InBlocks: 4, OutBlocks: 6}},
+	}
+	require.Equal(t, expected, tracker.Top(DimensionProject, ResourceCPU, 0))
+
+	expected[0].Key = "user-1"
+	require.Equal(t, expected, tracker.Top(DimensionUser, ResourceCPU, 0))
+}
+
+func TestTracker_Top(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	tracker := newTestTracker(&now, 0)
+
+	tracker.record([dimensionCount]string{"cpu-heavy", ""}, Usage{Commands: 1, CPUTime: time.Minute})
+	tracker.record([dimensionCount]string{"memory-heavy", ""}, Usage{Commands: 1, CPUTime: time.Second, MaxRSS: 1 << 30})
+	tracker.record([dimensionCount]string{"io-heavy", ""}, Usage{Commands: 1, CPUTime: 2 * time.Second, InBlocks: 1000, OutBlocks: 1000})
+
+	keys := func(consumers []Consumer) []string {
+		var keys []string
+		for _, consumer := range consumers {
+			keys = append(keys, consumer.Key)
+		}
+		return keys
+	}
+
+	require.Equal(t, []string{"cpu-heavy", "io-heavy", "memory-heavy"}, keys(tracker.Top(DimensionProject, ResourceCPU, 0)))
+	require.Equal(t, []string{"memory-heavy", "cpu-heavy", "io-heavy"}, keys(tracker.Top(DimensionProject, ResourceMemory, 0)))
+	require.Equal(t, []string{"io-heavy", "cpu-heavy", "memory-heavy"}, keys(tracker.Top(DimensionProject, ResourceIO, 0)))
+	require.Equal(t, []string{"cpu-heavy"}, keys(tracker.Top(DimensionProject, ResourceCPU, 1)))
+	require.Empty(t, tracker.Top(DimensionUser, ResourceCPU, 0))
+	require.Empty(t, tracker.Top(Dimension(-1), ResourceCPU, 0))
+}
+
+func TestTracker_Window(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	tracker := newTestTracker(&now, 0)
+	require.Equal(t, 10*time.Minute, tracker.Window())
+
+	tracker.record([dimensionCount]string{"project", ""}, Usage{Commands: 1, CPUTime: time.Second})
+
+	now = now.Add(5 * time.Minute)
+	tracker.record([dimensionCount]string{"project", ""}, Usage{Commands: 1, CPUTime: 2 * time.Second})
+	require.Equal(t, []Consumer{
+		{Key: "project", Usage: Usage{Commands: 2, CPUTime: 3 * time.Second}},
+	}, tracker.Top(DimensionProject, ResourceCPU, 0))
+
+	// The usage recorded at the start of the window expires once the window has moved past it.
+	now = now.Add(5 * time.Minute)
+	require.Equal(t, []Consumer{
+		{Key: "project", Usage: Usage{Commands: 1, CPUTime: 2 * time.Second}},
+	}, tracker.Top(DimensionProject, ResourceCPU, 0))
+
+	// Recording new usage into a bucket that still holds expired usage resets the bucket.
+	tracker.record([dimensionCount]string{"project", ""}, Usage{Commands: 1, CPUTime: 4 * time.Second})
+	require.Equal(t, []Consumer{
+		{Key: "project", Usage: Usage{Commands: 2, CPUTime: 6 * time.Second}},
+	}, tracker.Top(DimensionProject, ResourceCPU, 0))
+
+	now = now.Add(time.Hour)
+	require.Empty(t, tracker.Top(DimensionProject, ResourceCPU, 0))
+}
+
+func TestTracker_MaxKeys(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	tracker := newTestTracker(&now, 2)
+
+	tracker.record([dimensionCount]string{"a", ""}, Usage{Commands: 1, CPUTime: 3 * time.Second})
+	tracker.record([dimensionCount]string{"b", ""}, Usage{Commands: 1, CPUTime: 2 * time.Second})
+	tracker.record([dimensionCount]string{"c", ""}, Usage{Commands: 1, CPUTime: time.Second})
+	tracker.record([dimensionCount]string{"d", ""}, Usage{Commands: 1, CPUTime: time.Second})
+	tracker.record([dimensionCount]string{"a", ""}, Usage{Commands: 1, CPUTime: time.Second})
+
+	require.Equal(t, []Consumer{
+		{Key: "a", Usage: Usage{Commands: 2, CPUTime: 4 * time.Second}},
+		{Key: OverflowKey, Usage: Usage{Commands: 2, CPUTime: 2 * time.Second}},
+		{Key: "b", Usage: Usage{Commands: 1, CPUTime: 2 * time.Second}},
+	}, tracker.Top(DimensionProject, ResourceCPU, 0))
+}
+
+func TestTracker_Collect(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	tracker := newTestTracker(&now, 0)
+
+	tracker.record([dimensionCount]string{"a", "user-1"}, Usage{Commands: 1, CPUTime: 3 * time.Second, MaxRSS: 1024, InBlocks: 1, OutBlocks: 2})
+	tracker.record([dimensionCount]string{"b", "user-1"}, Usage{Commands: 1, CPUTime: 2 * time.Second})
+	tracker.record([dimensionCount]string{"c", "user-1"}, Usage{Commands: 1, CPUTime: time.Second})
+
+	require.NoError(t, testutil.CollectAndCompare(tracker, strings.NewReader(`
+# HELP gitaly_top_consumer_commands Number of commands spawned by a top consumer over the accounting window
+# TYPE gitaly_top_consumer_commands gauge
+gitaly_top_consumer_commands{dimension="project",key="a"} 1
+gitaly_top_consumer_commands{dimension="project",key="b"} 1
+gitaly_top_consumer_commands{dimension="user",key="user-1"} 3
+# HELP gitaly_top_consumer_cpu_seconds CPU time consumed by the commands of a top consumer over the accounting window
+# TYPE gitaly_top_consumer_cpu_seconds gauge
+gitaly_top_consumer_cpu_seconds{dimension="project",key="a"} 3
+gitaly_top_consumer_cpu_seconds{dimension="project",key="b"} 2
+gitaly_top_consumer_cpu_seconds{dimension="user",key="user-1"} 6
+# HELP gitaly_top_consumer_io_blocks Blocks read or written by the commands of a top consumer over the accounting window
+# TYPE gitaly_top_consumer_io_blocks gauge
+gitaly_top_consumer_io_blocks{dimension="project",key="a",op="read"} 1
+gitaly_top_consumer_io_blocks{dimension="project",key="a",op="write"} 2
+gitaly_top_consumer_io_blocks{dimension="project",key="b",op="read"} 0
+gitaly_top_consumer_io_blocks{dimension="project",key="b",op="write"} 0
+gitaly_top_consumer_io_blocks{dimension="user",key="user-1",op="read"} 1
+gitaly_top_consumer_io_blocks{dimension="user",key="user-1",op="write"} 2
+# HELP gitaly_top_consumer_max_rss_bytes Maximum resident set size of a single command of a top consumer over the accounting window
+# TYPE gitaly_top_consumer_max_rss_bytes gauge
+gitaly_top_consumer_max_rss_bytes{dimension="project",key="a"} 1024
+gitaly_top_consumer_max_rss_bytes{dimension="project",key="b"} 0
+gitaly_top_consumer_max_rss_bytes{dimension="user",key="user-1"} 1024
+`)))
+}
@@ -10,6 +10,7 @@ import (
 	"path"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"syscall"
 	"time"
 
@@ -17,9 +18,11 @@ import (
 	"github.com/opentracing/opentracing-go"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/command/accounting"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/command/commandcounter"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/featureflag"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/log"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/structerr"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/tracing"
 	labkittracing "gitlab.com/gitlab-org/labkit/tracing"
 )
@@ -123,6 +126,10 @@ func init() {
 	prometheus.MustRegister(globalSpawnTokenManager)
 }
 
+// ErrMaxRuntimeExceeded is wrapped by the error returned from Wait() when the command has been
+// terminated because it exceeded its maximum runtime.
+var ErrMaxRuntimeExceeded = errors.New("command exceeded maximum runtime")
+
 const (
 	// maxStderrBytes is at most how many bytes will be written to stderr
 	maxStderrBytes = 10000 // 10kb
@@ -155,6 +162,12 @@ type Command struct {
 	metricsSubCmd string
 	cgroupPath    string
 	cmdGitVersion string
+
+	resourceTracker *accounting.Tracker
+
+	maxRuntime         time.Duration
+	killGracePeriod    time.Duration
+	maxRuntimeExceeded atomic.Bool
 }
 
 // New creates a Command from the given executable name and arguments On success, the Command
@@ -237,8 +250,11 @@ func New(ctx context.Context, nameAndArgs []string, opts ...Option) (*Command, e
 		finalizers:      cfg.finalizers,
 		metricsCmd:      cfg.commandName,
 		metricsSubCmd:   cfg.subcommandName,
+		resourceTracker: cfg.resourceTracker,
 		cmdGitVersion:   cfg.gitVersion,
 		processExitedCh: make(chan struct{}),
+		maxRuntime:      cfg.maxRuntime,
+		killGracePeriod: cfg.killGracePeriod,
 	}
 
 	cmd.Dir = cfg.dir
@@ -307,7 +323,16 @@ func New(ctx context.Context, nameAndArgs []string, opts ...Option) (*Command, e
 	// We thus defer spawning the Goroutine.
 	defer func() {
 		go func() {
+			var maxRuntimeExceeded <-chan time.Time
+			if command.maxRuntime > 0 {
+				timer := time.NewTimer(command.maxRuntime)
+				defer timer.Stop()
+				maxRuntimeExceeded = timer.C
+			}
+
 			select {
+			case <-maxRuntimeExceeded:
+				command.terminate()
 			case <-ctx.Done():
 				// Before we kill the child process we need to close the process' standard streams. If
 				// we don't, it may happen that the signal gets delivered and that the process exits
@@ -348,6 +373,46 @@ func New(ctx context.Context, nameAndArgs []string, opts ...Option) (*Command, e
 	return command, nil
 }
 
+// terminate terminates the command because it has exceeded its maximum runtime. The process group
+// of the command first receives SIGTERM. If the command hasn't exited after the kill grace period,
+// the process group is killed with SIGKILL.
+func (c *Command) terminate() {
+	c.maxRuntimeExceeded.Store(true)
+
+	cmdName, subcmdName := c.metricsCommandName(), c.metricsSubCmd
+
+	log.FromContext(c.context).WithFields(log.Fields{
+		"pid":                          c.cmd.Process.Pid,
+		"path":                         c.cmd.Path,
+		"args":                         c.cmd.Args,
+		"command.max_runtime_ms":       c.maxRuntime.Milliseconds(),
+		"command.kill_grace_period_ms": c.killGracePeriod.Milliseconds(),
+	}).Warn("terminating command that exceeded its maximum runtime")
+
+	// Same as with context cancellation, we need to close the standard streams before signalling the
+	// process so that downstream readers observe the error.
+	c.teardownStandardStreams()
+
+	maxRuntimeExceededTotal.WithLabelValues(cmdName, subcmdName, "SIGTERM").Inc()
+	//nolint:errcheck // There is nothing we can do if signalling the process fails.
+	syscall.Kill(-c.cmd.Process.Pid, syscall.SIGTERM)
+
+	go func() {
+		timer := time.NewTimer(c.killGracePeriod)
+		defer timer.Stop()
+
+		select {
+		case <-timer.C:
+			maxRuntimeExceededTotal.WithLabelValues(cmdName, subcmdName, "SIGKILL").Inc()
+			//nolint:errcheck // There is nothing we can do if signalling the process fails.
+			syscall.Kill(-c.cmd.Process.Pid, syscall.SIGKILL)
+		case <-c.processExitedCh:
+		}
+	}()
+
+	_ = c.Wait()
+}
+
 // Read calls Read() on the stdout pipe of the command.
 func (c *Command) Read(p []byte) (int, error) {
 	if c.reader == nil {
@@ -386,9 +451,16 @@ func (c *Command) wait() {
 	c.teardownStandardStreams()
 	c.waitError = c.cmd.Wait()
 
-	// If the context is done, the process was likely terminated due to it. If so,
-	// we return the context error to correctly report the reason.
-	if c.context.Err() != nil {
+	// If the command has exceeded its maximum runtime, the process was likely terminated due to it.
+	// If so, we return a distinct error to correctly report the reason. Otherwise, if the context is
+	// done, the process was likely terminated due to it and we return the context error instead.
+	if c.maxRuntimeExceeded.Load() {
+		if exitCode, ok := ExitStatus(c.waitError); ok && exitCode == -1 {
+			//nolint:gitaly-linters // We can only wrap one
+			c.waitError = structerr.NewResourceExhausted("%s: %w", c.waitError, ErrMaxRuntimeExceeded).
+				WithMetadata("max_runtime", c.maxRuntime.String())
+		}
+	} else if c.context.Err() != nil {
 		// The standard library sets exit status -1 if the process was terminated by a signal,
 		// such as the SIGTERM sent when context is done.
 		if exitCode, ok := ExitStatus(c.waitError); ok && exitCode == -1 {
@@ -497,11 +569,24 @@ func (c *Command) logProcessComplete() {
 		}
 	}
 
-	service, method := methodFromContext(ctx)
-	cmdName := path.Base(c.cmd.Path)
-	if c.metricsCmd != "" {
-		cmdName = c.metricsCmd
+	if c.resourceTracker != nil {
+		usage := accounting.Usage{
+			Commands: 1,
+			CPUTime:  systemTime + userTime,
+		}
+
+		if ok {
+			// Maxrss is reported in kilobytes.
+			usage.MaxRSS = rusage.Maxrss * 1024
+			usage.InBlocks = rusage.Inblock
+			usage.OutBlocks = rusage.Oublock
+		}
+
+		c.resourceTracker.Record(ctx, usage)
 	}
+
+	service, method := methodFromContext(ctx)
+	cmdName := c.metricsCommandName()
 	cpuSecondsTotal.WithLabelValues(service, method, cmdName, c.metricsSubCmd, "system", c.cmdGitVersion).Add(systemTime.Seconds())
 	cpuSecondsTotal.WithLabelValues(service, method, cmdName, c.metricsSubCmd, "user", c.cmdGitVersion).Add(userTime.Seconds())
 	realSecondsTotal.WithLabelValues(service, method, cmdName, c.metricsSubCmd, c.cmdGitVersion).Add(realTime.Seconds())
@@ -586,6 +671,14 @@ func ExitStatus(err error) (int, bool) {
 	return 0, false
 }
 
+// metricsCommandName returns the name of the command used in metrics.
+func (c *Command) metricsCommandName() string {
+	if c.metricsCmd != "" {
+		return c.metricsCmd
+	}
+	return path.Base(c.cmd.Path)
+}
+
 func methodFromContext(ctx context.Context) (service string, method string) {
 	tags := grpcmwtags.Extract(ctx)
 	ctxValue := tags.Values()["grpc.request.fullMethod"]
@@ -16,10 +16,13 @@ import (
 	"testing"
 	"time"
 
+	grpcmwtags "github.com/grpc-ecosystem/go-grpc-middleware/tags"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/cgroups"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/command/accounting"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/structerr"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
 	"gitlab.com/gitlab-org/gitaly/v16/proto/go/gitalypb"
@@ -493,6 +496,88 @@ func TestCommand_logMessage(t *testing.T) {
 	assert.Equal(t, "/sys/fs/cgroup/1", logEntry.Data["command.cgroup_path"])
 }
 
+func TestCommand_withResourceTracker(t *testing.T) {
+	t.Parallel()
+
+	tags := grpcmwtags.NewTags()
+	tags.Set("grpc.request.glProjectPath", "group/project")
+	ctx := grpcmwtags.SetInContext(testhelper.Context(t), tags)
+
+	tracker := accounting.NewTracker(time.Hour, 1, 0, 0)
+
+	cmd, err := New(ctx, []string{"echo", "hello world"}, WithResourceTracker(tracker))
+	require.NoError(t, err)
+	require.NoError(t, cmd.Wait())
+
+	consumers := tracker.Top(accounting.DimensionProject, accounting.ResourceCPU, 0)
+	require.Len(t, consumers, 1)
+	require.Equal(t, "group/project", consumers[0].Key)
+	require.Equal(t, uint64(1), consumers[0].Usage.Commands)
+	require.Positive(t, consumers[0].Usage.MaxRSS)
+}
+
+func TestCommand_withMaxRuntime(t *testing.T) {
+	t.Parallel()
+
+	t.Run("command exits on SIGTERM", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testhelper.Context(t)
+
+		sigterms := maxRuntimeExceededTotal.WithLabelValues("test", "sigterm", "SIGTERM")
+		sigkills := maxRuntimeExceededTotal.WithLabelValues("test", "sigterm", "SIGKILL")
+		sigtermsBefore, sigkillsBefore := testutil.ToFloat64(sigterms), testutil.ToFloat64(sigkills)
+
+		cmd, err := New(ctx, []string{"sleep", "1h"},
+			WithMaxRuntime(time.Millisecond, time.Hour),
+			WithCommandName("test", "sigterm"),
+		)
+		require.NoError(t, err)
+
+		<-cmd.processExitedCh
+
+		err = cmd.Wait()
+		require.ErrorIs(t, err, ErrMaxRuntimeExceeded)
+		require.Equal(t, codes.ResourceExhausted, structerr.GRPCCode(err))
+		require.Equal(t, sigtermsBefore+1, testutil.ToFloat64(sigterms))
+		require.Equal(t, sigkillsBefore, testutil.ToFloat64(sigkills))
+	})
+
+	t.Run("command ignoring SIGTERM is killed", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testhelper.Context(t)
+
+		sigterms := maxRuntimeExceededTotal.WithLabelValues("test", "sigkill", "SIGTERM")
+		sigkills := maxRuntimeExceededTotal.WithLabelValues("test", "sigkill", "SIGKILL")
+		sigtermsBefore, sigkillsBefore := testutil.ToFloat64(sigterms), testutil.ToFloat64(sigkills)
+
+		// Ignored signals are inherited by child processes, so sleep(1) ignores SIGTERM, too.
+		cmd, err := New(ctx, []string{"sh", "-c", "trap '' TERM; sleep 1h"},
+			WithMaxRuntime(time.Millisecond, time.Millisecond),
+			WithCommandName("test", "sigkill"),
+		)
+		require.NoError(t, err)
+
+		<-cmd.processExitedCh
+
+		err = cmd.Wait()
+		require.ErrorIs(t, err, ErrMaxRuntimeExceeded)
+		require.Equal(t, sigtermsBefore+1, testutil.ToFloat64(sigterms))
+		require.Equal(t, sigkillsBefore+1, testutil.ToFloat64(sigkills))
+	})
+
+	t.Run("command finishing in time", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := testhelper.Context(t)
+
+		cmd, err := New(ctx, []string{"echo"}, WithMaxRuntime(time.Hour, time.Hour))
+		require.NoError(t, err)
+		require.NoError(t, cmd.Wait())
+	})
+}
+
 func TestCommand_withFinalizer(t *testing.T) {
 	t.Parallel()
 
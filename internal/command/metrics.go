@@ -11,3 +11,11 @@ var inFlightCommandGauge = promauto.NewGauge(
 		Help: "Total number of processes currently being executed",
 	},
 )
+
+var maxRuntimeExceededTotal = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "gitaly_command_max_runtime_exceeded_total",
+		Help: "Total number of signals sent to commands that exceeded their maximum runtime",
+	},
+	[]string{"cmd", "subcmd", "signal"},
+)
@@ -3,8 +3,10 @@ package command
 import (
 	"context"
 	"io"
+	"time"
 
 	"gitlab.com/gitlab-org/gitaly/v16/internal/cgroups"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/command/accounting"
 )
 
 type config struct {
@@ -23,6 +25,10 @@ type config struct {
 	cgroupsManager        cgroups.Manager
 	cgroupsAddCommandOpts []cgroups.AddCommandOption
 	spawnTokenManager     *SpawnTokenManager
+	resourceTracker       *accounting.Tracker
+
+	maxRuntime      time.Duration
+	killGracePeriod time.Duration
 }
 
 // Option is an option that can be passed to `New()` for controlling how the command is being
@@ -114,6 +120,24 @@ func WithSpawnTokenManager(spawnTokenManager *SpawnTokenManager) Option {
 	}
 }
 
+// WithResourceTracker records the resources consumed by the command into the given tracker once it
+// has finished.
+func WithResourceTracker(resourceTracker *accounting.Tracker) Option {
+	return func(cfg *config) {
+		cfg.resourceTracker = resourceTracker
+	}
+}
+
+// WithMaxRuntime sets up the command to be terminated when it runs for longer than maxRuntime. The
+// command first receives SIGTERM. If it hasn't exited after killGracePeriod, it is killed with
+// SIGKILL. Wait() returns an error wrapping ErrMaxRuntimeExceeded in that case.
+func WithMaxRuntime(maxRuntime, killGracePeriod time.Duration) Option {
+	return func(cfg *config) {
+		cfg.maxRuntime = maxRuntime
+		cfg.killGracePeriod = killGracePeriod
+	}
+}
+
 // WithFinalizer sets up the finalizer to be run when the command is being wrapped up. It will be
 // called after `Wait()` has returned.
 func WithFinalizer(finalizer func(context.Context, *Command)) Option {
@@ -79,7 +79,7 @@ func (p *parser) ParseCommit(object git.Object) (*gitalypb.GitCommit, error) {
 		case "committer":
 			commit.Committer = parseCommitAuthor(value)
 		case "gpgsig", "gpgsig-sha256":
-			commit.SignatureType = detectSignatureType(value)
+			commit.SignatureType = DetectSignatureType(value)
 		case "tree":
 			commit.TreeId = value
 		case "encoding":
@@ -173,7 +173,8 @@ func subjectFromBody(body []byte) []byte {
 	return bytes.TrimRight(subject, "\r\n")
 }
 
-func detectSignatureType(line string) gitalypb.SignatureType {
+// DetectSignatureType detects the type of a signature from its first line.
+func DetectSignatureType(line string) gitalypb.SignatureType {
 	switch strings.TrimSuffix(line, "\n") {
 	case "-----BEGIN SIGNED MESSAGE-----":
 		return gitalypb.SignatureType_X509
@@ -286,7 +287,7 @@ func (p *parser) parseTag(object git.Object, name []byte) (*gitalypb.Tag, tagged
 
 			if length > 0 {
 				signature := string(signature[:length])
-				tag.SignatureType = detectSignatureType(signature)
+				tag.SignatureType = DetectSignatureType(signature)
 			}
 		}
 	}
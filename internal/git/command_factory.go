@@ -9,18 +9,22 @@ import (
 	"path/filepath"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/cgroups"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/command"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/command/accounting"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/git/alternates"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/git/trace2"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/git/trace2hooks"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/config"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/storage"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/grpc/metadata"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/log"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/tracing"
 	"gitlab.com/gitlab-org/labkit/correlation"
+	"google.golang.org/grpc"
 )
 
 const (
@@ -47,10 +51,15 @@ type execCommandFactoryConfig struct {
 	hooksPath           string
 	gitBinaryPath       string
 	cgroupsManager      cgroups.Manager
+	resourceTracker     *accounting.Tracker
 	trace2Hooks         []trace2.Hook
 	execEnvConstructors []ExecutionEnvironmentConstructor
 }
 
+// defaultKillGracePeriod is the time a Git command that exceeded its maximum runtime has to exit
+// after having received SIGTERM if no grace period has been configured.
+const defaultKillGracePeriod = 10 * time.Second
+
 // ExecCommandFactoryOption is an option that can be passed to NewExecCommandFactory.
 type ExecCommandFactoryOption func(*execCommandFactoryConfig)
 
@@ -82,6 +91,14 @@ func WithCgroupsManager(cgroupsManager cgroups.Manager) ExecCommandFactoryOption
 	}
 }
 
+// WithResourceTracker sets up the command factory to record the resources consumed by Git commands
+// into the given tracker.
+func WithResourceTracker(resourceTracker *accounting.Tracker) ExecCommandFactoryOption {
+	return func(cfg *execCommandFactoryConfig) {
+		cfg.resourceTracker = resourceTracker
+	}
+}
+
 // WithTrace2Hooks overrides default trace2 hooks used by trace2 manager
 func WithTrace2Hooks(hooks []trace2.Hook) ExecCommandFactoryOption {
 	return func(cfg *execCommandFactoryConfig) {
@@ -126,6 +143,7 @@ type ExecCommandFactory struct {
 	execEnvs              []ExecutionEnvironment
 	logger                log.Logger
 	cgroupsManager        cgroups.Manager
+	resourceTracker       *accounting.Tracker
 	trace2Hooks           []trace2.Hook
 	invalidCommandsMetric *prometheus.CounterVec
 	hookDirs              hookDirectories
@@ -173,12 +191,13 @@ func NewExecCommandFactory(cfg config.Cfg, logger log.Logger, opts ...ExecComman
 	}
 
 	gitCmdFactory := &ExecCommandFactory{
-		cfg:            cfg,
-		execEnvs:       execEnvs,
-		logger:         logger,
-		locator:        config.NewLocator(cfg),
-		cgroupsManager: cgroupsManager,
-		trace2Hooks:    factoryCfg.trace2Hooks,
+		cfg:             cfg,
+		execEnvs:        execEnvs,
+		logger:          logger,
+		locator:         config.NewLocator(cfg),
+		cgroupsManager:  cgroupsManager,
+		resourceTracker: factoryCfg.resourceTracker,
+		trace2Hooks:     factoryCfg.trace2Hooks,
 		invalidCommandsMetric: prometheus.NewCounterVec(
 			prometheus.CounterOpts{
 				Name: "gitaly_invalid_commands_total",
@@ -464,6 +483,9 @@ func (cf *ExecCommandFactory) newCommand(ctx context.Context, repo storage.Repos
 			cgroups.WithCgroupKey(repo.GetStorageName() + "/" + repo.GetRelativePath()),
 		}
 	}
+	if tenant := metadata.GetValue(ctx, cgroups.TenantMetadataKey); tenant != "" {
+		cgroupsAddCommandOpts = append(cgroupsAddCommandOpts, cgroups.WithCgroupTenant(tenant))
+	}
 
 	commandOpts := config.commandOpts
 
@@ -481,12 +503,23 @@ func (cf *ExecCommandFactory) newCommand(ctx context.Context, repo storage.Repos
 		commandOpts = append(commandOpts, command.WithFinalizer(cf.trace2Finalizer(trace2Manager)))
 	}
 
+	fullMethod, _ := grpc.Method(ctx)
+	if maxRuntime := cf.cfg.Git.MaxRuntime(fullMethod, sc.Name); maxRuntime > 0 {
+		killGracePeriod := cf.cfg.Git.KillGracePeriod.Duration()
+		if killGracePeriod == 0 {
+			killGracePeriod = defaultKillGracePeriod
+		}
+
+		commandOpts = append(commandOpts, command.WithMaxRuntime(maxRuntime, killGracePeriod))
+	}
+
 	commandOpts = append(
 		commandOpts,
 		command.WithEnvironment(env),
 		command.WithCommandName("git", sc.Name),
 		command.WithCgroup(cf.cgroupsManager, cgroupsAddCommandOpts...),
 		command.WithCommandGitVersion(cmdGitVersion.String()),
+		command.WithResourceTracker(cf.resourceTracker),
 	)
 	command, err := command.New(ctx, append([]string{execEnv.BinaryPath}, args...), commandOpts...)
 	if err != nil {
@@ -5,6 +5,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"io/fs"
 	"net/http"
 	"net/http/httptest"
@@ -14,18 +15,23 @@ import (
 	"strings"
 	"syscall"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/command"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/git"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/git/gittest"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/git/trace2"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/git/trace2hooks"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/config"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/helper/duration"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/helper/text"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/log"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/structerr"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper/testcfg"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/tracing"
+	"google.golang.org/grpc/codes"
 )
 
 func TestGitCommandProxy(t *testing.T) {
@@ -630,6 +636,54 @@ func TestExecCommandFactory_config(t *testing.T) {
 	require.Equal(t, expectedEnv, strings.Split(strings.TrimSpace(stdout.String()), "\n"))
 }
 
+func TestExecCommandFactory_maxRuntime(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	cfg := testcfg.Build(t)
+	cfg.Git.MaxRuntimes = []config.GitMaxRuntime{
+		{Subcommand: "cat-file", MaxRuntime: duration.Duration(time.Millisecond)},
+	}
+	cfg.Git.KillGracePeriod = duration.Duration(time.Second)
+
+	repo, _ := gittest.CreateRepository(t, ctx, cfg, gittest.CreateRepositoryConfig{
+		SkipCreationViaService: true,
+	})
+
+	gitCmdFactory := gittest.NewCommandFactory(t, cfg)
+
+	t.Run("command exceeding its maximum runtime", func(t *testing.T) {
+		// git-cat-file(1) keeps on running until its standard input is closed.
+		cmd, err := gitCmdFactory.New(ctx, repo, git.Command{
+			Name: "cat-file",
+			Flags: []git.Option{
+				git.Flag{Name: "--batch"},
+			},
+		}, git.WithSetupStdin(), git.WithSetupStdout())
+		require.NoError(t, err)
+
+		// Reading from the command only returns once its standard streams have been torn down
+		// because it has exceeded its maximum runtime.
+		_, err = io.ReadAll(cmd)
+		require.Error(t, err)
+
+		err = cmd.Wait()
+		require.ErrorIs(t, err, command.ErrMaxRuntimeExceeded)
+		require.Equal(t, codes.ResourceExhausted, structerr.GRPCCode(err))
+	})
+
+	t.Run("command without maximum runtime", func(t *testing.T) {
+		cmd, err := gitCmdFactory.New(ctx, repo, git.Command{
+			Name: "config",
+			Flags: []git.Option{
+				git.Flag{Name: "--list"},
+			},
+		})
+		require.NoError(t, err)
+		require.NoError(t, cmd.Wait())
+	})
+}
+
 // TestFsckConfiguration tests the hardcoded configuration of the
 // git fsck subcommand generated through the command factory.
 func TestFsckConfiguration(t *testing.T) {
@@ -78,8 +78,22 @@ type Resolution struct {
 
 	// Content is used when no sections are defined
 	Content string `json:"content"`
+
+	// Choice is used to resolve conflicts which cannot be resolved section by
+	// section, like binary, add/add, modify/delete or rename/rename conflicts.
+	// The value is either "head" or "origin", which selects the file at OldPath
+	// in the ours OID or the file at NewPath in the theirs OID as a whole. If
+	// the selected side has deleted the file, the file is deleted.
+	Choice string `json:"choice"`
 }
 
+const (
+	// ChoiceHead selects the version of the file in the 'ours' OID.
+	ChoiceHead = head
+	// ChoiceOrigin selects the version of the file in the 'theirs' OID.
+	ChoiceOrigin = origin
+)
+
 // Resolve is used to resolve conflicts for a given blob. It expects the blob
 // to be provided as an io.Reader along with the resolutions for the provided
 // blob. Clients can also use appendNewLine to have an additional new line appended
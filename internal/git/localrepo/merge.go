@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"errors"
+	"fmt"
 	"strconv"
 	"strings"
 
@@ -36,6 +37,7 @@ type mergeTreeConfig struct {
 	allowUnrelatedHistories  bool
 	conflictingFileNamesOnly bool
 	mergeBase                git.Revision
+	strategyOptions          []string
 }
 
 // MergeTreeOption is a function that sets a config in mergeTreeConfig.
@@ -65,6 +67,61 @@ func WithMergeBase(base git.Revision) MergeTreeOption {
 	}
 }
 
+// MergeFavor denotes how MergeTree resolves conflicting hunks.
+type MergeFavor int
+
+const (
+	// MergeFavorNone reports conflicting hunks as conflicts.
+	MergeFavorNone = MergeFavor(iota)
+	// MergeFavorOurs resolves conflicting hunks in favor of our version.
+	MergeFavorOurs
+	// MergeFavorTheirs resolves conflicting hunks in favor of their version.
+	MergeFavorTheirs
+)
+
+// WithFavor lets MergeTree resolve conflicting hunks in favor of one side of
+// the merge instead of reporting them as conflicts.
+func WithFavor(favor MergeFavor) MergeTreeOption {
+	return func(options *mergeTreeConfig) {
+		switch favor {
+		case MergeFavorOurs:
+			options.strategyOptions = append(options.strategyOptions, "ours")
+		case MergeFavorTheirs:
+			options.strategyOptions = append(options.strategyOptions, "theirs")
+		}
+	}
+}
+
+// WithRenameThreshold sets the similarity index in percent above which
+// MergeTree considers a deleted and an added file to be a rename.
+func WithRenameThreshold(threshold uint) MergeTreeOption {
+	return func(options *mergeTreeConfig) {
+		options.strategyOptions = append(options.strategyOptions, fmt.Sprintf("find-renames=%d%%", threshold))
+	}
+}
+
+// WithoutRenames disables the rename detection of MergeTree.
+func WithoutRenames() MergeTreeOption {
+	return func(options *mergeTreeConfig) {
+		options.strategyOptions = append(options.strategyOptions, "no-renames")
+	}
+}
+
+// WithIgnoreSpaceChange lets MergeTree treat lines with changes in the amount
+// of whitespace as unchanged.
+func WithIgnoreSpaceChange() MergeTreeOption {
+	return func(options *mergeTreeConfig) {
+		options.strategyOptions = append(options.strategyOptions, "ignore-space-change")
+	}
+}
+
+// WithIgnoreAllSpace lets MergeTree ignore whitespace when comparing lines.
+func WithIgnoreAllSpace() MergeTreeOption {
+	return func(options *mergeTreeConfig) {
+		options.strategyOptions = append(options.strategyOptions, "ignore-all-space")
+	}
+}
+
 // MergeTree calls git-merge-tree(1) with arguments, and parses the results from
 // stdout.
 func (repo *Repo) MergeTree(
@@ -98,6 +155,13 @@ func (repo *Repo) MergeTree(
 		})
 	}
 
+	for _, strategyOption := range config.strategyOptions {
+		flags = append(flags, git.ValueFlag{
+			Name:  "--strategy-option",
+			Value: strategyOption,
+		})
+	}
+
 	objectHash, err := repo.ObjectHash(ctx)
 	if err != nil {
 		return "", structerr.NewInternal("getting object hash %w", err)
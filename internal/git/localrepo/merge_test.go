@@ -742,6 +742,81 @@ func TestMergeTree(t *testing.T) {
 				}
 			},
 		},
+		{
+			desc: "favor ours",
+			mergeTreeOptions: []MergeTreeOption{
+				WithFavor(MergeFavorOurs),
+			},
+			setup: func(t *testing.T, repoPath string) setupData {
+				base := gittest.WriteCommit(t, cfg, repoPath, gittest.WithTreeEntries(
+					gittest.TreeEntry{Mode: "100644", Path: "file", Content: "base\n"},
+				))
+				ours := gittest.WriteCommit(t, cfg, repoPath, gittest.WithParents(base), gittest.WithTreeEntries(
+					gittest.TreeEntry{Mode: "100644", Path: "file", Content: "ours\n"},
+				))
+				theirs := gittest.WriteCommit(t, cfg, repoPath, gittest.WithParents(base), gittest.WithTreeEntries(
+					gittest.TreeEntry{Mode: "100644", Path: "file", Content: "theirs\n"},
+				))
+
+				return setupData{
+					ours:   ours,
+					theirs: theirs,
+					expectedTreeEntries: []gittest.TreeEntry{
+						{Mode: "100644", Path: "file", Content: "ours\n"},
+					},
+				}
+			},
+		},
+		{
+			desc: "favor theirs",
+			mergeTreeOptions: []MergeTreeOption{
+				WithFavor(MergeFavorTheirs),
+			},
+			setup: func(t *testing.T, repoPath string) setupData {
+				base := gittest.WriteCommit(t, cfg, repoPath, gittest.WithTreeEntries(
+					gittest.TreeEntry{Mode: "100644", Path: "file", Content: "base\n"},
+				))
+				ours := gittest.WriteCommit(t, cfg, repoPath, gittest.WithParents(base), gittest.WithTreeEntries(
+					gittest.TreeEntry{Mode: "100644", Path: "file", Content: "ours\n"},
+				))
+				theirs := gittest.WriteCommit(t, cfg, repoPath, gittest.WithParents(base), gittest.WithTreeEntries(
+					gittest.TreeEntry{Mode: "100644", Path: "file", Content: "theirs\n"},
+				))
+
+				return setupData{
+					ours:   ours,
+					theirs: theirs,
+					expectedTreeEntries: []gittest.TreeEntry{
+						{Mode: "100644", Path: "file", Content: "theirs\n"},
+					},
+				}
+			},
+		},
+		{
+			desc: "ignore all space",
+			mergeTreeOptions: []MergeTreeOption{
+				WithIgnoreAllSpace(),
+			},
+			setup: func(t *testing.T, repoPath string) setupData {
+				base := gittest.WriteCommit(t, cfg, repoPath, gittest.WithTreeEntries(
+					gittest.TreeEntry{Mode: "100644", Path: "file", Content: "a b\n"},
+				))
+				ours := gittest.WriteCommit(t, cfg, repoPath, gittest.WithParents(base), gittest.WithTreeEntries(
+					gittest.TreeEntry{Mode: "100644", Path: "file", Content: "a  b\n"},
+				))
+				theirs := gittest.WriteCommit(t, cfg, repoPath, gittest.WithParents(base), gittest.WithTreeEntries(
+					gittest.TreeEntry{Mode: "100644", Path: "file", Content: "ab\n"},
+				))
+
+				return setupData{
+					ours:   ours,
+					theirs: theirs,
+					expectedTreeEntries: []gittest.TreeEntry{
+						{Mode: "100644", Path: "file", Content: "a  b\n"},
+					},
+				}
+			},
+		},
 	}
 
 	for _, tc := range testCases {
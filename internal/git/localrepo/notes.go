@@ -0,0 +1,73 @@
+package localrepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git"
+)
+
+// Note is a note attached to an object by a notes reference.
+type Note struct {
+	// ObjectID is the ID of the object the note is attached to.
+	ObjectID git.ObjectID
+	// BlobID is the ID of the blob that contains the note.
+	BlobID git.ObjectID
+	// Path is the path of the note in the tree of the notes reference. Git fans out notes into
+	// subdirectories named after the leading digits of the object ID when there are many of them,
+	// so the path is not necessarily the object ID itself.
+	Path string
+}
+
+// ListNotes returns all notes of the given notes reference in the order of the notes tree. No notes
+// are returned if the notes reference doesn't exist.
+func (repo *Repo) ListNotes(ctx context.Context, notesRef git.ReferenceName) ([]Note, error) {
+	tree, err := repo.ReadTree(ctx, notesRef.Revision(), WithRecursive())
+	if err != nil {
+		if errors.Is(err, git.ErrReferenceNotFound) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("reading notes tree: %w", err)
+	}
+
+	objectHash, err := repo.ObjectHash(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("detecting object hash: %w", err)
+	}
+
+	return NotesFromTree(objectHash, tree), nil
+}
+
+// NotesFromTree returns the notes contained in the recursively read tree of a notes reference.
+// Same as Git, entries whose path doesn't spell out an object ID are not considered to be notes.
+func NotesFromTree(objectHash git.ObjectHash, tree *TreeEntry) []Note {
+	var notes []Note
+
+	// The callback never fails, so neither does the walk.
+	_ = tree.Walk(func(dirPath string, entry *TreeEntry) error {
+		if !entry.IsBlob() {
+			return nil
+		}
+
+		path := filepath.Join(dirPath, entry.Path)
+
+		objectID, err := objectHash.FromHex(strings.ReplaceAll(path, "/", ""))
+		if err != nil {
+			return nil
+		}
+
+		notes = append(notes, Note{
+			ObjectID: objectID,
+			BlobID:   entry.OID,
+			Path:     path,
+		})
+
+		return nil
+	})
+
+	return notes
+}
@@ -0,0 +1,70 @@
+package localrepo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git/gittest"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
+)
+
+func TestRepo_ListNotes(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	cfg, repo, repoPath := setupRepo(t)
+
+	flatCommitID := gittest.WriteCommit(t, cfg, repoPath, gittest.WithMessage("flat"))
+	fannedOutCommitID := gittest.WriteCommit(t, cfg, repoPath, gittest.WithMessage("fanned out"))
+	flatNoteID := gittest.WriteBlob(t, cfg, repoPath, []byte("flat note"))
+	fannedOutNoteID := gittest.WriteBlob(t, cfg, repoPath, []byte("fanned out note"))
+
+	notesCommitID := gittest.WriteCommit(t, cfg, repoPath, gittest.WithTreeEntries(
+		gittest.TreeEntry{Path: flatCommitID.String(), Mode: "100644", OID: flatNoteID},
+		gittest.TreeEntry{Path: fannedOutCommitID.String()[:2], Mode: "040000", OID: gittest.WriteTree(t, cfg, repoPath, []gittest.TreeEntry{
+			{Path: fannedOutCommitID.String()[2:], Mode: "100644", OID: fannedOutNoteID},
+		})},
+		gittest.TreeEntry{Path: "README", Mode: "100644", Content: "not a note"},
+	))
+	gittest.WriteRef(t, cfg, repoPath, "refs/notes/custom", notesCommitID)
+
+	gittest.Exec(t, cfg, "-C", repoPath, "notes", "add", "-m", "written by git", flatCommitID.String())
+	gitNoteID := gittest.ResolveRevision(t, cfg, repoPath, git.DefaultNotesRef.String()+":"+flatCommitID.String())
+
+	for _, tc := range []struct {
+		desc          string
+		notesRef      git.ReferenceName
+		expectedNotes []Note
+	}{
+		{
+			desc:     "missing notes reference",
+			notesRef: "refs/notes/missing",
+		},
+		{
+			desc:     "notes written by Git",
+			notesRef: git.DefaultNotesRef,
+			expectedNotes: []Note{
+				{ObjectID: flatCommitID, BlobID: gitNoteID, Path: flatCommitID.String()},
+			},
+		},
+		{
+			desc:     "flat and fanned out notes",
+			notesRef: "refs/notes/custom",
+			expectedNotes: []Note{
+				{ObjectID: fannedOutCommitID, BlobID: fannedOutNoteID, Path: fannedOutCommitID.String()[:2] + "/" + fannedOutCommitID.String()[2:]},
+				{ObjectID: flatCommitID, BlobID: flatNoteID, Path: flatCommitID.String()},
+			},
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			notes, err := repo.ListNotes(ctx, tc.notesRef)
+			require.NoError(t, err)
+			require.ElementsMatch(t, tc.expectedNotes, notes)
+		})
+	}
+}
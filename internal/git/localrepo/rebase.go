@@ -126,15 +126,93 @@ func (repo *Repo) rebaseUsingMergeTree(ctx context.Context, cfg rebaseConfig, up
 		return "", structerr.NewInternal("git rev-list: %w", err).WithMetadata("stderr", stderr.String())
 	}
 
-	upstreamCommit, err := repo.ReadCommit(ctx, git.Revision(upstreamOID))
+	todo := make([]RebaseTodoItem, 0, len(todoList))
+	for _, todoItem := range todoList {
+		todo = append(todo, RebaseTodoItem{Action: RebasePick, Commit: git.ObjectID(todoItem)})
+	}
+
+	return repo.applyRebaseTodo(ctx, cfg, objectHash, upstreamOID, todo)
+}
+
+// RebaseAction is the action a rebase takes on a commit of the todo list.
+type RebaseAction int
+
+const (
+	// RebasePick applies the changes of the commit as a new commit.
+	RebasePick RebaseAction = iota
+	// RebaseReword applies the changes of the commit as a new commit with a different message.
+	RebaseReword
+	// RebaseSquash folds the changes of the commit into the previous commit and appends the commit's
+	// message to the previous commit's message.
+	RebaseSquash
+	// RebaseFixup folds the changes of the commit into the previous commit and discards the commit's
+	// message.
+	RebaseFixup
+	// RebaseDrop skips the commit.
+	RebaseDrop
+)
+
+// RebaseTodoItem is a step of a rebase todo list.
+type RebaseTodoItem struct {
+	// Action is the action to take on the commit.
+	Action RebaseAction
+	// Commit is the commit to take the action on.
+	Commit git.ObjectID
+	// Message is the new message of the commit for RebaseReword. For RebaseSquash, it replaces the
+	// combined message of the squashed commits if set. It is ignored for the other actions.
+	Message string
+}
+
+// ErrRebaseNothingToSquash is returned when a squash or fixup step of a rebase todo list isn't
+// preceded by a step which created a commit.
+var ErrRebaseNothingToSquash = errors.New("cannot squash without a previous commit")
+
+// RebaseTodo replays the steps of the todo list on top of onto and returns the resulting commit.
+// Unlike Rebase, it does not compute the commits to replay but takes them from the todo list in the
+// given order. Commits which become empty when applied are dropped unless they were empty to
+// begin with. A *RebaseConflictError is returned if applying a commit conflicts.
+func (repo *Repo) RebaseTodo(ctx context.Context, onto git.ObjectID, todo []RebaseTodoItem, options ...RebaseOption) (git.ObjectID, error) {
+	var config rebaseConfig
+	for _, option := range options {
+		option(&config)
+	}
+
+	objectHash, err := repo.ObjectHash(ctx)
+	if err != nil {
+		return "", structerr.NewInternal("getting object hash %w", err)
+	}
+
+	return repo.applyRebaseTodo(ctx, config, objectHash, onto, todo)
+}
+
+// rebasedCommit describes the last commit written by a rebase so it can be amended by squash and
+// fixup steps.
+type rebasedCommit struct {
+	parent  git.ObjectID
+	author  *git.Signature
+	message string
+}
+
+func (repo *Repo) applyRebaseTodo(ctx context.Context, cfg rebaseConfig, objectHash git.ObjectHash, onto git.ObjectID, todo []RebaseTodoItem) (git.ObjectID, error) {
+	ontoCommit, err := repo.ReadCommit(ctx, git.Revision(onto))
 	if err != nil {
 		return "", fmt.Errorf("reading upstream commit: %w", err)
 	}
 
-	oursCommitOID := upstreamOID
-	oursTreeOID := git.ObjectID(upstreamCommit.TreeId)
-	for _, todoItem := range todoList {
-		theirsCommit, err := repo.ReadCommit(ctx, git.Revision(todoItem))
+	oursCommitOID := onto
+	oursTreeOID := git.ObjectID(ontoCommit.TreeId)
+	var last *rebasedCommit
+	for _, todoItem := range todo {
+		if todoItem.Action == RebaseDrop {
+			continue
+		}
+
+		squash := todoItem.Action == RebaseSquash || todoItem.Action == RebaseFixup
+		if squash && last == nil {
+			return "", ErrRebaseNothingToSquash
+		}
+
+		theirsCommit, err := repo.ReadCommit(ctx, todoItem.Commit.Revision())
 		if err != nil {
 			return "", fmt.Errorf("reading todo list commit: %w", err)
 		}
@@ -148,7 +226,7 @@ func (repo *Repo) rebaseUsingMergeTree(ctx context.Context, cfg rebaseConfig, up
 		if err != nil {
 			var conflictErr *MergeTreeConflictError
 			if errors.As(err, &conflictErr) {
-				return newTreeOID, &RebaseConflictError{
+				return "", &RebaseConflictError{
 					Commit:        theirsCommit.Id,
 					ConflictError: conflictErr,
 				}
@@ -161,7 +239,9 @@ func (repo *Repo) rebaseUsingMergeTree(ctx context.Context, cfg rebaseConfig, up
 		// 2. if the commit is not empty to start and is not clean cherry-picks of any
 		//    upstream commit, but become empty after rebasing, we just ignore it.
 		// Refer to https://git-scm.com/docs/git-rebase#Documentation/git-rebase.txt---emptydropkeepask
-		if newTreeOID == oursTreeOID {
+		// Squashed commits are folded into the previous commit even if they become empty so that
+		// their message is kept.
+		if newTreeOID == oursTreeOID && !squash {
 			if len(theirsCommit.ParentIds) == 0 {
 				if theirsCommit.TreeId != objectHash.EmptyTreeOID.String() {
 					continue
@@ -178,21 +258,40 @@ func (repo *Repo) rebaseUsingMergeTree(ctx context.Context, cfg rebaseConfig, up
 			}
 		}
 
-		author := getSignatureFromCommitAuthor(theirsCommit.GetAuthor())
 		committer := cfg.committer
 		if committer == nil {
 			committer = getSignatureFromCommitAuthor(theirsCommit.GetCommitter())
 		}
 
+		commit := rebasedCommit{
+			parent:  oursCommitOID,
+			author:  getSignatureFromCommitAuthor(theirsCommit.GetAuthor()),
+			message: string(theirsCommit.GetBody()),
+		}
+
+		switch todoItem.Action {
+		case RebaseReword:
+			commit.message = todoItem.Message
+		case RebaseSquash:
+			commit = *last
+			if todoItem.Message != "" {
+				commit.message = todoItem.Message
+			} else {
+				commit.message = strings.TrimRight(last.message, "\n") + "\n\n" + string(theirsCommit.GetBody())
+			}
+		case RebaseFixup:
+			commit = *last
+		}
+
 		newCommitOID, err := repo.WriteCommit(ctx, WriteCommitConfig{
-			Parents:        []git.ObjectID{oursCommitOID},
-			AuthorName:     author.Name,
-			AuthorEmail:    author.Email,
-			AuthorDate:     author.When,
+			Parents:        []git.ObjectID{commit.parent},
+			AuthorName:     commit.author.Name,
+			AuthorEmail:    commit.author.Email,
+			AuthorDate:     commit.author.When,
 			CommitterName:  committer.Name,
 			CommitterEmail: committer.Email,
 			CommitterDate:  committer.When,
-			Message:        string(theirsCommit.GetBody()),
+			Message:        commit.message,
 			TreeID:         newTreeOID,
 		})
 		if err != nil {
@@ -200,6 +299,7 @@ func (repo *Repo) rebaseUsingMergeTree(ctx context.Context, cfg rebaseConfig, up
 		}
 		oursCommitOID = newCommitOID
 		oursTreeOID = newTreeOID
+		last = &commit
 	}
 
 	return oursCommitOID, nil
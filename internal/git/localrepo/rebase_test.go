@@ -2,7 +2,9 @@ package localrepo
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -1017,3 +1019,176 @@ func TestParseTimezoneFromCommitAuthor(t *testing.T) {
 		})
 	}
 }
+
+func TestRebaseTodo(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	cfg := testcfg.Build(t)
+
+	committer := git.Signature{
+		Name:  gittest.DefaultCommitterName,
+		Email: gittest.DefaultCommitterMail,
+		When:  gittest.DefaultCommitTime,
+	}
+
+	// The commits c1, c2 and c3 are replayed on top of upstream according to the todo list:
+	//
+	//   upstream
+	//   o
+	//  /
+	// o---o---o---o
+	// b   c1  c2  c3
+	repoProto, repoPath := gittest.CreateRepository(t, ctx, cfg, gittest.CreateRepositoryConfig{
+		SkipCreationViaService: true,
+	})
+	repo := NewTestRepo(t, cfg, repoProto)
+
+	base := gittest.WriteCommit(t, cfg, repoPath,
+		gittest.WithMessage("base"),
+		gittest.WithTreeEntries(
+			gittest.TreeEntry{Path: "file", Mode: "100644", Content: "base"},
+		),
+	)
+	upstream := gittest.WriteCommit(t, cfg, repoPath,
+		gittest.WithParents(base),
+		gittest.WithMessage("upstream"),
+		gittest.WithTreeEntries(
+			gittest.TreeEntry{Path: "file", Mode: "100644", Content: "upstream"},
+		),
+	)
+	c1 := gittest.WriteCommit(t, cfg, repoPath,
+		gittest.WithParents(base),
+		gittest.WithMessage("c1"),
+		gittest.WithTreeEntries(
+			gittest.TreeEntry{Path: "file", Mode: "100644", Content: "base"},
+			gittest.TreeEntry{Path: "a", Mode: "100644", Content: "a"},
+		),
+	)
+	c2 := gittest.WriteCommit(t, cfg, repoPath,
+		gittest.WithParents(c1),
+		gittest.WithMessage("c2"),
+		gittest.WithTreeEntries(
+			gittest.TreeEntry{Path: "file", Mode: "100644", Content: "base"},
+			gittest.TreeEntry{Path: "a", Mode: "100644", Content: "a"},
+			gittest.TreeEntry{Path: "b", Mode: "100644", Content: "b"},
+		),
+	)
+	c3 := gittest.WriteCommit(t, cfg, repoPath,
+		gittest.WithParents(c2),
+		gittest.WithMessage("c3"),
+		gittest.WithTreeEntries(
+			gittest.TreeEntry{Path: "file", Mode: "100644", Content: "c3"},
+			gittest.TreeEntry{Path: "a", Mode: "100644", Content: "a"},
+			gittest.TreeEntry{Path: "b", Mode: "100644", Content: "b"},
+		),
+	)
+
+	for _, tc := range []struct {
+		desc                string
+		todo                []RebaseTodoItem
+		expectedMessages    []string
+		expectedTreeEntries []gittest.TreeEntry
+		expectedErr         error
+	}{
+		{
+			desc: "pick in different order",
+			todo: []RebaseTodoItem{
+				{Action: RebasePick, Commit: c2},
+				{Action: RebasePick, Commit: c1},
+			},
+			expectedMessages: []string{"c2", "c1"},
+			expectedTreeEntries: []gittest.TreeEntry{
+				{Path: "a", Mode: "100644", Content: "a"},
+				{Path: "b", Mode: "100644", Content: "b"},
+				{Path: "file", Mode: "100644", Content: "upstream"},
+			},
+		},
+		{
+			desc: "reword and drop",
+			todo: []RebaseTodoItem{
+				{Action: RebaseReword, Commit: c1, Message: "reworded"},
+				{Action: RebaseDrop, Commit: c2},
+			},
+			expectedMessages: []string{"reworded"},
+			expectedTreeEntries: []gittest.TreeEntry{
+				{Path: "a", Mode: "100644", Content: "a"},
+				{Path: "file", Mode: "100644", Content: "upstream"},
+			},
+		},
+		{
+			desc: "squash",
+			todo: []RebaseTodoItem{
+				{Action: RebasePick, Commit: c1},
+				{Action: RebaseSquash, Commit: c2},
+			},
+			expectedMessages: []string{"c1\n\nc2"},
+			expectedTreeEntries: []gittest.TreeEntry{
+				{Path: "a", Mode: "100644", Content: "a"},
+				{Path: "b", Mode: "100644", Content: "b"},
+				{Path: "file", Mode: "100644", Content: "upstream"},
+			},
+		},
+		{
+			desc: "fixup keeps message",
+			todo: []RebaseTodoItem{
+				{Action: RebasePick, Commit: c1},
+				{Action: RebaseFixup, Commit: c2},
+			},
+			expectedMessages: []string{"c1"},
+			expectedTreeEntries: []gittest.TreeEntry{
+				{Path: "a", Mode: "100644", Content: "a"},
+				{Path: "b", Mode: "100644", Content: "b"},
+				{Path: "file", Mode: "100644", Content: "upstream"},
+			},
+		},
+		{
+			desc: "squash without previous commit",
+			todo: []RebaseTodoItem{
+				{Action: RebaseDrop, Commit: c1},
+				{Action: RebaseFixup, Commit: c2},
+			},
+			expectedErr: ErrRebaseNothingToSquash,
+		},
+		{
+			desc: "conflict",
+			todo: []RebaseTodoItem{
+				{Action: RebasePick, Commit: c1},
+				{Action: RebasePick, Commit: c3},
+			},
+			expectedErr: &RebaseConflictError{Commit: c3.String()},
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			result, err := repo.RebaseTodo(ctx, upstream, tc.todo, RebaseWithCommitter(committer))
+			if tc.expectedErr != nil {
+				var conflictErr *RebaseConflictError
+				if errors.As(err, &conflictErr) {
+					require.Equal(t, tc.expectedErr.(*RebaseConflictError).Commit, conflictErr.Commit)
+					return
+				}
+
+				require.Equal(t, tc.expectedErr, err)
+				return
+			}
+			require.NoError(t, err)
+
+			gittest.RequireTree(t, cfg, repoPath, result.String(), tc.expectedTreeEntries)
+
+			commit, err := repo.ReadCommit(ctx, result.Revision())
+			require.NoError(t, err)
+			for i := len(tc.expectedMessages) - 1; i >= 0; i-- {
+				require.Equal(t, tc.expectedMessages[i], strings.TrimSpace(string(commit.GetBody())))
+				require.Equal(t, gittest.DefaultCommitterName, string(commit.GetCommitter().GetName()))
+
+				commit, err = repo.ReadCommit(ctx, git.Revision(commit.GetParentIds()[0]))
+				require.NoError(t, err)
+			}
+			require.Equal(t, upstream.String(), commit.GetId())
+		})
+	}
+}
@@ -7,8 +7,10 @@ import (
 	"strings"
 	"time"
 
+	"gitlab.com/gitlab-org/gitaly/v16/internal/featureflag"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/git"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/helper/text"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/signature"
 )
 
 // FormatTagError is used by FormatTag() below
@@ -75,6 +77,21 @@ func (e MktagError) Error() string {
 	return fmt.Sprintf("Could not update refs/tags/%s. Please refresh and try again.", e.tagName)
 }
 
+type writeTagConfig struct {
+	signingKey string
+}
+
+// WriteTagOption is a function that sets a config in writeTagConfig.
+type WriteTagOption func(*writeTagConfig)
+
+// WriteTagWithSigningKey provides the path to the signing key the tag shall
+// be signed with. The tag is left unsigned if the path is empty.
+func WriteTagWithSigningKey(signingKey string) WriteTagOption {
+	return func(cfg *writeTagConfig) {
+		cfg.signingKey = signingKey
+	}
+}
+
 // WriteTag writes a tag to the repository's object database with
 // git-mktag and returns its object ID.
 //
@@ -86,7 +103,13 @@ func (repo *Repo) WriteTag(
 	objectType string,
 	tagName, tagBody []byte,
 	tagger git.Signature,
+	opts ...WriteTagOption,
 ) (git.ObjectID, error) {
+	var cfg writeTagConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
 	stdout := &bytes.Buffer{}
 	stderr := &bytes.Buffer{}
 
@@ -95,6 +118,13 @@ func (repo *Repo) WriteTag(
 		return "", err
 	}
 
+	if cfg.signingKey != "" && featureflag.GPGSigning.IsEnabled(ctx) {
+		tagBuf, err = signTag(tagBuf, cfg.signingKey)
+		if err != nil {
+			return "", err
+		}
+	}
+
 	content := strings.NewReader(tagBuf)
 
 	cmd, err := repo.Exec(ctx,
@@ -125,3 +155,29 @@ func (repo *Repo) WriteTag(
 
 	return tagID, nil
 }
+
+// signTag appends a signature of the formatted tag to it. Like git-tag(1)
+// does, the signature is appended to the tag message, which is terminated
+// with a newline first.
+func signTag(tagBuf, signingKey string) (string, error) {
+	signingKeys, err := signature.ParseSigningKeys(signingKey)
+	if err != nil {
+		return "", fmt.Errorf("parsing signing key: %w", err)
+	}
+
+	if !strings.HasSuffix(tagBuf, "\n") {
+		tagBuf += "\n"
+	}
+
+	sig, err := signingKeys.CreateSignature([]byte(tagBuf))
+	if err != nil {
+		return "", fmt.Errorf("signing tag: %w", err)
+	}
+
+	tagBuf += string(sig)
+	if !strings.HasSuffix(tagBuf, "\n") {
+		tagBuf += "\n"
+	}
+
+	return tagBuf, nil
+}
@@ -1,12 +1,19 @@
 package localrepo
 
 import (
+	"bytes"
+	"context"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/packet"
 	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/featureflag"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/git"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git/catfile"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/git/gittest"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/helper/text"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
@@ -157,3 +164,46 @@ tagger root <root@localhost> 12345 -0100
 		})
 	}
 }
+
+func TestRepo_WriteTag_signed(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewFeatureSets(featureflag.GPGSigning).Run(t, func(t *testing.T, ctx context.Context) {
+		t.Parallel()
+
+		cfg, repo, repoPath := setupRepo(t)
+		commitID := gittest.WriteCommit(t, cfg, repoPath)
+
+		tagObjID, err := repo.WriteTag(ctx, commitID, "commit", []byte("signed-tag"), []byte("my tag message"), git.Signature{
+			Name:  "root",
+			Email: "root@localhost",
+			When:  time.Unix(12345, 0).UTC(),
+		}, WriteTagWithSigningKey("testdata/signing_gpg_key"))
+		require.NoError(t, err)
+
+		content := fmt.Sprintf("object %s\ntype commit\ntag signed-tag\ntagger root <root@localhost> 12345 +0000\n\nmy tag message\n", commitID)
+
+		data, err := repo.ReadObject(ctx, tagObjID)
+		require.NoError(t, err)
+
+		sig, signedText := catfile.ExtractTagSignature(data)
+		if !featureflag.GPGSigning.IsEnabled(ctx) {
+			require.Empty(t, sig)
+			require.Equal(t, strings.TrimSuffix(content, "\n"), string(signedText))
+			return
+		}
+		require.Equal(t, content, string(signedText))
+
+		pubKey := testhelper.MustReadFile(t, "testdata/signing_gpg_key.pub")
+		keyring, err := openpgp.ReadKeyRing(bytes.NewReader(pubKey))
+		require.NoError(t, err)
+
+		_, err = openpgp.CheckArmoredDetachedSignature(
+			keyring,
+			bytes.NewReader(signedText),
+			bytes.NewReader(sig),
+			&packet.Config{},
+		)
+		require.NoError(t, err)
+	})
+}
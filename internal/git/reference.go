@@ -205,6 +205,20 @@ func ValidateReference(name string) error {
 	}
 }
 
+// ValidateNotesReference checks whether a reference looks valid and is part of the `refs/notes/`
+// namespace, which is where Git expects notes references to live.
+func ValidateNotesReference(name string) error {
+	if err := ValidateReference(name); err != nil {
+		return err
+	}
+
+	if !strings.HasPrefix(name, "refs/notes/") {
+		return fmt.Errorf("reference is not a notes reference")
+	}
+
+	return nil
+}
+
 // GetReferencesConfig is configuration that can be passed to GetReferences in order to change its default behaviour.
 type GetReferencesConfig struct {
 	// Patterns limits the returned references to only those which match the given pattern. If no patterns are given
@@ -182,6 +182,48 @@ func TestValidateReference(t *testing.T) {
 	}
 }
 
+func TestValidateNotesReference(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		desc        string
+		reference   string
+		expectedErr error
+	}{
+		{
+			desc:      "default notes reference",
+			reference: git.DefaultNotesRef.String(),
+		},
+		{
+			desc:      "nested notes reference",
+			reference: "refs/notes/ci/results",
+		},
+		{
+			desc:        "invalid reference",
+			reference:   "refs/notes/invalid..reference",
+			expectedErr: fmt.Errorf("reference must not contain double dots"),
+		},
+		{
+			desc:        "branch",
+			reference:   "refs/heads/main",
+			expectedErr: fmt.Errorf("reference is not a notes reference"),
+		},
+		{
+			desc:        "notes namespace",
+			reference:   "refs/notes/",
+			expectedErr: fmt.Errorf("reference must not end with slash"),
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tc.expectedErr, git.ValidateNotesReference(tc.reference))
+		})
+	}
+}
+
 func TestReferenceName_NewReferenceNameFromBranchName(t *testing.T) {
 	for _, tc := range []struct {
 		desc      string
@@ -20,6 +20,9 @@ const DefaultRef = ReferenceName("refs/heads/" + DefaultBranch)
 // legacy default.
 const LegacyDefaultRef = ReferenceName("refs/heads/master")
 
+// DefaultNotesRef is the notes reference Git uses if no other notes reference has been configured.
+const DefaultNotesRef = ReferenceName("refs/notes/commits")
+
 // MirrorRefSpec is the refspec used when --mirror is specified on git clone.
 const MirrorRefSpec = "+refs/*:refs/*"
 
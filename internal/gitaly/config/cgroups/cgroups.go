@@ -1,7 +1,10 @@
 package cgroups
 
 import (
+	"fmt"
+
 	"gitlab.com/gitlab-org/gitaly/v16/internal/errors/cfgerror"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/helper/duration"
 )
 
 // Config is a struct for cgroups config
@@ -56,11 +59,31 @@ func (c *Config) Validate() error {
 		AsError()
 }
 
+const (
+	// PlacementRepository assigns commands to one of the `count` repository cgroups by hashing
+	// the repository the command operates on.
+	PlacementRepository = "repository"
+	// PlacementTenant assigns commands to a cgroup per tenant. Tenant cgroups are created when
+	// they are first needed and removed again after they have been idle. Commands without a
+	// tenant are assigned to the hashed repository cgroups.
+	PlacementTenant = "tenant"
+)
+
 // Repositories configures cgroups to be created that are isolated by repository.
 type Repositories struct {
 	// Count is the number of cgroups that will be created for repository-level isolation
 	// of git commands.
 	Count uint `toml:"count"`
+	// Placement is the policy used to assign commands to cgroups. It is either "repository"
+	// or "tenant". Defaults to "repository".
+	Placement string `toml:"placement"`
+	// MaxTenants is the maximum number of tenant cgroups that may exist at the same time when
+	// using the "tenant" placement. Commands of further tenants are assigned to the hashed
+	// repository cgroups. Defaults to 1000.
+	MaxTenants uint `toml:"max_tenants"`
+	// TenantIdleTimeout is the duration after which a tenant cgroup that hasn't been used is
+	// removed when using the "tenant" placement. Defaults to 10 minutes.
+	TenantIdleTimeout duration.Duration `toml:"tenant_idle_timeout"`
 	// MemoryBytes is the memory limit for each cgroup. 0 implies no memory limit.
 	MemoryBytes int64 `toml:"memory_bytes"`
 	// CPUShares are the shares of CPU that each cgroup is allowed to utilize. A value of 1024
@@ -72,14 +95,64 @@ type Repositories struct {
 	//
 	// The cfs_period_us is hardcoded to 100ms
 	CPUQuotaUs int64 `toml:"cpu_quota_us"`
+	// PidsMax is the maximum number of processes that may exist in each cgroup at the same time.
+	// It sets `pids.max`. 0 implies no limit.
+	PidsMax int64 `toml:"pids_max"`
+	// IOWeight is the relative share of block I/O each cgroup is allowed to utilize when there
+	// are multiple cgroups competing for disk access. Valid values range from 10 to 1000. It sets
+	// `blkio.weight` for Cgroup V1 and is scaled to the [1, 10000] range of `io.weight` for
+	// Cgroup V2. 0 implies no weight.
+	IOWeight uint16 `toml:"io_weight"`
+	// IODevices configures per-device bandwidth and IOPS limits for each cgroup. They set the
+	// `blkio.throttle.*` files for Cgroup V1 and `io.max` for Cgroup V2.
+	IODevices []IODevice `toml:"io_devices"`
 }
 
 // Validate runs validation on all fields and compose all found errors.
 func (r *Repositories) Validate(memBytes int64, cpuShares uint64, cpuQuotaUs int64) error {
-	return cfgerror.New().
+	errs := cfgerror.New().
 		Append(cfgerror.InRange(0, memBytes, r.MemoryBytes, cfgerror.InRangeOptIncludeMin, cfgerror.InRangeOptIncludeMax), "memory_bytes").
 		Append(cfgerror.InRange(0, cpuShares, r.CPUShares, cfgerror.InRangeOptIncludeMin, cfgerror.InRangeOptIncludeMax), "cpu_shares").
 		Append(cfgerror.InRange(0, cpuQuotaUs, r.CPUQuotaUs, cfgerror.InRangeOptIncludeMin, cfgerror.InRangeOptIncludeMax), "cpu_quota_us").
+		Append(cfgerror.Comparable(r.PidsMax).GreaterOrEqual(0), "pids_max").
+		Append(cfgerror.IsSupportedValue(r.Placement, "", PlacementRepository, PlacementTenant), "placement").
+		Append(cfgerror.Comparable(r.TenantIdleTimeout.Duration()).GreaterOrEqual(0), "tenant_idle_timeout")
+
+	if r.IOWeight != 0 {
+		errs = errs.Append(cfgerror.InRange(10, 1000, r.IOWeight, cfgerror.InRangeOptIncludeMin, cfgerror.InRangeOptIncludeMax), "io_weight")
+	}
+
+	for i, device := range r.IODevices {
+		errs = errs.Append(device.Validate(), "io_devices", fmt.Sprintf("[%d]", i))
+	}
+
+	return errs.AsError()
+}
+
+// IOLimited returns true if any block I/O limit is configured for the repository cgroups.
+func (r *Repositories) IOLimited() bool {
+	return r.IOWeight > 0 || len(r.IODevices) > 0
+}
+
+// IODevice configures the block I/O limits of a single device. A limit of 0 implies no limit.
+type IODevice struct {
+	// Path is the absolute path of the block device node, for example `/dev/sda`. Its major and
+	// minor numbers are resolved when the cgroups are set up.
+	Path string `toml:"path"`
+	// ReadBps is the maximum number of bytes per second that can be read from the device.
+	ReadBps uint64 `toml:"read_bps"`
+	// WriteBps is the maximum number of bytes per second that can be written to the device.
+	WriteBps uint64 `toml:"write_bps"`
+	// ReadIops is the maximum number of read operations per second on the device.
+	ReadIops uint64 `toml:"read_iops"`
+	// WriteIops is the maximum number of write operations per second on the device.
+	WriteIops uint64 `toml:"write_iops"`
+}
+
+// Validate runs validation on all fields and compose all found errors.
+func (d IODevice) Validate() error {
+	return cfgerror.New().
+		Append(cfgerror.PathIsAbs(d.Path), "path").
 		AsError()
 }
 
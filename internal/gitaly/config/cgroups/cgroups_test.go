@@ -3,10 +3,12 @@ package cgroups
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/errors/cfgerror"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/helper/duration"
 )
 
 func TestFallbackToOldVersion(t *testing.T) {
@@ -201,6 +203,70 @@ func TestRepositories_Validate(t *testing.T) {
 				),
 			},
 		},
+		{
+			name: "valid io and pids limits",
+			repositories: Repositories{
+				Count:    2,
+				PidsMax:  128,
+				IOWeight: 500,
+				IODevices: []IODevice{
+					{Path: "/dev/sda", ReadBps: 1024, WriteIops: 100},
+				},
+			},
+		},
+		{
+			name: "invalid io and pids limits",
+			repositories: Repositories{
+				Count:    2,
+				PidsMax:  -1,
+				IOWeight: 5,
+				IODevices: []IODevice{
+					{Path: "/dev/sda"},
+					{Path: "dev/sdb"},
+				},
+			},
+			expectedErr: cfgerror.ValidationErrors{
+				cfgerror.NewValidationError(
+					fmt.Errorf("%w: -1 is not greater than or equal to 0", cfgerror.ErrNotInRange),
+					"pids_max",
+				),
+				cfgerror.NewValidationError(
+					fmt.Errorf("%w: 5 out of [10, 1000]", cfgerror.ErrNotInRange),
+					"io_weight",
+				),
+				cfgerror.NewValidationError(
+					fmt.Errorf("%w: %q", cfgerror.ErrNotAbsolutePath, "dev/sdb"),
+					"io_devices", "[1]", "path",
+				),
+			},
+		},
+		{
+			name: "valid tenant placement",
+			repositories: Repositories{
+				Count:             2,
+				Placement:         PlacementTenant,
+				MaxTenants:        100,
+				TenantIdleTimeout: duration.Duration(time.Minute),
+			},
+		},
+		{
+			name: "invalid tenant placement",
+			repositories: Repositories{
+				Count:             2,
+				Placement:         "namespace",
+				TenantIdleTimeout: duration.Duration(-time.Minute),
+			},
+			expectedErr: cfgerror.ValidationErrors{
+				cfgerror.NewValidationError(
+					fmt.Errorf(`%w: "namespace"`, cfgerror.ErrUnsupportedValue),
+					"placement",
+				),
+				cfgerror.NewValidationError(
+					fmt.Errorf("%w: -1m0s is not greater than or equal to 0s", cfgerror.ErrNotInRange),
+					"tenant_idle_timeout",
+				),
+			},
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			err := tc.repositories.Validate(tc.memBytes, tc.cpuShares, tc.cpuQuotaUs)
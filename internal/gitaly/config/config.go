@@ -120,6 +120,7 @@ type Cfg struct {
 	Cgroups                cgroups.Config      `toml:"cgroups,omitempty" json:"cgroups"`
 	PackObjectsCache       StreamCacheConfig   `toml:"pack_objects_cache,omitempty" json:"pack_objects_cache"`
 	PackObjectsLimiting    PackObjectsLimiting `toml:"pack_objects_limiting,omitempty" json:"pack_objects_limiting"`
+	LoadShedding           LoadShedding        `toml:"load_shedding,omitempty" json:"load_shedding"`
 	Backup                 BackupConfig        `toml:"backup,omitempty" json:"backup"`
 }
 
@@ -290,6 +291,13 @@ type Git struct {
 	Config             []GitConfig `toml:"config,omitempty" json:"config"`
 	SigningKey         string      `toml:"signing_key,omitempty" json:"signing_key"`
 	RotatedSigningKeys []string    `toml:"rotated_signing_keys,omitempty" json:"rotated_signing_keys"`
+	// MaxRuntimes configures the maximum runtime of Git commands. Commands that exceed their
+	// maximum runtime are terminated.
+	MaxRuntimes []GitMaxRuntime `toml:"max_runtime,omitempty" json:"max_runtime"`
+	// KillGracePeriod is the time a Git command that exceeded its maximum runtime has to exit
+	// after it has received SIGTERM. The command is killed with SIGKILL afterwards. Defaults to
+	// 10 seconds.
+	KillGracePeriod duration.Duration `toml:"kill_grace_period,omitempty" json:"kill_grace_period"`
 }
 
 // Validate runs validation on all fields and compose all found errors.
@@ -299,6 +307,60 @@ func (g Git) Validate() error {
 		errs = errs.Append(gc.Validate(), "config")
 	}
 
+	for i, maxRuntime := range g.MaxRuntimes {
+		errs = errs.Append(maxRuntime.Validate(), "max_runtime", fmt.Sprintf("[%d]", i))
+	}
+
+	errs = errs.Append(cfgerror.Comparable(g.KillGracePeriod.Duration()).GreaterOrEqual(0), "kill_grace_period")
+
+	return errs.AsError()
+}
+
+// MaxRuntime returns the maximum runtime of the Git subcommand spawned by the given RPC. If
+// multiple entries match, then the lowest maximum runtime is returned. Returns zero if no entry
+// matches.
+func (g Git) MaxRuntime(fullMethod, subcommand string) time.Duration {
+	var maxRuntime time.Duration
+	for _, entry := range g.MaxRuntimes {
+		if entry.RPC != "" && entry.RPC != fullMethod {
+			continue
+		}
+
+		if entry.Subcommand != "" && entry.Subcommand != subcommand {
+			continue
+		}
+
+		if maxRuntime == 0 || entry.MaxRuntime.Duration() < maxRuntime {
+			maxRuntime = entry.MaxRuntime.Duration()
+		}
+	}
+
+	return maxRuntime
+}
+
+// GitMaxRuntime configures the maximum runtime of Git commands. An entry applies to all Git
+// commands that match both its RPC and its subcommand. Unset fields match all commands.
+type GitMaxRuntime struct {
+	// RPC is the full name of the RPC including the service name, e.g.
+	// `/gitaly.CommitService/RawBlame`.
+	RPC string `toml:"rpc,omitempty" json:"rpc"`
+	// Subcommand is the name of the Git subcommand, e.g. `blame`.
+	Subcommand string `toml:"subcommand,omitempty" json:"subcommand"`
+	// MaxRuntime is the maximum duration a matching Git command may run for.
+	MaxRuntime duration.Duration `toml:"max_runtime,omitempty" json:"max_runtime"`
+}
+
+// Validate runs validation on all fields and compose all found errors.
+func (m GitMaxRuntime) Validate() error {
+	errs := cfgerror.New().
+		Append(cfgerror.Comparable(m.MaxRuntime.Duration()).GreaterThan(0), "max_runtime")
+
+	if m.RPC != "" && !strings.HasPrefix(m.RPC, "/") {
+		errs = errs.Append(cfgerror.NewValidationError(
+			fmt.Errorf(`%w: %q must start with "/"`, cfgerror.ErrUnsupportedValue, m.RPC),
+		), "rpc")
+	}
+
 	return errs.AsError()
 }
 
@@ -520,6 +582,48 @@ func (pol PackObjectsLimiting) Validate() error {
 		AsError()
 }
 
+// LoadShedding configures server-wide load shedding. Gitaly keeps track of the time requests spend
+// waiting in the queues of all concurrency limiters. When the configured percentile of queue wait
+// times stays above the target for a whole interval, the server is considered to be overloaded and
+// starts rejecting low-priority RPCs until queue wait times have recovered.
+type LoadShedding struct {
+	// Enabled enables load shedding.
+	Enabled bool `toml:"enabled,omitempty" json:"enabled,omitempty"`
+	// Target is the queue wait time above which the server is considered to be overloaded.
+	Target duration.Duration `toml:"target,omitempty" json:"target,omitempty"`
+	// Interval is the interval over which queue wait times are aggregated. It is also used as the
+	// retry-after hint returned to clients whose requests have been shed.
+	Interval duration.Duration `toml:"interval,omitempty" json:"interval,omitempty"`
+	// Percentile is the percentile of queue wait times that is compared with the target.
+	Percentile float64 `toml:"percentile,omitempty" json:"percentile,omitempty"`
+	// RPCs is the list of full method names of low-priority RPCs that are rejected when the
+	// server is overloaded.
+	RPCs []string `toml:"rpcs,omitempty" json:"rpcs,omitempty"`
+}
+
+// Validate runs validation on all fields and compose all found errors.
+func (ls LoadShedding) Validate() error {
+	if !ls.Enabled {
+		return nil
+	}
+
+	errs := cfgerror.New().
+		Append(cfgerror.Comparable(ls.Target.Duration()).GreaterThan(0), "target").
+		Append(cfgerror.Comparable(ls.Interval.Duration()).GreaterThan(0), "interval").
+		Append(cfgerror.Comparable(ls.Percentile).InRange(0, 100, cfgerror.InRangeOptIncludeMax), "percentile")
+
+	for i, rpc := range ls.RPCs {
+		if !strings.HasPrefix(rpc, "/") {
+			errs = errs.Append(
+				fmt.Errorf(`%w: %q must start with "/"`, cfgerror.ErrUnsupportedValue, rpc),
+				"rpcs", fmt.Sprintf("[%d]", i),
+			)
+		}
+	}
+
+	return errs.AsError()
+}
+
 // BackupConfig configures server-side backups.
 type BackupConfig struct {
 	// GoCloudURL is the blob storage GoCloud URL that will be used to store
@@ -709,6 +813,7 @@ func (cfg *Cfg) ValidateV2() error {
 		}},
 		{field: "pack_objects_cache", validate: cfg.PackObjectsCache.Validate},
 		{field: "pack_objects_limiting", validate: cfg.PackObjectsLimiting.Validate},
+		{field: "load_shedding", validate: cfg.LoadShedding.Validate},
 		{field: "backup", validate: cfg.Backup.Validate},
 	} {
 		var fields []string
@@ -753,6 +858,18 @@ func (cfg *Cfg) setDefaults() error {
 		cfg.Backup.Layout = "pointer"
 	}
 
+	if cfg.LoadShedding.Target == 0 {
+		cfg.LoadShedding.Target = duration.Duration(time.Second)
+	}
+
+	if cfg.LoadShedding.Interval == 0 {
+		cfg.LoadShedding.Interval = duration.Duration(10 * time.Second)
+	}
+
+	if cfg.LoadShedding.Percentile == 0 {
+		cfg.LoadShedding.Percentile = 90
+	}
+
 	return nil
 }
 
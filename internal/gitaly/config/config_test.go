@@ -670,6 +670,14 @@ value = "first-value"
 [[git.config]]
 key = "second.key"
 value = "second-value"
+
+[[git.max_runtime]]
+subcommand = "blame"
+max_runtime = "5m"
+
+[[git.max_runtime]]
+rpc = "/gitaly.CommitService/ListCommits"
+max_runtime = "1h"
 `)
 
 	cfg, err := Load(tmpFile)
@@ -682,6 +690,10 @@ value = "second-value"
 			{Key: "first.key", Value: "first-value"},
 			{Key: "second.key", Value: "second-value"},
 		},
+		MaxRuntimes: []GitMaxRuntime{
+			{Subcommand: "blame", MaxRuntime: duration.Duration(5 * time.Minute)},
+			{RPC: "/gitaly.CommitService/ListCommits", MaxRuntime: duration.Duration(time.Hour)},
+		},
 	}, cfg.Git)
 }
 
@@ -776,6 +788,78 @@ func TestGit_Validate(t *testing.T) {
 	}
 }
 
+func TestGit_ValidateMaxRuntimes(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		desc        string
+		git         Git
+		expectedErr error
+	}{
+		{
+			desc: "valid",
+			git: Git{
+				MaxRuntimes: []GitMaxRuntime{
+					{MaxRuntime: duration.Duration(time.Hour)},
+					{RPC: "/gitaly.CommitService/RawBlame", Subcommand: "blame", MaxRuntime: duration.Duration(time.Minute)},
+				},
+				KillGracePeriod: duration.Duration(time.Second),
+			},
+		},
+		{
+			desc: "invalid",
+			git: Git{
+				MaxRuntimes: []GitMaxRuntime{
+					{Subcommand: "blame"},
+					{RPC: "gitaly.CommitService/RawBlame", MaxRuntime: duration.Duration(time.Minute)},
+				},
+				KillGracePeriod: duration.Duration(-time.Second),
+			},
+			expectedErr: cfgerror.ValidationErrors{
+				cfgerror.NewValidationError(
+					fmt.Errorf("%w: 0s is not greater than 0s", cfgerror.ErrNotInRange),
+					"max_runtime", "[0]", "max_runtime",
+				),
+				cfgerror.NewValidationError(
+					fmt.Errorf(`%w: "gitaly.CommitService/RawBlame" must start with "/"`, cfgerror.ErrUnsupportedValue),
+					"max_runtime", "[1]", "rpc",
+				),
+				cfgerror.NewValidationError(
+					fmt.Errorf("%w: -1s is not greater than or equal to 0s", cfgerror.ErrNotInRange),
+					"kill_grace_period",
+				),
+			},
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tc.expectedErr, tc.git.Validate())
+		})
+	}
+}
+
+func TestGit_MaxRuntime(t *testing.T) {
+	t.Parallel()
+
+	git := Git{
+		MaxRuntimes: []GitMaxRuntime{
+			{Subcommand: "blame", MaxRuntime: duration.Duration(10 * time.Minute)},
+			{RPC: "/gitaly.CommitService/RawBlame", MaxRuntime: duration.Duration(5 * time.Minute)},
+			{RPC: "/gitaly.CommitService/ListCommits", Subcommand: "rev-list", MaxRuntime: duration.Duration(time.Hour)},
+		},
+	}
+
+	require.Equal(t, 5*time.Minute, git.MaxRuntime("/gitaly.CommitService/RawBlame", "blame"))
+	require.Equal(t, 5*time.Minute, git.MaxRuntime("/gitaly.CommitService/RawBlame", "cat-file"))
+	require.Equal(t, 10*time.Minute, git.MaxRuntime("/gitaly.CommitService/RawRangeBlame", "blame"))
+	require.Equal(t, 10*time.Minute, git.MaxRuntime("", "blame"))
+	require.Equal(t, time.Hour, git.MaxRuntime("/gitaly.CommitService/ListCommits", "rev-list"))
+	require.Equal(t, time.Duration(0), git.MaxRuntime("/gitaly.CommitService/ListCommits", "cat-file"))
+	require.Equal(t, time.Duration(0), git.MaxRuntime("", "rev-list"))
+}
+
 func TestCfg_ValidateGitlabSecret(t *testing.T) {
 	t.Parallel()
 
@@ -1808,6 +1892,84 @@ func TestPackObjectsLimiting_Validate(t *testing.T) {
 	)
 }
 
+func TestLoadShedding_Validate(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		desc         string
+		loadShedding LoadShedding
+		expectedErr  error
+	}{
+		{
+			desc: "disabled",
+			loadShedding: LoadShedding{
+				Percentile: 200,
+			},
+		},
+		{
+			desc: "valid",
+			loadShedding: LoadShedding{
+				Enabled:    true,
+				Target:     duration.Duration(time.Second),
+				Interval:   duration.Duration(10 * time.Second),
+				Percentile: 100,
+				RPCs:       []string{"/gitaly.RepositoryService/OptimizeRepository"},
+			},
+		},
+		{
+			desc: "invalid",
+			loadShedding: LoadShedding{
+				Enabled:    true,
+				Interval:   duration.Duration(-time.Second),
+				Percentile: 0,
+				RPCs:       []string{"gitaly.RepositoryService/OptimizeRepository"},
+			},
+			expectedErr: cfgerror.ValidationErrors{
+				cfgerror.NewValidationError(
+					fmt.Errorf("%w: 0s is not greater than 0s", cfgerror.ErrNotInRange),
+					"target",
+				),
+				cfgerror.NewValidationError(
+					fmt.Errorf("%w: -1s is not greater than 0s", cfgerror.ErrNotInRange),
+					"interval",
+				),
+				cfgerror.NewValidationError(
+					fmt.Errorf("%w: 0 out of (0, 100]", cfgerror.ErrNotInRange),
+					"percentile",
+				),
+				cfgerror.NewValidationError(
+					fmt.Errorf(`%w: "gitaly.RepositoryService/OptimizeRepository" must start with "/"`, cfgerror.ErrUnsupportedValue),
+					"rpcs", "[0]",
+				),
+			},
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tc.expectedErr, tc.loadShedding.Validate())
+		})
+	}
+}
+
+func TestLoadShedding_defaults(t *testing.T) {
+	t.Parallel()
+
+	cfg, err := Load(strings.NewReader(`[load_shedding]
+enabled = true
+rpcs = ["/gitaly.RepositoryService/OptimizeRepository"]
+`))
+	require.NoError(t, err)
+	require.Equal(t, LoadShedding{
+		Enabled:    true,
+		Target:     duration.Duration(time.Second),
+		Interval:   duration.Duration(10 * time.Second),
+		Percentile: 90,
+		RPCs:       []string{"/gitaly.RepositoryService/OptimizeRepository"},
+	}, cfg.LoadShedding)
+}
+
 func TestConcurrency_Validate(t *testing.T) {
 	t.Parallel()
 
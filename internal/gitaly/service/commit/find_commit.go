@@ -3,6 +3,7 @@ package commit
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	"gitlab.com/gitlab-org/gitaly/v16/internal/git"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/git/localrepo"
@@ -18,6 +19,11 @@ func validateFindCommitRequest(locator storage.Locator, in *gitalypb.FindCommitR
 	if err := git.ValidateRevision(in.GetRevision()); err != nil {
 		return err
 	}
+	if notesRef := in.GetNotesRef(); len(notesRef) > 0 {
+		if err := git.ValidateNotesReference(string(notesRef)); err != nil {
+			return fmt.Errorf("invalid NotesRef: %w", err)
+		}
+	}
 	return nil
 }
 
@@ -40,5 +46,30 @@ func (s *server) FindCommit(ctx context.Context, in *gitalypb.FindCommitRequest)
 		return &gitalypb.FindCommitResponse{}, err
 	}
 
+	if notesRef := in.GetNotesRef(); len(notesRef) > 0 {
+		objectInfoReader, cancel, err := s.catfileCache.ObjectInfoReader(ctx, repo)
+		if err != nil {
+			return nil, structerr.NewInternal("creating object info reader: %w", err)
+		}
+		defer cancel()
+
+		blobID, ok, err := lookupNote(ctx, objectInfoReader, git.ReferenceName(notesRef), git.ObjectID(commit.GetId()))
+		if err != nil {
+			return nil, structerr.NewInternal("looking up note: %w", err)
+		}
+
+		if ok {
+			objectReader, cancel, err := s.catfileCache.ObjectReader(ctx, repo)
+			if err != nil {
+				return nil, structerr.NewInternal("creating object reader: %w", err)
+			}
+			defer cancel()
+
+			if commit.Note, err = readNote(ctx, objectReader, blobID); err != nil {
+				return nil, structerr.NewInternal("reading note: %w", err)
+			}
+		}
+	}
+
 	return &gitalypb.FindCommitResponse{Commit: commit}, nil
 }
@@ -302,6 +302,99 @@ func TestFindCommit(t *testing.T) {
 				}
 			},
 		},
+		{
+			desc: "invalid notes reference",
+			setup: func(t *testing.T) setupData {
+				repo, _ := gittest.CreateRepository(t, ctx, cfg)
+
+				return setupData{
+					request: &gitalypb.FindCommitRequest{
+						Repository: repo,
+						Revision:   []byte("main"),
+						NotesRef:   []byte("refs/heads/main"),
+					},
+					expectedErr: structerr.NewInvalidArgument("invalid NotesRef: reference is not a notes reference"),
+				}
+			},
+		},
+		{
+			desc: "with note",
+			setup: func(t *testing.T) setupData {
+				repo, repoPath := gittest.CreateRepository(t, ctx, cfg)
+
+				commitID, commit := writeCommit(t, repo)
+				gittest.Exec(t, cfg, "-C", repoPath, "notes", "--ref=refs/notes/ci", "add", "-m", "pipeline passed", commitID.String())
+				commit.Note = []byte("pipeline passed\n")
+
+				return setupData{
+					request: &gitalypb.FindCommitRequest{
+						Repository: repo,
+						Revision:   []byte(commitID),
+						NotesRef:   []byte("refs/notes/ci"),
+					},
+					expectedCommit: commit,
+				}
+			},
+		},
+		{
+			desc: "with fanned-out note",
+			setup: func(t *testing.T) setupData {
+				repo, repoPath := gittest.CreateRepository(t, ctx, cfg)
+
+				commitID, commit := writeCommit(t, repo)
+				gittest.WriteCommit(t, cfg, repoPath, gittest.WithTreeEntries(
+					gittest.TreeEntry{Path: commitID.String()[:2], Mode: "040000", OID: gittest.WriteTree(t, cfg, repoPath, []gittest.TreeEntry{
+						{Path: commitID.String()[2:], Mode: "100644", Content: "pipeline passed\n"},
+					})},
+				), gittest.WithReference("refs/notes/ci"))
+				commit.Note = []byte("pipeline passed\n")
+
+				return setupData{
+					request: &gitalypb.FindCommitRequest{
+						Repository: repo,
+						Revision:   []byte(commitID),
+						NotesRef:   []byte("refs/notes/ci"),
+					},
+					expectedCommit: commit,
+				}
+			},
+		},
+		{
+			desc: "without note",
+			setup: func(t *testing.T) setupData {
+				repo, repoPath := gittest.CreateRepository(t, ctx, cfg)
+
+				annotatedCommitID, _ := writeCommit(t, repo, gittest.WithMessage("annotated"))
+				gittest.Exec(t, cfg, "-C", repoPath, "notes", "--ref=refs/notes/ci", "add", "-m", "pipeline passed", annotatedCommitID.String())
+				commitID, commit := writeCommit(t, repo, gittest.WithMessage("not annotated"))
+
+				return setupData{
+					request: &gitalypb.FindCommitRequest{
+						Repository: repo,
+						Revision:   []byte(commitID),
+						NotesRef:   []byte("refs/notes/ci"),
+					},
+					expectedCommit: commit,
+				}
+			},
+		},
+		{
+			desc: "with missing notes reference",
+			setup: func(t *testing.T) setupData {
+				repo, _ := gittest.CreateRepository(t, ctx, cfg)
+
+				commitID, commit := writeCommit(t, repo)
+
+				return setupData{
+					request: &gitalypb.FindCommitRequest{
+						Repository: repo,
+						Revision:   []byte(commitID),
+						NotesRef:   []byte("refs/notes/ci"),
+					},
+					expectedCommit: commit,
+				}
+			},
+		},
 	} {
 		tc := tc
 
@@ -2,6 +2,7 @@ package commit
 
 import (
 	"errors"
+	"fmt"
 
 	"gitlab.com/gitlab-org/gitaly/v16/internal/git"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/git/catfile"
@@ -24,6 +25,11 @@ func verifyListCommitsRequest(locator storage.Locator, request *gitalypb.ListCom
 			return structerr.NewInvalidArgument("invalid revision: %w", err).WithMetadata("revision", revision)
 		}
 	}
+	if notesRef := request.GetNotesRef(); len(notesRef) > 0 {
+		if err := git.ValidateNotesReference(string(notesRef)); err != nil {
+			return fmt.Errorf("invalid NotesRef: %w", err)
+		}
+	}
 	return nil
 }
 
@@ -122,6 +128,28 @@ func (s *server) ListCommits(
 		},
 	})
 
+	var notes *commitNotes
+	if notesRef := request.GetNotesRef(); len(notesRef) > 0 {
+		// The object reader's queue is in use by the pipeline, so notes need to be read via
+		// separate readers.
+		notesInfoReader, cancel, err := s.catfileCache.ObjectInfoReader(ctx, repo)
+		if err != nil {
+			return structerr.NewInternal("creating notes object info reader: %w", err)
+		}
+		defer cancel()
+
+		notesReader, cancel, err := s.catfileCache.ObjectReader(ctx, repo)
+		if err != nil {
+			return structerr.NewInternal("creating notes object reader: %w", err)
+		}
+		defer cancel()
+
+		notes, err = newCommitNotes(ctx, notesInfoReader, notesReader, git.ReferenceName(notesRef))
+		if err != nil {
+			return structerr.NewInternal("reading notes: %w", err)
+		}
+	}
+
 	limit := request.GetPaginationParams().GetLimit()
 	parser := catfile.NewParser()
 
@@ -139,6 +167,12 @@ func (s *server) ListCommits(
 			return structerr.NewInternal("parsing commit: %w", err)
 		}
 
+		if notes != nil {
+			if err := notes.attach(ctx, commit); err != nil {
+				return structerr.NewInternal("reading note: %w", err)
+			}
+		}
+
 		if err := chunker.Send(commit); err != nil {
 			return structerr.NewInternal("sending commit: %w", err)
 		}
@@ -11,6 +11,7 @@ import (
 	"gitlab.com/gitlab-org/gitaly/v16/internal/structerr"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
 	"gitlab.com/gitlab-org/gitaly/v16/proto/go/gitalypb"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -38,6 +39,15 @@ func TestListCommits(t *testing.T) {
 	featureID, feature := writeCommit(t, ctx, cfg, repo, gittest.WithParents(main4ID), gittest.WithMessage("feature"), committerYear(2005))
 	mergeID, merge := writeCommit(t, ctx, cfg, repo, gittest.WithParents(branchingID, featureID), gittest.WithMessage("merge"), committerYear(2006), gittest.WithBranch("branch"))
 
+	repoPath, err := repo.Path()
+	require.NoError(t, err)
+	gittest.Exec(t, cfg, "-C", repoPath, "notes", "--ref=refs/notes/ci", "add", "-m", "main 1 passed", main1ID.String())
+	gittest.Exec(t, cfg, "-C", repoPath, "notes", "--ref=refs/notes/ci", "add", "-m", "main 3 passed", main3ID.String())
+	main1WithNote := proto.Clone(main1).(*gitalypb.GitCommit)
+	main1WithNote.Note = []byte("main 1 passed\n")
+	main3WithNote := proto.Clone(main3).(*gitalypb.GitCommit)
+	main3WithNote.Note = []byte("main 3 passed\n")
+
 	for _, tc := range []struct {
 		desc            string
 		request         *gitalypb.ListCommitsRequest
@@ -252,6 +262,32 @@ func TestListCommits(t *testing.T) {
 				main3,
 			},
 		},
+		{
+			desc: "with notes",
+			request: &gitalypb.ListCommitsRequest{
+				Repository: repoProto,
+				Revisions: []string{
+					main4ID.String(),
+				},
+				NotesRef: []byte("refs/notes/ci"),
+			},
+			expectedCommits: []*gitalypb.GitCommit{
+				main4, main3WithNote, main2, main1WithNote, main0,
+			},
+		},
+		{
+			desc: "with missing notes reference",
+			request: &gitalypb.ListCommitsRequest{
+				Repository: repoProto,
+				Revisions: []string{
+					main1ID.String(),
+				},
+				NotesRef: []byte("refs/notes/missing"),
+			},
+			expectedCommits: []*gitalypb.GitCommit{
+				main1, main0,
+			},
+		},
 	} {
 		tc := tc
 
@@ -301,6 +337,11 @@ func TestListCommits_verify(t *testing.T) {
 				"revision", "-invalid",
 			),
 		},
+		{
+			desc:        "invalid notes reference",
+			req:         &gitalypb.ListCommitsRequest{Repository: repo, Revisions: []string{"main"}, NotesRef: []byte("refs/notes/")},
+			expectedErr: structerr.NewInvalidArgument("invalid NotesRef: reference must not end with slash"),
+		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			stream, err := client.ListCommits(ctx, tc.req)
@@ -0,0 +1,202 @@
+package commit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git/catfile"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/storage"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/helper/chunk"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/structerr"
+	"gitlab.com/gitlab-org/gitaly/v16/proto/go/gitalypb"
+	"google.golang.org/protobuf/proto"
+)
+
+func (s *server) ListNotes(request *gitalypb.ListNotesRequest, stream gitalypb.CommitService_ListNotesServer) error {
+	if err := validateListNotesRequest(s.locator, request); err != nil {
+		return structerr.NewInvalidArgument("%w", err)
+	}
+
+	ctx := stream.Context()
+	repo := s.localrepo(request.GetRepository())
+
+	notesRef := git.DefaultNotesRef
+	if len(request.GetNotesRef()) > 0 {
+		notesRef = git.ReferenceName(request.GetNotesRef())
+	}
+
+	notes, err := repo.ListNotes(ctx, notesRef)
+	if err != nil {
+		return structerr.NewInternal("listing notes: %w", err)
+	}
+
+	objectReader, cancel, err := s.catfileCache.ObjectReader(ctx, repo)
+	if err != nil {
+		return structerr.NewInternal("creating object reader: %w", err)
+	}
+	defer cancel()
+
+	chunker := chunk.New(&notesSender{
+		send: func(notes []*gitalypb.ListNotesResponse_Note) error {
+			return stream.Send(&gitalypb.ListNotesResponse{
+				Notes: notes,
+			})
+		},
+	})
+
+	for _, note := range notes {
+		content, err := readNote(ctx, objectReader, note.BlobID)
+		if err != nil {
+			return structerr.NewInternal("reading note: %w", err).WithMetadata("object_id", note.ObjectID.String())
+		}
+
+		if err := chunker.Send(&gitalypb.ListNotesResponse_Note{
+			ObjectId: note.ObjectID.String(),
+			BlobId:   note.BlobID.String(),
+			Content:  content,
+		}); err != nil {
+			return structerr.NewInternal("sending note: %w", err)
+		}
+	}
+
+	if err := chunker.Flush(); err != nil {
+		return structerr.NewInternal("flushing notes: %w", err)
+	}
+
+	return nil
+}
+
+func validateListNotesRequest(locator storage.Locator, request *gitalypb.ListNotesRequest) error {
+	if err := locator.ValidateRepository(request.GetRepository()); err != nil {
+		return err
+	}
+
+	if notesRef := request.GetNotesRef(); len(notesRef) > 0 {
+		if err := git.ValidateNotesReference(string(notesRef)); err != nil {
+			return fmt.Errorf("invalid NotesRef: %w", err)
+		}
+	}
+
+	return nil
+}
+
+type notesSender struct {
+	notes []*gitalypb.ListNotesResponse_Note
+	send  func([]*gitalypb.ListNotesResponse_Note) error
+}
+
+func (s *notesSender) Reset() {
+	s.notes = s.notes[:0]
+}
+
+func (s *notesSender) Append(m proto.Message) {
+	s.notes = append(s.notes, m.(*gitalypb.ListNotesResponse_Note))
+}
+
+func (s *notesSender) Send() error {
+	return s.send(s.notes)
+}
+
+// commitNotes attaches the notes of a notes reference to commits. Notes are looked up per commit so
+// that only the fanout subtrees leading to the commits' notes are read.
+type commitNotes struct {
+	objectInfoReader catfile.ObjectInfoReader
+	objectReader     catfile.ObjectContentReader
+	notesRef         git.ReferenceName
+}
+
+// newCommitNotes returns a commitNotes for the given notes reference. It returns nil if the notes
+// reference doesn't exist, in which case no commit has a note.
+func newCommitNotes(ctx context.Context, objectInfoReader catfile.ObjectInfoReader, objectReader catfile.ObjectContentReader, notesRef git.ReferenceName) (*commitNotes, error) {
+	if _, err := objectInfoReader.Info(ctx, notesRef.Revision()); err != nil {
+		if errors.As(err, &catfile.NotFoundError{}) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &commitNotes{
+		objectInfoReader: objectInfoReader,
+		objectReader:     objectReader,
+		notesRef:         notesRef,
+	}, nil
+}
+
+// attach sets the note of the commit if it has one.
+func (n *commitNotes) attach(ctx context.Context, commit *gitalypb.GitCommit) error {
+	blobID, ok, err := lookupNote(ctx, n.objectInfoReader, n.notesRef, git.ObjectID(commit.GetId()))
+	if err != nil {
+		return err
+	}
+
+	if !ok {
+		return nil
+	}
+
+	content, err := readNote(ctx, n.objectReader, blobID)
+	if err != nil {
+		return err
+	}
+
+	commit.Note = content
+
+	return nil
+}
+
+// lookupNote returns the ID of the blob of the note attached to the object in the given notes
+// reference. Instead of reading the whole notes tree, the note is looked up at each fanout level
+// in turn by descending into the subtree named after the next two hex digits of the object ID.
+func lookupNote(ctx context.Context, objectInfoReader catfile.ObjectInfoReader, notesRef git.ReferenceName, objectID git.ObjectID) (git.ObjectID, bool, error) {
+	dir, remaining := "", objectID.String()
+	for {
+		info, err := objectInfoReader.Info(ctx, git.Revision(fmt.Sprintf("%s:%s%s", notesRef, dir, remaining)))
+		if err != nil {
+			if !errors.As(err, &catfile.NotFoundError{}) {
+				return "", false, err
+			}
+		} else if info.IsBlob() {
+			return info.Oid, true, nil
+		}
+
+		if len(remaining) <= 2 {
+			return "", false, nil
+		}
+
+		dir += remaining[:2]
+		remaining = remaining[2:]
+
+		info, err = objectInfoReader.Info(ctx, git.Revision(fmt.Sprintf("%s:%s", notesRef, dir)))
+		if err != nil {
+			if errors.As(err, &catfile.NotFoundError{}) {
+				return "", false, nil
+			}
+
+			return "", false, err
+		}
+
+		if info.Type != "tree" {
+			return "", false, nil
+		}
+
+		dir += "/"
+	}
+}
+
+// readNote reads the content of the blob of a note.
+func readNote(ctx context.Context, objectReader catfile.ObjectContentReader, blobID git.ObjectID) ([]byte, error) {
+	object, err := objectReader.Object(ctx, blobID.Revision())
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := io.ReadAll(object)
+	if err != nil {
+		return nil, err
+	}
+
+	return content, nil
+}
@@ -0,0 +1,114 @@
+package commit
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git/gittest"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/storage"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/structerr"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
+	"gitlab.com/gitlab-org/gitaly/v16/proto/go/gitalypb"
+)
+
+func TestListNotes(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	cfg, client := setupCommitService(t, ctx)
+
+	repoProto, repoPath := gittest.CreateRepository(t, ctx, cfg)
+
+	firstCommitID := gittest.WriteCommit(t, cfg, repoPath, gittest.WithMessage("first"))
+	secondCommitID := gittest.WriteCommit(t, cfg, repoPath, gittest.WithMessage("second"))
+
+	gittest.Exec(t, cfg, "-C", repoPath, "notes", "add", "-m", "first note", firstCommitID.String())
+	gittest.Exec(t, cfg, "-C", repoPath, "notes", "add", "-m", "second note", secondCommitID.String())
+	gittest.Exec(t, cfg, "-C", repoPath, "notes", "--ref=refs/notes/ci", "add", "-m", "pipeline passed", secondCommitID.String())
+
+	noteBlobID := func(notesRef git.ReferenceName, commitID git.ObjectID) string {
+		return gittest.ResolveRevision(t, cfg, repoPath, notesRef.String()+":"+commitID.String()).String()
+	}
+
+	for _, tc := range []struct {
+		desc          string
+		request       *gitalypb.ListNotesRequest
+		expectedNotes []*gitalypb.ListNotesResponse_Note
+		expectedErr   error
+	}{
+		{
+			desc:        "unset repository",
+			request:     &gitalypb.ListNotesRequest{},
+			expectedErr: structerr.NewInvalidArgument("%w", storage.ErrRepositoryNotSet),
+		},
+		{
+			desc: "invalid notes reference",
+			request: &gitalypb.ListNotesRequest{
+				Repository: repoProto,
+				NotesRef:   []byte("refs/heads/main"),
+			},
+			expectedErr: structerr.NewInvalidArgument("invalid NotesRef: reference is not a notes reference"),
+		},
+		{
+			desc: "default notes reference",
+			request: &gitalypb.ListNotesRequest{
+				Repository: repoProto,
+			},
+			expectedNotes: []*gitalypb.ListNotesResponse_Note{
+				{
+					ObjectId: firstCommitID.String(),
+					BlobId:   noteBlobID(git.DefaultNotesRef, firstCommitID),
+					Content:  []byte("first note\n"),
+				},
+				{
+					ObjectId: secondCommitID.String(),
+					BlobId:   noteBlobID(git.DefaultNotesRef, secondCommitID),
+					Content:  []byte("second note\n"),
+				},
+			},
+		},
+		{
+			desc: "custom notes reference",
+			request: &gitalypb.ListNotesRequest{
+				Repository: repoProto,
+				NotesRef:   []byte("refs/notes/ci"),
+			},
+			expectedNotes: []*gitalypb.ListNotesResponse_Note{
+				{
+					ObjectId: secondCommitID.String(),
+					BlobId:   noteBlobID("refs/notes/ci", secondCommitID),
+					Content:  []byte("pipeline passed\n"),
+				},
+			},
+		},
+		{
+			desc: "missing notes reference",
+			request: &gitalypb.ListNotesRequest{
+				Repository: repoProto,
+				NotesRef:   []byte("refs/notes/missing"),
+			},
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			// Notes are listed in the order of the notes tree, which sorts them by object ID.
+			sort.Slice(tc.expectedNotes, func(i, j int) bool {
+				return tc.expectedNotes[i].ObjectId < tc.expectedNotes[j].ObjectId
+			})
+
+			stream, err := client.ListNotes(ctx, tc.request)
+			require.NoError(t, err)
+
+			notes, err := testhelper.ReceiveAndFold(stream.Recv, func(result []*gitalypb.ListNotesResponse_Note, response *gitalypb.ListNotesResponse) []*gitalypb.ListNotesResponse_Note {
+				return append(result, response.GetNotes()...)
+			})
+			testhelper.RequireGrpcError(t, tc.expectedErr, err)
+			testhelper.ProtoEqual(t, tc.expectedNotes, notes)
+		})
+	}
+}
@@ -0,0 +1,179 @@
+package commit
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git/catfile"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/signature"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/structerr"
+	"gitlab.com/gitlab-org/gitaly/v16/proto/go/gitalypb"
+)
+
+func (s *server) VerifySignatures(request *gitalypb.VerifySignaturesRequest, stream gitalypb.CommitService_VerifySignaturesServer) error {
+	ctx := stream.Context()
+
+	if err := s.locator.ValidateRepository(request.GetRepository()); err != nil {
+		return err
+	}
+
+	repo := s.localrepo(request.GetRepository())
+
+	objectHash, err := repo.ObjectHash(ctx)
+	if err != nil {
+		return fmt.Errorf("detecting object hash: %w", err)
+	}
+
+	if err := validateVerifySignaturesRequest(objectHash, request); err != nil {
+		return structerr.NewInvalidArgument("%w", err)
+	}
+
+	trustedKeys := make([]signature.PublicKey, 0, len(request.GetTrustedKeys()))
+	for i, key := range request.GetTrustedKeys() {
+		publicKey, err := signature.ParsePublicKey(key)
+		if err != nil {
+			return structerr.NewInvalidArgument("invalid TrustedKeys[%d]: %w", i, err)
+		}
+
+		trustedKeys = append(trustedKeys, publicKey)
+	}
+
+	var systemKeys []signature.PublicKey
+	if s.cfg.Git.SigningKey != "" {
+		signingKeys, err := signature.ParseSigningKeys(s.cfg.Git.SigningKey, s.cfg.Git.RotatedSigningKeys...)
+		if err != nil {
+			return fmt.Errorf("failed to parse signing key: %w", err)
+		}
+
+		systemKeys = signingKeys.PublicKeys()
+	}
+
+	objectReader, cancel, err := s.catfileCache.ObjectReader(ctx, repo)
+	if err != nil {
+		return structerr.NewInternal("%w", err)
+	}
+	defer cancel()
+
+	for _, objectID := range request.GetObjectIds() {
+		response := &gitalypb.VerifySignaturesResponse{ObjectId: objectID}
+
+		signatureText, signedText, err := readObjectSignature(ctx, objectReader, objectID)
+		if err != nil {
+			if !errors.As(err, &catfile.NotFoundError{}) {
+				return structerr.NewInternal("%w", err)
+			}
+
+			response.Status = gitalypb.VerifySignaturesResponse_STATUS_NOT_FOUND
+			response.FailureReason = err.Error()
+		} else {
+			verifySignature(response, signatureText, signedText, systemKeys, trustedKeys)
+		}
+
+		if err := stream.Send(response); err != nil {
+			return structerr.NewInternal("%w", err)
+		}
+	}
+
+	return nil
+}
+
+// readObjectSignature reads the commit or annotated tag and returns its signature and the text
+// signed by it. A catfile.NotFoundError is returned if the object is neither a commit nor a tag.
+func readObjectSignature(ctx context.Context, objectReader catfile.ObjectContentReader, objectID string) ([]byte, []byte, error) {
+	object, err := objectReader.Object(ctx, git.Revision(objectID))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	switch object.Type {
+	case "commit":
+		return extractSignature(object)
+	case "tag":
+		content, err := io.ReadAll(object)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		signatureText, signedText := catfile.ExtractTagSignature(content)
+		return signatureText, signedText, nil
+	default:
+		if _, err := io.Copy(io.Discard, object); err != nil {
+			return nil, nil, err
+		}
+
+		return nil, nil, catfile.NotFoundError{}
+	}
+}
+
+// verifySignature populates the response with the result of verifying the signature. The system
+// keys take precedence over the trusted keys of the request.
+func verifySignature(
+	response *gitalypb.VerifySignaturesResponse,
+	signatureText, signedText []byte,
+	systemKeys, trustedKeys []signature.PublicKey,
+) {
+	if len(signatureText) == 0 {
+		response.Status = gitalypb.VerifySignaturesResponse_STATUS_UNSIGNED
+		return
+	}
+
+	firstLine, _, _ := bytes.Cut(signatureText, []byte("\n"))
+	response.SignatureType = catfile.DetectSignatureType(string(firstLine))
+
+	switch response.SignatureType {
+	case gitalypb.SignatureType_PGP, gitalypb.SignatureType_SSH:
+	default:
+		response.Status = gitalypb.VerifySignaturesResponse_STATUS_UNSUPPORTED
+		response.FailureReason = fmt.Sprintf("verifying %s signatures is not supported", response.SignatureType)
+		return
+	}
+
+	var verifyErr error
+	for _, candidate := range []struct {
+		keys   []signature.PublicKey
+		signer gitalypb.VerifySignaturesResponse_Signer
+	}{
+		{keys: systemKeys, signer: gitalypb.VerifySignaturesResponse_SIGNER_SYSTEM},
+		{keys: trustedKeys, signer: gitalypb.VerifySignaturesResponse_SIGNER_USER},
+	} {
+		key, err := signature.VerifyWithKeys(candidate.keys, signatureText, signedText)
+		if err == nil {
+			response.Status = gitalypb.VerifySignaturesResponse_STATUS_VERIFIED
+			response.KeyFingerprint = key.Fingerprint()
+			response.SignerIdentity = key.Identity()
+			response.Signer = candidate.signer
+			return
+		}
+
+		if verifyErr == nil || !errors.Is(err, signature.ErrUnknownKey) {
+			verifyErr = err
+		}
+	}
+
+	if errors.Is(verifyErr, signature.ErrUnknownKey) {
+		response.Status = gitalypb.VerifySignaturesResponse_STATUS_UNKNOWN_KEY
+		response.FailureReason = "signature has not been created by a trusted key"
+		return
+	}
+
+	response.Status = gitalypb.VerifySignaturesResponse_STATUS_BAD_SIGNATURE
+	response.FailureReason = verifyErr.Error()
+}
+
+func validateVerifySignaturesRequest(objectHash git.ObjectHash, request *gitalypb.VerifySignaturesRequest) error {
+	if len(request.GetObjectIds()) == 0 {
+		return errors.New("empty ObjectIds")
+	}
+
+	for _, objectID := range request.GetObjectIds() {
+		if err := objectHash.ValidateHex(objectID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,238 @@
+package commit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/featureflag"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git/gittest"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git/localrepo"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/storage"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/signature"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/structerr"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper/testcfg"
+	"gitlab.com/gitlab-org/gitaly/v16/proto/go/gitalypb"
+)
+
+const x509Signature = `-----BEGIN SIGNED MESSAGE-----
+MIISfwYJKoZIhvcNAQcCoIIScDCCEmwCAQExDTALBglghkgBZQMEAgEwCwYJKoZI
+-----END SIGNED MESSAGE-----`
+
+func TestVerifySignatures(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	cfg := testcfg.Build(t)
+	testcfg.BuildGitalyGPG(t, cfg)
+	cfg.Git.SigningKey = "testdata/signing_ssh_key_ed25519"
+	cfg.SocketPath = startTestServices(t, cfg)
+	client := newCommitServiceClient(t, cfg.SocketPath)
+
+	repoProto, repoPath := gittest.CreateRepository(t, ctx, cfg)
+	repo := localrepo.NewTestRepo(t, cfg, repoProto)
+
+	// Objects are only signed by localrepo when signing is enabled.
+	signingCtx := featureflag.ContextWithFeatureFlag(ctx, featureflag.GPGSigning, true)
+
+	writeSignedCommit := func(t *testing.T, signingKey, message string) git.ObjectID {
+		commitID, err := repo.WriteCommit(signingCtx, localrepo.WriteCommitConfig{
+			TreeID:         gittest.DefaultObjectHash.EmptyTreeOID,
+			AuthorName:     gittest.DefaultCommitterName,
+			AuthorEmail:    gittest.DefaultCommitterMail,
+			CommitterName:  gittest.DefaultCommitterName,
+			CommitterEmail: gittest.DefaultCommitterMail,
+			AuthorDate:     gittest.DefaultCommitTime,
+			CommitterDate:  gittest.DefaultCommitTime,
+			Message:        message,
+			SigningKey:     signingKey,
+		})
+		require.NoError(t, err)
+		return commitID
+	}
+
+	systemCommit := writeSignedCommit(t, cfg.Git.SigningKey, "system")
+	userCommit := writeSignedCommit(t, "testdata/signing_ssh_key_rsa", "user")
+	unsignedCommit := gittest.WriteCommit(t, cfg, repoPath, gittest.WithMessage("unsigned"))
+	pgpCommit, _ := createCommitWithSignature(t, cfg, repoPath, "gpgsig", pgpSignature, "pgp")
+	x509Commit, _ := createCommitWithSignature(t, cfg, repoPath, "gpgsig", x509Signature, "x509")
+
+	systemKeys, err := signature.ParseSigningKeys(cfg.Git.SigningKey)
+	require.NoError(t, err)
+	tamperedSignature, err := systemKeys.CreateSignature([]byte("some other content"))
+	require.NoError(t, err)
+	tamperedCommit, _ := createCommitWithSignature(t, cfg, repoPath, "gpgsig", string(tamperedSignature), "tampered")
+
+	signedTag, err := repo.WriteTag(signingCtx, systemCommit, "commit", []byte("signed-tag"), []byte("message"),
+		git.Signature{
+			Name:  gittest.DefaultCommitterName,
+			Email: gittest.DefaultCommitterMail,
+			When:  gittest.DefaultCommitTime,
+		}, localrepo.WriteTagWithSigningKey(cfg.Git.SigningKey))
+	require.NoError(t, err)
+	unsignedTag := gittest.WriteTag(t, cfg, repoPath, "unsigned-tag", systemCommit.Revision(), gittest.WriteTagConfig{
+		Message: "message",
+	})
+
+	blob := gittest.WriteBlob(t, cfg, repoPath, []byte("blob"))
+	missing := gittest.DefaultObjectHash.HashData([]byte("missing"))
+
+	userKey := testhelper.MustReadFile(t, "testdata/signing_ssh_key_rsa.pub")
+
+	for _, tc := range []struct {
+		desc              string
+		request           *gitalypb.VerifySignaturesRequest
+		expectedErr       error
+		expectedResponses []*gitalypb.VerifySignaturesResponse
+	}{
+		{
+			desc: "unset repository",
+			request: &gitalypb.VerifySignaturesRequest{
+				ObjectIds: []string{systemCommit.String()},
+			},
+			expectedErr: structerr.NewInvalidArgument("%w", storage.ErrRepositoryNotSet),
+		},
+		{
+			desc: "unset object IDs",
+			request: &gitalypb.VerifySignaturesRequest{
+				Repository: repoProto,
+			},
+			expectedErr: structerr.NewInvalidArgument("empty ObjectIds"),
+		},
+		{
+			desc: "abbreviated object ID",
+			request: &gitalypb.VerifySignaturesRequest{
+				Repository: repoProto,
+				ObjectIds:  []string{"a17a9f6"},
+			},
+			expectedErr: structerr.NewInvalidArgument(`invalid object ID: "a17a9f6", expected length %v, got 7`, gittest.DefaultObjectHash.EncodedLen()),
+		},
+		{
+			desc: "invalid trusted key",
+			request: &gitalypb.VerifySignaturesRequest{
+				Repository:  repoProto,
+				ObjectIds:   []string{systemCommit.String()},
+				TrustedKeys: [][]byte{[]byte("garbage")},
+			},
+			expectedErr: structerr.NewInvalidArgument("invalid TrustedKeys[0]: parse authorized key: ssh: no key found"),
+		},
+		{
+			desc: "system keys only",
+			request: &gitalypb.VerifySignaturesRequest{
+				Repository: repoProto,
+				ObjectIds: []string{
+					systemCommit.String(),
+					userCommit.String(),
+					unsignedCommit.String(),
+				},
+			},
+			expectedResponses: []*gitalypb.VerifySignaturesResponse{
+				{
+					ObjectId:       systemCommit.String(),
+					Status:         gitalypb.VerifySignaturesResponse_STATUS_VERIFIED,
+					SignatureType:  gitalypb.SignatureType_SSH,
+					KeyFingerprint: "SHA256:AtktEnK7QyaUN5BKYe0yFhZwZm3oHeuEZp65Z6gjMS0",
+					Signer:         gitalypb.VerifySignaturesResponse_SIGNER_SYSTEM,
+				},
+				{
+					ObjectId:      userCommit.String(),
+					Status:        gitalypb.VerifySignaturesResponse_STATUS_UNKNOWN_KEY,
+					SignatureType: gitalypb.SignatureType_SSH,
+					FailureReason: "signature has not been created by a trusted key",
+				},
+				{
+					ObjectId: unsignedCommit.String(),
+					Status:   gitalypb.VerifySignaturesResponse_STATUS_UNSIGNED,
+				},
+			},
+		},
+		{
+			desc: "trusted keys",
+			request: &gitalypb.VerifySignaturesRequest{
+				Repository: repoProto,
+				ObjectIds: []string{
+					userCommit.String(),
+					pgpCommit.String(),
+					x509Commit.String(),
+					tamperedCommit.String(),
+				},
+				TrustedKeys: [][]byte{userKey},
+			},
+			expectedResponses: []*gitalypb.VerifySignaturesResponse{
+				{
+					ObjectId:       userCommit.String(),
+					Status:         gitalypb.VerifySignaturesResponse_STATUS_VERIFIED,
+					SignatureType:  gitalypb.SignatureType_SSH,
+					KeyFingerprint: "SHA256:53S0FjkYZNZgL9WPw9YB29Bw1ViDP2wlG0vivJhrSUs",
+					SignerIdentity: "igordrozdov@Igors-MacBook-Pro-2.local",
+					Signer:         gitalypb.VerifySignaturesResponse_SIGNER_USER,
+				},
+				{
+					ObjectId:      pgpCommit.String(),
+					Status:        gitalypb.VerifySignaturesResponse_STATUS_UNKNOWN_KEY,
+					SignatureType: gitalypb.SignatureType_PGP,
+					FailureReason: "signature has not been created by a trusted key",
+				},
+				{
+					ObjectId:      x509Commit.String(),
+					Status:        gitalypb.VerifySignaturesResponse_STATUS_UNSUPPORTED,
+					SignatureType: gitalypb.SignatureType_X509,
+					FailureReason: "verifying X509 signatures is not supported",
+				},
+				{
+					ObjectId:      tamperedCommit.String(),
+					Status:        gitalypb.VerifySignaturesResponse_STATUS_BAD_SIGNATURE,
+					SignatureType: gitalypb.SignatureType_SSH,
+					FailureReason: "ssh: signature did not verify",
+				},
+			},
+		},
+		{
+			desc: "tags and other objects",
+			request: &gitalypb.VerifySignaturesRequest{
+				Repository: repoProto,
+				ObjectIds: []string{
+					signedTag.String(),
+					unsignedTag.String(),
+					blob.String(),
+					missing.String(),
+				},
+			},
+			expectedResponses: []*gitalypb.VerifySignaturesResponse{
+				{
+					ObjectId:       signedTag.String(),
+					Status:         gitalypb.VerifySignaturesResponse_STATUS_VERIFIED,
+					SignatureType:  gitalypb.SignatureType_SSH,
+					KeyFingerprint: "SHA256:AtktEnK7QyaUN5BKYe0yFhZwZm3oHeuEZp65Z6gjMS0",
+					Signer:         gitalypb.VerifySignaturesResponse_SIGNER_SYSTEM,
+				},
+				{
+					ObjectId: unsignedTag.String(),
+					Status:   gitalypb.VerifySignaturesResponse_STATUS_UNSIGNED,
+				},
+				{
+					ObjectId:      blob.String(),
+					Status:        gitalypb.VerifySignaturesResponse_STATUS_NOT_FOUND,
+					FailureReason: "object not found",
+				},
+				{
+					ObjectId:      missing.String(),
+					Status:        gitalypb.VerifySignaturesResponse_STATUS_NOT_FOUND,
+					FailureReason: "object not found",
+				},
+			},
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			stream, err := client.VerifySignatures(ctx, tc.request)
+			require.NoError(t, err)
+
+			responses, err := testhelper.Receive(stream.Recv)
+			testhelper.RequireGrpcError(t, tc.expectedErr, err)
+			testhelper.ProtoEqual(t, tc.expectedResponses, responses)
+		})
+	}
+}
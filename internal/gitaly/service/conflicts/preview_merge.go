@@ -0,0 +1,121 @@
+package conflicts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git/localrepo"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/storage"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/structerr"
+	"gitlab.com/gitlab-org/gitaly/v16/proto/go/gitalypb"
+)
+
+// conflictTypes maps the conflict types reported by git-merge-tree(1) to their gRPC
+// representation. Any other conflict type is reported as TYPE_OTHER.
+var conflictTypes = map[string]gitalypb.PreviewMergeResponse_Conflict_Type{
+	"CONFLICT (contents)":       gitalypb.PreviewMergeResponse_Conflict_TYPE_CONTENT,
+	"CONFLICT (binary)":         gitalypb.PreviewMergeResponse_Conflict_TYPE_BINARY,
+	"CONFLICT (rename/delete)":  gitalypb.PreviewMergeResponse_Conflict_TYPE_RENAME_DELETE,
+	"CONFLICT (modify/delete)":  gitalypb.PreviewMergeResponse_Conflict_TYPE_MODIFY_DELETE,
+	"CONFLICT (file/directory)": gitalypb.PreviewMergeResponse_Conflict_TYPE_DIRECTORY_FILE,
+}
+
+func (s *server) PreviewMerge(ctx context.Context, request *gitalypb.PreviewMergeRequest) (*gitalypb.PreviewMergeResponse, error) {
+	if err := validatePreviewMergeRequest(s.locator, request); err != nil {
+		return nil, structerr.NewInvalidArgument("%w", err)
+	}
+
+	// The merge is performed in a quarantine directory that is never migrated into the
+	// repository, so none of the objects written by git-merge-tree(1) are persisted.
+	_, quarantineRepo, err := s.quarantinedRepo(ctx, request.GetRepository())
+	if err != nil {
+		return nil, err
+	}
+
+	ours, err := quarantineRepo.ResolveRevision(ctx, git.Revision(request.GetOurCommitOid()+"^{commit}"))
+	if err != nil {
+		return nil, structerr.NewFailedPrecondition("could not lookup 'our' OID: %w", err)
+	}
+
+	theirs, err := quarantineRepo.ResolveRevision(ctx, git.Revision(request.GetTheirCommitOid()+"^{commit}"))
+	if err != nil {
+		return nil, structerr.NewFailedPrecondition("could not lookup 'their' OID: %w", err)
+	}
+
+	treeID, err := quarantineRepo.MergeTree(ctx, ours.String(), theirs.String(), localrepo.WithAllowUnrelatedHistories())
+	if err != nil {
+		var mergeConflictErr *localrepo.MergeTreeConflictError
+		if !errors.As(err, &mergeConflictErr) {
+			return nil, structerr.NewInternal("merge tree: %w", err)
+		}
+
+		return &gitalypb.PreviewMergeResponse{
+			Conflicts: previewMergeConflicts(mergeConflictErr),
+		}, nil
+	}
+
+	return &gitalypb.PreviewMergeResponse{
+		Clean:  true,
+		TreeId: treeID.String(),
+	}, nil
+}
+
+// previewMergeConflicts converts the conflict messages reported by git-merge-tree(1) into
+// conflicts. Informational messages are skipped. As Git reports binary files both as binary and
+// as content conflict, the content conflict is dropped for binary files.
+func previewMergeConflicts(mergeConflictErr *localrepo.MergeTreeConflictError) []*gitalypb.PreviewMergeResponse_Conflict {
+	binaryPaths := make(map[string]struct{})
+	for _, message := range mergeConflictErr.ConflictInfoMessage {
+		if conflictTypes[message.Type] == gitalypb.PreviewMergeResponse_Conflict_TYPE_BINARY && len(message.Paths) > 0 {
+			binaryPaths[message.Paths[0]] = struct{}{}
+		}
+	}
+
+	var conflicts []*gitalypb.PreviewMergeResponse_Conflict
+	for _, message := range mergeConflictErr.ConflictInfoMessage {
+		if !strings.HasPrefix(message.Type, "CONFLICT") {
+			continue
+		}
+
+		conflictType, ok := conflictTypes[message.Type]
+		if !ok {
+			conflictType = gitalypb.PreviewMergeResponse_Conflict_TYPE_OTHER
+		}
+
+		if conflictType == gitalypb.PreviewMergeResponse_Conflict_TYPE_CONTENT && len(message.Paths) > 0 {
+			if _, ok := binaryPaths[message.Paths[0]]; ok {
+				continue
+			}
+		}
+
+		paths := make([][]byte, 0, len(message.Paths))
+		for _, path := range message.Paths {
+			paths = append(paths, []byte(path))
+		}
+
+		conflicts = append(conflicts, &gitalypb.PreviewMergeResponse_Conflict{
+			Paths:   paths,
+			Type:    conflictType,
+			Message: strings.TrimSpace(message.Message),
+		})
+	}
+
+	return conflicts
+}
+
+func validatePreviewMergeRequest(locator storage.Locator, in *gitalypb.PreviewMergeRequest) error {
+	if err := locator.ValidateRepository(in.GetRepository()); err != nil {
+		return err
+	}
+	if in.GetOurCommitOid() == "" {
+		return fmt.Errorf("empty OurCommitOid")
+	}
+	if in.GetTheirCommitOid() == "" {
+		return fmt.Errorf("empty TheirCommitOid")
+	}
+
+	return nil
+}
@@ -0,0 +1,180 @@
+package conflicts
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git/gittest"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git/localrepo"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/storage"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/structerr"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
+	"gitlab.com/gitlab-org/gitaly/v16/proto/go/gitalypb"
+)
+
+func TestPreviewMerge(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	cfg, client := setupConflictsService(t, nil)
+
+	repoProto, repoPath := gittest.CreateRepository(t, ctx, cfg)
+	repo := localrepo.NewTestRepo(t, cfg, repoProto)
+
+	renamedContent := strings.Repeat("renamed\n", 20)
+
+	baseCommitID := gittest.WriteCommit(t, cfg, repoPath, gittest.WithTreeEntries(
+		gittest.TreeEntry{Path: "text", Mode: "100644", Content: "base\n"},
+		gittest.TreeEntry{Path: "binary", Mode: "100644", Content: "\x00base"},
+		gittest.TreeEntry{Path: "renamed", Mode: "100644", Content: renamedContent},
+		gittest.TreeEntry{Path: "modified", Mode: "100644", Content: "base\n"},
+		gittest.TreeEntry{Path: "dir", Mode: "100644", Content: "base\n"},
+	))
+	cleanCommitID := gittest.WriteCommit(t, cfg, repoPath, gittest.WithParents(baseCommitID), gittest.WithTreeEntries(
+		gittest.TreeEntry{Path: "text", Mode: "100644", Content: "base\n"},
+		gittest.TreeEntry{Path: "binary", Mode: "100644", Content: "\x00base"},
+		gittest.TreeEntry{Path: "renamed", Mode: "100644", Content: renamedContent},
+		gittest.TreeEntry{Path: "modified", Mode: "100644", Content: "base\n"},
+		gittest.TreeEntry{Path: "dir", Mode: "100644", Content: "base\n"},
+		gittest.TreeEntry{Path: "added", Mode: "100644", Content: "added\n"},
+	))
+	ourCommitID := gittest.WriteCommit(t, cfg, repoPath, gittest.WithParents(baseCommitID), gittest.WithTreeEntries(
+		gittest.TreeEntry{Path: "text", Mode: "100644", Content: "ours\n"},
+		gittest.TreeEntry{Path: "binary", Mode: "100644", Content: "\x00ours"},
+		gittest.TreeEntry{Path: "renamed-by-us", Mode: "100644", Content: renamedContent},
+		gittest.TreeEntry{Path: "modified", Mode: "100644", Content: "ours\n"},
+		gittest.TreeEntry{Path: "dir", Mode: "040000", OID: gittest.WriteTree(t, cfg, repoPath, []gittest.TreeEntry{
+			{Path: "file", Mode: "100644", Content: "ours\n"},
+		})},
+	))
+	theirCommitID := gittest.WriteCommit(t, cfg, repoPath, gittest.WithParents(baseCommitID), gittest.WithTreeEntries(
+		gittest.TreeEntry{Path: "text", Mode: "100644", Content: "theirs\n"},
+		gittest.TreeEntry{Path: "binary", Mode: "100644", Content: "\x00theirs"},
+		gittest.TreeEntry{Path: "dir", Mode: "100644", Content: "theirs\n"},
+	))
+
+	for _, tc := range []struct {
+		desc             string
+		request          *gitalypb.PreviewMergeRequest
+		expectedResponse *gitalypb.PreviewMergeResponse
+		expectedErr      error
+	}{
+		{
+			desc: "unset repository",
+			request: &gitalypb.PreviewMergeRequest{
+				OurCommitOid:   ourCommitID.String(),
+				TheirCommitOid: theirCommitID.String(),
+			},
+			expectedErr: structerr.NewInvalidArgument("%w", storage.ErrRepositoryNotSet),
+		},
+		{
+			desc: "empty our commit",
+			request: &gitalypb.PreviewMergeRequest{
+				Repository:     repoProto,
+				TheirCommitOid: theirCommitID.String(),
+			},
+			expectedErr: structerr.NewInvalidArgument("empty OurCommitOid"),
+		},
+		{
+			desc: "empty their commit",
+			request: &gitalypb.PreviewMergeRequest{
+				Repository:   repoProto,
+				OurCommitOid: ourCommitID.String(),
+			},
+			expectedErr: structerr.NewInvalidArgument("empty TheirCommitOid"),
+		},
+		{
+			desc: "nonexistent commit",
+			request: &gitalypb.PreviewMergeRequest{
+				Repository:     repoProto,
+				OurCommitOid:   ourCommitID.String(),
+				TheirCommitOid: gittest.DefaultObjectHash.ZeroOID.String(),
+			},
+			expectedErr: structerr.NewFailedPrecondition("could not lookup 'their' OID: reference not found"),
+		},
+		{
+			desc: "conflicting merge",
+			request: &gitalypb.PreviewMergeRequest{
+				Repository:     repoProto,
+				OurCommitOid:   ourCommitID.String(),
+				TheirCommitOid: theirCommitID.String(),
+			},
+			expectedResponse: &gitalypb.PreviewMergeResponse{
+				Conflicts: []*gitalypb.PreviewMergeResponse_Conflict{
+					{
+						Paths: [][]byte{[]byte("binary")},
+						Type:  gitalypb.PreviewMergeResponse_Conflict_TYPE_BINARY,
+					},
+					{
+						Paths: [][]byte{[]byte("dir~" + theirCommitID.String()), []byte("dir")},
+						Type:  gitalypb.PreviewMergeResponse_Conflict_TYPE_DIRECTORY_FILE,
+					},
+					{
+						Paths: [][]byte{[]byte("dir~" + theirCommitID.String())},
+						Type:  gitalypb.PreviewMergeResponse_Conflict_TYPE_MODIFY_DELETE,
+					},
+					{
+						Paths: [][]byte{[]byte("modified")},
+						Type:  gitalypb.PreviewMergeResponse_Conflict_TYPE_MODIFY_DELETE,
+					},
+					{
+						Paths: [][]byte{[]byte("renamed-by-us"), []byte("renamed")},
+						Type:  gitalypb.PreviewMergeResponse_Conflict_TYPE_RENAME_DELETE,
+					},
+					{
+						Paths: [][]byte{[]byte("text")},
+						Type:  gitalypb.PreviewMergeResponse_Conflict_TYPE_CONTENT,
+					},
+				},
+			},
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			response, err := client.PreviewMerge(ctx, tc.request)
+			testhelper.RequireGrpcError(t, tc.expectedErr, err)
+
+			// The messages are generated by Git and thus not part of the contract.
+			for _, conflict := range response.GetConflicts() {
+				require.NotEmpty(t, conflict.Message)
+				conflict.Message = ""
+			}
+
+			testhelper.ProtoEqual(t, tc.expectedResponse, response)
+		})
+	}
+
+	t.Run("clean merge", func(t *testing.T) {
+		t.Parallel()
+
+		response, err := client.PreviewMerge(ctx, &gitalypb.PreviewMergeRequest{
+			Repository:     repoProto,
+			OurCommitOid:   ourCommitID.String(),
+			TheirCommitOid: cleanCommitID.String(),
+		})
+		require.NoError(t, err)
+		require.True(t, response.GetClean())
+		require.Empty(t, response.GetConflicts())
+
+		// The merged tree must not have been written into the repository.
+		exists, err := repo.HasRevision(ctx, git.Revision(response.GetTreeId()+"^{tree}"))
+		require.NoError(t, err)
+		require.False(t, exists)
+
+		require.Equal(t, gittest.WriteTree(t, cfg, repoPath, []gittest.TreeEntry{
+			{Path: "added", Mode: "100644", Content: "added\n"},
+			{Path: "binary", Mode: "100644", Content: "\x00ours"},
+			{Path: "dir", Mode: "040000", OID: gittest.WriteTree(t, cfg, repoPath, []gittest.TreeEntry{
+				{Path: "file", Mode: "100644", Content: "ours\n"},
+			})},
+			{Path: "modified", Mode: "100644", Content: "ours\n"},
+			{Path: "renamed-by-us", Mode: "100644", Content: renamedContent},
+			{Path: "text", Mode: "100644", Content: "ours\n"},
+		}).String(), response.GetTreeId())
+	})
+}
@@ -127,8 +127,9 @@ func (s *server) resolveConflicts(header *gitalypb.ResolveConflictsRequestHeader
 	for _, ck := range checkKeys {
 		_, sectionExists := ck["sections"]
 		_, contentExists := ck["content"]
-		if !sectionExists && !contentExists {
-			return structerr.NewInvalidArgument("missing sections or content for a resolution")
+		_, choiceExists := ck["choice"]
+		if !sectionExists && !contentExists && !choiceExists {
+			return structerr.NewInvalidArgument("missing sections, content or choice for a resolution")
 		}
 	}
 
@@ -206,10 +207,13 @@ func (s *server) resolveConflictsWithGit(
 
 	var mergeConflictErr *localrepo.MergeTreeConflictError
 	if errors.As(err, &mergeConflictErr) {
-		conflictedFiles := mergeConflictErr.ConflictedFiles()
+		// Paths which only exist in the common ancestor, like the source of a rename/rename
+		// conflict, are not part of the merged tree and thus don't require a resolution.
 		checkedConflictedFiles := make(map[string]bool)
-		for _, conflictedFile := range conflictedFiles {
-			checkedConflictedFiles[conflictedFile] = false
+		for _, conflictedFile := range mergeConflictErr.ConflictingFileInfo {
+			if conflictedFile.Stage != localrepo.MergeStageAncestor {
+				checkedConflictedFiles[conflictedFile.FileName] = false
+			}
 		}
 
 		tree, err := repo.ReadTree(ctx, treeOID.Revision(), localrepo.WithRecursive())
@@ -223,9 +227,33 @@ func (s *server) resolveConflictsWithGit(
 		}
 		defer cancel()
 
+		sideTrees := make(map[string]*localrepo.TreeEntry)
+
 		for _, resolution := range resolutions {
 			path := resolution.OldPath
 
+			if resolution.Choice != "" {
+				_, oldPathConflicted := checkedConflictedFiles[resolution.OldPath]
+				_, newPathConflicted := checkedConflictedFiles[resolution.NewPath]
+				if !oldPathConflicted && !newPathConflicted {
+					return "", structerr.NewInvalidArgument("no conflict to resolve with choice %q for file %s", resolution.Choice, resolution.OldPath)
+				}
+
+				// A choice resolves the conflict for both paths, which differ in case
+				// the file has been renamed.
+				for _, resolvedPath := range []string{resolution.OldPath, resolution.NewPath} {
+					if _, ok := checkedConflictedFiles[resolvedPath]; ok {
+						checkedConflictedFiles[resolvedPath] = true
+					}
+				}
+
+				if err := resolveConflictWithChoice(ctx, repo, tree, sideTrees, ours, theirs, resolution); err != nil {
+					return "", err
+				}
+
+				continue
+			}
+
 			if _, ok := checkedConflictedFiles[path]; !ok {
 				// Note: this emulates the Ruby error that occurs when
 				// there are no conflicts for a resolution
@@ -276,31 +304,36 @@ func (s *server) resolveConflictsWithGit(
 				}
 			}
 
-			object, err := objectReader.Object(ctx, git.Revision(fmt.Sprintf("%s:%s", ours, resolution.OldPath)))
-			if err != nil {
-				return "", structerr.NewInternal("retrieving object: %w", err)
-			}
+			// Content is written as-is, which allows resolving conflicts for binary files and
+			// for files that don't exist on both sides, too.
+			var resolvedContent io.Reader = strings.NewReader(resolution.Content)
+			if len(resolution.Sections) > 0 {
+				object, err := objectReader.Object(ctx, git.Revision(fmt.Sprintf("%s:%s", ours, resolution.OldPath)))
+				if err != nil {
+					return "", structerr.NewInternal("retrieving object: %w", err)
+				}
 
-			// Rails expects files ending with newlines to retain them post conflict, but
-			// git swallows ending newlines. So we manually append them if necessary.
-			needsNewLine := false
+				// Rails expects files ending with newlines to retain them post conflict, but
+				// git swallows ending newlines. So we manually append them if necessary.
+				needsNewLine := false
 
-			oursContent, err := io.ReadAll(object)
-			if err != nil {
-				return "", structerr.NewInternal("reading object: %w", err)
-			}
-			if len(oursContent) > 0 {
-				needsNewLine = oursContent[len(oursContent)-1] == '\n'
-			}
+				oursContent, err := io.ReadAll(object)
+				if err != nil {
+					return "", structerr.NewInternal("reading object: %w", err)
+				}
+				if len(oursContent) > 0 {
+					needsNewLine = oursContent[len(oursContent)-1] == '\n'
+				}
 
-			object, err = objectReader.Object(ctx, conflictedBlob.OID.Revision())
-			if err != nil {
-				return "", structerr.NewInternal("retrieving object: %w", err)
-			}
+				object, err = objectReader.Object(ctx, conflictedBlob.OID.Revision())
+				if err != nil {
+					return "", structerr.NewInternal("retrieving object: %w", err)
+				}
 
-			resolvedContent, err := conflict.Resolve(object, git.ObjectID(ours), git.ObjectID(theirs), path, resolution, needsNewLine)
-			if err != nil {
-				return "", structerr.NewInternal("%w", err)
+				resolvedContent, err = conflict.Resolve(object, git.ObjectID(ours), git.ObjectID(theirs), path, resolution, needsNewLine)
+				if err != nil {
+					return "", structerr.NewInternal("%w", err)
+				}
 			}
 
 			blobOID, err := repo.WriteBlob(ctx, resolvedContent, localrepo.WriteBlobConfig{
@@ -355,6 +388,78 @@ func (s *server) resolveConflictsWithGit(
 	return commitOID, nil
 }
 
+// resolveConflictWithChoice resolves a conflict by replacing the conflicted file in the merged tree
+// with the version of the side selected by the resolution. The path of the other side is removed
+// from the merged tree, which resolves rename/rename conflicts. If the selected side has deleted
+// the file, the file is removed from the merged tree.
+func resolveConflictWithChoice(
+	ctx context.Context,
+	repo *localrepo.Repo,
+	tree *localrepo.TreeEntry,
+	sideTrees map[string]*localrepo.TreeEntry,
+	ours, theirs string,
+	resolution conflict.Resolution,
+) error {
+	var commit, path, otherPath string
+	switch resolution.Choice {
+	case conflict.ChoiceHead:
+		commit, path, otherPath = ours, resolution.OldPath, resolution.NewPath
+	case conflict.ChoiceOrigin:
+		commit, path, otherPath = theirs, resolution.NewPath, resolution.OldPath
+	default:
+		return structerr.NewInvalidArgument("invalid choice %q for file %s", resolution.Choice, resolution.OldPath)
+	}
+
+	sideTree, ok := sideTrees[commit]
+	if !ok {
+		var err error
+		sideTree, err = repo.ReadTree(ctx, git.Revision(commit), localrepo.WithRecursive())
+		if err != nil {
+			return structerr.NewInternal("getting tree: %w", err)
+		}
+
+		sideTrees[commit] = sideTree
+	}
+
+	if otherPath != "" && otherPath != path {
+		if err := tree.Delete(otherPath); err != nil && !errors.Is(err, localrepo.ErrEntryNotFound) {
+			return structerr.NewInternal("delete from tree: %w", err)
+		}
+	}
+
+	chosenBlob, err := sideTree.Get(path)
+	if err != nil {
+		if !errors.Is(err, localrepo.ErrEntryNotFound) {
+			return structerr.NewInternal("getting entry: %w", err)
+		}
+
+		if err := tree.Delete(path); err != nil && !errors.Is(err, localrepo.ErrEntryNotFound) {
+			return structerr.NewInternal("delete from tree: %w", err)
+		}
+
+		return nil
+	}
+
+	if chosenBlob.Type != localrepo.Blob {
+		return structerr.NewInternal("entry should be of type blob").
+			WithMetadataItems(
+				structerr.MetadataItem{Key: "path", Value: path},
+				structerr.MetadataItem{Key: "type", Value: chosenBlob.Type},
+			)
+	}
+
+	if err := tree.Add(path, localrepo.TreeEntry{
+		OID:  chosenBlob.OID,
+		Mode: chosenBlob.Mode,
+		Path: filepath.Base(path),
+		Type: localrepo.Blob,
+	}, localrepo.WithOverwriteFile()); err != nil {
+		return structerr.NewInternal("add to tree: %w", err)
+	}
+
+	return nil
+}
+
 func sameRepo(left, right storage.Repository) bool {
 	lgaod := left.GetGitAlternateObjectDirectories()
 	rgaod := right.GetGitAlternateObjectDirectories()
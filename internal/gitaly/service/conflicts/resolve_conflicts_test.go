@@ -1380,6 +1380,208 @@ func testResolveConflicts(t *testing.T, ctx context.Context) {
 				}
 			},
 		},
+		{
+			"binary and tree conflicts resolved by choice and content",
+			func(tb testing.TB, ctx context.Context) setupData {
+				cfg, client := setupConflictsService(tb, nil)
+				repo, repoPath := gittest.CreateRepository(tb, ctx, cfg)
+
+				renamedContent := strings.Repeat("renamed\n", 20)
+				deletedContent := strings.Repeat("deleted\n", 20)
+
+				baseCommitID := gittest.WriteCommit(tb, cfg, repoPath, gittest.WithTreeEntries(
+					gittest.TreeEntry{Path: "binary", Mode: "100644", Content: "\x00base"},
+					gittest.TreeEntry{Path: "modified", Mode: "100644", Content: "base\n"},
+					gittest.TreeEntry{Path: "renamed", Mode: "100644", Content: renamedContent},
+					gittest.TreeEntry{Path: "deleted", Mode: "100644", Content: deletedContent},
+				))
+				ourCommitID := gittest.WriteCommit(tb, cfg, repoPath, gittest.WithParents(baseCommitID), gittest.WithBranch("ours"),
+					gittest.WithTreeEntries(
+						gittest.TreeEntry{Path: "added", Mode: "100644", Content: "\x00ours"},
+						gittest.TreeEntry{Path: "binary", Mode: "100644", Content: "\x00ours"},
+						gittest.TreeEntry{Path: "modified", Mode: "100644", Content: "ours\n"},
+						gittest.TreeEntry{Path: "renamed-by-us", Mode: "100644", Content: renamedContent},
+						gittest.TreeEntry{Path: "deleted-by-them", Mode: "100644", Content: deletedContent},
+					))
+				theirCommitID := gittest.WriteCommit(tb, cfg, repoPath, gittest.WithParents(baseCommitID), gittest.WithBranch("theirs"),
+					gittest.WithTreeEntries(
+						gittest.TreeEntry{Path: "added", Mode: "100644", Content: "\x00theirs"},
+						gittest.TreeEntry{Path: "binary", Mode: "100644", Content: "\x00theirs"},
+						gittest.TreeEntry{Path: "renamed-by-them", Mode: "100644", Content: renamedContent},
+					))
+
+				files := []map[string]interface{}{
+					{
+						"old_path": "added",
+						"new_path": "added",
+						"content":  "\x00resolved",
+					},
+					{
+						"old_path": "binary",
+						"new_path": "binary",
+						"choice":   "origin",
+					},
+					{
+						"old_path": "modified",
+						"new_path": "modified",
+						"choice":   "origin",
+					},
+					{
+						"old_path": "renamed-by-us",
+						"new_path": "renamed-by-them",
+						"choice":   "head",
+					},
+					{
+						"old_path": "deleted-by-them",
+						"new_path": "deleted-by-them",
+						"choice":   "head",
+					},
+				}
+
+				filesJSON, err := json.Marshal(files)
+				require.NoError(t, err)
+
+				return setupData{
+					cfg:      cfg,
+					client:   client,
+					repoPath: repoPath,
+					repo:     repo,
+					requestHeader: &gitalypb.ResolveConflictsRequest_Header{
+						Header: &gitalypb.ResolveConflictsRequestHeader{
+							Repository:       repo,
+							TargetRepository: repo,
+							OurCommitOid:     ourCommitID.String(),
+							TheirCommitOid:   theirCommitID.String(),
+							TargetBranch:     []byte("theirs"),
+							SourceBranch:     []byte("ours"),
+							CommitMessage:    []byte(conflictResolutionCommitMessage),
+							User:             defaultUser,
+							Timestamp:        defaultTimestamp,
+						},
+					},
+					requestsFilesJSON: []*gitalypb.ResolveConflictsRequest_FilesJson{
+						{FilesJson: filesJSON},
+					},
+					expectedCommitAuthor: defaultCommitAuthor,
+					expectedResponse:     &gitalypb.ResolveConflictsResponse{},
+					additionalChecks: func() {
+						gittest.RequireTree(tb, cfg, repoPath, "refs/heads/ours", []gittest.TreeEntry{
+							{Path: "added", Mode: "100644", Content: "\x00resolved"},
+							{Path: "binary", Mode: "100644", Content: "\x00theirs"},
+							{Path: "deleted-by-them", Mode: "100644", Content: deletedContent},
+							{Path: "renamed-by-us", Mode: "100644", Content: renamedContent},
+						})
+					},
+				}
+			},
+		},
+		{
+			"invalid choice",
+			func(tb testing.TB, ctx context.Context) setupData {
+				cfg, client := setupConflictsService(tb, nil)
+				repo, repoPath := gittest.CreateRepository(tb, ctx, cfg)
+
+				baseCommitID := gittest.WriteCommit(tb, cfg, repoPath, gittest.WithTreeEntries(
+					gittest.TreeEntry{Path: "a", Mode: "100644", Content: "apple"},
+				))
+
+				ourCommitID := gittest.WriteCommit(tb, cfg, repoPath, gittest.WithParents(baseCommitID), gittest.WithBranch("ours"),
+					gittest.WithTreeEntries(gittest.TreeEntry{Path: "a", Mode: "100644", Content: "apricot"}))
+				theirCommitID := gittest.WriteCommit(tb, cfg, repoPath, gittest.WithParents(baseCommitID), gittest.WithBranch("theirs"),
+					gittest.WithTreeEntries(gittest.TreeEntry{Path: "a", Mode: "100644", Content: "acai"}))
+
+				filesJSON, err := json.Marshal([]map[string]interface{}{
+					{
+						"old_path": "a",
+						"new_path": "a",
+						"choice":   "both",
+					},
+				})
+				require.NoError(t, err)
+
+				return setupData{
+					cfg:      cfg,
+					client:   client,
+					repoPath: repoPath,
+					repo:     repo,
+					requestHeader: &gitalypb.ResolveConflictsRequest_Header{
+						Header: &gitalypb.ResolveConflictsRequestHeader{
+							Repository:       repo,
+							TargetRepository: repo,
+							OurCommitOid:     ourCommitID.String(),
+							TheirCommitOid:   theirCommitID.String(),
+							TargetBranch:     []byte("theirs"),
+							SourceBranch:     []byte("ours"),
+							CommitMessage:    []byte(conflictResolutionCommitMessage),
+							User:             defaultUser,
+							Timestamp:        defaultTimestamp,
+						},
+					},
+					requestsFilesJSON: []*gitalypb.ResolveConflictsRequest_FilesJson{
+						{FilesJson: filesJSON},
+					},
+					expectedError:   structerr.NewInvalidArgument(`invalid choice "both" for file a`),
+					skipCommitCheck: true,
+				}
+			},
+		},
+		{
+			"choice for file without conflict",
+			func(tb testing.TB, ctx context.Context) setupData {
+				cfg, client := setupConflictsService(tb, nil)
+				repo, repoPath := gittest.CreateRepository(tb, ctx, cfg)
+
+				baseCommitID := gittest.WriteCommit(tb, cfg, repoPath, gittest.WithTreeEntries(
+					gittest.TreeEntry{Path: "a", Mode: "100644", Content: "apple"},
+					gittest.TreeEntry{Path: "b", Mode: "100644", Content: "banana"},
+				))
+
+				ourCommitID := gittest.WriteCommit(tb, cfg, repoPath, gittest.WithParents(baseCommitID), gittest.WithBranch("ours"),
+					gittest.WithTreeEntries(
+						gittest.TreeEntry{Path: "a", Mode: "100644", Content: "apricot"},
+						gittest.TreeEntry{Path: "b", Mode: "100644", Content: "blueberry"},
+					))
+				theirCommitID := gittest.WriteCommit(tb, cfg, repoPath, gittest.WithParents(baseCommitID), gittest.WithBranch("theirs"),
+					gittest.WithTreeEntries(
+						gittest.TreeEntry{Path: "a", Mode: "100644", Content: "acai"},
+						gittest.TreeEntry{Path: "b", Mode: "100644", Content: "banana"},
+					))
+
+				filesJSON, err := json.Marshal([]map[string]interface{}{
+					{
+						"old_path": "b",
+						"new_path": "b",
+						"choice":   "head",
+					},
+				})
+				require.NoError(t, err)
+
+				return setupData{
+					cfg:      cfg,
+					client:   client,
+					repoPath: repoPath,
+					repo:     repo,
+					requestHeader: &gitalypb.ResolveConflictsRequest_Header{
+						Header: &gitalypb.ResolveConflictsRequestHeader{
+							Repository:       repo,
+							TargetRepository: repo,
+							OurCommitOid:     ourCommitID.String(),
+							TheirCommitOid:   theirCommitID.String(),
+							TargetBranch:     []byte("theirs"),
+							SourceBranch:     []byte("ours"),
+							CommitMessage:    []byte(conflictResolutionCommitMessage),
+							User:             defaultUser,
+							Timestamp:        defaultTimestamp,
+						},
+					},
+					requestsFilesJSON: []*gitalypb.ResolveConflictsRequest_FilesJson{
+						{FilesJson: filesJSON},
+					},
+					expectedError:   structerr.NewInvalidArgument(`no conflict to resolve with choice "head" for file b`),
+					skipCommitCheck: true,
+				}
+			},
+		},
 		{
 			"invalid user timezone",
 			func(tb testing.TB, ctx context.Context) setupData {
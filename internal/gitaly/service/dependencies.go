@@ -3,6 +3,7 @@ package service
 import (
 	"gitlab.com/gitlab-org/gitaly/v16/internal/backup"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/cache"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/command/accounting"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/git"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/git/catfile"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/git/housekeeping"
@@ -45,6 +46,7 @@ type Dependencies struct {
 	PartitionManager    *storagemgr.PartitionManager
 	BackupSink          backup.Sink
 	BackupLocator       backup.Locator
+	ResourceTracker     *accounting.Tracker
 }
 
 // GetLogger returns the logger.
@@ -151,3 +153,8 @@ func (dc *Dependencies) GetBackupSink() backup.Sink {
 func (dc *Dependencies) GetBackupLocator() backup.Locator {
 	return dc.BackupLocator
 }
+
+// GetResourceTracker returns the resource tracker.
+func (dc *Dependencies) GetResourceTracker() *accounting.Tracker {
+	return dc.ResourceTracker
+}
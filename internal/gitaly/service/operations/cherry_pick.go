@@ -48,12 +48,20 @@ func (s *Server) UserCherryPick(ctx context.Context, req *gitalypb.UserCherryPic
 		}
 		return nil, fmt.Errorf("cherry pick: %w", err)
 	}
-	cherryDate := cherryCommit.Author.GetDate().AsTime()
-	loc, err := time.Parse("-0700", string(cherryCommit.Author.GetTimezone()))
+	cherryDate, err := cherryPickAuthorDate(cherryCommit)
 	if err != nil {
-		return nil, fmt.Errorf("get cherry commit location: %w", err)
+		return nil, err
+	}
+
+	mergeBase := git.Revision(req.Commit.Id + "^")
+	if mainline := req.GetMainline(); mainline > 0 {
+		parent, err := mainlineParent(cherryCommit, mainline)
+		if err != nil {
+			return nil, err
+		}
+
+		mergeBase = parent.Revision()
 	}
-	cherryDate = cherryDate.In(loc.Location())
 
 	// Cherry-pick is implemented using git-merge-tree(1). We
 	// "merge" in the changes from the commit that is cherry-picked,
@@ -62,7 +70,7 @@ func (s *Server) UserCherryPick(ctx context.Context, req *gitalypb.UserCherryPic
 		ctx,
 		startRevision.String(),
 		req.Commit.Id,
-		localrepo.WithMergeBase(git.Revision(req.Commit.Id+"^")),
+		localrepo.WithMergeBase(mergeBase),
 		localrepo.WithConflictingFileNamesOnly(),
 	)
 	if err != nil {
@@ -201,3 +209,13 @@ func (s *Server) UserCherryPick(ctx context.Context, req *gitalypb.UserCherryPic
 		},
 	}, nil
 }
+
+// cherryPickAuthorDate returns the author date of the commit in the author's timezone.
+func cherryPickAuthorDate(commit *gitalypb.GitCommit) (time.Time, error) {
+	loc, err := time.Parse("-0700", string(commit.GetAuthor().GetTimezone()))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("get cherry commit location: %w", err)
+	}
+
+	return commit.GetAuthor().GetDate().AsTime().In(loc.Location()), nil
+}
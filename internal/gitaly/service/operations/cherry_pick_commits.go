@@ -0,0 +1,203 @@
+package operations
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git/localrepo"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/hook/updateref"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/storage"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/structerr"
+	"gitlab.com/gitlab-org/gitaly/v16/proto/go/gitalypb"
+)
+
+// UserCherryPickCommits cherry-picks a list of commits onto a branch and updates the branch once
+// all of them have been applied. See the protobuf documentation for details.
+func (s *Server) UserCherryPickCommits(ctx context.Context, req *gitalypb.UserCherryPickCommitsRequest) (*gitalypb.UserCherryPickCommitsResponse, error) {
+	if err := validateUserCherryPickCommitsRequest(s.locator, req); err != nil {
+		return nil, structerr.NewInvalidArgument("%w", err)
+	}
+
+	quarantineDir, quarantineRepo, err := s.quarantinedRepo(ctx, req.GetRepository())
+	if err != nil {
+		return nil, structerr.NewInternal("creating repo quarantine: %w", err)
+	}
+
+	objectHash, err := quarantineRepo.ObjectHash(ctx)
+	if err != nil {
+		return nil, structerr.NewInternal("detecting object hash: %w", err)
+	}
+
+	referenceName := git.NewReferenceNameFromBranchName(string(req.GetBranchName()))
+
+	var oldrev git.ObjectID
+	if expectedOldOID := req.GetExpectedOldOid(); expectedOldOID != "" {
+		oldrev, err = objectHash.FromHex(expectedOldOID)
+		if err != nil {
+			return nil, structerr.NewInvalidArgument("invalid expected old object ID: %w", err).
+				WithMetadata("old_object_id", expectedOldOID)
+		}
+
+		oldrev, err = quarantineRepo.ResolveRevision(ctx, git.Revision(fmt.Sprintf("%s^{commit}", oldrev)))
+		if err != nil {
+			return nil, structerr.NewInvalidArgument("cannot resolve expected old object ID: %w", err).
+				WithMetadata("old_object_id", expectedOldOID)
+		}
+	} else {
+		oldrev, err = quarantineRepo.ResolveRevision(ctx, referenceName.Revision()+"^{commit}")
+		if err != nil {
+			if errors.Is(err, git.ErrReferenceNotFound) {
+				return nil, structerr.NewNotFound("branch not found").WithMetadata("branch", string(req.GetBranchName()))
+			}
+
+			return nil, structerr.NewInternal("resolving branch: %w", err)
+		}
+	}
+
+	committerSignature, err := git.SignatureFromRequest(req)
+	if err != nil {
+		return nil, structerr.NewInvalidArgument("%w", err)
+	}
+
+	oursTree, err := quarantineRepo.ResolveRevision(ctx, git.Revision(fmt.Sprintf("%s^{tree}", oldrev)))
+	if err != nil {
+		return nil, fmt.Errorf("resolve old tree: %w", err)
+	}
+
+	newrev := oldrev
+	for _, commitID := range req.GetCommitIds() {
+		cherryCommit, err := quarantineRepo.ReadCommit(ctx, git.Revision(commitID))
+		if err != nil {
+			if errors.Is(err, localrepo.ErrObjectNotFound) {
+				return nil, structerr.NewNotFound("cherry-pick: commit lookup: commit not found: %q", commitID)
+			}
+			return nil, fmt.Errorf("cherry pick: %w", err)
+		}
+
+		if len(cherryCommit.GetParentIds()) != 1 {
+			return nil, structerr.NewInvalidArgument("cherry-pick: commit must have exactly one parent").
+				WithMetadata("commit_id", cherryCommit.GetId())
+		}
+
+		cherryDate, err := cherryPickAuthorDate(cherryCommit)
+		if err != nil {
+			return nil, err
+		}
+
+		// Every commit is "merged" into the result of the previous cherry-pick, compared to its
+		// parent commit, the same way UserCherryPick does.
+		treeOID, err := quarantineRepo.MergeTree(
+			ctx,
+			newrev.String(),
+			cherryCommit.GetId(),
+			localrepo.WithMergeBase(git.Revision(cherryCommit.GetParentIds()[0])),
+			localrepo.WithConflictingFileNamesOnly(),
+		)
+		if err != nil {
+			var conflictErr *localrepo.MergeTreeConflictError
+			if errors.As(err, &conflictErr) {
+				conflictingFiles := make([][]byte, 0, len(conflictErr.ConflictingFileInfo))
+				for _, conflictingFileInfo := range conflictErr.ConflictingFileInfo {
+					conflictingFiles = append(conflictingFiles, []byte(conflictingFileInfo.FileName))
+				}
+
+				return nil, structerr.NewFailedPrecondition("cherry pick: %w", err).WithDetail(
+					&gitalypb.UserCherryPickCommitsError{
+						CommitId: cherryCommit.GetId(),
+						Error: &gitalypb.UserCherryPickCommitsError_CherryPickConflict{
+							CherryPickConflict: &gitalypb.MergeConflictError{
+								ConflictingFiles:     conflictingFiles,
+								ConflictingCommitIds: []string{newrev.String(), cherryCommit.GetId()},
+							},
+						},
+					},
+				)
+			}
+
+			return nil, fmt.Errorf("cherry-pick command: %w", err)
+		}
+
+		if treeOID == oursTree {
+			return nil, structerr.NewFailedPrecondition("cherry-pick: could not apply because the result was empty").WithDetail(
+				&gitalypb.UserCherryPickCommitsError{
+					CommitId: cherryCommit.GetId(),
+					Error: &gitalypb.UserCherryPickCommitsError_ChangesAlreadyApplied{
+						ChangesAlreadyApplied: &gitalypb.ChangesAlreadyAppliedError{},
+					},
+				},
+			)
+		}
+
+		newrev, err = quarantineRepo.WriteCommit(
+			ctx,
+			localrepo.WriteCommitConfig{
+				TreeID:         treeOID,
+				Message:        string(cherryCommit.GetBody()),
+				Parents:        []git.ObjectID{newrev},
+				AuthorName:     string(cherryCommit.GetAuthor().GetName()),
+				AuthorEmail:    string(cherryCommit.GetAuthor().GetEmail()),
+				AuthorDate:     cherryDate,
+				CommitterName:  committerSignature.Name,
+				CommitterEmail: committerSignature.Email,
+				CommitterDate:  committerSignature.When,
+				SigningKey:     s.signingKey,
+			},
+		)
+		if err != nil {
+			return nil, fmt.Errorf("write commit: %w", err)
+		}
+		oursTree = treeOID
+	}
+
+	if err := s.updateReferenceWithHooks(ctx, req.GetRepository(), req.GetUser(), quarantineDir, referenceName, newrev, oldrev); err != nil {
+		var customHookErr updateref.CustomHookError
+		if errors.As(err, &customHookErr) {
+			return nil, structerr.NewFailedPrecondition("access check failed").WithDetail(
+				&gitalypb.UserCherryPickCommitsError{
+					Error: &gitalypb.UserCherryPickCommitsError_AccessCheck{
+						AccessCheck: &gitalypb.AccessCheckError{
+							ErrorMessage: strings.TrimSuffix(customHookErr.Error(), "\n"),
+						},
+					},
+				},
+			)
+		}
+
+		return nil, structerr.NewInternal("update reference with hooks: %w", err)
+	}
+
+	return &gitalypb.UserCherryPickCommitsResponse{
+		BranchUpdate: &gitalypb.OperationBranchUpdate{
+			CommitId: newrev.String(),
+		},
+	}, nil
+}
+
+func validateUserCherryPickCommitsRequest(locator storage.Locator, req *gitalypb.UserCherryPickCommitsRequest) error {
+	if err := locator.ValidateRepository(req.GetRepository()); err != nil {
+		return err
+	}
+
+	if req.GetUser() == nil {
+		return errors.New("empty User")
+	}
+
+	if len(req.GetBranchName()) == 0 {
+		return errors.New("empty BranchName")
+	}
+
+	if len(req.GetCommitIds()) == 0 {
+		return errors.New("empty CommitIds")
+	}
+
+	for i, commitID := range req.GetCommitIds() {
+		if err := git.ValidateRevision([]byte(commitID)); err != nil {
+			return fmt.Errorf("invalid CommitIds[%d]: %w", i, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,258 @@
+package operations
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/featureflag"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git/gittest"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git/localrepo"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/structerr"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
+	"gitlab.com/gitlab-org/gitaly/v16/proto/go/gitalypb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestUserCherryPickCommits(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewFeatureSets(
+		featureflag.GPGSigning,
+	).Run(t, testUserCherryPickCommits)
+}
+
+func testUserCherryPickCommits(t *testing.T, ctx context.Context) {
+	t.Parallel()
+
+	ctx, cfg, client := setupOperationsService(t, ctx)
+
+	// The commits of the "feature" branch are cherry-picked onto the "stable" branch:
+	//
+	//   o stable
+	//  /
+	// o---o---o---o feature
+	// base first second conflicted
+	repoProto, repoPath := gittest.CreateRepository(t, ctx, cfg)
+	repo := localrepo.NewTestRepo(t, cfg, repoProto)
+
+	base := gittest.WriteCommit(t, cfg, repoPath, gittest.WithMessage("base"), gittest.WithTreeEntries(
+		gittest.TreeEntry{Path: "file", Mode: "100644", Content: "base\n"},
+	))
+	stable := gittest.WriteCommit(t, cfg, repoPath, gittest.WithParents(base), gittest.WithMessage("stable"),
+		gittest.WithTreeEntries(
+			gittest.TreeEntry{Path: "file", Mode: "100644", Content: "stable\n"},
+		))
+	first := gittest.WriteCommit(t, cfg, repoPath, gittest.WithParents(base), gittest.WithMessage("first"),
+		gittest.WithTreeEntries(
+			gittest.TreeEntry{Path: "file", Mode: "100644", Content: "base\n"},
+			gittest.TreeEntry{Path: "a", Mode: "100644", Content: "a\n"},
+		))
+	second := gittest.WriteCommit(t, cfg, repoPath, gittest.WithParents(first), gittest.WithMessage("second"),
+		gittest.WithTreeEntries(
+			gittest.TreeEntry{Path: "file", Mode: "100644", Content: "base\n"},
+			gittest.TreeEntry{Path: "a", Mode: "100644", Content: "a\n"},
+			gittest.TreeEntry{Path: "b", Mode: "100644", Content: "b\n"},
+		))
+	conflicted := gittest.WriteCommit(t, cfg, repoPath, gittest.WithParents(second), gittest.WithMessage("conflicted"),
+		gittest.WithTreeEntries(
+			gittest.TreeEntry{Path: "file", Mode: "100644", Content: "feature\n"},
+			gittest.TreeEntry{Path: "a", Mode: "100644", Content: "a\n"},
+			gittest.TreeEntry{Path: "b", Mode: "100644", Content: "b\n"},
+		))
+
+	for _, tc := range []struct {
+		desc                string
+		commitIDs           []git.ObjectID
+		expectedMessages    []string
+		expectedTreeEntries []gittest.TreeEntry
+		expectedErr         error
+	}{
+		{
+			desc:             "single commit",
+			commitIDs:        []git.ObjectID{first},
+			expectedMessages: []string{"first"},
+			expectedTreeEntries: []gittest.TreeEntry{
+				{Path: "a", Mode: "100644", Content: "a\n"},
+				{Path: "file", Mode: "100644", Content: "stable\n"},
+			},
+		},
+		{
+			desc:             "multiple commits",
+			commitIDs:        []git.ObjectID{first, second},
+			expectedMessages: []string{"first", "second"},
+			expectedTreeEntries: []gittest.TreeEntry{
+				{Path: "a", Mode: "100644", Content: "a\n"},
+				{Path: "b", Mode: "100644", Content: "b\n"},
+				{Path: "file", Mode: "100644", Content: "stable\n"},
+			},
+		},
+		{
+			desc:      "conflict",
+			commitIDs: []git.ObjectID{first, second, conflicted},
+			expectedErr: structerr.NewFailedPrecondition("cherry pick: merge: there are conflicting files").WithDetail(
+				&gitalypb.UserCherryPickCommitsError{
+					CommitId: conflicted.String(),
+					Error: &gitalypb.UserCherryPickCommitsError_CherryPickConflict{
+						CherryPickConflict: &gitalypb.MergeConflictError{
+							ConflictingFiles: [][]byte{[]byte("file")},
+						},
+					},
+				},
+			),
+		},
+		{
+			desc:      "changes already applied",
+			commitIDs: []git.ObjectID{first, first},
+			expectedErr: structerr.NewFailedPrecondition("cherry-pick: could not apply because the result was empty").WithDetail(
+				&gitalypb.UserCherryPickCommitsError{
+					CommitId: first.String(),
+					Error: &gitalypb.UserCherryPickCommitsError_ChangesAlreadyApplied{
+						ChangesAlreadyApplied: &gitalypb.ChangesAlreadyAppliedError{},
+					},
+				},
+			),
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			branch := strings.ReplaceAll(tc.desc, " ", "-")
+			gittest.WriteRef(t, cfg, repoPath, git.NewReferenceNameFromBranchName(branch), stable)
+
+			commitIDs := make([]string, 0, len(tc.commitIDs))
+			for _, commitID := range tc.commitIDs {
+				commitIDs = append(commitIDs, commitID.String())
+			}
+
+			response, err := client.UserCherryPickCommits(ctx, &gitalypb.UserCherryPickCommitsRequest{
+				Repository:     repoProto,
+				User:           gittest.TestUser,
+				BranchName:     []byte(branch),
+				CommitIds:      commitIDs,
+				ExpectedOldOid: stable.String(),
+				Timestamp:      &timestamppb.Timestamp{Seconds: 12345},
+			})
+			if tc.expectedErr != nil {
+				// The conflicting commit IDs contain the intermediate cherry-picked commit, which
+				// isn't known upfront.
+				testhelper.RequireGrpcError(t, tc.expectedErr, err,
+					protocmp.IgnoreFields(&gitalypb.MergeConflictError{}, "conflicting_commit_ids"),
+				)
+				require.Equal(t, stable, gittest.ResolveRevision(t, cfg, repoPath, branch))
+				return
+			}
+			require.NoError(t, err)
+
+			branchOID := gittest.ResolveRevision(t, cfg, repoPath, branch)
+			require.Equal(t, branchOID.String(), response.GetBranchUpdate().GetCommitId())
+			gittest.RequireTree(t, cfg, repoPath, branchOID.String(), tc.expectedTreeEntries)
+
+			commit, err := repo.ReadCommit(ctx, branchOID.Revision())
+			require.NoError(t, err)
+			for i := len(tc.expectedMessages) - 1; i >= 0; i-- {
+				require.Equal(t, tc.expectedMessages[i], strings.TrimSpace(string(commit.GetBody())))
+				require.Equal(t, gittest.TestUser.GetName(), commit.GetCommitter().GetName())
+
+				commit, err = repo.ReadCommit(ctx, git.Revision(commit.GetParentIds()[0]))
+				require.NoError(t, err)
+			}
+			require.Equal(t, stable.String(), commit.GetId())
+		})
+	}
+}
+
+func TestUserCherryPickCommits_validation(t *testing.T) {
+	t.Parallel()
+
+	ctx, cfg, client := setupOperationsService(t, testhelper.Context(t))
+	repoProto, repoPath := gittest.CreateRepository(t, ctx, cfg)
+
+	base := gittest.WriteCommit(t, cfg, repoPath, gittest.WithBranch("main"))
+	merge := gittest.WriteCommit(t, cfg, repoPath, gittest.WithParents(base, gittest.WriteCommit(t, cfg, repoPath, gittest.WithMessage("other"))))
+
+	for _, tc := range []struct {
+		desc         string
+		req          *gitalypb.UserCherryPickCommitsRequest
+		expectedCode codes.Code
+		expectedErr  string
+	}{
+		{
+			desc: "empty User",
+			req: &gitalypb.UserCherryPickCommitsRequest{
+				Repository: repoProto,
+				BranchName: []byte("main"),
+				CommitIds:  []string{base.String()},
+			},
+			expectedCode: codes.InvalidArgument,
+			expectedErr:  "empty User",
+		},
+		{
+			desc: "empty BranchName",
+			req: &gitalypb.UserCherryPickCommitsRequest{
+				Repository: repoProto,
+				User:       gittest.TestUser,
+				CommitIds:  []string{base.String()},
+			},
+			expectedCode: codes.InvalidArgument,
+			expectedErr:  "empty BranchName",
+		},
+		{
+			desc: "empty CommitIds",
+			req: &gitalypb.UserCherryPickCommitsRequest{
+				Repository: repoProto,
+				User:       gittest.TestUser,
+				BranchName: []byte("main"),
+			},
+			expectedCode: codes.InvalidArgument,
+			expectedErr:  "empty CommitIds",
+		},
+		{
+			desc: "missing branch",
+			req: &gitalypb.UserCherryPickCommitsRequest{
+				Repository: repoProto,
+				User:       gittest.TestUser,
+				BranchName: []byte("missing"),
+				CommitIds:  []string{base.String()},
+			},
+			expectedCode: codes.NotFound,
+			expectedErr:  "branch not found",
+		},
+		{
+			desc: "missing commit",
+			req: &gitalypb.UserCherryPickCommitsRequest{
+				Repository: repoProto,
+				User:       gittest.TestUser,
+				BranchName: []byte("main"),
+				CommitIds:  []string{gittest.DefaultObjectHash.EmptyTreeOID.String()},
+			},
+			expectedCode: codes.NotFound,
+			expectedErr:  "commit not found",
+		},
+		{
+			desc: "merge commit",
+			req: &gitalypb.UserCherryPickCommitsRequest{
+				Repository: repoProto,
+				User:       gittest.TestUser,
+				BranchName: []byte("main"),
+				CommitIds:  []string{merge.String()},
+			},
+			expectedCode: codes.InvalidArgument,
+			expectedErr:  "commit must have exactly one parent",
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := client.UserCherryPickCommits(ctx, tc.req)
+			testhelper.RequireGrpcCode(t, err, tc.expectedCode)
+			require.Contains(t, err.Error(), tc.expectedErr)
+		})
+	}
+}
@@ -522,6 +522,114 @@ func testServerUserCherryPickMergeCommit(t *testing.T, ctx context.Context) {
 	}
 }
 
+func TestServer_UserCherryPick_mainline(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewFeatureSets(
+		featureflag.GPGSigning,
+	).Run(t, testServerUserCherryPickMainline)
+}
+
+func testServerUserCherryPickMainline(t *testing.T, ctx context.Context) {
+	t.Parallel()
+
+	ctx, cfg, client := setupOperationsService(t, ctx)
+
+	repoProto, repoPath := gittest.CreateRepository(t, ctx, cfg)
+	repo := localrepo.NewTestRepo(t, cfg, repoProto)
+
+	baseCommitID := gittest.WriteCommit(t, cfg, repoPath, gittest.WithTreeEntries(
+		gittest.TreeEntry{Mode: "100644", Path: "a", Content: "apple"},
+	))
+	leftCommitID := gittest.WriteCommit(t, cfg, repoPath, gittest.WithParents(baseCommitID), gittest.WithTreeEntries(
+		gittest.TreeEntry{Mode: "100644", Path: "a", Content: "apple"},
+		gittest.TreeEntry{Mode: "100644", Path: "b", Content: "banana"},
+	))
+	rightCommitID := gittest.WriteCommit(t, cfg, repoPath, gittest.WithParents(baseCommitID), gittest.WithTreeEntries(
+		gittest.TreeEntry{Mode: "100644", Path: "a", Content: "apple"},
+		gittest.TreeEntry{Mode: "100644", Path: "c", Content: "coconut"},
+	))
+	mergedCommitID := gittest.WriteCommit(t, cfg, repoPath, gittest.WithParents(leftCommitID, rightCommitID), gittest.WithTreeEntries(
+		gittest.TreeEntry{Mode: "100644", Path: "a", Content: "apple"},
+		gittest.TreeEntry{Mode: "100644", Path: "b", Content: "banana"},
+		gittest.TreeEntry{Mode: "100644", Path: "c", Content: "coconut"},
+	))
+	mergedCommit, err := repo.ReadCommit(ctx, mergedCommitID.Revision())
+	require.NoError(t, err)
+	leftCommit, err := repo.ReadCommit(ctx, leftCommitID.Revision())
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		desc string
+		// commit is the commit to pick. It defaults to the merge commit.
+		commit              *gitalypb.GitCommit
+		mainline            uint32
+		expectedTreeEntries []gittest.TreeEntry
+		expectedErr         error
+	}{
+		{
+			desc:     "first parent",
+			mainline: 1,
+			expectedTreeEntries: []gittest.TreeEntry{
+				{Mode: "100644", Path: "a", Content: "apple"},
+				{Mode: "100644", Path: "c", Content: "coconut"},
+			},
+		},
+		{
+			desc:     "second parent",
+			mainline: 2,
+			expectedTreeEntries: []gittest.TreeEntry{
+				{Mode: "100644", Path: "a", Content: "apple"},
+				{Mode: "100644", Path: "b", Content: "banana"},
+			},
+		},
+		{
+			desc:     "nonexistent parent",
+			mainline: 3,
+			expectedErr: testhelper.WithInterceptedMetadata(
+				structerr.NewInvalidArgument("mainline 3 does not match any of the 2 parents of the commit"),
+				"commit_id", mergedCommitID.String(),
+			),
+		},
+		{
+			desc:     "commit which is not a merge",
+			commit:   leftCommit,
+			mainline: 1,
+			expectedErr: testhelper.WithInterceptedMetadata(
+				structerr.NewInvalidArgument("mainline 1 given but the commit is not a merge"),
+				"commit_id", leftCommitID.String(),
+			),
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			commit := mergedCommit
+			if tc.commit != nil {
+				commit = tc.commit
+			}
+
+			branch := fmt.Sprintf("mainline-%d", tc.mainline)
+			gittest.WriteRef(t, cfg, repoPath, git.NewReferenceNameFromBranchName(branch), baseCommitID)
+
+			response, err := client.UserCherryPick(ctx, &gitalypb.UserCherryPickRequest{
+				Repository: repoProto,
+				User:       gittest.TestUser,
+				Commit:     commit,
+				BranchName: []byte(branch),
+				Message:    []byte("Cherry-picking " + commit.Id),
+				Mainline:   tc.mainline,
+			})
+			testhelper.RequireGrpcError(t, tc.expectedErr, err)
+			if tc.expectedErr != nil {
+				return
+			}
+
+			gittest.RequireTree(t, cfg, repoPath, response.BranchUpdate.CommitId, tc.expectedTreeEntries)
+		})
+	}
+}
+
 func TestServer_UserCherryPick_stableID(t *testing.T) {
 	t.Parallel()
 
@@ -1,7 +1,6 @@
 package operations
 
 import (
-	"bytes"
 	"context"
 	"encoding/base64"
 	"errors"
@@ -42,6 +41,8 @@ const (
 	errFileNotFound
 	// ErrInvalidPath represent an invalid path error.
 	errInvalidPath
+	// ErrDirectoryNotFound represent a directory not found error.
+	errDirectoryNotFound
 )
 
 // IndexError is a well-defined error that was produced by performing an invalid operation on the index.
@@ -65,6 +66,8 @@ func (err indexError) Error() string {
 		return "A file with this name doesn't exist"
 	case errInvalidPath:
 		return fmt.Sprintf("invalid path: %q", err.path)
+	case errDirectoryNotFound:
+		return "A directory with this name doesn't exist"
 	default:
 		panic(fmt.Sprintf("unhandled IndexErrorType: %v", err.errorType))
 	}
@@ -86,6 +89,8 @@ func (err indexError) Proto() *gitalypb.IndexError {
 		errType = gitalypb.IndexError_ERROR_TYPE_FILE_NOT_FOUND
 	case errInvalidPath:
 		errType = gitalypb.IndexError_ERROR_TYPE_INVALID_PATH
+	case errDirectoryNotFound:
+		errType = gitalypb.IndexError_ERROR_TYPE_DIRECTORY_NOT_FOUND
 	}
 
 	return &gitalypb.IndexError{
@@ -102,7 +107,7 @@ func (err indexError) StructuredError() structerr.Error {
 		return structerr.NewAlreadyExists("%w", e)
 	case errDirectoryTraversal, errEmptyPath, errInvalidPath:
 		return structerr.NewInvalidArgument("%w", e)
-	case errFileNotFound:
+	case errFileNotFound, errDirectoryNotFound:
 		return structerr.NewNotFound("%w", e)
 	default:
 		return structerr.NewInternal("%w", e)
@@ -362,6 +367,71 @@ func applyAction(
 		); err != nil {
 			return translateError(err, action.Path)
 		}
+	case moveDirectory:
+		entry, err := getDirectory(root, action.Path)
+		if err != nil {
+			return err
+		}
+
+		if action.NewPath == action.Path || strings.HasPrefix(action.NewPath, action.Path+"/") {
+			return invalidArgumentError(fmt.Sprintf("cannot move directory %q into itself", action.Path))
+		}
+
+		if err := ensurePathIsFree(root, action.NewPath); err != nil {
+			return err
+		}
+
+		if err := root.Delete(action.Path); err != nil {
+			return translateError(err, action.Path)
+		}
+
+		// The moved entry keeps its object ID, so only the trees along the old and the
+		// new path need to be rewritten.
+		movedEntry := *entry
+		movedEntry.Path = filepath.Base(action.NewPath)
+
+		if err := root.Add(action.NewPath, movedEntry); err != nil {
+			return translateError(err, action.NewPath)
+		}
+	case deleteDirectory:
+		if _, err := getDirectory(root, action.Path); err != nil {
+			return err
+		}
+
+		if err := root.Delete(action.Path); err != nil {
+			return translateError(err, action.Path)
+		}
+	case extractArchive:
+		if action.ReplaceDirectory {
+			if action.Path == "" {
+				root.Entries = nil
+				root.OID = ""
+			} else if err := root.Delete(action.Path); err != nil && !errors.Is(err, localrepo.ErrEntryNotFound) {
+				return translateError(err, action.Path)
+			}
+		}
+
+		for _, archiveEntry := range action.Entries {
+			path := filepath.Join(action.Path, archiveEntry.Path)
+
+			if err := ensureParentDirectories(root, path); err != nil {
+				return err
+			}
+
+			if err := root.Add(
+				path,
+				localrepo.TreeEntry{
+					OID:  archiveEntry.OID,
+					Path: filepath.Base(path),
+					Type: localrepo.Blob,
+					Mode: archiveEntry.Mode,
+				},
+				localrepo.WithOverwriteFile(),
+				localrepo.WithOverwriteDirectory(),
+			); err != nil {
+				return translateError(err, path)
+			}
+		}
 	default:
 		return errors.New("unsupported action")
 	}
@@ -402,6 +472,69 @@ func translateError(err error, path string) error {
 	return err
 }
 
+// getDirectory returns the tree entry of the directory at the given path. A DirectoryNotFoundError is
+// returned if there is no directory at the path.
+func getDirectory(root *localrepo.TreeEntry, path string) (*localrepo.TreeEntry, error) {
+	entry, err := root.Get(path)
+	if err != nil {
+		if errors.Is(err, localrepo.ErrEntryNotFound) {
+			return nil, indexError{path: path, errorType: errDirectoryNotFound}
+		}
+
+		return nil, translateError(err, path)
+	}
+
+	if entry.Type != localrepo.Tree {
+		return nil, indexError{path: path, errorType: errDirectoryNotFound}
+	}
+
+	return entry, nil
+}
+
+// ensurePathIsFree verifies that neither a file nor a directory exists at the given path and that
+// none of its parent directories exists as a file.
+func ensurePathIsFree(root *localrepo.TreeEntry, path string) error {
+	if err := ensureParentDirectories(root, path); err != nil {
+		return err
+	}
+
+	entry, err := root.Get(path)
+	if err != nil {
+		if errors.Is(err, localrepo.ErrEntryNotFound) {
+			return nil
+		}
+
+		return translateError(err, path)
+	}
+
+	if entry.Type == localrepo.Tree {
+		return indexError{path: path, errorType: errDirectoryExists}
+	}
+
+	return indexError{path: path, errorType: errFileExists}
+}
+
+// ensureParentDirectories verifies that none of the parent directories of the given path exists as
+// a file, which would otherwise be treated as a directory when adding the path to the tree.
+func ensureParentDirectories(root *localrepo.TreeEntry, path string) error {
+	for dir := filepath.Dir(path); dir != "."; dir = filepath.Dir(dir) {
+		entry, err := root.Get(dir)
+		if err != nil {
+			if errors.Is(err, localrepo.ErrEntryNotFound) {
+				continue
+			}
+
+			return translateError(err, dir)
+		}
+
+		if entry.Type != localrepo.Tree {
+			return indexError{path: dir, errorType: errFileExists}
+		}
+	}
+
+	return nil
+}
+
 var errSignatureMissingNameOrEmail = errors.New(
 	"commit: failed to parse signature - Signature cannot have an empty name or email",
 )
@@ -571,56 +704,41 @@ func (s *Server) userCommitFiles(
 		}
 	}
 
-	type action struct {
-		header  *gitalypb.UserCommitFilesActionHeader
-		content []byte
-	}
-
-	var pbActions []action
+	// The actions are applied as they are received so that their content is streamed into the
+	// repository instead of being buffered in memory. This matters most for archives, which may
+	// contain the files of a whole directory tree.
+	reader := &actionReader{stream: stream}
 
+	var actions []commitAction
 	for {
-		req, err := stream.Recv()
+		actionHeader, err := reader.nextAction()
 		if err != nil {
 			if errors.Is(err, io.EOF) {
 				break
 			}
 
-			return fmt.Errorf("receive request: %w", err)
-		}
-
-		switch payload := req.GetAction().GetUserCommitFilesActionPayload().(type) {
-		case *gitalypb.UserCommitFilesAction_Header:
-			pbActions = append(pbActions, action{header: payload.Header})
-		case *gitalypb.UserCommitFilesAction_Content:
-			if len(pbActions) == 0 {
-				return errors.New("content sent before action")
-			}
-
-			// append the content to the previous action
-			content := &pbActions[len(pbActions)-1].content
-			*content = append(*content, payload.Content...)
-		default:
-			return fmt.Errorf("unhandled action payload type: %T", payload)
+			return err
 		}
-	}
 
-	actions := make([]commitAction, 0, len(pbActions))
-	for _, pbAction := range pbActions {
-		if _, ok := gitalypb.UserCommitFilesActionHeader_ActionType_name[int32(pbAction.header.Action)]; !ok {
-			return structerr.NewInvalidArgument("NoMethodError: undefined method `downcase' for %d:Integer", pbAction.header.Action)
+		if _, ok := gitalypb.UserCommitFilesActionHeader_ActionType_name[int32(actionHeader.Action)]; !ok {
+			return structerr.NewInvalidArgument("NoMethodError: undefined method `downcase' for %d:Integer", actionHeader.Action)
 		}
 
-		path, err := validatePath(repoPath, string(pbAction.header.FilePath))
-		if err != nil {
-			return structerr.NewInvalidArgument("validate path: %w", err)
+		// Archives without a path are extracted into the root directory.
+		var path string
+		if actionHeader.Action != gitalypb.UserCommitFilesActionHeader_EXTRACT_ARCHIVE || len(actionHeader.FilePath) > 0 {
+			path, err = validatePath(repoPath, string(actionHeader.FilePath))
+			if err != nil {
+				return structerr.NewInvalidArgument("validate path: %w", err)
+			}
 		}
 
-		content := io.Reader(bytes.NewReader(pbAction.content))
-		if pbAction.header.Base64Content {
+		content := io.Reader(reader)
+		if actionHeader.Base64Content {
 			content = base64.NewDecoder(base64.StdEncoding, content)
 		}
 
-		switch pbAction.header.Action {
+		switch actionHeader.Action {
 		case gitalypb.UserCommitFilesActionHeader_CREATE:
 			blobID, err := quarantineRepo.WriteBlob(ctx, content, localrepo.WriteBlobConfig{
 				Path: path,
@@ -632,21 +750,21 @@ func (s *Server) userCommitFiles(
 			actions = append(actions, createFile{
 				OID:            blobID.String(),
 				Path:           path,
-				ExecutableMode: pbAction.header.ExecuteFilemode,
+				ExecutableMode: actionHeader.ExecuteFilemode,
 			})
 		case gitalypb.UserCommitFilesActionHeader_CHMOD:
 			actions = append(actions, changeFileMode{
 				Path:           path,
-				ExecutableMode: pbAction.header.ExecuteFilemode,
+				ExecutableMode: actionHeader.ExecuteFilemode,
 			})
 		case gitalypb.UserCommitFilesActionHeader_MOVE:
-			prevPath, err := validatePath(repoPath, string(pbAction.header.PreviousPath))
+			prevPath, err := validatePath(repoPath, string(actionHeader.PreviousPath))
 			if err != nil {
 				return structerr.NewInvalidArgument("validate previous path: %w", err)
 			}
 
 			var oid git.ObjectID
-			if !pbAction.header.InferContent {
+			if !actionHeader.InferContent {
 				var err error
 				oid, err = quarantineRepo.WriteBlob(ctx, content, localrepo.WriteBlobConfig{
 					Path: path,
@@ -680,6 +798,31 @@ func (s *Server) userCommitFiles(
 			actions = append(actions, createDirectory{
 				Path: path,
 			})
+		case gitalypb.UserCommitFilesActionHeader_EXTRACT_ARCHIVE:
+			entries, err := readArchive(ctx, quarantineRepo, content)
+			if err != nil {
+				return fmt.Errorf("read archive: %w", err)
+			}
+
+			actions = append(actions, extractArchive{
+				Path:             path,
+				ReplaceDirectory: actionHeader.ReplaceDirectory,
+				Entries:          entries,
+			})
+		case gitalypb.UserCommitFilesActionHeader_MOVE_DIR:
+			prevPath, err := validatePath(repoPath, string(actionHeader.PreviousPath))
+			if err != nil {
+				return structerr.NewInvalidArgument("validate previous path: %w", err)
+			}
+
+			actions = append(actions, moveDirectory{
+				Path:    prevPath,
+				NewPath: path,
+			})
+		case gitalypb.UserCommitFilesActionHeader_DELETE_DIR:
+			actions = append(actions, deleteDirectory{
+				Path: path,
+			})
 		}
 	}
 
@@ -924,3 +1067,122 @@ type updateFile struct {
 	// OID is the id of the object that contains the new content of the file.
 	OID string
 }
+
+// extractArchive adds the entries of an archive to the directory at the given path.
+// If ReplaceDirectory is set, the existing directory is removed beforehand.
+// FileExistsError is returned if one of the parent directories of an entry
+// already exists as a file.
+type extractArchive struct {
+	isAction
+	// Path is the path of the directory to extract the archive into. It is empty
+	// for the root directory.
+	Path string
+	// ReplaceDirectory indicates whether the directory should be replaced instead
+	// of merging the archive into it.
+	ReplaceDirectory bool
+	// Entries are the files of the archive.
+	Entries []archiveEntry
+}
+
+// moveDirectory moves a directory including all of its contents to the new path.
+// DirectoryNotFoundError is returned if the directory does not exist.
+type moveDirectory struct {
+	isAction
+	// Path is the path of the directory to move.
+	Path string
+	// NewPath is the new path of the directory.
+	NewPath string
+}
+
+// deleteDirectory deletes a directory including all of its contents.
+// DirectoryNotFoundError is returned if the directory does not exist.
+type deleteDirectory struct {
+	isAction
+	// Path is the path of the directory to delete.
+	Path string
+}
+
+// actionReader reads the actions of a UserCommitFiles request from its stream. The content of the
+// current action is read from the requests following its header as it is consumed, so it is never
+// buffered in full.
+type actionReader struct {
+	stream gitalypb.OperationService_UserCommitFilesServer
+	// current is the header of the action whose content is being read.
+	current *gitalypb.UserCommitFilesActionHeader
+	// next is the header of the next action. It is set once it has been received, which ends
+	// the content of the current action.
+	next *gitalypb.UserCommitFilesActionHeader
+	// pending is the received content of the current action which has not been read yet.
+	pending []byte
+	// eof is set once the client has closed the stream.
+	eof bool
+}
+
+// receive receives the next request from the stream.
+func (r *actionReader) receive() (*gitalypb.UserCommitFilesAction, error) {
+	req, err := r.stream.Recv()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			r.eof = true
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("receive request: %w", err)
+	}
+
+	switch payload := req.GetAction().GetUserCommitFilesActionPayload().(type) {
+	case *gitalypb.UserCommitFilesAction_Header:
+		r.next = payload.Header
+	case *gitalypb.UserCommitFilesAction_Content:
+		r.pending = payload.Content
+	default:
+		return nil, fmt.Errorf("unhandled action payload type: %T", payload)
+	}
+
+	return req.GetAction(), nil
+}
+
+// nextAction returns the header of the next action. The content of the current action which has
+// not been read is discarded. It returns io.EOF once all actions have been read.
+func (r *actionReader) nextAction() (*gitalypb.UserCommitFilesActionHeader, error) {
+	if r.current != nil {
+		if _, err := io.Copy(io.Discard, r); err != nil {
+			return nil, err
+		}
+	} else if r.next == nil && !r.eof {
+		action, err := r.receive()
+		if err != nil {
+			return nil, err
+		}
+
+		if action != nil && action.GetHeader() == nil {
+			return nil, errors.New("content sent before action")
+		}
+	}
+
+	if r.next == nil {
+		return nil, io.EOF
+	}
+
+	r.current, r.next = r.next, nil
+
+	return r.current, nil
+}
+
+// Read reads the content of the current action.
+func (r *actionReader) Read(p []byte) (int, error) {
+	for len(r.pending) == 0 {
+		if r.next != nil || r.eof {
+			return 0, io.EOF
+		}
+
+		if _, err := r.receive(); err != nil {
+			return 0, err
+		}
+	}
+
+	n := copy(p, r.pending)
+	r.pending = r.pending[n:]
+
+	return n, nil
+}
@@ -0,0 +1,114 @@
+package operations
+
+import (
+	"archive/tar"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git/localrepo"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/structerr"
+)
+
+// archiveEntry is a file extracted from an archive whose content has been written as a blob.
+type archiveEntry struct {
+	// Path is the path of the file relative to the directory the archive is extracted into.
+	Path string
+	// Mode is the file mode of the tree entry.
+	Mode string
+	// OID is the id of the blob that contains the content of the file.
+	OID git.ObjectID
+}
+
+// readArchive reads the tar archive and writes the contents of all regular files and symbolic links
+// as blobs. Directories are implied by the paths of the files and thus skipped. Hard links are
+// resolved to the file they link to, all other entry types are rejected.
+func readArchive(ctx context.Context, repo *localrepo.Repo, archive io.Reader) ([]archiveEntry, error) {
+	var entries []archiveEntry
+	entriesByPath := make(map[string]archiveEntry)
+
+	tr := tar.NewReader(archive)
+	for {
+		header, err := tr.Next()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+
+			return nil, structerr.NewInvalidArgument("reading archive: %w", err)
+		}
+
+		if header.Typeflag == tar.TypeXGlobalHeader || header.Typeflag == tar.TypeDir {
+			continue
+		}
+
+		path, err := archiveEntryPath(header.Name)
+		if err != nil {
+			return nil, structerr.NewInvalidArgument("validate archive entry: %w", err)
+		}
+
+		entry := archiveEntry{Path: path, Mode: "100644"}
+
+		switch header.Typeflag {
+		case tar.TypeReg:
+			if header.FileInfo().Mode()&0o111 != 0 {
+				entry.Mode = "100755"
+			}
+
+			entry.OID, err = repo.WriteBlob(ctx, tr, localrepo.WriteBlobConfig{
+				Path: path,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("write archive blob: %w", err)
+			}
+		case tar.TypeSymlink:
+			entry.Mode = "120000"
+
+			entry.OID, err = repo.WriteBlob(ctx, strings.NewReader(header.Linkname), localrepo.WriteBlobConfig{
+				Path: path,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("write archive blob: %w", err)
+			}
+		case tar.TypeLink:
+			target, err := archiveEntryPath(header.Linkname)
+			if err != nil {
+				return nil, structerr.NewInvalidArgument("validate archive entry: %w", err)
+			}
+
+			linkedEntry, ok := entriesByPath[target]
+			if !ok {
+				return nil, structerr.NewInvalidArgument("hard link %q refers to unknown file %q", header.Name, header.Linkname)
+			}
+
+			entry.Mode = linkedEntry.Mode
+			entry.OID = linkedEntry.OID
+		default:
+			return nil, structerr.NewInvalidArgument("unsupported type %q of archive entry %q", header.Typeflag, header.Name)
+		}
+
+		entries = append(entries, entry)
+		entriesByPath[path] = entry
+	}
+
+	return entries, nil
+}
+
+// archiveEntryPath cleans the name of an archive entry. A DirectoryTraversalError is returned if the
+// name refers to a path outside of the directory the archive is extracted into.
+func archiveEntryPath(name string) (string, error) {
+	path := filepath.Clean(name)
+	if filepath.IsAbs(path) || path == ".." || strings.HasPrefix(path, "../") {
+		return "", indexError{path: name, errorType: errDirectoryTraversal}
+	}
+
+	if path == "." {
+		return "", indexError{path: name, errorType: errEmptyPath}
+	}
+
+	return path, nil
+}
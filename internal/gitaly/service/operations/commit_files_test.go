@@ -1,6 +1,7 @@
 package operations
 
 import (
+	"archive/tar"
 	"bytes"
 	"context"
 	"encoding/base64"
@@ -863,6 +864,270 @@ func testUserCommitFiles(t *testing.T, ctx context.Context) {
 				},
 			},
 		},
+		{
+			desc: "extract archive merges into directory",
+			steps: []step{
+				{
+					actions: []*gitalypb.UserCommitFilesRequest{
+						createFileHeaderRequest("directory-1/existing"),
+						actionContentRequest("existing"),
+						createFileHeaderRequest("directory-1/overwritten"),
+						actionContentRequest("original"),
+						createFileHeaderRequest("file-1"),
+						actionContentRequest("content-1"),
+					},
+					repoCreated:   true,
+					branchCreated: true,
+					treeEntries: []gittest.TreeEntry{
+						{Mode: DefaultMode, Path: "directory-1/existing", Content: "existing"},
+						{Mode: DefaultMode, Path: "directory-1/overwritten", Content: "original"},
+						{Mode: DefaultMode, Path: "file-1", Content: "content-1"},
+					},
+				},
+				{
+					actions: []*gitalypb.UserCommitFilesRequest{
+						extractArchiveHeaderRequest("directory-1", false),
+						actionContentRequest(tarArchive(t,
+							archiveFile{header: tar.Header{Typeflag: tar.TypeXGlobalHeader, PAXRecords: map[string]string{"comment": "commit-id"}}},
+							archiveFile{header: tar.Header{Typeflag: tar.TypeDir, Name: "./", Mode: 0o755}},
+							archiveFile{header: tar.Header{Typeflag: tar.TypeReg, Name: "./overwritten", Mode: 0o644}, content: "overwritten"},
+							archiveFile{header: tar.Header{Typeflag: tar.TypeDir, Name: "nested/", Mode: 0o755}},
+							archiveFile{header: tar.Header{Typeflag: tar.TypeReg, Name: "nested/file", Mode: 0o644}, content: "nested"},
+							archiveFile{header: tar.Header{Typeflag: tar.TypeReg, Name: "script", Mode: 0o755}, content: "#!/bin/sh"},
+							archiveFile{header: tar.Header{Typeflag: tar.TypeSymlink, Name: "symlink", Linkname: "nested/file"}},
+							archiveFile{header: tar.Header{Typeflag: tar.TypeLink, Name: "hardlink", Linkname: "script"}},
+						)),
+					},
+					treeEntries: []gittest.TreeEntry{
+						{Mode: DefaultMode, Path: "directory-1/existing", Content: "existing"},
+						{Mode: ExecutableMode, Path: "directory-1/hardlink", Content: "#!/bin/sh"},
+						{Mode: DefaultMode, Path: "directory-1/nested/file", Content: "nested"},
+						{Mode: DefaultMode, Path: "directory-1/overwritten", Content: "overwritten"},
+						{Mode: ExecutableMode, Path: "directory-1/script", Content: "#!/bin/sh"},
+						{Mode: "120000", Path: "directory-1/symlink", Content: "nested/file"},
+						{Mode: DefaultMode, Path: "file-1", Content: "content-1"},
+					},
+				},
+			},
+		},
+		{
+			desc: "extract archive sent in chunks",
+			steps: []step{
+				{
+					actions: append(append(
+						[]*gitalypb.UserCommitFilesRequest{extractArchiveHeaderRequest("directory-1", false)},
+						chunkedActionContentRequests(tarArchive(t,
+							archiveFile{header: tar.Header{Typeflag: tar.TypeReg, Name: "large", Mode: 0o644}, content: strings.Repeat("large\n", 1000)},
+							archiveFile{header: tar.Header{Typeflag: tar.TypeReg, Name: "small", Mode: 0o644}, content: "small"},
+						), 100)...),
+						createFileHeaderRequest("file-1"),
+						actionContentRequest("content-1"),
+					),
+					repoCreated:   true,
+					branchCreated: true,
+					treeEntries: []gittest.TreeEntry{
+						{Mode: DefaultMode, Path: "directory-1/large", Content: strings.Repeat("large\n", 1000)},
+						{Mode: DefaultMode, Path: "directory-1/small", Content: "small"},
+						{Mode: DefaultMode, Path: "file-1", Content: "content-1"},
+					},
+				},
+			},
+		},
+		{
+			desc: "extract archive replaces directory",
+			steps: []step{
+				{
+					actions: []*gitalypb.UserCommitFilesRequest{
+						createFileHeaderRequest("directory-1/existing"),
+						actionContentRequest("existing"),
+						createFileHeaderRequest("file-1"),
+						actionContentRequest("content-1"),
+					},
+					repoCreated:   true,
+					branchCreated: true,
+					treeEntries: []gittest.TreeEntry{
+						{Mode: DefaultMode, Path: "directory-1/existing", Content: "existing"},
+						{Mode: DefaultMode, Path: "file-1", Content: "content-1"},
+					},
+				},
+				{
+					actions: []*gitalypb.UserCommitFilesRequest{
+						extractArchiveHeaderRequest("directory-1", true),
+						actionContentRequest(tarArchive(t,
+							archiveFile{header: tar.Header{Typeflag: tar.TypeReg, Name: "extracted", Mode: 0o644}, content: "extracted"},
+						)),
+					},
+					treeEntries: []gittest.TreeEntry{
+						{Mode: DefaultMode, Path: "directory-1/extracted", Content: "extracted"},
+						{Mode: DefaultMode, Path: "file-1", Content: "content-1"},
+					},
+				},
+			},
+		},
+		{
+			desc: "extract archive replaces root directory",
+			steps: []step{
+				{
+					actions: []*gitalypb.UserCommitFilesRequest{
+						createFileHeaderRequest("file-1"),
+						actionContentRequest("content-1"),
+					},
+					repoCreated:   true,
+					branchCreated: true,
+					treeEntries: []gittest.TreeEntry{
+						{Mode: DefaultMode, Path: "file-1", Content: "content-1"},
+					},
+				},
+				{
+					actions: []*gitalypb.UserCommitFilesRequest{
+						extractArchiveHeaderRequest("", true),
+						actionContentRequest(tarArchive(t,
+							archiveFile{header: tar.Header{Typeflag: tar.TypeReg, Name: "directory-1/extracted", Mode: 0o644}, content: "extracted"},
+						)),
+					},
+					treeEntries: []gittest.TreeEntry{
+						{Mode: DefaultMode, Path: "directory-1/extracted", Content: "extracted"},
+					},
+				},
+			},
+		},
+		{
+			desc: "extract archive with directory traversal",
+			steps: []step{
+				{
+					actions: []*gitalypb.UserCommitFilesRequest{
+						extractArchiveHeaderRequest("directory-1", false),
+						actionContentRequest(tarArchive(t,
+							archiveFile{header: tar.Header{Typeflag: tar.TypeReg, Name: "../file-1", Mode: 0o644}, content: "content-1"},
+						)),
+					},
+					expectedErr: structuredIndexError(t, &indexError{path: "../file-1", errorType: errDirectoryTraversal}),
+				},
+			},
+		},
+		{
+			desc: "extract archive with unsupported entry",
+			steps: []step{
+				{
+					actions: []*gitalypb.UserCommitFilesRequest{
+						extractArchiveHeaderRequest("directory-1", false),
+						actionContentRequest(tarArchive(t,
+							archiveFile{header: tar.Header{Typeflag: tar.TypeFifo, Name: "fifo", Mode: 0o644}},
+						)),
+					},
+					expectedErr: structerr.NewInvalidArgument(`read archive: unsupported type '6' of archive entry "fifo"`),
+				},
+			},
+		},
+		{
+			desc: "extract archive with file in place of a directory",
+			steps: []step{
+				{
+					actions: []*gitalypb.UserCommitFilesRequest{
+						createFileHeaderRequest("file-1"),
+						actionContentRequest("content-1"),
+						extractArchiveHeaderRequest("", false),
+						actionContentRequest(tarArchive(t,
+							archiveFile{header: tar.Header{Typeflag: tar.TypeReg, Name: "file-1/nested", Mode: 0o644}, content: "nested"},
+						)),
+					},
+					expectedErr: structuredIndexError(t, &indexError{path: "file-1", errorType: errFileExists}),
+				},
+			},
+		},
+		{
+			desc: "move directory",
+			steps: []step{
+				{
+					actions: []*gitalypb.UserCommitFilesRequest{
+						createFileHeaderRequest("directory-1/file-1"),
+						actionContentRequest("content-1"),
+						createFileHeaderRequest("directory-1/nested/file-2"),
+						actionContentRequest("content-2"),
+						moveDirHeaderRequest("directory-1", "directory-2/moved"),
+					},
+					repoCreated:   true,
+					branchCreated: true,
+					treeEntries: []gittest.TreeEntry{
+						{Mode: DefaultMode, Path: "directory-2/moved/file-1", Content: "content-1"},
+						{Mode: DefaultMode, Path: "directory-2/moved/nested/file-2", Content: "content-2"},
+					},
+				},
+			},
+		},
+		{
+			desc: "move directory into itself",
+			steps: []step{
+				{
+					actions: []*gitalypb.UserCommitFilesRequest{
+						createFileHeaderRequest("directory-1/file-1"),
+						actionContentRequest("content-1"),
+						moveDirHeaderRequest("directory-1", "directory-1/moved"),
+					},
+					expectedErr: structerr.NewInvalidArgument(`performing action operations.moveDirectory: cannot move directory "directory-1" into itself`),
+				},
+			},
+		},
+		{
+			desc: "move directory onto existing file",
+			steps: []step{
+				{
+					actions: []*gitalypb.UserCommitFilesRequest{
+						createFileHeaderRequest("directory-1/file-1"),
+						actionContentRequest("content-1"),
+						createFileHeaderRequest("file-2"),
+						actionContentRequest("content-2"),
+						moveDirHeaderRequest("directory-1", "file-2"),
+					},
+					expectedErr: structuredIndexError(t, &indexError{path: "file-2", errorType: errFileExists}),
+				},
+			},
+		},
+		{
+			desc: "move nonexistent directory",
+			steps: []step{
+				{
+					actions: []*gitalypb.UserCommitFilesRequest{
+						moveDirHeaderRequest("directory-1", "directory-2"),
+					},
+					expectedErr: structuredIndexError(t, &indexError{path: "directory-1", errorType: errDirectoryNotFound}),
+				},
+			},
+		},
+		{
+			desc: "delete directory",
+			steps: []step{
+				{
+					actions: []*gitalypb.UserCommitFilesRequest{
+						createFileHeaderRequest("directory-1/file-1"),
+						actionContentRequest("content-1"),
+						createFileHeaderRequest("directory-1/nested/file-2"),
+						actionContentRequest("content-2"),
+						createFileHeaderRequest("file-3"),
+						actionContentRequest("content-3"),
+						deleteDirHeaderRequest("directory-1"),
+					},
+					repoCreated:   true,
+					branchCreated: true,
+					treeEntries: []gittest.TreeEntry{
+						{Mode: DefaultMode, Path: "file-3", Content: "content-3"},
+					},
+				},
+			},
+		},
+		{
+			desc: "delete directory of a file",
+			steps: []step{
+				{
+					actions: []*gitalypb.UserCommitFilesRequest{
+						createFileHeaderRequest("file-1"),
+						actionContentRequest("content-1"),
+						deleteDirHeaderRequest("file-1"),
+					},
+					expectedErr: structuredIndexError(t, &indexError{path: "file-1", errorType: errDirectoryNotFound}),
+				},
+			},
+		},
 		{
 			desc: "start repository refers to an empty remote repository",
 			steps: []step{
@@ -2059,6 +2324,70 @@ func deleteFileHeaderRequest(filePath string) *gitalypb.UserCommitFilesRequest {
 	})
 }
 
+func extractArchiveHeaderRequest(filePath string, replaceDirectory bool) *gitalypb.UserCommitFilesRequest {
+	return actionRequest(&gitalypb.UserCommitFilesAction{
+		UserCommitFilesActionPayload: &gitalypb.UserCommitFilesAction_Header{
+			Header: &gitalypb.UserCommitFilesActionHeader{
+				Action:           gitalypb.UserCommitFilesActionHeader_EXTRACT_ARCHIVE,
+				FilePath:         []byte(filePath),
+				ReplaceDirectory: replaceDirectory,
+			},
+		},
+	})
+}
+
+func moveDirHeaderRequest(previousPath, filePath string) *gitalypb.UserCommitFilesRequest {
+	return actionRequest(&gitalypb.UserCommitFilesAction{
+		UserCommitFilesActionPayload: &gitalypb.UserCommitFilesAction_Header{
+			Header: &gitalypb.UserCommitFilesActionHeader{
+				Action:       gitalypb.UserCommitFilesActionHeader_MOVE_DIR,
+				FilePath:     []byte(filePath),
+				PreviousPath: []byte(previousPath),
+			},
+		},
+	})
+}
+
+func deleteDirHeaderRequest(filePath string) *gitalypb.UserCommitFilesRequest {
+	return actionRequest(&gitalypb.UserCommitFilesAction{
+		UserCommitFilesActionPayload: &gitalypb.UserCommitFilesAction_Header{
+			Header: &gitalypb.UserCommitFilesActionHeader{
+				Action:   gitalypb.UserCommitFilesActionHeader_DELETE_DIR,
+				FilePath: []byte(filePath),
+			},
+		},
+	})
+}
+
+type archiveFile struct {
+	header  tar.Header
+	content string
+}
+
+// tarArchive creates a tar archive from the given files. The size of regular files is derived
+// from their content.
+func tarArchive(tb testing.TB, files ...archiveFile) string {
+	tb.Helper()
+
+	var archive bytes.Buffer
+	tw := tar.NewWriter(&archive)
+
+	for _, file := range files {
+		header := file.header
+		if header.Typeflag == tar.TypeReg {
+			header.Size = int64(len(file.content))
+		}
+
+		require.NoError(tb, tw.WriteHeader(&header))
+		_, err := tw.Write([]byte(file.content))
+		require.NoError(tb, err)
+	}
+
+	require.NoError(tb, tw.Close())
+
+	return archive.String()
+}
+
 func actionContentRequest(content string) *gitalypb.UserCommitFilesRequest {
 	return actionRequest(&gitalypb.UserCommitFilesAction{
 		UserCommitFilesActionPayload: &gitalypb.UserCommitFilesAction_Content{
@@ -2067,6 +2396,16 @@ func actionContentRequest(content string) *gitalypb.UserCommitFilesRequest {
 	})
 }
 
+func chunkedActionContentRequests(content string, chunkSize int) []*gitalypb.UserCommitFilesRequest {
+	var requests []*gitalypb.UserCommitFilesRequest
+	for len(content) > chunkSize {
+		requests = append(requests, actionContentRequest(content[:chunkSize]))
+		content = content[chunkSize:]
+	}
+
+	return append(requests, actionContentRequest(content))
+}
+
 func actionRequest(action *gitalypb.UserCommitFilesAction) *gitalypb.UserCommitFilesRequest {
 	return &gitalypb.UserCommitFilesRequest{
 		UserCommitFilesRequestPayload: &gitalypb.UserCommitFilesRequest_Action{
@@ -67,14 +67,17 @@ func (s *Server) UserMergeBranch(stream gitalypb.OperationService_UserMergeBranc
 		return structerr.NewInvalidArgument("%w", err)
 	}
 
+	theirs := append([]string{firstRequest.CommitId}, firstRequest.GetAdditionalCommitIds()...)
+
 	mergeCommitID, err := s.merge(ctx, quarantineRepo,
 		authorSignature,
 		authorSignature,
 		string(firstRequest.Message),
 		revision.String(),
-		firstRequest.CommitId,
-		false,
+		theirs,
+		firstRequest.GetSquash(),
 		true,
+		mergeStrategyOptions(firstRequest.GetStrategyOptions())...,
 	)
 	if err != nil {
 		var conflictErr *localrepo.MergeTreeConflictError
@@ -89,11 +92,8 @@ func (s *Server) UserMergeBranch(stream gitalypb.OperationService_UserMergeBranc
 					&gitalypb.UserMergeBranchError{
 						Error: &gitalypb.UserMergeBranchError_MergeConflict{
 							MergeConflict: &gitalypb.MergeConflictError{
-								ConflictingFiles: conflictingFiles,
-								ConflictingCommitIds: []string{
-									revision.String(),
-									firstRequest.CommitId,
-								},
+								ConflictingFiles:     conflictingFiles,
+								ConflictingCommitIds: append([]string{revision.String()}, theirs...),
 							},
 						},
 					},
@@ -209,6 +209,16 @@ func validateMergeBranchRequest(locator storage.Locator, request *gitalypb.UserM
 		return errors.New("empty commit ID")
 	}
 
+	for _, commitID := range request.GetAdditionalCommitIds() {
+		if commitID == "" {
+			return errors.New("empty additional commit ID")
+		}
+	}
+
+	if err := validateMergeStrategyOptions(request.GetStrategyOptions()); err != nil {
+		return fmt.Errorf("invalid strategy options: %w", err)
+	}
+
 	if len(request.Message) == 0 {
 		return errors.New("empty message")
 	}
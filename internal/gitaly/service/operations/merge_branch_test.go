@@ -488,6 +488,53 @@ func testUserMergeBranchFailure(t *testing.T, ctx context.Context) {
 			},
 			expectedErr: structerr.NewInvalidArgument("empty message"),
 		},
+		{
+			desc: "empty additional commit",
+			setup: func() *gitalypb.UserMergeBranchRequest {
+				return &gitalypb.UserMergeBranchRequest{
+					Repository:          repoProto,
+					User:                gittest.TestUser,
+					CommitId:            master.Revision().String(),
+					Branch:              []byte(branchToMerge),
+					Message:             []byte("sample-message"),
+					AdditionalCommitIds: []string{""},
+				}
+			},
+			expectedErr: structerr.NewInvalidArgument("empty additional commit ID"),
+		},
+		{
+			desc: "rename threshold out of range",
+			setup: func() *gitalypb.UserMergeBranchRequest {
+				return &gitalypb.UserMergeBranchRequest{
+					Repository: repoProto,
+					User:       gittest.TestUser,
+					CommitId:   master.Revision().String(),
+					Branch:     []byte(branchToMerge),
+					Message:    []byte("sample-message"),
+					StrategyOptions: &gitalypb.MergeStrategyOptions{
+						RenameThreshold: 101,
+					},
+				}
+			},
+			expectedErr: structerr.NewInvalidArgument("invalid strategy options: rename threshold must not exceed 100"),
+		},
+		{
+			desc: "rename threshold without renames",
+			setup: func() *gitalypb.UserMergeBranchRequest {
+				return &gitalypb.UserMergeBranchRequest{
+					Repository: repoProto,
+					User:       gittest.TestUser,
+					CommitId:   master.Revision().String(),
+					Branch:     []byte(branchToMerge),
+					Message:    []byte("sample-message"),
+					StrategyOptions: &gitalypb.MergeStrategyOptions{
+						RenameThreshold: 50,
+						NoRenames:       true,
+					},
+				}
+			},
+			expectedErr: structerr.NewInvalidArgument("invalid strategy options: rename threshold cannot be combined with no renames"),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1023,6 +1070,162 @@ func testUserMergeBranchConflict(t *testing.T, ctx context.Context) {
 	require.Nil(t, firstResponse)
 }
 
+func TestUserMergeBranch_strategies(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewFeatureSets(featureflag.GPGSigning).Run(t, testUserMergeBranchStrategies)
+}
+
+func testUserMergeBranchStrategies(t *testing.T, ctx context.Context) {
+	t.Parallel()
+
+	ctx, cfg, client := setupOperationsService(t, ctx)
+
+	repoProto, repoPath := gittest.CreateRepository(t, ctx, cfg)
+	repo := localrepo.NewTestRepo(t, cfg, repoProto)
+
+	base := gittest.WriteCommit(t, cfg, repoPath, gittest.WithTreeEntries(
+		gittest.TreeEntry{Mode: "100644", Path: "file", Content: "base\n"},
+	))
+	target := gittest.WriteCommit(t, cfg, repoPath, gittest.WithParents(base), gittest.WithTreeEntries(
+		gittest.TreeEntry{Mode: "100644", Path: "file", Content: "target\n"},
+	))
+	conflicting := gittest.WriteCommit(t, cfg, repoPath, gittest.WithParents(base), gittest.WithTreeEntries(
+		gittest.TreeEntry{Mode: "100644", Path: "file", Content: "conflicting\n"},
+	))
+	first := gittest.WriteCommit(t, cfg, repoPath, gittest.WithParents(base), gittest.WithTreeEntries(
+		gittest.TreeEntry{Mode: "100644", Path: "file", Content: "base\n"},
+		gittest.TreeEntry{Mode: "100644", Path: "first", Content: "first\n"},
+	))
+	second := gittest.WriteCommit(t, cfg, repoPath, gittest.WithParents(base), gittest.WithTreeEntries(
+		gittest.TreeEntry{Mode: "100644", Path: "file", Content: "base\n"},
+		gittest.TreeEntry{Mode: "100644", Path: "second", Content: "second\n"},
+	))
+
+	for _, tc := range []struct {
+		desc                string
+		request             *gitalypb.UserMergeBranchRequest
+		expectedParents     []git.ObjectID
+		expectedTreeEntries []gittest.TreeEntry
+		expectedErr         error
+	}{
+		{
+			desc: "favor theirs",
+			request: &gitalypb.UserMergeBranchRequest{
+				CommitId: conflicting.String(),
+				StrategyOptions: &gitalypb.MergeStrategyOptions{
+					ConflictFavor: gitalypb.MergeStrategyOptions_CONFLICT_FAVOR_THEIRS,
+				},
+			},
+			expectedParents: []git.ObjectID{target, conflicting},
+			expectedTreeEntries: []gittest.TreeEntry{
+				{Mode: "100644", Path: "file", Content: "conflicting\n"},
+			},
+		},
+		{
+			desc: "squash",
+			request: &gitalypb.UserMergeBranchRequest{
+				CommitId: first.String(),
+				Squash:   true,
+			},
+			expectedParents: []git.ObjectID{target},
+			expectedTreeEntries: []gittest.TreeEntry{
+				{Mode: "100644", Path: "file", Content: "target\n"},
+				{Mode: "100644", Path: "first", Content: "first\n"},
+			},
+		},
+		{
+			desc: "octopus",
+			request: &gitalypb.UserMergeBranchRequest{
+				CommitId:            first.String(),
+				AdditionalCommitIds: []string{second.String()},
+			},
+			expectedParents: []git.ObjectID{target, first, second},
+			expectedTreeEntries: []gittest.TreeEntry{
+				{Mode: "100644", Path: "file", Content: "target\n"},
+				{Mode: "100644", Path: "first", Content: "first\n"},
+				{Mode: "100644", Path: "second", Content: "second\n"},
+			},
+		},
+		{
+			desc: "octopus with conflict",
+			request: &gitalypb.UserMergeBranchRequest{
+				CommitId:            first.String(),
+				AdditionalCommitIds: []string{conflicting.String()},
+			},
+			expectedErr: structerr.NewFailedPrecondition("merging commits: merge: there are conflicting files").WithDetail(
+				&gitalypb.UserMergeBranchError{
+					Error: &gitalypb.UserMergeBranchError_MergeConflict{
+						MergeConflict: &gitalypb.MergeConflictError{
+							ConflictingFiles: [][]byte{
+								[]byte("file"),
+							},
+							ConflictingCommitIds: []string{
+								target.String(),
+								first.String(),
+								conflicting.String(),
+							},
+						},
+					},
+				},
+			),
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			branch := strings.ReplaceAll(tc.desc, " ", "-")
+			gittest.WriteRef(t, cfg, repoPath, git.NewReferenceNameFromBranchName(branch), target)
+
+			request := tc.request
+			request.Repository = repoProto
+			request.User = gittest.TestUser
+			request.Branch = []byte(branch)
+			request.Message = []byte("merge")
+
+			commitsBefore := countCommitObjects(t, cfg, repoPath)
+
+			mergeBidi, err := client.UserMergeBranch(ctx)
+			require.NoError(t, err)
+			require.NoError(t, mergeBidi.Send(request), "send first request")
+
+			firstResponse, err := mergeBidi.Recv()
+			if tc.expectedErr != nil {
+				testhelper.RequireGrpcError(t, tc.expectedErr, err)
+				require.Equal(t, target, gittest.ResolveRevision(t, cfg, repoPath, branch))
+				return
+			}
+			require.NoError(t, err, "receive first response")
+
+			require.NoError(t, mergeBidi.Send(&gitalypb.UserMergeBranchRequest{Apply: true}), "apply merge")
+			secondResponse, err := mergeBidi.Recv()
+			require.NoError(t, err, "receive second response")
+			require.Equal(t, firstResponse.GetCommitId(), secondResponse.GetBranchUpdate().GetCommitId())
+
+			commit, err := repo.ReadCommit(ctx, git.Revision(firstResponse.GetCommitId()))
+			require.NoError(t, err)
+
+			parents := make([]git.ObjectID, 0, len(commit.GetParentIds()))
+			for _, parent := range commit.GetParentIds() {
+				parents = append(parents, git.ObjectID(parent))
+			}
+			require.Equal(t, tc.expectedParents, parents)
+			gittest.RequireTree(t, cfg, repoPath, firstResponse.GetCommitId(), tc.expectedTreeEntries)
+
+			// Only the merge commit is written. Octopus merges don't leave intermediate
+			// commits behind.
+			require.Equal(t, commitsBefore+1, countCommitObjects(t, cfg, repoPath))
+		})
+	}
+}
+
+func countCommitObjects(tb testing.TB, cfg config.Cfg, repoPath string) int {
+	tb.Helper()
+
+	objectTypes := gittest.Exec(tb, cfg, "-C", repoPath, "cat-file", "--batch-all-objects", "--batch-check=%(objecttype)")
+	return strings.Count(string(objectTypes), "commit\n")
+}
+
 func TestUserMergeBranch_allowed(t *testing.T) {
 	t.Parallel()
 
@@ -43,6 +43,17 @@ func (s *Server) UserMergeToRef(ctx context.Context, request *gitalypb.UserMerge
 		return nil, structerr.NewInvalidArgument("Invalid merge source")
 	}
 
+	theirs := []string{sourceOID.String()}
+	for _, additionalSourceSha := range request.GetAdditionalSourceShas() {
+		additionalSourceOID, err := repo.ResolveRevision(ctx, git.Revision(additionalSourceSha))
+		if err != nil {
+			return nil, structerr.NewInvalidArgument("Invalid merge source").
+				WithMetadata("source_sha", additionalSourceSha)
+		}
+
+		theirs = append(theirs, additionalSourceOID.String())
+	}
+
 	authorSignature, err := git.SignatureFromRequest(request)
 	if err != nil {
 		return nil, structerr.NewInvalidArgument("%w", err)
@@ -97,9 +108,10 @@ func (s *Server) UserMergeToRef(ctx context.Context, request *gitalypb.UserMerge
 		authorSignature,
 		string(request.Message),
 		oid.String(),
-		sourceOID.String(),
-		false,
+		theirs,
+		request.GetSquash(),
 		false,
+		mergeStrategyOptions(request.GetStrategyOptions())...,
 	)
 	if err != nil {
 		s.logger.WithError(err).WithFields(
@@ -147,6 +159,16 @@ func validateUserMergeToRefRequest(locator storage.Locator, in *gitalypb.UserMer
 		return errors.New("empty source SHA")
 	}
 
+	for _, sourceSha := range in.GetAdditionalSourceShas() {
+		if sourceSha == "" {
+			return errors.New("empty additional source SHA")
+		}
+	}
+
+	if err := validateMergeStrategyOptions(in.GetStrategyOptions()); err != nil {
+		return fmt.Errorf("invalid strategy options: %w", err)
+	}
+
 	if len(in.TargetRef) == 0 {
 		return errors.New("empty target ref")
 	}
@@ -314,6 +314,80 @@ func testUserMergeToRefStableMergeID(t *testing.T, ctx context.Context) {
 	}, commit)
 }
 
+func TestUserMergeToRef_octopusAndSquash(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewFeatureSets(
+		featureflag.GPGSigning,
+	).Run(t, testUserMergeToRefOctopusAndSquash)
+}
+
+func testUserMergeToRefOctopusAndSquash(t *testing.T, ctx context.Context) {
+	t.Parallel()
+
+	ctx, cfg, client := setupOperationsService(t, ctx)
+
+	repoProto, repoPath := gittest.CreateRepository(t, ctx, cfg)
+	repo := localrepo.NewTestRepo(t, cfg, repoProto)
+
+	base := gittest.WriteCommit(t, cfg, repoPath, gittest.WithBranch("main"), gittest.WithTreeEntries(
+		gittest.TreeEntry{Mode: "100644", Path: "file", Content: "base\n"},
+	))
+	first := gittest.WriteCommit(t, cfg, repoPath, gittest.WithParents(base), gittest.WithTreeEntries(
+		gittest.TreeEntry{Mode: "100644", Path: "file", Content: "base\n"},
+		gittest.TreeEntry{Mode: "100644", Path: "first", Content: "first\n"},
+	))
+	second := gittest.WriteCommit(t, cfg, repoPath, gittest.WithParents(base), gittest.WithTreeEntries(
+		gittest.TreeEntry{Mode: "100644", Path: "file", Content: "base\n"},
+		gittest.TreeEntry{Mode: "100644", Path: "second", Content: "second\n"},
+	))
+
+	for _, tc := range []struct {
+		desc            string
+		squash          bool
+		expectedParents []string
+	}{
+		{
+			desc:            "octopus",
+			expectedParents: []string{base.String(), first.String(), second.String()},
+		},
+		{
+			desc:            "squashed octopus",
+			squash:          true,
+			expectedParents: []string{base.String()},
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			targetRef := "refs/merge-requests/x/" + strings.ReplaceAll(tc.desc, " ", "-")
+
+			response, err := client.UserMergeToRef(ctx, &gitalypb.UserMergeToRefRequest{
+				Repository:           repoProto,
+				User:                 gittest.TestUser,
+				FirstParentRef:       []byte("refs/heads/main"),
+				SourceSha:            first.String(),
+				AdditionalSourceShas: []string{second.String()},
+				Squash:               tc.squash,
+				TargetRef:            []byte(targetRef),
+				Message:              []byte("merge"),
+			})
+			require.NoError(t, err)
+			require.Equal(t, response.GetCommitId(), gittest.ResolveRevision(t, cfg, repoPath, targetRef).String())
+
+			commit, err := repo.ReadCommit(ctx, git.Revision(response.GetCommitId()))
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedParents, commit.GetParentIds())
+
+			gittest.RequireTree(t, cfg, repoPath, response.GetCommitId(), []gittest.TreeEntry{
+				{Mode: "100644", Path: "file", Content: "base\n"},
+				{Mode: "100644", Path: "first", Content: "first\n"},
+				{Mode: "100644", Path: "second", Content: "second\n"},
+			})
+		})
+	}
+}
+
 func TestUserMergeToRef_failure(t *testing.T) {
 	testhelper.NewFeatureSets(
 		featureflag.GPGSigning,
@@ -2,12 +2,19 @@ package operations
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"gitlab.com/gitlab-org/gitaly/v16/internal/git"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/git/localrepo"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git/quarantine"
+	"gitlab.com/gitlab-org/gitaly/v16/proto/go/gitalypb"
+	"google.golang.org/protobuf/proto"
 )
 
+// merge merges theirs into ours and writes the resulting commit. If more than one commit is given
+// in theirs, an octopus merge is created by merging the commits one after the other into the result
+// of the previous merge.
 func (s *Server) merge(
 	ctx context.Context,
 	quarantineRepo *localrepo.Repo,
@@ -15,24 +22,19 @@ func (s *Server) merge(
 	committer git.Signature,
 	message string,
 	ours string,
-	theirs string,
+	theirs []string,
 	squash bool,
 	sign bool,
+	mergeTreeOptions ...localrepo.MergeTreeOption,
 ) (string, error) {
-	treeOID, err := quarantineRepo.MergeTree(ctx, ours, theirs, localrepo.WithAllowUnrelatedHistories(), localrepo.WithConflictingFileNamesOnly())
-	if err != nil {
-		return "", err
-	}
-
-	parents := []git.ObjectID{git.ObjectID(ours)}
-	if !squash {
-		parents = append(parents, git.ObjectID(theirs))
-	}
+	mergeTreeOptions = append([]localrepo.MergeTreeOption{
+		localrepo.WithAllowUnrelatedHistories(),
+		localrepo.WithConflictingFileNamesOnly(),
+	}, mergeTreeOptions...)
 
 	cfg := localrepo.WriteCommitConfig{
-		TreeID:         treeOID,
 		Message:        message,
-		Parents:        parents,
+		Parents:        []git.ObjectID{git.ObjectID(ours)},
 		AuthorName:     author.Name,
 		AuthorEmail:    author.Email,
 		AuthorDate:     author.When,
@@ -40,6 +42,44 @@ func (s *Server) merge(
 		CommitterEmail: committer.Email,
 		CommitterDate:  committer.When,
 	}
+
+	mergeRepo, scratchRepo := quarantineRepo, quarantineRepo
+	if len(theirs) > 1 {
+		var err error
+		if mergeRepo, scratchRepo, err = s.octopusRepos(ctx, quarantineRepo); err != nil {
+			return "", fmt.Errorf("create scratch repository: %w", err)
+		}
+	}
+
+	current := ours
+	for i, commitID := range theirs {
+		if i > 0 {
+			// git-merge-tree(1) can only merge two commits, so the result of the previous
+			// merge is written to an intermediate commit so that the next commit can be
+			// merged into it with the correct merge base. The intermediate commit is
+			// written to the scratch repository and thus discarded after the merge.
+			intermediateCfg := cfg
+			intermediateCfg.Parents = append([]git.ObjectID{git.ObjectID(ours)}, toObjectIDs(theirs[:i])...)
+			intermediateCommit, err := scratchRepo.WriteCommit(ctx, intermediateCfg)
+			if err != nil {
+				return "", fmt.Errorf("create intermediate commit: %w", err)
+			}
+
+			current = intermediateCommit.String()
+		}
+
+		treeOID, err := mergeRepo.MergeTree(ctx, current, commitID, mergeTreeOptions...)
+		if err != nil {
+			return "", err
+		}
+
+		cfg.TreeID = treeOID
+	}
+
+	if !squash {
+		cfg.Parents = append(cfg.Parents, toObjectIDs(theirs)...)
+	}
+
 	if sign {
 		cfg.SigningKey = s.signingKey
 	}
@@ -53,3 +93,82 @@ func (s *Server) merge(
 
 	return string(c), nil
 }
+
+// octopusRepos returns the repositories an octopus merge is performed in. The merged trees are
+// written to the quarantine through the returned merge repository. The intermediate commits are
+// written to the returned scratch repository, whose objects are readable from the merge repository
+// but are never migrated into the repository. The scratch objects are removed once the context is
+// done.
+func (s *Server) octopusRepos(ctx context.Context, quarantineRepo *localrepo.Repo) (*localrepo.Repo, *localrepo.Repo, error) {
+	quarantinedRepo, ok := quarantineRepo.Repository.(*gitalypb.Repository)
+	if !ok {
+		return nil, nil, fmt.Errorf("unexpected repository type %T", quarantineRepo.Repository)
+	}
+
+	scratchDir, err := quarantine.New(ctx, quarantinedRepo, s.locator)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	mergeRepo := proto.Clone(quarantinedRepo).(*gitalypb.Repository)
+	mergeRepo.GitAlternateObjectDirectories = append(
+		mergeRepo.GitAlternateObjectDirectories, scratchDir.QuarantinedRepo().GetGitObjectDirectory(),
+	)
+
+	return s.localrepo(mergeRepo), s.localrepo(scratchDir.QuarantinedRepo()), nil
+}
+
+func toObjectIDs(commitIDs []string) []git.ObjectID {
+	objectIDs := make([]git.ObjectID, 0, len(commitIDs))
+	for _, commitID := range commitIDs {
+		objectIDs = append(objectIDs, git.ObjectID(commitID))
+	}
+	return objectIDs
+}
+
+// mergeStrategyOptions converts the merge strategy options of a request into options of
+// localrepo.MergeTree.
+func mergeStrategyOptions(options *gitalypb.MergeStrategyOptions) []localrepo.MergeTreeOption {
+	var mergeTreeOptions []localrepo.MergeTreeOption
+
+	switch options.GetConflictFavor() {
+	case gitalypb.MergeStrategyOptions_CONFLICT_FAVOR_OURS:
+		mergeTreeOptions = append(mergeTreeOptions, localrepo.WithFavor(localrepo.MergeFavorOurs))
+	case gitalypb.MergeStrategyOptions_CONFLICT_FAVOR_THEIRS:
+		mergeTreeOptions = append(mergeTreeOptions, localrepo.WithFavor(localrepo.MergeFavorTheirs))
+	}
+
+	if options.GetRenameThreshold() > 0 {
+		mergeTreeOptions = append(mergeTreeOptions, localrepo.WithRenameThreshold(uint(options.GetRenameThreshold())))
+	}
+
+	if options.GetNoRenames() {
+		mergeTreeOptions = append(mergeTreeOptions, localrepo.WithoutRenames())
+	}
+
+	if options.GetIgnoreSpaceChange() {
+		mergeTreeOptions = append(mergeTreeOptions, localrepo.WithIgnoreSpaceChange())
+	}
+
+	if options.GetIgnoreAllSpace() {
+		mergeTreeOptions = append(mergeTreeOptions, localrepo.WithIgnoreAllSpace())
+	}
+
+	return mergeTreeOptions
+}
+
+func validateMergeStrategyOptions(options *gitalypb.MergeStrategyOptions) error {
+	if _, ok := gitalypb.MergeStrategyOptions_ConflictFavor_name[int32(options.GetConflictFavor())]; !ok {
+		return fmt.Errorf("invalid conflict favor %d", options.GetConflictFavor())
+	}
+
+	if options.GetRenameThreshold() > 100 {
+		return errors.New("rename threshold must not exceed 100")
+	}
+
+	if options.GetNoRenames() && options.GetRenameThreshold() > 0 {
+		return errors.New("rename threshold cannot be combined with no renames")
+	}
+
+	return nil
+}
@@ -0,0 +1,343 @@
+package operations
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git/localrepo"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/hook"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/hook/updateref"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/storage"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/structerr"
+	"gitlab.com/gitlab-org/gitaly/v16/proto/go/gitalypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// userNoteRequest is the common interface of requests which modify the note attached to a commit.
+type userNoteRequest interface {
+	GetRepository() *gitalypb.Repository
+	GetUser() *gitalypb.User
+	GetNotesRef() []byte
+	GetCommitId() string
+	GetTimestamp() *timestamppb.Timestamp
+}
+
+// updateNotesFunc modifies the tree of the notes reference. Only the subtrees on the fanout path of
+// the commit's note have been read. The note is nil if the commit has no note yet.
+type updateNotesFunc func(repo *localrepo.Repo, tree *localrepo.TreeEntry, commitID git.ObjectID, note *localrepo.Note) error
+
+// UserAddNote attaches a note to a commit and updates the notes reference with hooks.
+func (s *Server) UserAddNote(ctx context.Context, req *gitalypb.UserAddNoteRequest) (*gitalypb.UserAddNoteResponse, error) {
+	if err := validateUserNoteRequest(s.locator, req); err != nil {
+		return nil, structerr.NewInvalidArgument("%w", err)
+	}
+
+	notesCommitID, err := s.updateNotes(ctx, req, "Notes added by 'git notes add'",
+		func(repo *localrepo.Repo, tree *localrepo.TreeEntry, commitID git.ObjectID, note *localrepo.Note) error {
+			if note != nil {
+				return structerr.NewAlreadyExists("note already exists").WithMetadata("commit_id", commitID.String())
+			}
+
+			if err := requireCommit(ctx, repo, commitID); err != nil {
+				return err
+			}
+
+			blobID, err := repo.WriteBlob(ctx, bytes.NewReader(req.GetContent()), localrepo.WriteBlobConfig{})
+			if err != nil {
+				return structerr.NewInternal("writing note: %w", err)
+			}
+
+			if err := tree.Add(commitID.String(), localrepo.TreeEntry{
+				OID:  blobID,
+				Mode: "100644",
+				Path: commitID.String(),
+				Type: localrepo.Blob,
+			}); err != nil {
+				return structerr.NewInternal("adding note: %w", err)
+			}
+
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &gitalypb.UserAddNoteResponse{
+		NotesCommitId: notesCommitID.String(),
+	}, nil
+}
+
+// UserUpdateNote replaces the content of the note attached to a commit and updates the notes
+// reference with hooks.
+func (s *Server) UserUpdateNote(ctx context.Context, req *gitalypb.UserUpdateNoteRequest) (*gitalypb.UserUpdateNoteResponse, error) {
+	if err := validateUserNoteRequest(s.locator, req); err != nil {
+		return nil, structerr.NewInvalidArgument("%w", err)
+	}
+
+	notesCommitID, err := s.updateNotes(ctx, req, "Notes added by 'git notes edit'",
+		func(repo *localrepo.Repo, tree *localrepo.TreeEntry, commitID git.ObjectID, note *localrepo.Note) error {
+			if note == nil {
+				return structerr.NewNotFound("note not found").WithMetadata("commit_id", commitID.String())
+			}
+
+			blobID, err := repo.WriteBlob(ctx, bytes.NewReader(req.GetContent()), localrepo.WriteBlobConfig{})
+			if err != nil {
+				return structerr.NewInternal("writing note: %w", err)
+			}
+
+			if err := tree.Modify(note.Path, func(entry *localrepo.TreeEntry) error {
+				entry.OID = blobID
+				return nil
+			}); err != nil {
+				return structerr.NewInternal("modifying note: %w", err)
+			}
+
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &gitalypb.UserUpdateNoteResponse{
+		NotesCommitId: notesCommitID.String(),
+	}, nil
+}
+
+// UserRemoveNote removes the note attached to a commit and updates the notes reference with hooks.
+func (s *Server) UserRemoveNote(ctx context.Context, req *gitalypb.UserRemoveNoteRequest) (*gitalypb.UserRemoveNoteResponse, error) {
+	if err := validateUserNoteRequest(s.locator, req); err != nil {
+		return nil, structerr.NewInvalidArgument("%w", err)
+	}
+
+	notesCommitID, err := s.updateNotes(ctx, req, "Notes removed by 'git notes remove'",
+		func(repo *localrepo.Repo, tree *localrepo.TreeEntry, commitID git.ObjectID, note *localrepo.Note) error {
+			if note == nil {
+				return structerr.NewNotFound("note not found").WithMetadata("commit_id", commitID.String())
+			}
+
+			if err := tree.Delete(note.Path); err != nil {
+				return structerr.NewInternal("removing note: %w", err)
+			}
+
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &gitalypb.UserRemoveNoteResponse{
+		NotesCommitId: notesCommitID.String(),
+	}, nil
+}
+
+// updateNotes writes a new commit of the notes reference whose tree has been modified by
+// updateTree and updates the notes reference to it with hooks. It returns the ID of the new commit.
+func (s *Server) updateNotes(ctx context.Context, req userNoteRequest, message string, updateTree updateNotesFunc) (git.ObjectID, error) {
+	quarantineDir, quarantineRepo, err := s.quarantinedRepo(ctx, req.GetRepository())
+	if err != nil {
+		return "", structerr.NewInternal("creating repo quarantine: %w", err)
+	}
+
+	objectHash, err := quarantineRepo.ObjectHash(ctx)
+	if err != nil {
+		return "", structerr.NewInternal("detecting object hash: %w", err)
+	}
+
+	commitID, err := objectHash.FromHex(req.GetCommitId())
+	if err != nil {
+		return "", structerr.NewInvalidArgument("invalid commit ID: %w", err).WithMetadata("commit_id", req.GetCommitId())
+	}
+
+	notesRef := notesReference(req.GetNotesRef())
+
+	tree := &localrepo.TreeEntry{
+		Mode: "040000",
+		Type: localrepo.Tree,
+	}
+	var note *localrepo.Note
+
+	oldrev, err := quarantineRepo.ResolveRevision(ctx, notesRef.Revision()+"^{commit}")
+	switch {
+	case errors.Is(err, git.ErrReferenceNotFound):
+		oldrev = objectHash.ZeroOID
+	case err != nil:
+		return "", structerr.NewInternal("resolving notes reference: %w", err)
+	default:
+		tree, note, err = readNotesTree(ctx, quarantineRepo, oldrev, commitID)
+		if err != nil {
+			return "", structerr.NewInternal("reading notes tree: %w", err)
+		}
+	}
+
+	if err := updateTree(quarantineRepo, tree, commitID, note); err != nil {
+		return "", err
+	}
+
+	if err := tree.Write(ctx, quarantineRepo); err != nil {
+		return "", structerr.NewInternal("writing notes tree: %w", err)
+	}
+
+	signature, err := git.SignatureFromRequest(req)
+	if err != nil {
+		return "", structerr.NewInvalidArgument("%w", err)
+	}
+
+	var parents []git.ObjectID
+	if oldrev != objectHash.ZeroOID {
+		parents = []git.ObjectID{oldrev}
+	}
+
+	newrev, err := quarantineRepo.WriteCommit(ctx, localrepo.WriteCommitConfig{
+		Parents:        parents,
+		AuthorDate:     signature.When,
+		AuthorName:     signature.Name,
+		AuthorEmail:    signature.Email,
+		CommitterName:  signature.Name,
+		CommitterEmail: signature.Email,
+		CommitterDate:  signature.When,
+		Message:        message,
+		TreeID:         tree.OID,
+	})
+	if err != nil {
+		return "", structerr.NewInternal("writing notes commit: %w", err)
+	}
+
+	if err := s.updateReferenceWithHooks(ctx, req.GetRepository(), req.GetUser(), quarantineDir, notesRef, newrev, oldrev); err != nil {
+		var notAllowedError hook.NotAllowedError
+		var customHookErr updateref.CustomHookError
+		var updateRefError updateref.Error
+
+		if errors.As(err, &notAllowedError) {
+			return "", structerr.NewPermissionDenied("update denied by access checks: %w", err).WithDetail(
+				&gitalypb.UserNoteError{
+					Error: &gitalypb.UserNoteError_AccessCheck{
+						AccessCheck: &gitalypb.AccessCheckError{
+							ErrorMessage: notAllowedError.Message,
+							UserId:       notAllowedError.UserID,
+							Protocol:     notAllowedError.Protocol,
+							Changes:      notAllowedError.Changes,
+						},
+					},
+				},
+			)
+		} else if errors.As(err, &customHookErr) {
+			return "", structerr.NewPermissionDenied("update denied by custom hooks: %w", err).WithDetail(
+				&gitalypb.UserNoteError{
+					Error: &gitalypb.UserNoteError_CustomHook{
+						CustomHook: customHookErr.Proto(),
+					},
+				},
+			)
+		} else if errors.As(err, &updateRefError) {
+			return "", structerr.NewFailedPrecondition("reference update failed: %w", updateRefError).WithDetail(
+				&gitalypb.UserNoteError{
+					Error: &gitalypb.UserNoteError_ReferenceUpdate{
+						ReferenceUpdate: &gitalypb.ReferenceUpdateError{
+							ReferenceName: []byte(updateRefError.Reference.String()),
+							OldOid:        updateRefError.OldOID.String(),
+							NewOid:        updateRefError.NewOID.String(),
+						},
+					},
+				},
+			)
+		}
+
+		return "", structerr.NewInternal("updating notes reference: %w", err)
+	}
+
+	return newrev, nil
+}
+
+// readNotesTree reads the tree of the notes commit along the fanout path of the note attached to
+// the object. Same as Git, the note is looked up at each level either as a blob named after the
+// remaining digits of the object ID or in the subtree named after the next two digits, so that only
+// the subtrees leading to the note are read. The note is nil if the object has no note.
+func readNotesTree(ctx context.Context, repo *localrepo.Repo, notesCommitID, objectID git.ObjectID) (*localrepo.TreeEntry, *localrepo.Note, error) {
+	root, err := repo.ReadTree(ctx, notesCommitID.Revision())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tree, dir, remaining := root, "", objectID.String()
+	for {
+		var subtree *localrepo.TreeEntry
+		for _, entry := range tree.Entries {
+			switch {
+			case entry.IsBlob() && entry.Path == remaining:
+				return root, &localrepo.Note{
+					ObjectID: objectID,
+					BlobID:   entry.OID,
+					Path:     dir + remaining,
+				}, nil
+			case entry.Type == localrepo.Tree && len(remaining) > 2 && entry.Path == remaining[:2]:
+				subtree = entry
+			}
+		}
+
+		if subtree == nil {
+			return root, nil, nil
+		}
+
+		dir += subtree.Path + "/"
+		remaining = remaining[2:]
+
+		entries, err := repo.ReadTree(ctx, notesCommitID.Revision(), localrepo.WithRelativePath(dir))
+		if err != nil {
+			return nil, nil, err
+		}
+
+		subtree.Entries = entries.Entries
+		tree = subtree
+	}
+}
+
+// requireCommit verifies that the commit a note shall be attached to exists.
+func requireCommit(ctx context.Context, repo *localrepo.Repo, commitID git.ObjectID) error {
+	if _, err := repo.ResolveRevision(ctx, commitID.Revision()+"^{commit}"); err != nil {
+		if errors.Is(err, git.ErrReferenceNotFound) {
+			return structerr.NewNotFound("commit not found").WithMetadata("commit_id", commitID.String())
+		}
+
+		return structerr.NewInternal("resolving commit: %w", err)
+	}
+
+	return nil
+}
+
+// notesReference returns the notes reference to use, which defaults to Git's default notes
+// reference.
+func notesReference(notesRef []byte) git.ReferenceName {
+	if len(notesRef) == 0 {
+		return git.DefaultNotesRef
+	}
+
+	return git.ReferenceName(notesRef)
+}
+
+func validateUserNoteRequest(locator storage.Locator, req userNoteRequest) error {
+	if err := locator.ValidateRepository(req.GetRepository()); err != nil {
+		return err
+	}
+
+	if req.GetUser() == nil {
+		return errors.New("empty User")
+	}
+
+	if notesRef := req.GetNotesRef(); len(notesRef) > 0 {
+		if err := git.ValidateNotesReference(string(notesRef)); err != nil {
+			return fmt.Errorf("invalid NotesRef: %w", err)
+		}
+	}
+
+	if req.GetCommitId() == "" {
+		return errors.New("empty CommitId")
+	}
+
+	return nil
+}
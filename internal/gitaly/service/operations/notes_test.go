@@ -0,0 +1,463 @@
+package operations
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/featureflag"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git/gittest"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/config"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/storage"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/helper/text"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/structerr"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
+	"gitlab.com/gitlab-org/gitaly/v16/proto/go/gitalypb"
+)
+
+// readNotes returns the content of all notes of the notes reference keyed by the annotated object.
+func readNotes(tb testing.TB, cfg config.Cfg, repoPath string, notesRef git.ReferenceName) map[string]string {
+	tb.Helper()
+
+	notes := make(map[string]string)
+	for _, line := range strings.Split(text.ChompBytes(gittest.Exec(tb, cfg, "-C", repoPath, "notes", "--ref="+notesRef.String(), "list")), "\n") {
+		if line == "" {
+			continue
+		}
+
+		blobID, objectID, ok := strings.Cut(line, " ")
+		require.True(tb, ok)
+
+		notes[objectID] = string(gittest.Exec(tb, cfg, "-C", repoPath, "cat-file", "-p", blobID))
+	}
+
+	return notes
+}
+
+func TestUserAddNote(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewFeatureSets(
+		featureflag.GPGSigning,
+	).
+		Run(t, testUserAddNote)
+}
+
+func testUserAddNote(t *testing.T, ctx context.Context) {
+	ctx, cfg, client := setupOperationsService(t, ctx)
+
+	type setupData struct {
+		request       *gitalypb.UserAddNoteRequest
+		repoPath      string
+		notesRef      git.ReferenceName
+		oldNotesRev   git.ObjectID
+		expectedNotes map[string]string
+		expectedErr   error
+	}
+
+	for _, tc := range []struct {
+		desc  string
+		setup func(t *testing.T) setupData
+	}{
+		{
+			desc: "unset repository",
+			setup: func(t *testing.T) setupData {
+				return setupData{
+					request: &gitalypb.UserAddNoteRequest{
+						User:     gittest.TestUser,
+						CommitId: gittest.DefaultObjectHash.EmptyTreeOID.String(),
+					},
+					expectedErr: structerr.NewInvalidArgument("%w", storage.ErrRepositoryNotSet),
+				}
+			},
+		},
+		{
+			desc: "unset user",
+			setup: func(t *testing.T) setupData {
+				repoProto, _ := gittest.CreateRepository(t, ctx, cfg)
+
+				return setupData{
+					request: &gitalypb.UserAddNoteRequest{
+						Repository: repoProto,
+						CommitId:   gittest.DefaultObjectHash.EmptyTreeOID.String(),
+					},
+					expectedErr: structerr.NewInvalidArgument("empty User"),
+				}
+			},
+		},
+		{
+			desc: "invalid notes reference",
+			setup: func(t *testing.T) setupData {
+				repoProto, _ := gittest.CreateRepository(t, ctx, cfg)
+
+				return setupData{
+					request: &gitalypb.UserAddNoteRequest{
+						Repository: repoProto,
+						User:       gittest.TestUser,
+						NotesRef:   []byte("refs/heads/main"),
+						CommitId:   gittest.DefaultObjectHash.EmptyTreeOID.String(),
+					},
+					expectedErr: structerr.NewInvalidArgument("invalid NotesRef: reference is not a notes reference"),
+				}
+			},
+		},
+		{
+			desc: "unset commit ID",
+			setup: func(t *testing.T) setupData {
+				repoProto, _ := gittest.CreateRepository(t, ctx, cfg)
+
+				return setupData{
+					request: &gitalypb.UserAddNoteRequest{
+						Repository: repoProto,
+						User:       gittest.TestUser,
+					},
+					expectedErr: structerr.NewInvalidArgument("empty CommitId"),
+				}
+			},
+		},
+		{
+			desc: "invalid commit ID",
+			setup: func(t *testing.T) setupData {
+				repoProto, _ := gittest.CreateRepository(t, ctx, cfg)
+
+				return setupData{
+					request: &gitalypb.UserAddNoteRequest{
+						Repository: repoProto,
+						User:       gittest.TestUser,
+						CommitId:   "main",
+					},
+					expectedErr: testhelper.WithInterceptedMetadata(
+						structerr.NewInvalidArgument("invalid commit ID: %w", gittest.DefaultObjectHash.ValidateHex("main")),
+						"commit_id", "main",
+					),
+				}
+			},
+		},
+		{
+			desc: "missing commit",
+			setup: func(t *testing.T) setupData {
+				repoProto, repoPath := gittest.CreateRepository(t, ctx, cfg)
+				blobID := gittest.WriteBlob(t, cfg, repoPath, []byte("not a commit"))
+
+				return setupData{
+					request: &gitalypb.UserAddNoteRequest{
+						Repository: repoProto,
+						User:       gittest.TestUser,
+						CommitId:   blobID.String(),
+						Content:    []byte("note"),
+					},
+					repoPath: repoPath,
+					notesRef: git.DefaultNotesRef,
+					expectedErr: testhelper.WithInterceptedMetadata(
+						structerr.NewNotFound("commit not found"),
+						"commit_id", blobID.String(),
+					),
+					expectedNotes: map[string]string{},
+				}
+			},
+		},
+		{
+			desc: "default notes reference is created",
+			setup: func(t *testing.T) setupData {
+				repoProto, repoPath := gittest.CreateRepository(t, ctx, cfg)
+				commitID := gittest.WriteCommit(t, cfg, repoPath)
+
+				return setupData{
+					request: &gitalypb.UserAddNoteRequest{
+						Repository: repoProto,
+						User:       gittest.TestUser,
+						CommitId:   commitID.String(),
+						Content:    []byte("pipeline passed\n"),
+					},
+					repoPath: repoPath,
+					notesRef: git.DefaultNotesRef,
+					expectedNotes: map[string]string{
+						commitID.String(): "pipeline passed\n",
+					},
+				}
+			},
+		},
+		{
+			desc: "existing notes reference is updated",
+			setup: func(t *testing.T) setupData {
+				repoProto, repoPath := gittest.CreateRepository(t, ctx, cfg)
+				annotatedCommitID := gittest.WriteCommit(t, cfg, repoPath, gittest.WithMessage("annotated"))
+				commitID := gittest.WriteCommit(t, cfg, repoPath, gittest.WithMessage("to be annotated"))
+
+				gittest.Exec(t, cfg, "-C", repoPath, "notes", "--ref=refs/notes/ci", "add", "-m", "existing", annotatedCommitID.String())
+
+				return setupData{
+					request: &gitalypb.UserAddNoteRequest{
+						Repository: repoProto,
+						User:       gittest.TestUser,
+						NotesRef:   []byte("refs/notes/ci"),
+						CommitId:   commitID.String(),
+						Content:    []byte("added"),
+					},
+					repoPath:    repoPath,
+					notesRef:    "refs/notes/ci",
+					oldNotesRev: gittest.ResolveRevision(t, cfg, repoPath, "refs/notes/ci"),
+					expectedNotes: map[string]string{
+						annotatedCommitID.String(): "existing\n",
+						commitID.String():          "added",
+					},
+				}
+			},
+		},
+		{
+			desc: "note already exists",
+			setup: func(t *testing.T) setupData {
+				repoProto, repoPath := gittest.CreateRepository(t, ctx, cfg)
+				commitID := gittest.WriteCommit(t, cfg, repoPath)
+
+				gittest.Exec(t, cfg, "-C", repoPath, "notes", "add", "-m", "existing", commitID.String())
+
+				return setupData{
+					request: &gitalypb.UserAddNoteRequest{
+						Repository: repoProto,
+						User:       gittest.TestUser,
+						CommitId:   commitID.String(),
+						Content:    []byte("added"),
+					},
+					repoPath: repoPath,
+					notesRef: git.DefaultNotesRef,
+					expectedErr: testhelper.WithInterceptedMetadata(
+						structerr.NewAlreadyExists("note already exists"),
+						"commit_id", commitID.String(),
+					),
+					expectedNotes: map[string]string{
+						commitID.String(): "existing\n",
+					},
+				}
+			},
+		},
+		{
+			desc: "rejected by custom hook",
+			setup: func(t *testing.T) setupData {
+				repoProto, repoPath := gittest.CreateRepository(t, ctx, cfg)
+				commitID := gittest.WriteCommit(t, cfg, repoPath)
+
+				gittest.WriteCustomHook(t, repoPath, "pre-receive", []byte("#!/bin/sh\necho rejected\nexit 1"))
+
+				return setupData{
+					request: &gitalypb.UserAddNoteRequest{
+						Repository: repoProto,
+						User:       gittest.TestUser,
+						CommitId:   commitID.String(),
+						Content:    []byte("added"),
+					},
+					repoPath: repoPath,
+					notesRef: git.DefaultNotesRef,
+					expectedErr: structerr.NewPermissionDenied("update denied by custom hooks: running pre-receive hooks: rejected\n").WithDetail(
+						&gitalypb.UserNoteError{
+							Error: &gitalypb.UserNoteError_CustomHook{
+								CustomHook: &gitalypb.CustomHookError{
+									HookType: gitalypb.CustomHookError_HOOK_TYPE_PRERECEIVE,
+									Stdout:   []byte("rejected\n"),
+								},
+							},
+						},
+					),
+					expectedNotes: map[string]string{},
+				}
+			},
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			setup := tc.setup(t)
+
+			response, err := client.UserAddNote(ctx, setup.request)
+			testhelper.RequireGrpcError(t, setup.expectedErr, err)
+
+			if setup.repoPath == "" {
+				return
+			}
+
+			require.Equal(t, setup.expectedNotes, readNotes(t, cfg, setup.repoPath, setup.notesRef))
+
+			if err != nil {
+				return
+			}
+
+			notesRev := gittest.ResolveRevision(t, cfg, setup.repoPath, setup.notesRef.String())
+			require.Equal(t, notesRev.String(), response.GetNotesCommitId())
+
+			expectedParents := ""
+			if setup.oldNotesRev != "" {
+				expectedParents = setup.oldNotesRev.String()
+			}
+			require.Equal(t, expectedParents, text.ChompBytes(gittest.Exec(t, cfg, "-C", setup.repoPath, "log", "-1", "--format=%P", notesRev.String())))
+			require.Equal(t, "Notes added by 'git notes add'", text.ChompBytes(gittest.Exec(t, cfg, "-C", setup.repoPath, "log", "-1", "--format=%s", notesRev.String())))
+		})
+	}
+}
+
+func TestUserUpdateNote(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewFeatureSets(
+		featureflag.GPGSigning,
+	).
+		Run(t, testUserUpdateNote)
+}
+
+func testUserUpdateNote(t *testing.T, ctx context.Context) {
+	ctx, cfg, client := setupOperationsService(t, ctx)
+
+	t.Run("successful", func(t *testing.T) {
+		t.Parallel()
+
+		repoProto, repoPath := gittest.CreateRepository(t, ctx, cfg)
+		commitID := gittest.WriteCommit(t, cfg, repoPath, gittest.WithMessage("updated"))
+		otherCommitID := gittest.WriteCommit(t, cfg, repoPath, gittest.WithMessage("other"))
+
+		// Fan out the note which is updated to verify that its path is retained.
+		blobID := gittest.WriteBlob(t, cfg, repoPath, []byte("original"))
+		otherBlobID := gittest.WriteBlob(t, cfg, repoPath, []byte("other"))
+		oldNotesRev := gittest.WriteCommit(t, cfg, repoPath, gittest.WithTreeEntries(
+			gittest.TreeEntry{Path: commitID.String()[:2], Mode: "040000", OID: gittest.WriteTree(t, cfg, repoPath, []gittest.TreeEntry{
+				{Path: commitID.String()[2:], Mode: "100644", OID: blobID},
+			})},
+			gittest.TreeEntry{Path: otherCommitID.String(), Mode: "100644", OID: otherBlobID},
+		), gittest.WithReference("refs/notes/ci"))
+
+		response, err := client.UserUpdateNote(ctx, &gitalypb.UserUpdateNoteRequest{
+			Repository: repoProto,
+			User:       gittest.TestUser,
+			NotesRef:   []byte("refs/notes/ci"),
+			CommitId:   commitID.String(),
+			Content:    []byte("updated"),
+		})
+		require.NoError(t, err)
+
+		notesRev := gittest.ResolveRevision(t, cfg, repoPath, "refs/notes/ci")
+		require.Equal(t, notesRev.String(), response.GetNotesCommitId())
+		require.Equal(t, oldNotesRev.String(), text.ChompBytes(gittest.Exec(t, cfg, "-C", repoPath, "log", "-1", "--format=%P", notesRev.String())))
+		require.Equal(t, "Notes added by 'git notes edit'", text.ChompBytes(gittest.Exec(t, cfg, "-C", repoPath, "log", "-1", "--format=%s", notesRev.String())))
+
+		require.Equal(t, map[string]string{
+			commitID.String():      "updated",
+			otherCommitID.String(): "other",
+		}, readNotes(t, cfg, repoPath, "refs/notes/ci"))
+		fanOutTreeID := gittest.ResolveRevision(t, cfg, repoPath, notesRev.String()+":"+commitID.String()[:2])
+		gittest.RequireTree(t, cfg, repoPath, fanOutTreeID.String(), []gittest.TreeEntry{
+			{Path: commitID.String()[2:], Mode: "100644", Content: "updated"},
+		})
+	})
+
+	t.Run("missing note", func(t *testing.T) {
+		t.Parallel()
+
+		repoProto, repoPath := gittest.CreateRepository(t, ctx, cfg)
+		commitID := gittest.WriteCommit(t, cfg, repoPath)
+
+		response, err := client.UserUpdateNote(ctx, &gitalypb.UserUpdateNoteRequest{
+			Repository: repoProto,
+			User:       gittest.TestUser,
+			CommitId:   commitID.String(),
+			Content:    []byte("updated"),
+		})
+		testhelper.RequireGrpcError(t, testhelper.WithInterceptedMetadata(
+			structerr.NewNotFound("note not found"),
+			"commit_id", commitID.String(),
+		), err)
+		require.Nil(t, response)
+		require.Empty(t, readNotes(t, cfg, repoPath, git.DefaultNotesRef))
+	})
+}
+
+func TestUserRemoveNote(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewFeatureSets(
+		featureflag.GPGSigning,
+	).
+		Run(t, testUserRemoveNote)
+}
+
+func testUserRemoveNote(t *testing.T, ctx context.Context) {
+	ctx, cfg, client := setupOperationsService(t, ctx)
+
+	t.Run("successful", func(t *testing.T) {
+		t.Parallel()
+
+		repoProto, repoPath := gittest.CreateRepository(t, ctx, cfg)
+		commitID := gittest.WriteCommit(t, cfg, repoPath, gittest.WithMessage("removed"))
+		otherCommitID := gittest.WriteCommit(t, cfg, repoPath, gittest.WithMessage("other"))
+
+		gittest.Exec(t, cfg, "-C", repoPath, "notes", "add", "-m", "removed", commitID.String())
+		gittest.Exec(t, cfg, "-C", repoPath, "notes", "add", "-m", "other", otherCommitID.String())
+		oldNotesRev := gittest.ResolveRevision(t, cfg, repoPath, git.DefaultNotesRef.String())
+
+		response, err := client.UserRemoveNote(ctx, &gitalypb.UserRemoveNoteRequest{
+			Repository: repoProto,
+			User:       gittest.TestUser,
+			CommitId:   commitID.String(),
+		})
+		require.NoError(t, err)
+
+		notesRev := gittest.ResolveRevision(t, cfg, repoPath, git.DefaultNotesRef.String())
+		require.Equal(t, notesRev.String(), response.GetNotesCommitId())
+		require.Equal(t, oldNotesRev.String(), text.ChompBytes(gittest.Exec(t, cfg, "-C", repoPath, "log", "-1", "--format=%P", notesRev.String())))
+		require.Equal(t, "Notes removed by 'git notes remove'", text.ChompBytes(gittest.Exec(t, cfg, "-C", repoPath, "log", "-1", "--format=%s", notesRev.String())))
+
+		require.Equal(t, map[string]string{
+			otherCommitID.String(): "other\n",
+		}, readNotes(t, cfg, repoPath, git.DefaultNotesRef))
+	})
+
+	t.Run("fanned out note", func(t *testing.T) {
+		t.Parallel()
+
+		repoProto, repoPath := gittest.CreateRepository(t, ctx, cfg)
+		commitID := gittest.WriteCommit(t, cfg, repoPath, gittest.WithMessage("removed"))
+		otherCommitID := gittest.WriteCommit(t, cfg, repoPath, gittest.WithMessage("other"))
+
+		// The subtree only holds the removed note, so it is expected to be removed as well.
+		otherTreeID := gittest.WriteTree(t, cfg, repoPath, []gittest.TreeEntry{
+			{Path: otherCommitID.String()[2:], Mode: "100644", Content: "other"},
+		})
+		gittest.WriteCommit(t, cfg, repoPath, gittest.WithTreeEntries(
+			gittest.TreeEntry{Path: commitID.String()[:2], Mode: "040000", OID: gittest.WriteTree(t, cfg, repoPath, []gittest.TreeEntry{
+				{Path: commitID.String()[2:], Mode: "100644", Content: "removed"},
+			})},
+			gittest.TreeEntry{Path: otherCommitID.String()[:2], Mode: "040000", OID: otherTreeID},
+		), gittest.WithReference(git.DefaultNotesRef.String()))
+
+		_, err := client.UserRemoveNote(ctx, &gitalypb.UserRemoveNoteRequest{
+			Repository: repoProto,
+			User:       gittest.TestUser,
+			CommitId:   commitID.String(),
+		})
+		require.NoError(t, err)
+
+		require.Equal(t, map[string]string{
+			otherCommitID.String(): "other",
+		}, readNotes(t, cfg, repoPath, git.DefaultNotesRef))
+		require.Equal(t, otherTreeID, gittest.ResolveRevision(t, cfg, repoPath, git.DefaultNotesRef.String()+":"+otherCommitID.String()[:2]))
+	})
+
+	t.Run("missing note", func(t *testing.T) {
+		t.Parallel()
+
+		repoProto, repoPath := gittest.CreateRepository(t, ctx, cfg)
+		commitID := gittest.WriteCommit(t, cfg, repoPath)
+
+		response, err := client.UserRemoveNote(ctx, &gitalypb.UserRemoveNoteRequest{
+			Repository: repoProto,
+			User:       gittest.TestUser,
+			CommitId:   commitID.String(),
+		})
+		testhelper.RequireGrpcError(t, testhelper.WithInterceptedMetadata(
+			structerr.NewNotFound("note not found"),
+			"commit_id", commitID.String(),
+		), err)
+		require.Nil(t, response)
+	})
+}
@@ -0,0 +1,264 @@
+package operations
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git/localrepo"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/hook/updateref"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/storage"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/structerr"
+	"gitlab.com/gitlab-org/gitaly/v16/proto/go/gitalypb"
+)
+
+// UserRebaseInteractive replays the commits of a branch on top of an upstream commit according to
+// the todo list of the request and updates the branch to the result.
+func (s *Server) UserRebaseInteractive(ctx context.Context, req *gitalypb.UserRebaseInteractiveRequest) (*gitalypb.UserRebaseInteractiveResponse, error) {
+	if err := validateUserRebaseInteractiveRequest(s.locator, req); err != nil {
+		return nil, structerr.NewInvalidArgument("%w", err)
+	}
+
+	quarantineDir, quarantineRepo, err := s.quarantinedRepo(ctx, req.GetRepository())
+	if err != nil {
+		return nil, structerr.NewInternal("creating repo quarantine: %w", err)
+	}
+
+	objectHash, err := quarantineRepo.ObjectHash(ctx)
+	if err != nil {
+		return nil, structerr.NewInternal("detecting object hash: %w", err)
+	}
+
+	branch := git.NewReferenceNameFromBranchName(string(req.GetBranch()))
+
+	var oldrev git.ObjectID
+	if expectedOldOID := req.GetExpectedOldOid(); expectedOldOID != "" {
+		oldrev, err = objectHash.FromHex(expectedOldOID)
+		if err != nil {
+			return nil, structerr.NewInvalidArgument("invalid expected old object ID: %w", err).
+				WithMetadata("old_object_id", expectedOldOID)
+		}
+
+		oldrev, err = quarantineRepo.ResolveRevision(ctx, git.Revision(fmt.Sprintf("%s^{commit}", oldrev)))
+		if err != nil {
+			return nil, structerr.NewInvalidArgument("cannot resolve expected old object ID: %w", err).
+				WithMetadata("old_object_id", expectedOldOID)
+		}
+	} else {
+		oldrev, err = quarantineRepo.ResolveRevision(ctx, branch.Revision()+"^{commit}")
+		if err != nil {
+			if errors.Is(err, git.ErrReferenceNotFound) {
+				return nil, structerr.NewNotFound("branch not found").WithMetadata("branch", string(req.GetBranch()))
+			}
+
+			return nil, structerr.NewInternal("resolving branch: %w", err)
+		}
+	}
+
+	upstreamOID, err := quarantineRepo.ResolveRevision(ctx, git.Revision(req.GetUpstream())+"^{commit}")
+	if err != nil {
+		return nil, structerr.NewInvalidArgument("resolving upstream: %w", err).
+			WithMetadata("upstream", string(req.GetUpstream()))
+	}
+
+	rebaseCommits, err := listRebaseCommits(ctx, quarantineRepo, upstreamOID, oldrev)
+	if err != nil {
+		return nil, structerr.NewInternal("listing commits: %w", err)
+	}
+
+	todo := make([]localrepo.RebaseTodoItem, 0, len(req.GetSteps()))
+	stepsByCommit := make(map[git.ObjectID]int, len(req.GetSteps()))
+	for i, step := range req.GetSteps() {
+		commitID, err := objectHash.FromHex(step.GetCommitId())
+		if err != nil {
+			return nil, structerr.NewInvalidArgument("step %d: invalid commit ID: %w", i, err)
+		}
+
+		isMerge, ok := rebaseCommits[commitID]
+		if !ok {
+			return nil, structerr.NewInvalidArgument("step %d: commit is not part of the rebased range", i).
+				WithMetadata("commit_id", commitID.String())
+		}
+
+		if isMerge {
+			return nil, structerr.NewInvalidArgument("step %d: cannot rebase merge commit", i).
+				WithMetadata("commit_id", commitID.String())
+		}
+
+		if _, ok := stepsByCommit[commitID]; ok {
+			return nil, structerr.NewInvalidArgument("step %d: commit is already part of the todo list", i).
+				WithMetadata("commit_id", commitID.String())
+		}
+		stepsByCommit[commitID] = i
+
+		todo = append(todo, localrepo.RebaseTodoItem{
+			Action:  rebaseActions[step.GetAction()],
+			Commit:  commitID,
+			Message: string(step.GetMessage()),
+		})
+	}
+
+	committerSignature, err := git.SignatureFromRequest(req)
+	if err != nil {
+		return nil, structerr.NewInvalidArgument("%w", err)
+	}
+
+	newrev, err := quarantineRepo.RebaseTodo(ctx, upstreamOID, todo, localrepo.RebaseWithCommitter(committerSignature))
+	if err != nil {
+		var conflictErr *localrepo.RebaseConflictError
+		switch {
+		case errors.Is(err, localrepo.ErrRebaseNothingToSquash):
+			return nil, structerr.NewInvalidArgument("%w", err)
+		case errors.As(err, &conflictErr):
+			conflictingFilesFromErr := conflictErr.ConflictError.ConflictedFiles()
+			conflictingFiles := make([][]byte, 0, len(conflictingFilesFromErr))
+			for _, conflictingFile := range conflictingFilesFromErr {
+				conflictingFiles = append(conflictingFiles, []byte(conflictingFile))
+			}
+
+			return nil, structerr.NewFailedPrecondition("rebasing commits: %w", conflictErr).WithDetail(
+				&gitalypb.UserRebaseInteractiveError{
+					Error: &gitalypb.UserRebaseInteractiveError_StepConflict_{
+						StepConflict: &gitalypb.UserRebaseInteractiveError_StepConflict{
+							Step:     uint32(stepsByCommit[git.ObjectID(conflictErr.Commit)]),
+							CommitId: conflictErr.Commit,
+							Conflict: &gitalypb.MergeConflictError{
+								ConflictingFiles: conflictingFiles,
+								ConflictingCommitIds: []string{
+									upstreamOID.String(),
+									conflictErr.Commit,
+								},
+							},
+						},
+					},
+				},
+			)
+		}
+
+		return nil, structerr.NewInternal("rebasing commits: %w", err)
+	}
+
+	if err := s.updateReferenceWithHooks(
+		ctx,
+		req.GetRepository(),
+		req.GetUser(),
+		quarantineDir,
+		branch,
+		newrev,
+		oldrev,
+		req.GetGitPushOptions()...,
+	); err != nil {
+		var customHookErr updateref.CustomHookError
+		if errors.As(err, &customHookErr) {
+			return nil, structerr.NewPermissionDenied("access check failed").WithDetail(
+				&gitalypb.UserRebaseInteractiveError{
+					Error: &gitalypb.UserRebaseInteractiveError_AccessCheck{
+						AccessCheck: &gitalypb.AccessCheckError{
+							ErrorMessage: strings.TrimSuffix(customHookErr.Error(), "\n"),
+						},
+					},
+				},
+			)
+		}
+
+		return nil, structerr.NewInternal("updating ref with hooks: %w", err)
+	}
+
+	return &gitalypb.UserRebaseInteractiveResponse{
+		BranchUpdate: &gitalypb.OperationBranchUpdate{
+			CommitId: newrev.String(),
+		},
+	}, nil
+}
+
+var rebaseActions = map[gitalypb.UserRebaseInteractiveRequest_Step_Action]localrepo.RebaseAction{
+	gitalypb.UserRebaseInteractiveRequest_Step_ACTION_PICK:   localrepo.RebasePick,
+	gitalypb.UserRebaseInteractiveRequest_Step_ACTION_REWORD: localrepo.RebaseReword,
+	gitalypb.UserRebaseInteractiveRequest_Step_ACTION_SQUASH: localrepo.RebaseSquash,
+	gitalypb.UserRebaseInteractiveRequest_Step_ACTION_FIXUP:  localrepo.RebaseFixup,
+	gitalypb.UserRebaseInteractiveRequest_Step_ACTION_DROP:   localrepo.RebaseDrop,
+}
+
+// listRebaseCommits returns the commits reachable from branch but not from upstream. The value
+// reports whether the commit is a merge commit.
+func listRebaseCommits(ctx context.Context, repo *localrepo.Repo, upstream, branch git.ObjectID) (map[git.ObjectID]bool, error) {
+	var stderr bytes.Buffer
+	cmd, err := repo.Exec(ctx, git.Command{
+		Name:  "rev-list",
+		Flags: []git.Option{git.Flag{Name: "--parents"}},
+		Args:  []string{fmt.Sprintf("%s..%s", upstream, branch)},
+	}, git.WithStderr(&stderr), git.WithSetupStdout())
+	if err != nil {
+		return nil, fmt.Errorf("start git rev-list: %w", err)
+	}
+
+	commits := make(map[git.ObjectID]bool)
+	scanner := bufio.NewScanner(cmd)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+
+		commits[git.ObjectID(fields[0])] = len(fields) > 2
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("scanning rev-list output: %w", err)
+	}
+
+	if err := cmd.Wait(); err != nil {
+		return nil, structerr.New("git rev-list: %w", err).WithMetadata("stderr", stderr.String())
+	}
+
+	return commits, nil
+}
+
+func validateUserRebaseInteractiveRequest(locator storage.Locator, req *gitalypb.UserRebaseInteractiveRequest) error {
+	if err := locator.ValidateRepository(req.GetRepository()); err != nil {
+		return err
+	}
+
+	if req.GetUser() == nil {
+		return errors.New("empty User")
+	}
+
+	if len(req.GetBranch()) == 0 {
+		return errors.New("empty Branch")
+	}
+
+	if err := git.ValidateRevision(req.GetUpstream()); err != nil {
+		return fmt.Errorf("invalid Upstream: %w", err)
+	}
+
+	if len(req.GetSteps()) == 0 {
+		return errors.New("empty Steps")
+	}
+
+	for i, step := range req.GetSteps() {
+		if _, ok := rebaseActions[step.GetAction()]; !ok {
+			return fmt.Errorf("step %d: invalid action %q", i, step.GetAction())
+		}
+
+		if step.GetCommitId() == "" {
+			return fmt.Errorf("step %d: empty CommitId", i)
+		}
+
+		switch step.GetAction() {
+		case gitalypb.UserRebaseInteractiveRequest_Step_ACTION_REWORD:
+			if len(step.GetMessage()) == 0 {
+				return fmt.Errorf("step %d: empty Message", i)
+			}
+		case gitalypb.UserRebaseInteractiveRequest_Step_ACTION_SQUASH:
+		default:
+			if len(step.GetMessage()) != 0 {
+				return fmt.Errorf("step %d: Message is only supported by reword and squash", i)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,259 @@
+package operations
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/featureflag"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git/gittest"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git/localrepo"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/config"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/structerr"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
+	"gitlab.com/gitlab-org/gitaly/v16/proto/go/gitalypb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type rebaseInteractiveSetup struct {
+	repo       *gitalypb.Repository
+	repoPath   string
+	upstream   git.ObjectID
+	first      git.ObjectID
+	second     git.ObjectID
+	conflicted git.ObjectID
+}
+
+// setupRebaseInteractive creates a repository with a "feature" branch of three commits diverging
+// from an "upstream" commit. The last commit of the branch conflicts with upstream.
+func setupRebaseInteractive(t *testing.T, ctx context.Context, cfg config.Cfg) rebaseInteractiveSetup {
+	repo, repoPath := gittest.CreateRepository(t, ctx, cfg)
+
+	base := gittest.WriteCommit(t, cfg, repoPath, gittest.WithMessage("base"), gittest.WithTreeEntries(
+		gittest.TreeEntry{Path: "file", Mode: "100644", Content: "base\n"},
+	))
+	upstream := gittest.WriteCommit(t, cfg, repoPath, gittest.WithParents(base), gittest.WithBranch("main"),
+		gittest.WithMessage("upstream"), gittest.WithTreeEntries(
+			gittest.TreeEntry{Path: "file", Mode: "100644", Content: "upstream\n"},
+		))
+	first := gittest.WriteCommit(t, cfg, repoPath, gittest.WithParents(base), gittest.WithMessage("first"),
+		gittest.WithTreeEntries(
+			gittest.TreeEntry{Path: "file", Mode: "100644", Content: "base\n"},
+			gittest.TreeEntry{Path: "a", Mode: "100644", Content: "a\n"},
+		))
+	second := gittest.WriteCommit(t, cfg, repoPath, gittest.WithParents(first), gittest.WithMessage("second"),
+		gittest.WithTreeEntries(
+			gittest.TreeEntry{Path: "file", Mode: "100644", Content: "base\n"},
+			gittest.TreeEntry{Path: "a", Mode: "100644", Content: "a\n"},
+			gittest.TreeEntry{Path: "b", Mode: "100644", Content: "b\n"},
+		))
+	conflicted := gittest.WriteCommit(t, cfg, repoPath, gittest.WithParents(second), gittest.WithBranch("feature"),
+		gittest.WithMessage("conflicted"), gittest.WithTreeEntries(
+			gittest.TreeEntry{Path: "file", Mode: "100644", Content: "feature\n"},
+			gittest.TreeEntry{Path: "a", Mode: "100644", Content: "a\n"},
+			gittest.TreeEntry{Path: "b", Mode: "100644", Content: "b\n"},
+		))
+
+	return rebaseInteractiveSetup{
+		repo:       repo,
+		repoPath:   repoPath,
+		upstream:   upstream,
+		first:      first,
+		second:     second,
+		conflicted: conflicted,
+	}
+}
+
+func TestUserRebaseInteractive_successful(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewFeatureSets(
+		featureflag.GPGSigning,
+	).Run(t, testUserRebaseInteractiveSuccessful)
+}
+
+func testUserRebaseInteractiveSuccessful(t *testing.T, ctx context.Context) {
+	t.Parallel()
+
+	ctx, cfg, client := setupOperationsService(t, ctx)
+	setup := setupRebaseInteractive(t, ctx, cfg)
+	repo := localrepo.NewTestRepo(t, cfg, setup.repo)
+
+	response, err := client.UserRebaseInteractive(ctx, &gitalypb.UserRebaseInteractiveRequest{
+		Repository:     setup.repo,
+		User:           gittest.TestUser,
+		Branch:         []byte("feature"),
+		ExpectedOldOid: setup.conflicted.String(),
+		Upstream:       []byte("main"),
+		Steps: []*gitalypb.UserRebaseInteractiveRequest_Step{
+			{Action: gitalypb.UserRebaseInteractiveRequest_Step_ACTION_REWORD, CommitId: setup.second.String(), Message: []byte("reworded\n")},
+			{Action: gitalypb.UserRebaseInteractiveRequest_Step_ACTION_SQUASH, CommitId: setup.first.String()},
+			{Action: gitalypb.UserRebaseInteractiveRequest_Step_ACTION_DROP, CommitId: setup.conflicted.String()},
+		},
+		Timestamp: &timestamppb.Timestamp{Seconds: 100000000},
+	})
+	require.NoError(t, err)
+
+	branchOID := gittest.ResolveRevision(t, cfg, setup.repoPath, "feature")
+	require.Equal(t, branchOID.String(), response.GetBranchUpdate().GetCommitId())
+
+	commit, err := repo.ReadCommit(ctx, branchOID.Revision())
+	require.NoError(t, err)
+	require.Equal(t, []string{setup.upstream.String()}, commit.GetParentIds())
+	require.Equal(t, "reworded\n\nfirst", strings.TrimSpace(string(commit.GetBody())))
+	require.Equal(t, gittest.TestUser.GetName(), commit.GetCommitter().GetName())
+
+	gittest.RequireTree(t, cfg, setup.repoPath, branchOID.String(), []gittest.TreeEntry{
+		{Path: "a", Mode: "100644", Content: "a\n"},
+		{Path: "b", Mode: "100644", Content: "b\n"},
+		{Path: "file", Mode: "100644", Content: "upstream\n"},
+	})
+}
+
+func TestUserRebaseInteractive_conflict(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewFeatureSets(
+		featureflag.GPGSigning,
+	).Run(t, testUserRebaseInteractiveConflict)
+}
+
+func testUserRebaseInteractiveConflict(t *testing.T, ctx context.Context) {
+	t.Parallel()
+
+	ctx, cfg, client := setupOperationsService(t, ctx)
+	setup := setupRebaseInteractive(t, ctx, cfg)
+
+	response, err := client.UserRebaseInteractive(ctx, &gitalypb.UserRebaseInteractiveRequest{
+		Repository: setup.repo,
+		User:       gittest.TestUser,
+		Branch:     []byte("feature"),
+		Upstream:   []byte("main"),
+		Steps: []*gitalypb.UserRebaseInteractiveRequest_Step{
+			{Action: gitalypb.UserRebaseInteractiveRequest_Step_ACTION_PICK, CommitId: setup.first.String()},
+			{Action: gitalypb.UserRebaseInteractiveRequest_Step_ACTION_PICK, CommitId: setup.conflicted.String()},
+		},
+	})
+	require.Nil(t, response)
+	testhelper.RequireGrpcError(t, structerr.NewFailedPrecondition(`rebasing commits: rebase: commit %q: there are conflicting files`, setup.conflicted).WithDetail(
+		&gitalypb.UserRebaseInteractiveError{
+			Error: &gitalypb.UserRebaseInteractiveError_StepConflict_{
+				StepConflict: &gitalypb.UserRebaseInteractiveError_StepConflict{
+					Step:     1,
+					CommitId: setup.conflicted.String(),
+					Conflict: &gitalypb.MergeConflictError{
+						ConflictingFiles: [][]byte{
+							[]byte("file"),
+						},
+						ConflictingCommitIds: []string{
+							setup.upstream.String(),
+							setup.conflicted.String(),
+						},
+					},
+				},
+			},
+		},
+	), err)
+
+	require.Equal(t, setup.conflicted, gittest.ResolveRevision(t, cfg, setup.repoPath, "feature"))
+}
+
+func TestUserRebaseInteractive_validation(t *testing.T) {
+	t.Parallel()
+
+	ctx, cfg, client := setupOperationsService(t, testhelper.Context(t))
+	setup := setupRebaseInteractive(t, ctx, cfg)
+
+	pick := func(commitID git.ObjectID) *gitalypb.UserRebaseInteractiveRequest_Step {
+		return &gitalypb.UserRebaseInteractiveRequest_Step{
+			Action:   gitalypb.UserRebaseInteractiveRequest_Step_ACTION_PICK,
+			CommitId: commitID.String(),
+		}
+	}
+
+	for _, tc := range []struct {
+		desc        string
+		modify      func(*gitalypb.UserRebaseInteractiveRequest)
+		expectedErr string
+	}{
+		{
+			desc:        "empty User",
+			modify:      func(req *gitalypb.UserRebaseInteractiveRequest) { req.User = nil },
+			expectedErr: "empty User",
+		},
+		{
+			desc:        "empty Branch",
+			modify:      func(req *gitalypb.UserRebaseInteractiveRequest) { req.Branch = nil },
+			expectedErr: "empty Branch",
+		},
+		{
+			desc:        "empty Steps",
+			modify:      func(req *gitalypb.UserRebaseInteractiveRequest) { req.Steps = nil },
+			expectedErr: "empty Steps",
+		},
+		{
+			desc: "unspecified action",
+			modify: func(req *gitalypb.UserRebaseInteractiveRequest) {
+				req.Steps[0].Action = gitalypb.UserRebaseInteractiveRequest_Step_ACTION_UNSPECIFIED
+			},
+			expectedErr: `step 0: invalid action "ACTION_UNSPECIFIED"`,
+		},
+		{
+			desc: "reword without message",
+			modify: func(req *gitalypb.UserRebaseInteractiveRequest) {
+				req.Steps[0].Action = gitalypb.UserRebaseInteractiveRequest_Step_ACTION_REWORD
+			},
+			expectedErr: "step 0: empty Message",
+		},
+		{
+			desc: "pick with message",
+			modify: func(req *gitalypb.UserRebaseInteractiveRequest) {
+				req.Steps[0].Message = []byte("message")
+			},
+			expectedErr: "step 0: Message is only supported by reword and squash",
+		},
+		{
+			desc: "commit outside of range",
+			modify: func(req *gitalypb.UserRebaseInteractiveRequest) {
+				req.Steps = append(req.Steps, pick(setup.upstream))
+			},
+			expectedErr: "step 1: commit is not part of the rebased range",
+		},
+		{
+			desc: "duplicate commit",
+			modify: func(req *gitalypb.UserRebaseInteractiveRequest) {
+				req.Steps = append(req.Steps, pick(setup.first))
+			},
+			expectedErr: "step 1: commit is already part of the todo list",
+		},
+		{
+			desc: "fixup without previous commit",
+			modify: func(req *gitalypb.UserRebaseInteractiveRequest) {
+				req.Steps[0].Action = gitalypb.UserRebaseInteractiveRequest_Step_ACTION_FIXUP
+			},
+			expectedErr: "cannot squash without a previous commit",
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			req := &gitalypb.UserRebaseInteractiveRequest{
+				Repository: setup.repo,
+				User:       gittest.TestUser,
+				Branch:     []byte("feature"),
+				Upstream:   []byte("main"),
+				Steps:      []*gitalypb.UserRebaseInteractiveRequest_Step{pick(setup.first)},
+			}
+			tc.modify(req)
+
+			_, err := client.UserRebaseInteractive(ctx, req)
+			testhelper.RequireGrpcCode(t, err, codes.InvalidArgument)
+			require.Contains(t, err.Error(), tc.expectedErr)
+		})
+	}
+}
@@ -62,7 +62,12 @@ func (s *Server) UserRevert(ctx context.Context, req *gitalypb.UserRevertRequest
 	}
 
 	var theirs git.ObjectID
-	if len(revertCommit.ParentIds) > 0 {
+	if mainline := req.GetMainline(); mainline > 0 {
+		theirs, err = mainlineParent(revertCommit, mainline)
+		if err != nil {
+			return nil, err
+		}
+	} else if len(revertCommit.ParentIds) > 0 {
 		// Use the first parent as `theirs` to implement mainline = 1.
 		theirs = git.ObjectID(revertCommit.ParentIds[0])
 	} else {
@@ -142,7 +142,7 @@ func (s *Server) userSquash(ctx context.Context, req *gitalypb.UserSquashRequest
 		committerSignature,
 		message,
 		startCommit.String(),
-		endCommit.String(),
+		[]string{endCommit.String()},
 		true,
 		true,
 	)
@@ -288,7 +288,9 @@ func (s *Server) createTag(
 	refObjectID := targetObjectID
 	var tagObject *gitalypb.Tag
 	if makingTag {
-		tagObjectID, err := repo.WriteTag(ctx, targetObjectID, targetObjectType, tagName, message, tagger)
+		tagObjectID, err := repo.WriteTag(ctx, targetObjectID, targetObjectType, tagName, message, tagger,
+			localrepo.WriteTagWithSigningKey(s.signingKey),
+		)
 		if err != nil {
 			var formatTagErr localrepo.FormatTagError
 			if errors.As(err, &formatTagErr) {
@@ -308,10 +310,11 @@ func (s *Server) createTag(
 		}
 
 		tagObject = &gitalypb.Tag{
-			Name:        tagName,
-			Id:          tagObjectID.String(),
-			Message:     createdTag.Message,
-			MessageSize: createdTag.MessageSize,
+			Name:          tagName,
+			Id:            tagObjectID.String(),
+			Message:       createdTag.Message,
+			MessageSize:   createdTag.MessageSize,
+			SignatureType: createdTag.SignatureType,
 			// TargetCommit: is filled in below if needed
 		}
 
@@ -1,6 +1,7 @@
 package operations
 
 import (
+	"context"
 	"fmt"
 	"path/filepath"
 	"strings"
@@ -8,13 +9,16 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/featureflag"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/git"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git/catfile"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/git/gittest"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/git/localrepo"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/storage"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/grpc/backchannel"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/grpc/metadata"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/helper/text"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/signature"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/structerr"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper/testcfg"
@@ -1076,6 +1080,51 @@ func TestUserCreateTag_stableTagIDs(t *testing.T) {
 	}, response.Tag)
 }
 
+func TestUserCreateTag_signed(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewFeatureSets(
+		featureflag.GPGSigning,
+	).Run(t, testUserCreateTagSigned)
+}
+
+func testUserCreateTagSigned(t *testing.T, ctx context.Context) {
+	t.Parallel()
+
+	ctx, cfg, client := setupOperationsService(t, ctx, testserver.WithSigningKey("testdata/signing_ssh_key_ed25519"))
+
+	repoProto, repoPath := gittest.CreateRepository(t, ctx, cfg)
+	repo := localrepo.NewTestRepo(t, cfg, repoProto)
+
+	commitID := gittest.WriteCommit(t, cfg, repoPath)
+
+	response, err := client.UserCreateTag(ctx, &gitalypb.UserCreateTagRequest{
+		Repository:     repoProto,
+		TagName:        []byte("signed-tag"),
+		TargetRevision: []byte(commitID),
+		Message:        []byte("my message"),
+		User:           gittest.TestUser,
+		Timestamp:      &timestamppb.Timestamp{Seconds: 12345},
+	})
+	require.NoError(t, err)
+
+	data, err := repo.ReadObject(ctx, git.ObjectID(response.GetTag().GetId()))
+	require.NoError(t, err)
+
+	tagSignature, signedText := catfile.ExtractTagSignature(data)
+	if !featureflag.GPGSigning.IsEnabled(ctx) {
+		require.Empty(t, tagSignature)
+		require.Equal(t, gitalypb.SignatureType_NONE, response.GetTag().GetSignatureType())
+		return
+	}
+
+	require.Equal(t, gitalypb.SignatureType_SSH, response.GetTag().GetSignatureType())
+
+	signingKeys, err := signature.ParseSigningKeys("testdata/signing_ssh_key_ed25519")
+	require.NoError(t, err)
+	require.NoError(t, signingKeys.Verify(tagSignature, signedText))
+}
+
 func TestUserCreateTag_prefixedTag(t *testing.T) {
 	t.Parallel()
 
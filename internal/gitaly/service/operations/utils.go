@@ -3,7 +3,9 @@ package operations
 import (
 	"errors"
 
+	"gitlab.com/gitlab-org/gitaly/v16/internal/git"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/storage"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/structerr"
 	"gitlab.com/gitlab-org/gitaly/v16/proto/go/gitalypb"
 )
 
@@ -38,3 +40,20 @@ func validateCherryPickOrRevertRequest(locator storage.Locator, req cherryPickOr
 
 	return nil
 }
+
+// mainlineParent returns the parent of the commit selected by the 1-based mainline. The changes of the
+// commit are computed against this parent when cherry-picking or reverting it. Same as Git, a mainline
+// may only be given for merge commits.
+func mainlineParent(commit *gitalypb.GitCommit, mainline uint32) (git.ObjectID, error) {
+	if len(commit.GetParentIds()) < 2 {
+		return "", structerr.NewInvalidArgument("mainline %d given but the commit is not a merge", mainline).
+			WithMetadata("commit_id", commit.GetId())
+	}
+
+	if mainline == 0 || int(mainline) > len(commit.GetParentIds()) {
+		return "", structerr.NewInvalidArgument("mainline %d does not match any of the %d parents of the commit",
+			mainline, len(commit.GetParentIds())).WithMetadata("commit_id", commit.GetId())
+	}
+
+	return git.ObjectID(commit.GetParentIds()[mainline-1]), nil
+}
@@ -4,6 +4,7 @@ import (
 	"gitlab.com/gitlab-org/gitaly/v16/internal/git"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/git/catfile"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/git/localrepo"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/config"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/service"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/storage"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/transaction"
@@ -14,6 +15,7 @@ import (
 type server struct {
 	gitalypb.UnimplementedRefServiceServer
 	logger        log.Logger
+	cfg           config.Cfg
 	txManager     transaction.Manager
 	locator       storage.Locator
 	gitCmdFactory git.CommandFactory
@@ -24,6 +26,7 @@ type server struct {
 func NewServer(deps *service.Dependencies) gitalypb.RefServiceServer {
 	return &server{
 		logger:        deps.GetLogger(),
+		cfg:           deps.GetCfg(),
 		txManager:     deps.GetTxManager(),
 		locator:       deps.GetLocator(),
 		gitCmdFactory: deps.GetGitCmdFactory(),
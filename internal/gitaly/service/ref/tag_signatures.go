@@ -2,6 +2,7 @@ package ref
 
 import (
 	"errors"
+	"fmt"
 	"io"
 
 	"gitlab.com/gitlab-org/gitaly/v16/internal/git"
@@ -9,6 +10,7 @@ import (
 	"gitlab.com/gitlab-org/gitaly/v16/internal/git/gitpipe"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/storage"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/helper/chunk"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/signature"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/structerr"
 	"gitlab.com/gitlab-org/gitaly/v16/proto/go/gitalypb"
 	"google.golang.org/protobuf/proto"
@@ -45,6 +47,14 @@ func (s *server) GetTagSignatures(req *gitalypb.GetTagSignaturesRequest, stream
 	}
 	defer cancel()
 
+	var signingKeys *signature.SigningKeys
+	if s.cfg.Git.SigningKey != "" {
+		signingKeys, err = signature.ParseSigningKeys(s.cfg.Git.SigningKey, s.cfg.Git.RotatedSigningKeys...)
+		if err != nil {
+			return fmt.Errorf("failed to parse signing key: %w", err)
+		}
+	}
+
 	chunker := chunk.New(&tagSignatureSender{
 		send: func(signatures []*gitalypb.GetTagSignaturesResponse_TagSignature) error {
 			return stream.Send(&gitalypb.GetTagSignaturesResponse{
@@ -75,10 +85,21 @@ func (s *server) GetTagSignatures(req *gitalypb.GetTagSignaturesRequest, stream
 
 		signatureKey, tagText := catfile.ExtractTagSignature(raw)
 
+		var signer gitalypb.GetTagSignaturesResponse_TagSignature_Signer
+		if len(signatureKey) > 0 {
+			signer = gitalypb.GetTagSignaturesResponse_TagSignature_SIGNER_USER
+			if signingKeys != nil {
+				if err := signingKeys.Verify(signatureKey, tagText); err == nil {
+					signer = gitalypb.GetTagSignaturesResponse_TagSignature_SIGNER_SYSTEM
+				}
+			}
+		}
+
 		if err := chunker.Send(&gitalypb.GetTagSignaturesResponse_TagSignature{
 			TagId:     tag.ObjectID().String(),
 			Signature: signatureKey,
 			Content:   tagText,
+			Signer:    signer,
 		}); err != nil {
 			return structerr.NewInternal("sending tag signature chunk: %w", err)
 		}
@@ -10,8 +10,10 @@ import (
 	"gitlab.com/gitlab-org/gitaly/v16/internal/git/gittest"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/storage"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/helper"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/signature"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/structerr"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper/testcfg"
 	"gitlab.com/gitlab-org/gitaly/v16/proto/go/gitalypb"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -99,6 +101,7 @@ iJwEAAEIAAYFAlmmbf0ACgkQv52SX5Ee/WVv1gP/WrjclOc3CYiTrTgNuxs/vyXl
 				{
 					TagId:     plainTagID.String(),
 					Signature: []byte(plainSignature),
+					Signer:    gitalypb.GetTagSignaturesResponse_TagSignature_SIGNER_USER,
 					Content:   []byte(plainContent),
 				},
 			},
@@ -112,6 +115,7 @@ iJwEAAEIAAYFAlmmbf0ACgkQv52SX5Ee/WVv1gP/WrjclOc3CYiTrTgNuxs/vyXl
 				{
 					TagId:     plainTagID.String(),
 					Signature: []byte(plainSignature),
+					Signer:    gitalypb.GetTagSignaturesResponse_TagSignature_SIGNER_USER,
 					Content:   []byte(plainContent),
 				},
 			},
@@ -125,6 +129,7 @@ iJwEAAEIAAYFAlmmbf0ACgkQv52SX5Ee/WVv1gP/WrjclOc3CYiTrTgNuxs/vyXl
 				{
 					TagId:     plainTagID.String(),
 					Signature: []byte(plainSignature),
+					Signer:    gitalypb.GetTagSignaturesResponse_TagSignature_SIGNER_USER,
 					Content:   []byte(plainContent),
 				},
 			},
@@ -139,11 +144,13 @@ iJwEAAEIAAYFAlmmbf0ACgkQv52SX5Ee/WVv1gP/WrjclOc3CYiTrTgNuxs/vyXl
 				{
 					TagId:     plainTagID.String(),
 					Signature: []byte(plainSignature),
+					Signer:    gitalypb.GetTagSignaturesResponse_TagSignature_SIGNER_USER,
 					Content:   []byte(plainContent),
 				},
 				{
 					TagId:     pgpTagID.String(),
 					Signature: []byte(pgpSignature),
+					Signer:    gitalypb.GetTagSignaturesResponse_TagSignature_SIGNER_USER,
 					Content:   []byte(pgpContent),
 				},
 			},
@@ -224,3 +231,58 @@ func TestGetTagSignatures_validate(t *testing.T) {
 		})
 	}
 }
+
+func TestGetTagSignatures_signer(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	cfg := testcfg.Build(t)
+	cfg.Git.SigningKey = "testdata/signing_ssh_key_ed25519"
+	cfg.SocketPath = runRefServiceServer(t, cfg)
+	client, conn := newRefServiceClient(t, cfg.SocketPath)
+	t.Cleanup(func() { conn.Close() })
+
+	repoProto, repoPath := gittest.CreateRepository(t, ctx, cfg)
+	commitID := gittest.WriteCommit(t, cfg, repoPath)
+
+	message := "tag message\n"
+	content := fmt.Sprintf("object %s\ntype commit\ntag system-signed-tag\ntagger %s\n\n%s", commitID, gittest.DefaultCommitterSignature, message)
+
+	signingKeys, err := signature.ParseSigningKeys(cfg.Git.SigningKey)
+	require.NoError(t, err)
+	systemSignature, err := signingKeys.CreateSignature([]byte(content))
+	require.NoError(t, err)
+
+	systemTagID := gittest.WriteTag(t, cfg, repoPath, "system-signed-tag", commitID.Revision(), gittest.WriteTagConfig{
+		Message: message + string(systemSignature),
+	})
+
+	userSignature := "-----BEGIN PGP SIGNATURE-----\niJwEAAEIAAYFAlmmbf0ACgkQv52SX5Ee/WVv1gP/WrjclOc3CYiTrTgNuxs/vyXl\n-----END PGP SIGNATURE-----"
+	userTagID := gittest.WriteTag(t, cfg, repoPath, "user-signed-tag", commitID.Revision(), gittest.WriteTagConfig{
+		Message: message + userSignature,
+	})
+
+	stream, err := client.GetTagSignatures(ctx, &gitalypb.GetTagSignaturesRequest{
+		Repository:   repoProto,
+		TagRevisions: []string{systemTagID.String(), userTagID.String()},
+	})
+	require.NoError(t, err)
+
+	signatures, err := testhelper.ReceiveAndFold(stream.Recv, func(
+		result []*gitalypb.GetTagSignaturesResponse_TagSignature,
+		response *gitalypb.GetTagSignaturesResponse,
+	) []*gitalypb.GetTagSignaturesResponse_TagSignature {
+		return append(result, response.GetSignatures()...)
+	})
+	require.NoError(t, err)
+
+	signers := make(map[string]gitalypb.GetTagSignaturesResponse_TagSignature_Signer, len(signatures))
+	for _, signature := range signatures {
+		signers[signature.GetTagId()] = signature.GetSigner()
+	}
+
+	require.Equal(t, map[string]gitalypb.GetTagSignaturesResponse_TagSignature_Signer{
+		systemTagID.String(): gitalypb.GetTagSignaturesResponse_TagSignature_SIGNER_SYSTEM,
+		userTagID.String():   gitalypb.GetTagSignaturesResponse_TagSignature_SIGNER_USER,
+	}, signers)
+}
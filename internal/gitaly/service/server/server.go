@@ -1,6 +1,7 @@
 package server
 
 import (
+	"gitlab.com/gitlab-org/gitaly/v16/internal/command/accounting"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/git"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/config"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/service"
@@ -13,6 +14,7 @@ type server struct {
 	logger        log.Logger
 	gitCmdFactory git.CommandFactory
 	storages      []config.Storage
+	resources     *accounting.Tracker
 }
 
 // NewServer creates a new instance of a grpc ServerServiceServer
@@ -21,5 +23,6 @@ func NewServer(deps *service.Dependencies) gitalypb.ServerServiceServer {
 		logger:        deps.GetLogger(),
 		gitCmdFactory: deps.GetGitCmdFactory(),
 		storages:      deps.GetCfg().Storages,
+		resources:     deps.GetResourceTracker(),
 	}
 }
@@ -0,0 +1,59 @@
+package server
+
+import (
+	"context"
+
+	"gitlab.com/gitlab-org/gitaly/v16/internal/command/accounting"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/structerr"
+	"gitlab.com/gitlab-org/gitaly/v16/proto/go/gitalypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// TopResourceConsumers returns the projects or users whose commands have consumed most resources
+// over the accounting window.
+func (s *server) TopResourceConsumers(_ context.Context, req *gitalypb.TopResourceConsumersRequest) (*gitalypb.TopResourceConsumersResponse, error) {
+	if s.resources == nil {
+		return nil, structerr.NewFailedPrecondition("resource accounting is not enabled")
+	}
+
+	var dimension accounting.Dimension
+	switch req.GetDimension() {
+	case gitalypb.TopResourceConsumersRequest_DIMENSION_UNSPECIFIED, gitalypb.TopResourceConsumersRequest_DIMENSION_PROJECT:
+		dimension = accounting.DimensionProject
+	case gitalypb.TopResourceConsumersRequest_DIMENSION_USER:
+		dimension = accounting.DimensionUser
+	default:
+		return nil, structerr.NewInvalidArgument("unsupported dimension: %q", req.GetDimension())
+	}
+
+	var resource accounting.Resource
+	switch req.GetSortBy() {
+	case gitalypb.TopResourceConsumersRequest_RESOURCE_UNSPECIFIED, gitalypb.TopResourceConsumersRequest_RESOURCE_CPU:
+		resource = accounting.ResourceCPU
+	case gitalypb.TopResourceConsumersRequest_RESOURCE_MEMORY:
+		resource = accounting.ResourceMemory
+	case gitalypb.TopResourceConsumersRequest_RESOURCE_IO:
+		resource = accounting.ResourceIO
+	default:
+		return nil, structerr.NewInvalidArgument("unsupported resource: %q", req.GetSortBy())
+	}
+
+	consumers := s.resources.Top(dimension, resource, int(req.GetLimit()))
+
+	response := &gitalypb.TopResourceConsumersResponse{
+		Window:    durationpb.New(s.resources.Window()),
+		Consumers: make([]*gitalypb.TopResourceConsumersResponse_Consumer, 0, len(consumers)),
+	}
+	for _, consumer := range consumers {
+		response.Consumers = append(response.Consumers, &gitalypb.TopResourceConsumersResponse_Consumer{
+			Key:          consumer.Key,
+			CommandCount: consumer.Usage.Commands,
+			CpuTime:      durationpb.New(consumer.Usage.CPUTime),
+			MaxRssBytes:  consumer.Usage.MaxRSS,
+			InputBlocks:  consumer.Usage.InBlocks,
+			OutputBlocks: consumer.Usage.OutBlocks,
+		})
+	}
+
+	return response, nil
+}
@@ -0,0 +1,108 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	grpcmwtags "github.com/grpc-ecosystem/go-grpc-middleware/tags"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/command/accounting"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/structerr"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
+	"gitlab.com/gitlab-org/gitaly/v16/proto/go/gitalypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestTopResourceConsumers(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+
+	tracker := accounting.NewTracker(time.Hour, 60, 100, 0)
+	for _, usage := range []struct {
+		projectPath string
+		glID        string
+		usage       accounting.Usage
+	}{
+		{projectPath: "group/a", glID: "user-1", usage: accounting.Usage{Commands: 1, CPUTime: time.Second, MaxRSS: 1024, InBlocks: 10}},
+		{projectPath: "group/b", glID: "user-1", usage: accounting.Usage{Commands: 1, CPUTime: 2 * time.Second, MaxRSS: 512, OutBlocks: 1}},
+		{projectPath: "group/b", glID: "user-2", usage: accounting.Usage{Commands: 1, CPUTime: 2 * time.Second}},
+	} {
+		tags := grpcmwtags.NewTags()
+		tags.Set("grpc.request.glProjectPath", usage.projectPath)
+		tags.Set("grpc.request.glID", usage.glID)
+		tracker.Record(grpcmwtags.SetInContext(ctx, tags), usage.usage)
+	}
+
+	srv := &server{resources: tracker}
+
+	for _, tc := range []struct {
+		desc             string
+		request          *gitalypb.TopResourceConsumersRequest
+		expectedResponse *gitalypb.TopResourceConsumersResponse
+		expectedErr      error
+	}{
+		{
+			desc:    "default dimension and resource",
+			request: &gitalypb.TopResourceConsumersRequest{},
+			expectedResponse: &gitalypb.TopResourceConsumersResponse{
+				Window: durationpb.New(time.Hour),
+				Consumers: []*gitalypb.TopResourceConsumersResponse_Consumer{
+					{Key: "group/b", CommandCount: 2, CpuTime: durationpb.New(4 * time.Second), MaxRssBytes: 512, OutputBlocks: 1},
+					{Key: "group/a", CommandCount: 1, CpuTime: durationpb.New(time.Second), MaxRssBytes: 1024, InputBlocks: 10},
+				},
+			},
+		},
+		{
+			desc: "by memory with limit",
+			request: &gitalypb.TopResourceConsumersRequest{
+				Dimension: gitalypb.TopResourceConsumersRequest_DIMENSION_PROJECT,
+				SortBy:    gitalypb.TopResourceConsumersRequest_RESOURCE_MEMORY,
+				Limit:     1,
+			},
+			expectedResponse: &gitalypb.TopResourceConsumersResponse{
+				Window: durationpb.New(time.Hour),
+				Consumers: []*gitalypb.TopResourceConsumersResponse_Consumer{
+					{Key: "group/a", CommandCount: 1, CpuTime: durationpb.New(time.Second), MaxRssBytes: 1024, InputBlocks: 10},
+				},
+			},
+		},
+		{
+			desc: "by user",
+			request: &gitalypb.TopResourceConsumersRequest{
+				Dimension: gitalypb.TopResourceConsumersRequest_DIMENSION_USER,
+				SortBy:    gitalypb.TopResourceConsumersRequest_RESOURCE_IO,
+			},
+			expectedResponse: &gitalypb.TopResourceConsumersResponse{
+				Window: durationpb.New(time.Hour),
+				Consumers: []*gitalypb.TopResourceConsumersResponse_Consumer{
+					{Key: "user-1", CommandCount: 2, CpuTime: durationpb.New(3 * time.Second), MaxRssBytes: 1024, InputBlocks: 10, OutputBlocks: 1},
+					{Key: "user-2", CommandCount: 1, CpuTime: durationpb.New(2 * time.Second)},
+				},
+			},
+		},
+		{
+			desc: "invalid dimension",
+			request: &gitalypb.TopResourceConsumersRequest{
+				Dimension: 42,
+			},
+			expectedErr: structerr.NewInvalidArgument("unsupported dimension: %q", gitalypb.TopResourceConsumersRequest_Dimension(42)),
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			response, err := srv.TopResourceConsumers(ctx, tc.request)
+			testhelper.RequireGrpcError(t, tc.expectedErr, err)
+			testhelper.ProtoEqual(t, tc.expectedResponse, response)
+		})
+	}
+
+	t.Run("accounting disabled", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := (&server{}).TopResourceConsumers(ctx, &gitalypb.TopResourceConsumersRequest{})
+		testhelper.RequireGrpcError(t, structerr.NewFailedPrecondition("resource accounting is not enabled"), err)
+	})
+}
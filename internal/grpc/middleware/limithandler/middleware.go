@@ -165,8 +165,9 @@ func (w *wrappedStream) RecvMsg(m interface{}) error {
 }
 
 // WithConcurrencyLimiters sets up middleware to limit the concurrency of
-// requests based on RPC and repository
-func WithConcurrencyLimiters(cfg config.Cfg) (map[string]*limiter.AdaptiveLimit, SetupFunc) {
+// requests based on RPC and repository. The given monitors are notified in
+// addition to the Prometheus monitor of each limiter.
+func WithConcurrencyLimiters(cfg config.Cfg, monitors ...limiter.ConcurrencyMonitor) (map[string]*limiter.AdaptiveLimit, SetupFunc) {
 	perRPCLimits := map[string]*limiter.AdaptiveLimit{}
 	for _, concurrency := range cfg.Concurrency {
 		limitName := fmt.Sprintf("perRPC%s", concurrency.RPC)
@@ -219,6 +220,15 @@ func WithConcurrencyLimiters(cfg config.Cfg) (map[string]*limiter.AdaptiveLimit,
 			queuedMetric.Collect(metrics)
 		}
 
+		newMonitor := func(fullMethod string) limiter.ConcurrencyMonitor {
+			return limiter.NewMultiConcurrencyMonitor(append([]limiter.ConcurrencyMonitor{
+				limiter.NewPerRPCPromMonitor(
+					"gitaly", fullMethod,
+					queuedMetric, inProgressMetric, acquiringSecondsMetric, middleware.requestsDroppedMetric,
+				),
+			}, monitors...)...)
+		}
+
 		result := make(map[string]limiter.Limiter)
 		for _, concurrency := range cfg.Concurrency {
 			concurrency := concurrency
@@ -227,10 +237,7 @@ func WithConcurrencyLimiters(cfg config.Cfg) (map[string]*limiter.AdaptiveLimit,
 				perRPCLimits[concurrency.RPC],
 				concurrency.MaxQueueSize,
 				concurrency.MaxQueueWait.Duration(),
-				limiter.NewPerRPCPromMonitor(
-					"gitaly", concurrency.RPC,
-					queuedMetric, inProgressMetric, acquiringSecondsMetric, middleware.requestsDroppedMetric,
-				),
+				newMonitor(concurrency.RPC),
 			)
 		}
 
@@ -241,10 +248,7 @@ func WithConcurrencyLimiters(cfg config.Cfg) (map[string]*limiter.AdaptiveLimit,
 				limiter.NewAdaptiveLimit("staticLimit", limiter.AdaptiveSetting{Initial: 1}),
 				0,
 				0,
-				limiter.NewPerRPCPromMonitor(
-					"gitaly", replicateRepositoryFullMethod,
-					queuedMetric, inProgressMetric, acquiringSecondsMetric, middleware.requestsDroppedMetric,
-				),
+				newMonitor(replicateRepositoryFullMethod),
 			)
 		}
 
@@ -281,6 +285,31 @@ func WithRateLimiters(ctx context.Context) SetupFunc {
 	}
 }
 
+// WithLoadShedding sets up a middleware that rejects the low-priority RPCs
+// configured for load shedding while the load shedder considers the server to
+// be overloaded.
+func WithLoadShedding(loadShedder *limiter.LoadShedder) SetupFunc {
+	return func(cfg config.Cfg, middleware *LimiterMiddleware) {
+		result := make(map[string]limiter.Limiter)
+
+		if cfg.LoadShedding.Enabled {
+			for _, rpc := range cfg.LoadShedding.RPCs {
+				serviceName, methodName := splitMethodName(rpc)
+				result[rpc] = loadShedder.Limiter(
+					middleware.requestsDroppedMetric.With(prometheus.Labels{
+						"system":       "gitaly",
+						"grpc_service": serviceName,
+						"grpc_method":  methodName,
+						"reason":       "load",
+					}),
+				)
+			}
+		}
+
+		middleware.methodLimiters = result
+	}
+}
+
 func splitMethodName(fullMethodName string) (string, string) {
 	fullMethodName = strings.TrimPrefix(fullMethodName, "/") // remove leading slash
 	service, method, ok := strings.Cut(fullMethodName, "/")
@@ -752,6 +752,74 @@ gitaly_requests_dropped_total{grpc_method="UnaryCall",grpc_service="grpc.testing
 	<-respCh
 }
 
+func TestLoadSheddingHandler(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+
+	cfg := config.Cfg{
+		LoadShedding: config.LoadShedding{
+			Enabled: true,
+			RPCs:    []string{"/grpc.testing.TestService/UnaryCall"},
+		},
+	}
+
+	loadShedder := limiter.NewLoadShedder(time.Second, 100*time.Millisecond, 90)
+
+	s := &server{blockCh: make(chan struct{})}
+	close(s.blockCh)
+
+	lh := limithandler.New(cfg, fixedLockKey, limithandler.WithLoadShedding(loadShedder))
+	srv, serverSocketPath := runServer(t, s, grpc.UnaryInterceptor(lh.UnaryInterceptor()))
+	defer srv.Stop()
+
+	client, conn := newClient(t, serverSocketPath)
+	defer testhelper.MustClose(t, conn)
+
+	// Requests are served as long as the server is not overloaded.
+	_, err := client.UnaryCall(ctx, &grpc_testing.SimpleRequest{})
+	require.NoError(t, err)
+
+	// Keep on dropping requests in the background so that every interval is considered to be
+	// overloaded.
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	defer func() {
+		close(stop)
+		<-done
+	}()
+	go func() {
+		defer close(done)
+		for {
+			select {
+			case <-stop:
+				return
+			case <-time.After(time.Millisecond):
+				loadShedder.Dropped(ctx, "fixed-id", 1, 1, 0, "max_size")
+			}
+		}
+	}()
+
+	require.Eventually(t, loadShedder.Overloaded, 10*time.Second, 10*time.Millisecond)
+
+	_, err = client.UnaryCall(ctx, &grpc_testing.SimpleRequest{})
+	testhelper.RequireGrpcError(t, structerr.NewResourceExhausted("%w", limiter.ErrLoadShed).WithDetail(
+		&gitalypb.LimitError{
+			ErrorMessage: limiter.ErrLoadShed.Error(),
+			RetryAfter:   durationpb.New(100 * time.Millisecond),
+		},
+	), err)
+
+	// RPCs that are not low-priority are never shed and thus reach the server.
+	_, err = client.EmptyCall(ctx, &grpc_testing.Empty{})
+	testhelper.RequireGrpcCode(t, err, codes.Unimplemented)
+
+	require.NoError(t, promtest.CollectAndCompare(lh, bytes.NewBufferString(`# HELP gitaly_requests_dropped_total Number of requests dropped from the queue
+# TYPE gitaly_requests_dropped_total counter
+gitaly_requests_dropped_total{grpc_method="UnaryCall",grpc_service="grpc.testing.TestService",reason="load",system="gitaly"} 1
+`), "gitaly_requests_dropped_total"))
+}
+
 func TestRateLimitHandler(t *testing.T) {
 	t.Parallel()
 
@@ -52,6 +52,7 @@ type requestInfo struct {
 	repository  *gitalypb.Repository
 	objectPool  *gitalypb.ObjectPool
 	storageName string
+	glID        string
 }
 
 // Unknown client and feature. Matches the prometheus grpc unknown value
@@ -167,6 +168,14 @@ func (i *requestInfo) extractRequestInfo(request any) {
 		GetStorageName() string
 	}
 
+	type userScopedRequest interface {
+		GetUser() *gitalypb.User
+	}
+
+	type glIDScopedRequest interface {
+		GetGlId() string
+	}
+
 	if repoScoped, ok := request.(repoScopedRequest); ok {
 		i.repository = repoScoped.GetRepository()
 	}
@@ -178,6 +187,12 @@ func (i *requestInfo) extractRequestInfo(request any) {
 	if storageScoped, ok := request.(storageScopedRequest); ok {
 		i.storageName = storageScoped.GetStorageName()
 	}
+
+	if userScoped, ok := request.(userScopedRequest); ok {
+		i.glID = userScoped.GetUser().GetGlId()
+	} else if glIDScoped, ok := request.(glIDScopedRequest); ok {
+		i.glID = glIDScoped.GetGlId()
+	}
 }
 
 func (i *requestInfo) injectTags(tags grpcmwtags.Tags) {
@@ -191,6 +206,7 @@ func (i *requestInfo) injectTags(tags grpcmwtags.Tags) {
 		"grpc.meta.method_scope":     i.methodScope,
 		"grpc.request.fullMethod":    i.fullMethod,
 		"grpc.request.StorageName":   i.storageName,
+		"grpc.request.glID":          i.glID,
 		"remote_ip":                  i.remoteIP,
 		"user_id":                    i.userID,
 		"username":                   i.userName,
@@ -285,6 +285,58 @@ func TestGRPCTags(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestExtractRequestInfo_GlID(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		desc         string
+		request      any
+		expectedGlID string
+	}{
+		{
+			desc:    "request without user",
+			request: &gitalypb.RepositoryInfoRequest{},
+		},
+		{
+			desc: "request with user",
+			request: &gitalypb.UserCreateBranchRequest{
+				User: &gitalypb.User{GlId: "user-1"},
+			},
+			expectedGlID: "user-1",
+		},
+		{
+			desc:    "request with unset user",
+			request: &gitalypb.UserCreateBranchRequest{},
+		},
+		{
+			desc: "request with GlId",
+			request: &gitalypb.SSHReceivePackRequest{
+				GlId: "user-2",
+			},
+			expectedGlID: "user-2",
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			var info requestInfo
+			info.extractRequestInfo(tc.request)
+			require.Equal(t, tc.expectedGlID, info.glID)
+
+			tags := grpcmwtags.NewTags()
+			info.injectTags(tags)
+
+			glID, ok := tags.Values()["grpc.request.glID"]
+			require.Equal(t, tc.expectedGlID != "", ok)
+			if ok {
+				require.Equal(t, tc.expectedGlID, glID)
+			}
+		})
+	}
+}
+
 func TestExtractServiceAndMethodName(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,228 @@
+package limiter
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/structerr"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/tracing"
+	"gitlab.com/gitlab-org/gitaly/v16/proto/go/gitalypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// ErrLoadShed is returned when a request has been rejected because the server is overloaded.
+var ErrLoadShed = errors.New("server is overloaded")
+
+// maxLoadShedderSamples is the maximum number of queue wait times kept per interval. Further samples
+// replace existing ones at random positions so that the percentile stays representative while the
+// memory used by the load shedder stays bounded.
+const maxLoadShedderSamples = 10000
+
+// droppedQueueWait is the queue wait time recorded for requests that have been dropped by a
+// concurrency limiter. Dropped requests never got to run, so they are treated as if they had waited
+// forever.
+const droppedQueueWait = time.Duration(math.MaxInt64)
+
+// LoadShedder detects server-wide overload from the time requests spend waiting in the queues of
+// concurrency limiters. Similar to CoDel, queue wait times are aggregated over an interval. If the
+// configured percentile of queue wait times exceeds the target for a whole interval, the server is
+// considered to be overloaded until the percentile of a later interval has dropped below the target
+// again.
+//
+// The LoadShedder implements ConcurrencyMonitor so that it can observe concurrency limiters, and
+// hands out limiters via Limiter that reject requests while the server is overloaded.
+type LoadShedder struct {
+	now        func() time.Time
+	target     time.Duration
+	interval   time.Duration
+	percentile float64
+
+	mu            sync.Mutex
+	intervalStart time.Time
+	samples       []time.Duration
+	observed      uint64
+	overloaded    bool
+	queueWait     time.Duration
+
+	overloadedDesc *prometheus.Desc
+	queueWaitDesc  *prometheus.Desc
+}
+
+// NewLoadShedder creates a new LoadShedder. The server is considered to be overloaded when the
+// given percentile of queue wait times exceeds the target over an interval.
+func NewLoadShedder(target, interval time.Duration, percentile float64) *LoadShedder {
+	return &LoadShedder{
+		now:        time.Now,
+		target:     target,
+		interval:   interval,
+		percentile: percentile,
+		overloadedDesc: prometheus.NewDesc(
+			"gitaly_load_shedding_overloaded",
+			"Whether the server is considered to be overloaded and sheds low-priority requests",
+			nil, nil,
+		),
+		queueWaitDesc: prometheus.NewDesc(
+			"gitaly_load_shedding_queue_wait_seconds",
+			"Percentile of queue wait times over the last completed load shedding interval",
+			nil, nil,
+		),
+	}
+}
+
+// Observe records the time a request has spent waiting in the queue of a concurrency limiter.
+func (l *LoadShedder) Observe(queueWait time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.advance()
+
+	l.observed++
+	if len(l.samples) < maxLoadShedderSamples {
+		l.samples = append(l.samples, queueWait)
+		return
+	}
+
+	// Reservoir sampling: every observation of this interval has the same probability of being
+	// part of the samples.
+	if i := rand.Uint64() % l.observed; i < maxLoadShedderSamples {
+		l.samples[i] = queueWait
+	}
+}
+
+// Overloaded returns whether the server is currently considered to be overloaded.
+func (l *LoadShedder) Overloaded() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.advance()
+
+	return l.overloaded
+}
+
+// advance evaluates the samples of the current interval if it has ended. Must be called with the
+// lock held.
+func (l *LoadShedder) advance() {
+	now := l.now()
+	if l.intervalStart.IsZero() {
+		l.intervalStart = now
+		return
+	}
+
+	elapsed := now.Sub(l.intervalStart)
+	if elapsed < l.interval {
+		return
+	}
+
+	l.queueWait = 0
+	if len(l.samples) > 0 {
+		sort.Slice(l.samples, func(i, j int) bool {
+			return l.samples[i] < l.samples[j]
+		})
+
+		index := int(math.Ceil(l.percentile/100*float64(len(l.samples)))) - 1
+		if index < 0 {
+			index = 0
+		}
+
+		l.queueWait = l.samples[index]
+	}
+
+	// An interval without any queued requests means that there is no pressure on the limiters.
+	// The same is true when more than a single interval has passed since the last observation.
+	l.overloaded = l.queueWait > l.target && elapsed < 2*l.interval
+
+	l.intervalStart = now.Add(-elapsed % l.interval)
+	l.samples = l.samples[:0]
+	l.observed = 0
+}
+
+// Queued is called when a request has been queued.
+func (l *LoadShedder) Queued(context.Context, string, int) {}
+
+// Dequeued is called when a request has been dequeued.
+func (l *LoadShedder) Dequeued(context.Context) {}
+
+// Enter is called when a request begins to be processed. The time the request has spent waiting
+// for its turn is recorded.
+func (l *LoadShedder) Enter(_ context.Context, _ int, acquireTime time.Duration) {
+	l.Observe(acquireTime)
+}
+
+// Exit is called when a request has finished processing.
+func (l *LoadShedder) Exit(context.Context) {}
+
+// Dropped is called when a request is dropped. Dropped requests are recorded as if they had
+// exceeded any target queue wait time.
+func (l *LoadShedder) Dropped(context.Context, string, int, int, time.Duration, string) {
+	l.Observe(droppedQueueWait)
+}
+
+// Limiter returns a Limiter that rejects all requests while the server is overloaded. Rejected
+// requests are counted with requestsDroppedMetric.
+func (l *LoadShedder) Limiter(requestsDroppedMetric prometheus.Counter) Limiter {
+	return &loadShedLimiter{
+		loadShedder:           l,
+		requestsDroppedMetric: requestsDroppedMetric,
+	}
+}
+
+// Describe is used to describe Prometheus metrics.
+func (l *LoadShedder) Describe(descs chan<- *prometheus.Desc) {
+	prometheus.DescribeByCollect(l, descs)
+}
+
+// Collect is used to collect Prometheus metrics.
+func (l *LoadShedder) Collect(metrics chan<- prometheus.Metric) {
+	l.mu.Lock()
+	l.advance()
+	overloaded, queueWait := l.overloaded, l.queueWait
+	l.mu.Unlock()
+
+	var overloadedValue float64
+	if overloaded {
+		overloadedValue = 1
+	}
+
+	queueWaitSeconds := queueWait.Seconds()
+	if queueWait == droppedQueueWait {
+		queueWaitSeconds = math.Inf(1)
+	}
+
+	metrics <- prometheus.MustNewConstMetric(l.overloadedDesc, prometheus.GaugeValue, overloadedValue)
+	metrics <- prometheus.MustNewConstMetric(l.queueWaitDesc, prometheus.GaugeValue, queueWaitSeconds)
+}
+
+type loadShedLimiter struct {
+	loadShedder           *LoadShedder
+	requestsDroppedMetric prometheus.Counter
+}
+
+// Limit rejects the request if the server is overloaded. Rejected requests are asked to retry after
+// one interval, which is the earliest point in time the server may have recovered.
+func (l *loadShedLimiter) Limit(ctx context.Context, lockKey string, f LimitedFunc) (interface{}, error) {
+	span, _ := tracing.StartSpanIfHasParent(
+		ctx,
+		"limiter.LoadShedLimiter.Limit",
+		tracing.Tags{"key": lockKey},
+	)
+	defer span.Finish()
+
+	if l.loadShedder.Overloaded() {
+		l.requestsDroppedMetric.Inc()
+
+		return nil, structerr.NewResourceExhausted("%w", ErrLoadShed).WithDetail(
+			&gitalypb.LimitError{
+				ErrorMessage: ErrLoadShed.Error(),
+				RetryAfter:   durationpb.New(l.loadShedder.interval),
+			},
+		)
+	}
+
+	return f()
+}
@@ -0,0 +1,139 @@
+package limiter
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/structerr"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
+	"gitlab.com/gitlab-org/gitaly/v16/proto/go/gitalypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func newTestLoadShedder(now *time.Time) *LoadShedder {
+	loadShedder := NewLoadShedder(time.Second, 10*time.Second, 90)
+	loadShedder.now = func() time.Time {
+		return *now
+	}
+	return loadShedder
+}
+
+func TestLoadShedder_Overloaded(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	loadShedder := newTestLoadShedder(&now)
+
+	// The first call starts the first interval.
+	require.False(t, loadShedder.Overloaded())
+
+	// A single slow request is not sufficient to consider the server overloaded as the 90th
+	// percentile is still below the target.
+	for i := 0; i < 9; i++ {
+		loadShedder.Enter(ctx, 1, 100*time.Millisecond)
+	}
+	loadShedder.Enter(ctx, 1, time.Minute)
+
+	now = now.Add(10 * time.Second)
+	require.False(t, loadShedder.Overloaded())
+
+	// The state only changes once a whole interval has passed.
+	for i := 0; i < 10; i++ {
+		loadShedder.Enter(ctx, 1, 2*time.Second)
+	}
+	require.False(t, loadShedder.Overloaded())
+
+	now = now.Add(10 * time.Second)
+	require.True(t, loadShedder.Overloaded())
+
+	// Dropped requests count as exceeding the target.
+	loadShedder.Dropped(ctx, "key", 1, 1, 0, "max_size")
+
+	now = now.Add(10 * time.Second)
+	require.True(t, loadShedder.Overloaded())
+
+	// The server recovers once queue wait times are back below the target.
+	loadShedder.Enter(ctx, 1, 500*time.Millisecond)
+
+	now = now.Add(10 * time.Second)
+	require.False(t, loadShedder.Overloaded())
+}
+
+func TestLoadShedder_Overloaded_idle(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	loadShedder := newTestLoadShedder(&now)
+
+	loadShedder.Enter(ctx, 1, time.Minute)
+
+	now = now.Add(10 * time.Second)
+	require.True(t, loadShedder.Overloaded())
+
+	// An interval without any queued requests means the pressure is gone.
+	now = now.Add(10 * time.Second)
+	require.False(t, loadShedder.Overloaded())
+
+	// Observations that are older than a single interval are not considered anymore.
+	loadShedder.Enter(ctx, 1, time.Minute)
+
+	now = now.Add(time.Hour)
+	require.False(t, loadShedder.Overloaded())
+}
+
+func TestLoadShedder_Limiter(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	loadShedder := newTestLoadShedder(&now)
+	droppedMetric := prometheus.NewCounter(prometheus.CounterOpts{Name: "dropped"})
+	limiter := loadShedder.Limiter(droppedMetric)
+
+	limitedFunc := func() (interface{}, error) {
+		return "result", nil
+	}
+
+	result, err := limiter.Limit(ctx, "key", limitedFunc)
+	require.NoError(t, err)
+	require.Equal(t, "result", result)
+
+	loadShedder.Enter(ctx, 1, time.Minute)
+	now = now.Add(10 * time.Second)
+
+	result, err = limiter.Limit(ctx, "key", limitedFunc)
+	testhelper.RequireGrpcError(t, structerr.NewResourceExhausted("%w", ErrLoadShed).WithDetail(
+		&gitalypb.LimitError{
+			ErrorMessage: ErrLoadShed.Error(),
+			RetryAfter:   durationpb.New(10 * time.Second),
+		},
+	), err)
+	require.Nil(t, result)
+	require.Equal(t, 1.0, testutil.ToFloat64(droppedMetric))
+}
+
+func TestLoadShedder_Collect(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	loadShedder := newTestLoadShedder(&now)
+
+	loadShedder.Enter(ctx, 1, 1500*time.Millisecond)
+	now = now.Add(10 * time.Second)
+
+	require.NoError(t, testutil.CollectAndCompare(loadShedder, strings.NewReader(`
+# HELP gitaly_load_shedding_overloaded Whether the server is considered to be overloaded and sheds low-priority requests
+# TYPE gitaly_load_shedding_overloaded gauge
+gitaly_load_shedding_overloaded 1
+# HELP gitaly_load_shedding_queue_wait_seconds Percentile of queue wait times over the last completed load shedding interval
+# TYPE gitaly_load_shedding_queue_wait_seconds gauge
+gitaly_load_shedding_queue_wait_seconds 1.5
+`)))
+}
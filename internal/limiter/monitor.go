@@ -31,6 +31,43 @@ func NewNoopConcurrencyMonitor() ConcurrencyMonitor {
 	return &noopConcurrencyMonitor{}
 }
 
+type multiConcurrencyMonitor []ConcurrencyMonitor
+
+// NewMultiConcurrencyMonitor returns a ConcurrencyMonitor that notifies all of the given monitors.
+func NewMultiConcurrencyMonitor(monitors ...ConcurrencyMonitor) ConcurrencyMonitor {
+	return multiConcurrencyMonitor(monitors)
+}
+
+func (m multiConcurrencyMonitor) Queued(ctx context.Context, key string, length int) {
+	for _, monitor := range m {
+		monitor.Queued(ctx, key, length)
+	}
+}
+
+func (m multiConcurrencyMonitor) Dequeued(ctx context.Context) {
+	for _, monitor := range m {
+		monitor.Dequeued(ctx)
+	}
+}
+
+func (m multiConcurrencyMonitor) Enter(ctx context.Context, inProgress int, acquireTime time.Duration) {
+	for _, monitor := range m {
+		monitor.Enter(ctx, inProgress, acquireTime)
+	}
+}
+
+func (m multiConcurrencyMonitor) Exit(ctx context.Context) {
+	for _, monitor := range m {
+		monitor.Exit(ctx)
+	}
+}
+
+func (m multiConcurrencyMonitor) Dropped(ctx context.Context, key string, queueLength int, inProgress int, acquireTime time.Duration, message string) {
+	for _, monitor := range m {
+		monitor.Dropped(ctx, key, queueLength, inProgress, acquireTime, message)
+	}
+}
+
 // PromMonitor keeps track of prometheus metrics for limiters.
 // It conforms to both the ConcurrencyMonitor, and prometheus.Collector
 // interfaces.
@@ -8,6 +8,7 @@ import (
 	"io"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"sort"
 	"time"
 
@@ -44,10 +45,35 @@ const (
 	// ElectionStrategyPerRepository configures an SQL based strategy that elects different primaries per repository.
 	ElectionStrategyPerRepository ElectionStrategy = "per_repository"
 
+	// ReplicaSelectionRandom routes reads to a random up-to-date replica.
+	ReplicaSelectionRandom ReplicaSelection = "random"
+	// ReplicaSelectionLeastOutstanding routes reads to the up-to-date replica with the fewest
+	// requests in flight.
+	ReplicaSelectionLeastOutstanding ReplicaSelection = "least_outstanding"
+	// ReplicaSelectionEWMA routes reads to the up-to-date replica with the lowest exponentially
+	// weighted moving average of request latencies, weighted by its requests in flight.
+	ReplicaSelectionEWMA ReplicaSelection = "ewma"
+	// ReplicaSelectionPowerOfTwoChoices routes reads to the less loaded of two randomly chosen
+	// up-to-date replicas.
+	ReplicaSelectionPowerOfTwoChoices ReplicaSelection = "power_of_two_choices"
+
 	minimalSyncCheckInterval = time.Minute
 	minimalSyncRunInterval   = time.Minute
 )
 
+// ReplicaSelection is a strategy for selecting the replica that serves a read.
+type ReplicaSelection string
+
+// validate validates the replica selection is a valid one.
+func (rs ReplicaSelection) validate() error {
+	switch rs {
+	case "", ReplicaSelectionRandom, ReplicaSelectionLeastOutstanding, ReplicaSelectionEWMA, ReplicaSelectionPowerOfTwoChoices:
+		return nil
+	default:
+		return fmt.Errorf("invalid replica selection: %q", rs)
+	}
+}
+
 // Failover contains configuration for the mechanism that tracks healthiness of the cluster nodes.
 type Failover struct {
 	// Enabled is a trigger used to check if failover is enabled or not.
@@ -128,6 +154,10 @@ type BackgroundVerification struct {
 	// DeleteInvalidRecords controls whether the background verifier will actually delete the metadata
 	// records that point to non-existent replicas.
 	DeleteInvalidRecords bool `toml:"delete_invalid_records" json:"delete_invalid_records"`
+	// VerifyChecksums enables deep verification of the replicas. In addition to checking the
+	// replica exists, the verifier compares the replica's checksum against the primary's and
+	// schedules a repair for replicas that have silently diverged.
+	VerifyChecksums bool `toml:"verify_checksums,omitempty" json:"verify_checksums"`
 }
 
 // Validate runs validation on all fields and compose all found errors.
@@ -152,6 +182,22 @@ type Reconciliation struct {
 	SchedulingInterval duration.Duration `toml:"scheduling_interval,omitempty" json:"scheduling_interval"`
 	// HistogramBuckets configures the reconciliation scheduling duration histogram's buckets.
 	HistogramBuckets []float64 `toml:"histogram_buckets,omitempty" json:"histogram_buckets"`
+	// MaxAssignmentMoves is the maximum number of assignments moved in a single run to spread
+	// the replicas of repositories across failure domains.
+	MaxAssignmentMoves uint `toml:"max_assignment_moves,omitempty" json:"max_assignment_moves"`
+}
+
+// validate validates the reconciliation configuration if automatic reconciliation is enabled.
+func (r Reconciliation) validate() error {
+	if r.SchedulingInterval.Duration() == 0 {
+		return nil
+	}
+
+	if r.MaxAssignmentMoves < 1 {
+		return fmt.Errorf("reconciliation.max_assignment_moves must be at least 1 but it was %d", r.MaxAssignmentMoves)
+	}
+
+	return nil
 }
 
 // Validate runs validation on all fields and compose all found errors.
@@ -163,6 +209,8 @@ func (r Reconciliation) Validate() error {
 		if !sort.Float64sAreSorted(r.HistogramBuckets) {
 			errs = errs.Append(cfgerror.ErrBadOrder, "histogram_buckets")
 		}
+
+		errs = errs.Append(cfgerror.Comparable(r.MaxAssignmentMoves).GreaterOrEqual(1), "max_assignment_moves")
 	}
 
 	return errs.AsError()
@@ -173,9 +221,185 @@ func DefaultReconciliationConfig() Reconciliation {
 	return Reconciliation{
 		SchedulingInterval: 5 * duration.Duration(time.Minute),
 		HistogramBuckets:   promclient.DefBuckets,
+		MaxAssignmentMoves: 100,
+	}
+}
+
+// RebalanceStrategy determines how the load of a storage is measured when rebalancing assignments.
+type RebalanceStrategy string
+
+const (
+	// RebalanceStrategyRepositoryCount balances the number of repositories assigned to each
+	// storage.
+	RebalanceStrategyRepositoryCount RebalanceStrategy = "repository_count"
+	// RebalanceStrategyRepositorySize balances the on-disk size of the repositories assigned to
+	// each storage.
+	RebalanceStrategyRepositorySize RebalanceStrategy = "repository_size"
+)
+
+// validate validates the rebalance strategy is a valid one.
+func (rs RebalanceStrategy) validate() error {
+	switch rs {
+	case RebalanceStrategyRepositoryCount, RebalanceStrategyRepositorySize:
+		return nil
+	default:
+		return fmt.Errorf("invalid rebalance strategy: %q", rs)
+	}
+}
+
+// Rebalancing contains configuration options for moving repository assignments between the
+// storages of a virtual storage.
+type Rebalancing struct {
+	// RunInterval is the interval between background rebalancing runs. If set to 0, background
+	// rebalancing is disabled.
+	RunInterval duration.Duration `toml:"run_interval,omitempty" json:"run_interval"`
+	// Strategy determines how the load of a storage is measured.
+	Strategy RebalanceStrategy `toml:"strategy,omitempty" json:"strategy"`
+	// MaxMoves is the maximum number of assignments moved in a single run.
+	MaxMoves uint `toml:"max_moves,omitempty" json:"max_moves"`
+}
+
+// validate validates the rebalancing configuration if background rebalancing is enabled.
+func (r Rebalancing) validate() error {
+	if r.RunInterval.Duration() == 0 {
+		return nil
+	}
+
+	if err := r.Strategy.validate(); err != nil {
+		return fmt.Errorf("rebalancing: %w", err)
+	}
+
+	if r.MaxMoves < 1 {
+		return fmt.Errorf("rebalancing.max_moves must be at least 1 but it was %d", r.MaxMoves)
+	}
+
+	return nil
+}
+
+// Validate runs validation on all fields and compose all found errors.
+func (r Rebalancing) Validate() error {
+	errs := cfgerror.New().
+		Append(cfgerror.Comparable(r.RunInterval.Duration()).GreaterOrEqual(0), "run_interval")
+
+	if r.RunInterval != 0 {
+		errs = errs.
+			Append(cfgerror.IsSupportedValue(r.Strategy, RebalanceStrategyRepositoryCount, RebalanceStrategyRepositorySize), "strategy").
+			Append(cfgerror.Comparable(r.MaxMoves).GreaterOrEqual(1), "max_moves")
+	}
+
+	return errs.AsError()
+}
+
+// DefaultRebalancingConfig returns the default values for rebalancing configuration.
+func DefaultRebalancingConfig() Rebalancing {
+	return Rebalancing{
+		Strategy: RebalanceStrategyRepositoryCount,
+		MaxMoves: 10,
+	}
+}
+
+// DatalossRecoveryMode determines what Praefect does once it has evaluated how to recover an
+// unavailable repository.
+type DatalossRecoveryMode string
+
+const (
+	// DatalossRecoveryModeRecommend only records and logs the replica recommended to be accepted as
+	// the authoritative one. An administrator has to accept the data loss with `accept-dataloss`.
+	DatalossRecoveryModeRecommend DatalossRecoveryMode = "recommend"
+	// DatalossRecoveryModeAutomatic accepts the data loss automatically by promoting the recommended
+	// replica if the recommendation is within the limits of the policy. Otherwise the recommendation
+	// is only recorded and logged.
+	DatalossRecoveryModeAutomatic DatalossRecoveryMode = "automatic"
+)
+
+// DatalossRecovery contains configuration options for recovering repositories which are unavailable
+// as none of their up to date replicas are available.
+type DatalossRecovery struct {
+	// RunInterval is the interval between dataloss recovery runs. If set to 0, dataloss recovery is
+	// disabled.
+	RunInterval duration.Duration `toml:"run_interval,omitempty" json:"run_interval"`
+	// UnavailabilityThreshold is how long a repository has to be unavailable before its remaining
+	// replicas are evaluated.
+	UnavailabilityThreshold duration.Duration `toml:"unavailability_threshold,omitempty" json:"unavailability_threshold"`
+	// Mode determines whether the recommended replica is promoted automatically.
+	Mode DatalossRecoveryMode `toml:"mode,omitempty" json:"mode"`
+	// MaxGenerationGap is the maximum number of generations the recommended replica may be behind
+	// the repository's latest generation for it to be promoted automatically.
+	MaxGenerationGap uint `toml:"max_generation_gap,omitempty" json:"max_generation_gap"`
+}
+
+// Validate runs validation on all fields and compose all found errors.
+func (r DatalossRecovery) Validate() error {
+	errs := cfgerror.New().
+		Append(cfgerror.Comparable(r.RunInterval.Duration()).GreaterOrEqual(0), "run_interval")
+
+	if r.RunInterval != 0 {
+		errs = errs.
+			Append(cfgerror.Comparable(r.UnavailabilityThreshold.Duration()).GreaterOrEqual(0), "unavailability_threshold").
+			Append(cfgerror.IsSupportedValue(r.Mode, DatalossRecoveryModeRecommend, DatalossRecoveryModeAutomatic), "mode")
+	}
+
+	return errs.AsError()
+}
+
+// DefaultDatalossRecoveryConfig returns the default values for dataloss recovery configuration.
+func DefaultDatalossRecoveryConfig() DatalossRecovery {
+	return DatalossRecovery{
+		UnavailabilityThreshold: duration.Duration(time.Hour),
+		Mode:                    DatalossRecoveryModeRecommend,
+		MaxGenerationGap:        1,
 	}
 }
 
+// AuditLog contains configuration options for the repository audit log.
+type AuditLog struct {
+	// Retention is how long the entries are kept in the audit log. Older entries are purged
+	// periodically. If set to 0, the entries are kept forever.
+	Retention duration.Duration `toml:"retention,omitempty" json:"retention"`
+	// LogChanges enables logging every entry added to the audit log. Every Praefect logs every
+	// entry, so the log volume grows with the number of Praefects.
+	LogChanges bool `toml:"log_changes,omitempty" json:"log_changes"`
+}
+
+// Validate runs validation on all fields and compose all found errors.
+func (a AuditLog) Validate() error {
+	return cfgerror.New().
+		Append(cfgerror.Comparable(a.Retention.Duration()).GreaterOrEqual(0), "retention").
+		AsError()
+}
+
+// DefaultAuditLogConfig returns the default values for the audit log configuration.
+func DefaultAuditLogConfig() AuditLog {
+	return AuditLog{
+		Retention: duration.Duration(30 * 24 * time.Hour),
+	}
+}
+
+// EmbeddedDB configures the embedded database Praefect stores its metadata in instead of
+// PostgreSQL. The embedded database can only be used by a single Praefect and thus is meant for small
+// installations and test environments.
+type EmbeddedDB struct {
+	// Path is the path of the directory the database is stored in. If not set, the embedded
+	// database is disabled.
+	Path string `toml:"path,omitempty" json:"path"`
+}
+
+// Enabled returns whether the embedded database is configured.
+func (db EmbeddedDB) Enabled() bool {
+	return db.Path != ""
+}
+
+// Validate runs validation on all fields and compose all found errors.
+func (db EmbeddedDB) Validate() error {
+	if !db.Enabled() {
+		return nil
+	}
+
+	return cfgerror.New().
+		Append(cfgerror.PathIsAbs(db.Path), "path").
+		AsError()
+}
+
 // Replication contains replication specific configuration options.
 type Replication struct {
 	// BatchSize controls how many replication jobs to dequeue and lock
@@ -209,6 +433,9 @@ type Config struct {
 	AllowLegacyElectors    bool                   `toml:"i_understand_my_election_strategy_is_unsupported_and_will_be_removed_without_warning,omitempty" json:"i_understand_my_election_strategy_is_unsupported_and_will_be_removed_without_warning"`
 	BackgroundVerification BackgroundVerification `toml:"background_verification,omitempty" json:"background_verification"`
 	Reconciliation         Reconciliation         `toml:"reconciliation,omitempty" json:"reconciliation"`
+	Rebalancing            Rebalancing            `toml:"rebalancing,omitempty" json:"rebalancing"`
+	DatalossRecovery       DatalossRecovery       `toml:"dataloss_recovery,omitempty" json:"dataloss_recovery"`
+	AuditLog               AuditLog               `toml:"audit_log,omitempty" json:"audit_log"`
 	Replication            Replication            `toml:"replication,omitempty" json:"replication"`
 	ListenAddr             string                 `toml:"listen_addr,omitempty" json:"listen_addr"`
 	TLSListenAddr          string                 `toml:"tls_listen_addr,omitempty" json:"tls_listen_addr"`
@@ -221,6 +448,7 @@ type Config struct {
 	Auth                   auth.Config            `toml:"auth,omitempty" json:"auth"`
 	TLS                    config.TLS             `toml:"tls,omitempty" json:"tls"`
 	DB                     `toml:"database,omitempty" json:"database"`
+	EmbeddedDB             EmbeddedDB          `toml:"embedded_database,omitempty" json:"embedded_database"`
 	Failover               Failover            `toml:"failover,omitempty" json:"failover"`
 	MemoryQueueEnabled     bool                `toml:"memory_queue_enabled,omitempty" json:"memory_queue_enabled"`
 	GracefulStopTimeout    duration.Duration   `toml:"graceful_stop_timeout,omitempty" json:"graceful_stop_timeout"`
@@ -279,13 +507,24 @@ type VirtualStorage struct {
 	// host assignments, falling back to the behavior of replicating to every configured
 	// storage
 	DefaultReplicationFactor int `toml:"default_replication_factor,omitempty" json:"default_replication_factor"`
+	// ReplicaSelection is the strategy used to select the replica that serves a read. Reads
+	// are routed to a random up-to-date replica by default.
+	ReplicaSelection ReplicaSelection `toml:"replica_selection,omitempty" json:"replica_selection,omitempty"`
 }
 
 // Validate runs validation on all fields and compose all found errors.
 func (vs VirtualStorage) Validate() error {
 	errs := cfgerror.New().
 		Append(cfgerror.NotBlank(vs.Name), "name").
-		Append(cfgerror.NotEmptySlice(vs.Nodes), "node")
+		Append(cfgerror.NotEmptySlice(vs.Nodes), "node").
+		Append(cfgerror.IsSupportedValue(
+			vs.ReplicaSelection,
+			"",
+			ReplicaSelectionRandom,
+			ReplicaSelectionLeastOutstanding,
+			ReplicaSelectionEWMA,
+			ReplicaSelectionPowerOfTwoChoices,
+		), "replica_selection")
 
 	for i, node := range vs.Nodes {
 		errs = errs.Append(node.Validate(), "node", fmt.Sprintf("[%d]", i))
@@ -309,6 +548,9 @@ func FromReader(reader io.Reader) (Config, error) {
 	conf := &Config{
 		BackgroundVerification: DefaultBackgroundVerificationConfig(),
 		Reconciliation:         DefaultReconciliationConfig(),
+		Rebalancing:            DefaultRebalancingConfig(),
+		DatalossRecovery:       DefaultDatalossRecoveryConfig(),
+		AuditLog:               DefaultAuditLogConfig(),
 		Replication:            DefaultReplicationConfig(),
 		Prometheus:             prometheus.DefaultConfig(),
 		// Sets the default Failover, to be overwritten when deserializing the TOML
@@ -408,6 +650,10 @@ func (c *Config) Validate() error {
 				virtualStorage.Name, virtualStorage.DefaultReplicationFactor, len(virtualStorage.Nodes),
 			)
 		}
+
+		if err := virtualStorage.ReplicaSelection.validate(); err != nil {
+			return fmt.Errorf("virtual storage %q: %w", virtualStorage.Name, err)
+		}
 	}
 
 	if c.RepositoriesCleanup.RunInterval.Duration() > 0 {
@@ -419,6 +665,18 @@ func (c *Config) Validate() error {
 		}
 	}
 
+	if err := c.Reconciliation.validate(); err != nil {
+		return err
+	}
+
+	if err := c.Rebalancing.validate(); err != nil {
+		return err
+	}
+
+	if err := c.validateEmbeddedDB(); err != nil {
+		return err
+	}
+
 	if err := c.Yamux.validate(); err != nil {
 		return err
 	}
@@ -439,6 +697,10 @@ func (c *Config) ValidateV2() error {
 		}()).
 		Append(c.BackgroundVerification.Validate(), "background_verification").
 		Append(c.Reconciliation.Validate(), "reconciliation").
+		Append(c.Rebalancing.Validate(), "rebalancing").
+		Append(c.DatalossRecovery.Validate(), "dataloss_recovery").
+		Append(c.AuditLog.Validate(), "audit_log").
+		Append(c.EmbeddedDB.Validate(), "embedded_database").
 		Append(c.Replication.Validate(), "replication").
 		Append(c.Prometheus.Validate(), "prometheus").
 		Append(c.TLS.Validate(), "tls").
@@ -455,8 +717,34 @@ func (c *Config) ValidateV2() error {
 	return errs.AsError()
 }
 
+// validateEmbeddedDB checks the embedded database is configured in a supported combination with the
+// other options.
+func (c *Config) validateEmbeddedDB() error {
+	if !c.EmbeddedDB.Enabled() {
+		return nil
+	}
+
+	if !filepath.IsAbs(c.EmbeddedDB.Path) {
+		return fmt.Errorf("embedded_database.path must be an absolute path but it was %q", c.EmbeddedDB.Path)
+	}
+
+	if c.MemoryQueueEnabled {
+		return errors.New("embedded_database can't be used together with memory_queue_enabled")
+	}
+
+	if c.Failover.ElectionStrategy != ElectionStrategyPerRepository {
+		return fmt.Errorf("embedded_database requires the %q election strategy", ElectionStrategyPerRepository)
+	}
+
+	return nil
+}
+
 // NeedsSQL returns true if the driver for SQL needs to be initialized
 func (c *Config) NeedsSQL() bool {
+	if c.EmbeddedDB.Enabled() {
+		return false
+	}
+
 	return !c.MemoryQueueEnabled || (c.Failover.Enabled && c.Failover.ElectionStrategy != ElectionStrategyLocal)
 }
 
@@ -511,6 +799,46 @@ func (c Config) DefaultReplicationFactors() map[string]int {
 	return replicationFactors
 }
 
+// ReplicaSelections returns a map with the replica selection strategies of the virtual storages.
+func (c Config) ReplicaSelections() map[string]ReplicaSelection {
+	replicaSelections := make(map[string]ReplicaSelection, len(c.VirtualStorages))
+	for _, vs := range c.VirtualStorages {
+		replicaSelections[vs.Name] = vs.ReplicaSelection
+	}
+
+	return replicaSelections
+}
+
+// FailureDomains maps the storages of each virtual storage to the zone they are located in.
+type FailureDomains map[string]map[string]string
+
+// FailureDomain returns the failure domain of a storage. Storages without a configured zone form a
+// failure domain of their own.
+func (fd FailureDomains) FailureDomain(virtualStorage, storage string) string {
+	if zone := fd[virtualStorage][storage]; zone != "" {
+		return zone
+	}
+
+	return storage
+}
+
+// FailureDomains returns the zones of the storages that have one configured.
+func (c Config) FailureDomains() FailureDomains {
+	failureDomains := make(FailureDomains, len(c.VirtualStorages))
+	for _, vs := range c.VirtualStorages {
+		zones := make(map[string]string, len(vs.Nodes))
+		for _, node := range vs.Nodes {
+			if node.Zone != "" {
+				zones[node.Storage] = node.Zone
+			}
+		}
+
+		failureDomains[vs.Name] = zones
+	}
+
+	return failureDomains
+}
+
 // DBConnection holds Postgres client configuration data.
 type DBConnection struct {
 	Host        string `toml:"host,omitempty" json:"host"`
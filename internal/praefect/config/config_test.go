@@ -197,6 +197,31 @@ func TestConfigValidation(t *testing.T) {
 			},
 			errMsg: `virtual storage "default" has a default replication factor (2) which is higher than the number of storages (1)`,
 		},
+		{
+			desc: "invalid replica selection",
+			changeConfig: func(cfg *Config) {
+				cfg.VirtualStorages = []*VirtualStorage{
+					{
+						Name:             "default",
+						ReplicaSelection: "ewmaa",
+						Nodes: []*Node{
+							{
+								Storage: "storage-1",
+								Address: "localhost:23456",
+							},
+						},
+					},
+				}
+			},
+			errMsg: `virtual storage "default": invalid replica selection: "ewmaa"`,
+		},
+		{
+			desc: "reconciliation without assignment moves",
+			changeConfig: func(cfg *Config) {
+				cfg.Reconciliation = Reconciliation{SchedulingInterval: duration.Duration(time.Minute)}
+			},
+			errMsg: "reconciliation.max_assignment_moves must be at least 1 but it was 0",
+		},
 		{
 			desc: "repositories_cleanup minimal duration is too low",
 			changeConfig: func(cfg *Config) {
@@ -211,6 +236,37 @@ func TestConfigValidation(t *testing.T) {
 			},
 			errMsg: `repositories_cleanup.run_interval is less then 1m0s, which could lead to a database performance problem`,
 		},
+		{
+			desc: "Valid config with embedded database",
+			changeConfig: func(cfg *Config) {
+				cfg.Failover.ElectionStrategy = ElectionStrategyPerRepository
+				cfg.EmbeddedDB.Path = "/var/opt/praefect/database"
+			},
+		},
+		{
+			desc: "embedded database with relative path",
+			changeConfig: func(cfg *Config) {
+				cfg.Failover.ElectionStrategy = ElectionStrategyPerRepository
+				cfg.EmbeddedDB.Path = "database"
+			},
+			errMsg: `embedded_database.path must be an absolute path but it was "database"`,
+		},
+		{
+			desc: "embedded database with memory queue",
+			changeConfig: func(cfg *Config) {
+				cfg.Failover.ElectionStrategy = ElectionStrategyPerRepository
+				cfg.EmbeddedDB.Path = "/var/opt/praefect/database"
+				cfg.MemoryQueueEnabled = true
+			},
+			errMsg: `embedded_database can't be used together with memory_queue_enabled`,
+		},
+		{
+			desc: "embedded database with legacy election strategy",
+			changeConfig: func(cfg *Config) {
+				cfg.EmbeddedDB.Path = "/var/opt/praefect/database"
+			},
+			errMsg: `embedded_database requires the "per_repository" election strategy`,
+		},
 		{
 			desc: "yamux.maximum_stream_window_size_bytes is too low",
 			changeConfig: func(cfg *Config) {
@@ -330,6 +386,22 @@ func TestConfigParsing(t *testing.T) {
 				Reconciliation: Reconciliation{
 					SchedulingInterval: duration.Duration(time.Minute),
 					HistogramBuckets:   []float64{1, 2, 3, 4, 5},
+					MaxAssignmentMoves: 50,
+				},
+				Rebalancing: Rebalancing{
+					RunInterval: duration.Duration(time.Hour),
+					Strategy:    RebalanceStrategyRepositorySize,
+					MaxMoves:    5,
+				},
+				DatalossRecovery: DatalossRecovery{
+					RunInterval:             duration.Duration(5 * time.Minute),
+					UnavailabilityThreshold: duration.Duration(30 * time.Minute),
+					Mode:                    DatalossRecoveryModeAutomatic,
+					MaxGenerationGap:        2,
+				},
+				AuditLog: AuditLog{
+					Retention:  duration.Duration(7 * 24 * time.Hour),
+					LogChanges: true,
 				},
 				Replication: Replication{BatchSize: 1, ParallelStorageProcessingWorkers: 2},
 				Failover: Failover{
@@ -349,6 +421,7 @@ func TestConfigParsing(t *testing.T) {
 				BackgroundVerification: BackgroundVerification{
 					VerificationInterval: duration.Duration(24 * time.Hour),
 					DeleteInvalidRecords: false,
+					VerifyChecksums:      true,
 				},
 				Yamux: Yamux{
 					MaximumStreamWindowSizeBytes: 1000,
@@ -364,9 +437,13 @@ func TestConfigParsing(t *testing.T) {
 				Reconciliation: Reconciliation{
 					SchedulingInterval: 0,
 					HistogramBuckets:   []float64{1, 2, 3, 4, 5},
+					MaxAssignmentMoves: 100,
 				},
-				Prometheus:  prometheus.DefaultConfig(),
-				Replication: Replication{BatchSize: 1, ParallelStorageProcessingWorkers: 2},
+				Rebalancing:      DefaultRebalancingConfig(),
+				DatalossRecovery: DefaultDatalossRecoveryConfig(),
+				AuditLog:         DefaultAuditLogConfig(),
+				Prometheus:       prometheus.DefaultConfig(),
+				Replication:      Replication{BatchSize: 1, ParallelStorageProcessingWorkers: 2},
 				Failover: Failover{
 					Enabled:           false,
 					ElectionStrategy:  "local",
@@ -389,6 +466,9 @@ func TestConfigParsing(t *testing.T) {
 				GracefulStopTimeout: duration.Duration(time.Minute),
 				Prometheus:          prometheus.DefaultConfig(),
 				Reconciliation:      DefaultReconciliationConfig(),
+				Rebalancing:         DefaultRebalancingConfig(),
+				DatalossRecovery:    DefaultDatalossRecoveryConfig(),
+				AuditLog:            DefaultAuditLogConfig(),
 				Replication:         DefaultReplicationConfig(),
 				Failover: Failover{
 					Enabled:           true,
@@ -471,6 +551,33 @@ func TestDefaultReplicationFactors(t *testing.T) {
 	}
 }
 
+func TestFailureDomains(t *testing.T) {
+	failureDomains := Config{VirtualStorages: []*VirtualStorage{
+		{
+			Name: "virtual-storage-1",
+			Nodes: []*Node{
+				{Storage: "gitaly-1", Zone: "zone-a"},
+				{Storage: "gitaly-2", Zone: "zone-b"},
+				{Storage: "gitaly-3"},
+			},
+		},
+		{
+			Name:  "virtual-storage-2",
+			Nodes: []*Node{{Storage: "gitaly-1"}},
+		},
+	}}.FailureDomains()
+
+	require.Equal(t, FailureDomains{
+		"virtual-storage-1": {"gitaly-1": "zone-a", "gitaly-2": "zone-b"},
+		"virtual-storage-2": {},
+	}, failureDomains)
+
+	require.Equal(t, "zone-a", failureDomains.FailureDomain("virtual-storage-1", "gitaly-1"))
+	require.Equal(t, "gitaly-3", failureDomains.FailureDomain("virtual-storage-1", "gitaly-3"))
+	require.Equal(t, "gitaly-1", failureDomains.FailureDomain("virtual-storage-2", "gitaly-1"))
+	require.Equal(t, "gitaly-1", FailureDomains(nil).FailureDomain("virtual-storage-1", "gitaly-1"))
+}
+
 func TestNeedsSQL(t *testing.T) {
 	testCases := []struct {
 		desc     string
@@ -487,6 +594,11 @@ func TestNeedsSQL(t *testing.T) {
 			config:   Config{MemoryQueueEnabled: true},
 			expected: false,
 		},
+		{
+			desc:     "Embedded database enabled",
+			config:   Config{EmbeddedDB: EmbeddedDB{Path: "/var/opt/praefect/database"}},
+			expected: false,
+		},
 		{
 			desc:     "Failover enabled with default election strategy",
 			config:   Config{Failover: Failover{Enabled: true}},
@@ -699,6 +811,7 @@ func TestReconciliation_Validate(t *testing.T) {
 			reconciliation: Reconciliation{
 				SchedulingInterval: duration.Duration(1),
 				HistogramBuckets:   []float64{-1, 0, 1},
+				MaxAssignmentMoves: 1,
 			},
 		},
 		{
@@ -713,6 +826,9 @@ func TestReconciliation_Validate(t *testing.T) {
 			}, {
 				Key:   []string{"histogram_buckets"},
 				Cause: cfgerror.ErrBadOrder,
+			}, {
+				Key:   []string{"max_assignment_moves"},
+				Cause: fmt.Errorf("%w: 0 is not greater than or equal to 1", cfgerror.ErrNotInRange),
 			}},
 		},
 	} {
@@ -723,6 +839,143 @@ func TestReconciliation_Validate(t *testing.T) {
 	}
 }
 
+func TestRebalancing_Validate(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		name        string
+		rebalancing Rebalancing
+		expectedErr error
+	}{
+		{
+			name:        "disabled is valid",
+			rebalancing: Rebalancing{},
+		},
+		{
+			name: "valid",
+			rebalancing: Rebalancing{
+				RunInterval: duration.Duration(time.Hour),
+				Strategy:    RebalanceStrategyRepositorySize,
+				MaxMoves:    1,
+			},
+		},
+		{
+			name: "invalid",
+			rebalancing: Rebalancing{
+				RunInterval: duration.Duration(time.Hour),
+				Strategy:    "unknown",
+			},
+			expectedErr: cfgerror.ValidationErrors{{
+				Key:   []string{"strategy"},
+				Cause: fmt.Errorf(`%w: "unknown"`, cfgerror.ErrUnsupportedValue),
+			}, {
+				Key:   []string{"max_moves"},
+				Cause: fmt.Errorf("%w: 0 is not greater than or equal to 1", cfgerror.ErrNotInRange),
+			}},
+		},
+		{
+			name: "negative run interval",
+			rebalancing: Rebalancing{
+				RunInterval: duration.Duration(-1),
+				Strategy:    RebalanceStrategyRepositoryCount,
+				MaxMoves:    1,
+			},
+			expectedErr: cfgerror.ValidationErrors{{
+				Key:   []string{"run_interval"},
+				Cause: fmt.Errorf("%w: -1ns is not greater than or equal to 0s", cfgerror.ErrNotInRange),
+			}},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.rebalancing.Validate()
+			require.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
+
+func TestDatalossRecovery_Validate(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		name             string
+		datalossRecovery DatalossRecovery
+		expectedErr      error
+	}{
+		{
+			name:             "disabled is valid",
+			datalossRecovery: DatalossRecovery{},
+		},
+		{
+			name: "valid",
+			datalossRecovery: DatalossRecovery{
+				RunInterval:             duration.Duration(time.Minute),
+				UnavailabilityThreshold: duration.Duration(time.Hour),
+				Mode:                    DatalossRecoveryModeAutomatic,
+			},
+		},
+		{
+			name: "invalid",
+			datalossRecovery: DatalossRecovery{
+				RunInterval:             duration.Duration(time.Minute),
+				UnavailabilityThreshold: duration.Duration(-1),
+				Mode:                    "unknown",
+			},
+			expectedErr: cfgerror.ValidationErrors{{
+				Key:   []string{"unavailability_threshold"},
+				Cause: fmt.Errorf("%w: -1ns is not greater than or equal to 0s", cfgerror.ErrNotInRange),
+			}, {
+				Key:   []string{"mode"},
+				Cause: fmt.Errorf(`%w: "unknown"`, cfgerror.ErrUnsupportedValue),
+			}},
+		},
+		{
+			name: "negative run interval",
+			datalossRecovery: DatalossRecovery{
+				RunInterval: duration.Duration(-1),
+				Mode:        DatalossRecoveryModeRecommend,
+			},
+			expectedErr: cfgerror.ValidationErrors{{
+				Key:   []string{"run_interval"},
+				Cause: fmt.Errorf("%w: -1ns is not greater than or equal to 0s", cfgerror.ErrNotInRange),
+			}},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.datalossRecovery.Validate()
+			require.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
+
+func TestAuditLog_Validate(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		name        string
+		auditLog    AuditLog
+		expectedErr error
+	}{
+		{
+			name:     "valid",
+			auditLog: DefaultAuditLogConfig(),
+		},
+		{
+			name:     "entries kept forever",
+			auditLog: AuditLog{LogChanges: true},
+		},
+		{
+			name:     "negative retention",
+			auditLog: AuditLog{Retention: duration.Duration(-1)},
+			expectedErr: cfgerror.ValidationErrors{{
+				Key:   []string{"retention"},
+				Cause: fmt.Errorf("%w: -1ns is not greater than or equal to 0s", cfgerror.ErrNotInRange),
+			}},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.auditLog.Validate()
+			require.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
+
 func TestReplication_Validate(t *testing.T) {
 	t.Parallel()
 	for _, tc := range []struct {
@@ -800,6 +1053,28 @@ func TestVirtualStorage_Validate(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "valid replica selection",
+			vs: VirtualStorage{
+				Name:             "vs",
+				Nodes:            []*Node{{Storage: "st", Address: "addr"}},
+				ReplicaSelection: ReplicaSelectionPowerOfTwoChoices,
+			},
+		},
+		{
+			name: "invalid replica selection",
+			vs: VirtualStorage{
+				Name:             "vs",
+				Nodes:            []*Node{{Storage: "st", Address: "addr"}},
+				ReplicaSelection: "fastest",
+			},
+			expectedErr: cfgerror.ValidationErrors{
+				cfgerror.NewValidationError(
+					fmt.Errorf(`%w: "fastest"`, cfgerror.ErrUnsupportedValue),
+					"replica_selection",
+				),
+			},
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			err := tc.vs.Validate()
@@ -937,6 +1212,10 @@ func TestConfig_ValidateV2(t *testing.T) {
 			},
 			Reconciliation: Reconciliation{
 				SchedulingInterval: duration.Duration(-1),
+				MaxAssignmentMoves: 1,
+			},
+			EmbeddedDB: EmbeddedDB{
+				Path: "relative/path",
 			},
 			Replication: Replication{
 				BatchSize:                        0,
@@ -972,6 +1251,7 @@ func TestConfig_ValidateV2(t *testing.T) {
 			cfgerror.NewValidationError(errors.New(`none of "socket_path", "listen_addr" or "tls_listen_addr" is set`)),
 			cfgerror.NewValidationError(negativeDurationErr, "background_verification", "verification_interval"),
 			cfgerror.NewValidationError(negativeDurationErr, "reconciliation", "scheduling_interval"),
+			cfgerror.NewValidationError(fmt.Errorf(`%w: "relative/path"`, cfgerror.ErrNotAbsolutePath), "embedded_database", "path"),
 			cfgerror.NewValidationError(fmt.Errorf("%w: 0 is not greater than or equal to 1", cfgerror.ErrNotInRange), "replication", "batch_size"),
 			cfgerror.NewValidationError(negativeDurationErr, "prometheus", "scrape_timeout"),
 			cfgerror.NewValidationError(fmt.Errorf(`%w: "/doesnt/exist"`, cfgerror.ErrDoesntExist), "tls", "certificate_path"),
@@ -12,6 +12,9 @@ type Node struct {
 	Storage string `toml:"storage,omitempty" json:"storage"`
 	Address string `toml:"address,omitempty" json:"address"`
 	Token   string `toml:"token,omitempty" json:"token"`
+	// Zone is the failure domain the storage is located in. Praefect spreads the replicas of a
+	// repository across distinct zones where possible.
+	Zone string `toml:"zone,omitempty" json:"zone"`
 }
 
 //nolint:revive // This is unintentionally missing documentation.
@@ -19,6 +22,7 @@ func (n Node) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]interface{}{
 		"storage": n.Storage,
 		"address": n.Address,
+		"zone":    n.Zone,
 	})
 }
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
 	"sync"
 	"time"
 
@@ -25,6 +26,7 @@ import (
 	"gitlab.com/gitlab-org/gitaly/v16/proto/go/gitalypb"
 	"gitlab.com/gitlab-org/labkit/correlation"
 	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc"
 	grpc_metadata "google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
@@ -54,8 +56,10 @@ var transactionRPCs = map[string]transactionsCondition{
 	"/gitaly.ObjectPoolService/DisconnectGitAlternates":      transactionsFlag(featureflag.TransactionalAlternatesDisconnect),
 	"/gitaly.ObjectPoolService/FetchIntoObjectPool":          transactionsEnabled,
 	"/gitaly.ObjectPoolService/LinkRepositoryToObjectPool":   transactionsFlag(featureflag.TransactionalLinkRepository),
+	"/gitaly.OperationService/UserAddNote":                   transactionsEnabled,
 	"/gitaly.OperationService/UserApplyPatch":                transactionsEnabled,
 	"/gitaly.OperationService/UserCherryPick":                transactionsEnabled,
+	"/gitaly.OperationService/UserCherryPickCommits":         transactionsEnabled,
 	"/gitaly.OperationService/UserCommitFiles":               transactionsEnabled,
 	"/gitaly.OperationService/UserCreateBranch":              transactionsEnabled,
 	"/gitaly.OperationService/UserCreateTag":                 transactionsEnabled,
@@ -66,9 +70,12 @@ var transactionRPCs = map[string]transactionsCondition{
 	"/gitaly.OperationService/UserMergeToRef":                transactionsEnabled,
 	"/gitaly.OperationService/UserRebaseToRef":               transactionsEnabled,
 	"/gitaly.OperationService/UserRebaseConfirmable":         transactionsEnabled,
+	"/gitaly.OperationService/UserRebaseInteractive":         transactionsEnabled,
+	"/gitaly.OperationService/UserRemoveNote":                transactionsEnabled,
 	"/gitaly.OperationService/UserRevert":                    transactionsEnabled,
 	"/gitaly.OperationService/UserSquash":                    transactionsEnabled,
 	"/gitaly.OperationService/UserUpdateBranch":              transactionsEnabled,
+	"/gitaly.OperationService/UserUpdateNote":                transactionsEnabled,
 	"/gitaly.OperationService/UserUpdateSubmodule":           transactionsEnabled,
 	"/gitaly.RefService/DeleteRefs":                          transactionsEnabled,
 	"/gitaly.RefService/UpdateReferences":                    transactionsEnabled,
@@ -298,12 +305,38 @@ func shouldRouteRepositoryAccessorToPrimary(ctx context.Context, call grpcCall)
 	return forcePrimary
 }
 
+// minimumRepositoryGeneration returns the minimum generation the client requires the replica serving the
+// request to be on. datastore.GenerationUnknown is returned if the client has no such requirement.
+func minimumRepositoryGeneration(ctx context.Context) (int, error) {
+	md, ok := grpc_metadata.FromIncomingContext(ctx)
+	if !ok {
+		return datastore.GenerationUnknown, nil
+	}
+
+	header := md.Get(minimumRepositoryGenerationKey)
+	if len(header) == 0 {
+		return datastore.GenerationUnknown, nil
+	}
+
+	generation, err := strconv.Atoi(header[0])
+	if err != nil || generation < 0 {
+		return 0, structerr.NewInvalidArgument("invalid minimum repository generation: %q", header[0])
+	}
+
+	return generation, nil
+}
+
 func (c *Coordinator) accessorStreamParameters(ctx context.Context, call grpcCall) (*proxy.StreamParameters, error) {
 	repoPath := call.targetRepo.GetRelativePath()
 	virtualStorage := call.targetRepo.StorageName
 
+	minimumGeneration, err := minimumRepositoryGeneration(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	route, err := c.router.RouteRepositoryAccessor(
-		ctx, virtualStorage, repoPath, shouldRouteRepositoryAccessorToPrimary(ctx, call),
+		ctx, virtualStorage, repoPath, shouldRouteRepositoryAccessorToPrimary(ctx, call), minimumGeneration,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("accessor call: route repository accessor: %w", err)
@@ -313,16 +346,27 @@ func (c *Coordinator) accessorStreamParameters(ctx context.Context, call grpcCal
 
 	b, err := rewrittenRepositoryMessage(call.methodInfo, call.msg, route.Node.Storage, route.ReplicaPath, "")
 	if err != nil {
+		if route.Done != nil {
+			route.Done()
+		}
 		return nil, fmt.Errorf("accessor call: rewrite storage: %w", err)
 	}
 
 	metrics.ReadDistribution.WithLabelValues(virtualStorage, route.Node.Storage).Inc()
 
+	var finalizer func() error
+	if route.Done != nil {
+		finalizer = func() error {
+			route.Done()
+			return nil
+		}
+	}
+
 	return proxy.NewStreamParameters(proxy.Destination{
 		Ctx:  streamParametersContext(ctx),
 		Conn: route.Node.Connection,
 		Msg:  b,
-	}, nil, nil, nil), nil
+	}, nil, finalizer, nil), nil
 }
 
 func (c *Coordinator) registerTransaction(ctx context.Context, primary RouterNode, secondaries []RouterNode) (transactions.Transaction, transactions.CancelFunc, error) {
@@ -1085,12 +1129,16 @@ func (c *Coordinator) newRequestFinalizer(
 		}
 		logEntry.InfoContext(ctx, "queueing replication jobs")
 
+		// generation is the repository's generation after the write. Created repositories start
+		// at generation 0.
+		var generation int
 		switch change {
 		case datastore.UpdateRepo:
 			// If this fails, the primary might have changes on it that are not recorded in the database. The secondaries will appear
 			// consistent with the primary but might serve different stale data. Follow-up mutator calls will solve this state although
 			// the primary will be a later generation in the mean while.
-			if err := c.rs.IncrementGeneration(ctx, repositoryID, primary, updatedSecondaries); err != nil {
+			var err error
+			if generation, err = c.rs.IncrementGeneration(ctx, repositoryID, primary, updatedSecondaries); err != nil {
 				return fmt.Errorf("increment generation: %w", err)
 			}
 		case datastore.RenameRepo:
@@ -1129,6 +1177,10 @@ func (c *Coordinator) newRequestFinalizer(
 			change = datastore.UpdateRepo
 		}
 
+		if change == datastore.UpdateRepo {
+			c.setRepositoryGenerationTrailer(ctx, originalCtx, generation)
+		}
+
 		correlationID := correlation.ExtractFromContextOrGenerate(ctx)
 
 		g, ctx := errgroup.WithContext(ctx)
@@ -1171,6 +1223,19 @@ func (c *Coordinator) newRequestFinalizer(
 	}
 }
 
+// setRepositoryGenerationTrailer sets the repository's generation after the write into the response's
+// trailer. Clients can pass the generation back on subsequent reads to ensure the reads observe the write.
+// Failing to set the trailer doesn't fail the request as the write itself has succeeded.
+func (c *Coordinator) setRepositoryGenerationTrailer(ctx, streamCtx context.Context, generation int) {
+	if grpc.ServerTransportStreamFromContext(streamCtx) == nil {
+		return
+	}
+
+	if err := grpc.SetTrailer(streamCtx, grpc_metadata.Pairs(repositoryGenerationKey, strconv.Itoa(generation))); err != nil {
+		c.logger.WithError(err).WarnContext(ctx, "failed setting repository generation trailer")
+	}
+}
+
 func (c *Coordinator) validateTargetRepo(repo *gitalypb.Repository) error {
 	if repo.GetStorageName() == "" && repo.GetRelativePath() == "" {
 		return storage.ErrRepositoryNotSet
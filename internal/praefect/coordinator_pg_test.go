@@ -237,9 +237,12 @@ func TestStreamDirectorMutator_Transaction(t *testing.T) {
 					StaticHealthChecker(conf.StorageNames()),
 					NewLockedRandom(rand.New(rand.NewSource(0))),
 					rs,
-					datastore.NewAssignmentStore(tx, conf.StorageNames()),
+					datastore.NewAssignmentStore(tx, conf.StorageNames(), nil),
 					rs,
 					nil,
+					nil,
+					nil,
+					nil,
 				),
 				txMgr,
 				conf,
@@ -389,9 +389,12 @@ func TestStreamDirectorMutator(t *testing.T) {
 					StaticHealthChecker(conf.StorageNames()),
 					NewLockedRandom(rand.New(rand.NewSource(0))),
 					rs,
-					datastore.NewAssignmentStore(tx, conf.StorageNames()),
+					datastore.NewAssignmentStore(tx, conf.StorageNames(), nil),
 					rs,
 					nil,
+					nil,
+					nil,
+					nil,
 				),
 				txMgr,
 				conf,
@@ -687,9 +690,9 @@ func TestStreamDirectorMutator_ReplicateRepository(t *testing.T) {
 			require.Fail(t, "CreateRepository should not be called")
 			return nil
 		},
-		IncrementGenerationFunc: func(ctx context.Context, repositoryID int64, primary string, secondaries []string) error {
+		IncrementGenerationFunc: func(ctx context.Context, repositoryID int64, primary string, secondaries []string) (int, error) {
 			incrementGenerationInvoked = true
-			return nil
+			return 1, nil
 		},
 	}
 
@@ -798,9 +801,12 @@ func TestStreamDirector_maintenance(t *testing.T) {
 			StaticHealthChecker(cfg.StorageNames()),
 			NewLockedRandom(rand.New(rand.NewSource(0))),
 			rs,
-			datastore.NewAssignmentStore(tx, cfg.StorageNames()),
+			datastore.NewAssignmentStore(tx, cfg.StorageNames(), nil),
 			rs,
 			nil,
+			nil,
+			nil,
+			nil,
 		),
 		nil,
 		cfg,
@@ -1021,13 +1027,13 @@ func (m *mockMaintenanceServer) PruneUnreachableObjects(ctx context.Context, in
 
 type mockRouter struct {
 	Router
-	routeRepositoryAccessorFunc func(ctx context.Context, virtualStorage, relativePath string, forcePrimary bool) (RepositoryAccessorRoute, error)
+	routeRepositoryAccessorFunc func(ctx context.Context, virtualStorage, relativePath string, forcePrimary bool, minimumGeneration int) (RepositoryAccessorRoute, error)
 	routeRepositoryCreation     func(ctx context.Context, virtualStorage, relativePath, additionalRepoRelativePath string) (RepositoryMutatorRoute, error)
 	routeRepositoryMutator      func(ctx context.Context, virtualStorage, relativePath, additionalRepoRelativePath string) (RepositoryMutatorRoute, error)
 }
 
-func (m mockRouter) RouteRepositoryAccessor(ctx context.Context, virtualStorage, relativePath string, forcePrimary bool) (RepositoryAccessorRoute, error) {
-	return m.routeRepositoryAccessorFunc(ctx, virtualStorage, relativePath, forcePrimary)
+func (m mockRouter) RouteRepositoryAccessor(ctx context.Context, virtualStorage, relativePath string, forcePrimary bool, minimumGeneration int) (RepositoryAccessorRoute, error) {
+	return m.routeRepositoryAccessorFunc(ctx, virtualStorage, relativePath, forcePrimary, minimumGeneration)
 }
 
 func (m mockRouter) RouteRepositoryCreation(ctx context.Context, virtualStorage, relativePath, additionalRepoRelativePath string) (RepositoryMutatorRoute, error) {
@@ -1088,7 +1094,7 @@ func TestStreamDirectorAccessor(t *testing.T) {
 		{
 			desc: "repository not found",
 			router: mockRouter{
-				routeRepositoryAccessorFunc: func(_ context.Context, virtualStorage, relativePath string, _ bool) (RepositoryAccessorRoute, error) {
+				routeRepositoryAccessorFunc: func(_ context.Context, virtualStorage, relativePath string, _ bool, _ int) (RepositoryAccessorRoute, error) {
 					return RepositoryAccessorRoute{}, datastore.ErrRepositoryNotFound
 				},
 			},
@@ -1563,6 +1569,9 @@ func TestStreamDirector_repo_creation(t *testing.T) {
 				nil,
 				repositoryStore,
 				conf.DefaultReplicationFactors(),
+				nil,
+				nil,
+				nil,
 			)
 
 			txMgr := transactions.NewManager(conf)
@@ -2913,9 +2922,9 @@ func TestNewRequestFinalizer_contextIsDisjointedFromTheRPC(t *testing.T) {
 						},
 					},
 					datastore.MockRepositoryStore{
-						IncrementGenerationFunc: func(ctx context.Context, _ int64, _ string, _ []string) error {
+						IncrementGenerationFunc: func(ctx context.Context, _ int64, _ string, _ []string) (int, error) {
 							requireSuppressedCancellation(t, ctx)
-							return err
+							return 0, err
 						},
 						RenameRepositoryFunc: func(ctx context.Context, _, _, _, _ string) error {
 							requireSuppressedCancellation(t, ctx)
@@ -3003,6 +3012,134 @@ func TestNewRequestFinalizer_enqueueErrorPropagation(t *testing.T) {
 	}
 }
 
+// trailerRecordingStream records the trailers set on the stream.
+type trailerRecordingStream struct {
+	grpc.ServerTransportStream
+	trailer metadata.MD
+}
+
+func (s *trailerRecordingStream) SetTrailer(md metadata.MD) error {
+	s.trailer = metadata.Join(s.trailer, md)
+	return nil
+}
+
+func TestNewRequestFinalizer_repositoryGenerationTrailer(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		desc            string
+		change          datastore.ChangeType
+		expectedTrailer metadata.MD
+	}{
+		{
+			desc:            "update sets the generation",
+			change:          datastore.UpdateRepo,
+			expectedTrailer: metadata.Pairs(repositoryGenerationKey, "3"),
+		},
+		{
+			desc:            "creation sets the initial generation",
+			change:          datastore.CreateRepo,
+			expectedTrailer: metadata.Pairs(repositoryGenerationKey, "0"),
+		},
+		{
+			desc:   "rename does not set the generation",
+			change: datastore.RenameRepo,
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			stream := &trailerRecordingStream{}
+			ctx := grpc.NewContextWithServerTransportStream(testhelper.Context(t), stream)
+
+			require.NoError(t, NewCoordinator(
+				testhelper.NewLogger(t),
+				&datastore.MockReplicationEventQueue{},
+				datastore.MockRepositoryStore{
+					IncrementGenerationFunc: func(_ context.Context, repositoryID int64, primary string, _ []string) (int, error) {
+						require.Equal(t, int64(1), repositoryID)
+						require.Equal(t, "primary", primary)
+						return 3, nil
+					},
+					GetGenerationFunc: func(context.Context, int64, string) (int, error) {
+						require.Fail(t, "GetGeneration should not be called")
+						return 0, nil
+					},
+				},
+				nil,
+				nil,
+				config.Config{},
+				nil,
+			).newRequestFinalizer(ctx,
+				1,
+				"praefect",
+				&gitalypb.Repository{},
+				"replica-path",
+				"primary",
+				[]string{"secondary"},
+				nil,
+				tc.change,
+				datastore.Params{"RelativePath": "new-relative-path"},
+				"rpc-name",
+			)())
+			require.Equal(t, tc.expectedTrailer, stream.trailer)
+		})
+	}
+}
+
+func TestMinimumRepositoryGeneration(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		desc               string
+		metadata           metadata.MD
+		expectedGeneration int
+		expectedErr        error
+	}{
+		{
+			desc:               "no metadata",
+			expectedGeneration: datastore.GenerationUnknown,
+		},
+		{
+			desc:               "no minimum generation",
+			metadata:           metadata.Pairs("other-key", "1"),
+			expectedGeneration: datastore.GenerationUnknown,
+		},
+		{
+			desc:               "minimum generation",
+			metadata:           metadata.Pairs(minimumRepositoryGenerationKey, "5"),
+			expectedGeneration: 5,
+		},
+		{
+			desc:        "invalid minimum generation",
+			metadata:    metadata.Pairs(minimumRepositoryGenerationKey, "five"),
+			expectedErr: structerr.NewInvalidArgument("invalid minimum repository generation: %q", "five"),
+		},
+		{
+			desc:        "negative minimum generation",
+			metadata:    metadata.Pairs(minimumRepositoryGenerationKey, "-1"),
+			expectedErr: structerr.NewInvalidArgument("invalid minimum repository generation: %q", "-1"),
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := testhelper.Context(t)
+			if tc.metadata != nil {
+				ctx = metadata.NewIncomingContext(ctx, tc.metadata)
+			}
+
+			generation, err := minimumRepositoryGeneration(ctx)
+			require.Equal(t, tc.expectedErr, err)
+			require.Equal(t, tc.expectedGeneration, generation)
+		})
+	}
+}
+
 func TestStreamParametersContext(t *testing.T) {
 	// Because we're using NewFeatureFlag, they'll end up in the All array.
 	enabledFF := featureflag.NewFeatureFlag("default_enabled", "", "", true)
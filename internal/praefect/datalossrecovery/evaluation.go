@@ -0,0 +1,137 @@
+package datalossrecovery
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Action is the action taken after evaluating the replicas of an unavailable repository.
+type Action string
+
+const (
+	// ActionPromoted means the recommended replica was promoted to be the authoritative one.
+	ActionPromoted Action = "promoted"
+	// ActionRecommended means the recommended replica was only recorded and logged. An administrator
+	// has to accept the data loss.
+	ActionRecommended Action = "recommended"
+)
+
+// Candidate describes a remaining replica of an unavailable repository.
+type Candidate struct {
+	// Storage is the storage the replica is stored on.
+	Storage string `json:"storage"`
+	// GenerationGap is the number of generations the replica is behind the repository's latest
+	// generation.
+	GenerationGap int64 `json:"generation_gap"`
+	// References is the number of references in the replica.
+	References int `json:"references"`
+	// DivergentReferences is the number of references in the other candidates which are missing from
+	// the replica or point to a different object.
+	DivergentReferences int `json:"divergent_references"`
+	// Error is set if the replica's references could not be listed.
+	Error string `json:"error,omitempty"`
+}
+
+// Evaluation is the result of evaluating the remaining replicas of an unavailable repository.
+type Evaluation struct {
+	// Storage is the storage of the recommended replica.
+	Storage string
+	// GenerationGap is the number of generations the recommended replica is behind the
+	// repository's latest generation.
+	GenerationGap int64
+	// Promotable is true if the recommended replica is within the limits for automatic promotion.
+	Promotable bool
+	// Reason explains why the recommended replica is not promotable.
+	Reason string
+	// Candidates are all of the evaluated replicas. The recommended one is first.
+	Candidates []Candidate
+}
+
+// replicaReferences contains the references of a replica or the error encountered listing them.
+type replicaReferences struct {
+	generationGap int64
+	references    map[string]string
+	err           error
+}
+
+// evaluate picks the replica to recommend as the authoritative one of an unavailable repository from
+// the remaining replicas. The replica with the smallest generation gap is preferred. Ties are broken by
+// the number of references diverging from the other replicas, and then by the number of references.
+// The recommendation is promotable only if it is at most maxGenerationGap generations behind, has no
+// diverging references, and the references of every remaining replica could be compared.
+func evaluate(replicas map[string]replicaReferences, maxGenerationGap int64) Evaluation {
+	candidates := make([]Candidate, 0, len(replicas))
+	failed := 0
+	for storage, replica := range replicas {
+		candidate := Candidate{
+			Storage:       storage,
+			GenerationGap: replica.generationGap,
+		}
+
+		if replica.err != nil {
+			candidate.Error = replica.err.Error()
+			failed++
+			candidates = append(candidates, candidate)
+			continue
+		}
+
+		candidate.References = len(replica.references)
+		for otherStorage, other := range replicas {
+			if otherStorage == storage || other.err != nil {
+				continue
+			}
+
+			for reference, oid := range other.references {
+				if replica.references[reference] != oid {
+					candidate.DivergentReferences++
+				}
+			}
+		}
+
+		candidates = append(candidates, candidate)
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if (a.Error == "") != (b.Error == "") {
+			return a.Error == ""
+		}
+
+		if a.GenerationGap != b.GenerationGap {
+			return a.GenerationGap < b.GenerationGap
+		}
+
+		if a.DivergentReferences != b.DivergentReferences {
+			return a.DivergentReferences < b.DivergentReferences
+		}
+
+		if a.References != b.References {
+			return a.References > b.References
+		}
+
+		return a.Storage < b.Storage
+	})
+
+	evaluation := Evaluation{Candidates: candidates}
+	if len(candidates) == 0 || candidates[0].Error != "" {
+		evaluation.Reason = "no replica could be evaluated"
+		return evaluation
+	}
+
+	best := candidates[0]
+	evaluation.Storage = best.Storage
+	evaluation.GenerationGap = best.GenerationGap
+
+	switch {
+	case best.GenerationGap > maxGenerationGap:
+		evaluation.Reason = fmt.Sprintf("generation gap %d exceeds the maximum of %d", best.GenerationGap, maxGenerationGap)
+	case best.DivergentReferences > 0:
+		evaluation.Reason = fmt.Sprintf("%d references diverge from the other replicas", best.DivergentReferences)
+	case failed > 0:
+		evaluation.Reason = fmt.Sprintf("references of %d replicas could not be compared", failed)
+	default:
+		evaluation.Promotable = true
+	}
+
+	return evaluation
+}
@@ -0,0 +1,129 @@
+package datalossrecovery
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvaluate(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		desc               string
+		replicas           map[string]replicaReferences
+		maxGenerationGap   int64
+		expectedEvaluation Evaluation
+	}{
+		{
+			desc: "no replicas",
+			expectedEvaluation: Evaluation{
+				Reason:     "no replica could be evaluated",
+				Candidates: []Candidate{},
+			},
+		},
+		{
+			desc: "smallest generation gap is recommended",
+			replicas: map[string]replicaReferences{
+				"gitaly-1": {generationGap: 2, references: map[string]string{"refs/heads/main": "a"}},
+				"gitaly-2": {generationGap: 1, references: map[string]string{"refs/heads/main": "a", "refs/heads/feature": "b"}},
+			},
+			maxGenerationGap: 1,
+			expectedEvaluation: Evaluation{
+				Storage:       "gitaly-2",
+				GenerationGap: 1,
+				Promotable:    true,
+				Candidates: []Candidate{
+					{Storage: "gitaly-2", GenerationGap: 1, References: 2},
+					{Storage: "gitaly-1", GenerationGap: 2, References: 1, DivergentReferences: 1},
+				},
+			},
+		},
+		{
+			desc: "generation gap above maximum",
+			replicas: map[string]replicaReferences{
+				"gitaly-1": {generationGap: 3, references: map[string]string{"refs/heads/main": "a"}},
+			},
+			maxGenerationGap: 1,
+			expectedEvaluation: Evaluation{
+				Storage:       "gitaly-1",
+				GenerationGap: 3,
+				Reason:        "generation gap 3 exceeds the maximum of 1",
+				Candidates: []Candidate{
+					{Storage: "gitaly-1", GenerationGap: 3, References: 1},
+				},
+			},
+		},
+		{
+			desc: "divergent references break ties",
+			replicas: map[string]replicaReferences{
+				"gitaly-1": {generationGap: 1, references: map[string]string{"refs/heads/main": "a"}},
+				"gitaly-2": {generationGap: 1, references: map[string]string{"refs/heads/main": "a", "refs/heads/feature": "b"}},
+				"gitaly-3": {generationGap: 1, references: map[string]string{"refs/heads/main": "c"}},
+			},
+			maxGenerationGap: 1,
+			expectedEvaluation: Evaluation{
+				Storage:       "gitaly-2",
+				GenerationGap: 1,
+				Reason:        "1 references diverge from the other replicas",
+				Candidates: []Candidate{
+					{Storage: "gitaly-2", GenerationGap: 1, References: 2, DivergentReferences: 1},
+					{Storage: "gitaly-1", GenerationGap: 1, References: 1, DivergentReferences: 2},
+					{Storage: "gitaly-3", GenerationGap: 1, References: 1, DivergentReferences: 3},
+				},
+			},
+		},
+		{
+			desc: "reference count breaks ties",
+			replicas: map[string]replicaReferences{
+				"gitaly-1": {generationGap: 0, references: map[string]string{}},
+				"gitaly-2": {generationGap: 0, references: map[string]string{"refs/heads/main": "a"}},
+			},
+			expectedEvaluation: Evaluation{
+				Storage:    "gitaly-2",
+				Promotable: true,
+				Candidates: []Candidate{
+					{Storage: "gitaly-2", References: 1},
+					{Storage: "gitaly-1", DivergentReferences: 1},
+				},
+			},
+		},
+		{
+			desc: "failed replicas prevent promotion",
+			replicas: map[string]replicaReferences{
+				"gitaly-1": {generationGap: 0, err: errors.New("connection refused")},
+				"gitaly-2": {generationGap: 1, references: map[string]string{"refs/heads/main": "a"}},
+			},
+			maxGenerationGap: 1,
+			expectedEvaluation: Evaluation{
+				Storage:       "gitaly-2",
+				GenerationGap: 1,
+				Reason:        "references of 1 replicas could not be compared",
+				Candidates: []Candidate{
+					{Storage: "gitaly-2", GenerationGap: 1, References: 1},
+					{Storage: "gitaly-1", Error: "connection refused"},
+				},
+			},
+		},
+		{
+			desc: "all replicas failed",
+			replicas: map[string]replicaReferences{
+				"gitaly-1": {generationGap: 0, err: errors.New("connection refused")},
+			},
+			expectedEvaluation: Evaluation{
+				Reason: "no replica could be evaluated",
+				Candidates: []Candidate{
+					{Storage: "gitaly-1", Error: "connection refused"},
+				},
+			},
+		},
+	} {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tc.expectedEvaluation, evaluate(tc.replicas, tc.maxGenerationGap))
+		})
+	}
+}
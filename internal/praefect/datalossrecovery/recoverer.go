@@ -0,0 +1,402 @@
+package datalossrecovery
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/helper"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/log"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/config"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/datastore"
+	"gitlab.com/gitlab-org/gitaly/v16/proto/go/gitalypb"
+)
+
+// RefLister lists the references of the replica stored at replicaPath on the given storage. The
+// returned map contains the object ID each reference points to by the reference's name.
+type RefLister func(ctx context.Context, virtualStorage, storage, replicaPath string) (map[string]string, error)
+
+// NewRefLister returns a RefLister that lists the references of replicas from the Gitaly nodes via
+// the ListRefs RPC.
+func NewRefLister(conns praefect.Connections) RefLister {
+	return func(ctx context.Context, virtualStorage, storage, replicaPath string) (map[string]string, error) {
+		conn, ok := conns[virtualStorage][storage]
+		if !ok {
+			return nil, fmt.Errorf("no connection to storage %q of virtual storage %q", storage, virtualStorage)
+		}
+
+		stream, err := gitalypb.NewRefServiceClient(conn).ListRefs(ctx, &gitalypb.ListRefsRequest{
+			Repository: &gitalypb.Repository{
+				StorageName:  storage,
+				RelativePath: replicaPath,
+			},
+			Patterns: [][]byte{[]byte("refs/")},
+			Head:     true,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list refs: %w", err)
+		}
+
+		references := map[string]string{}
+		for {
+			response, err := stream.Recv()
+			if err != nil {
+				if errors.Is(err, io.EOF) {
+					return references, nil
+				}
+
+				return nil, fmt.Errorf("receive: %w", err)
+			}
+
+			for _, reference := range response.GetReferences() {
+				references[string(reference.GetName())] = reference.GetTarget()
+			}
+		}
+	}
+}
+
+// Recoverer evaluates the remaining replicas of repositories which have been unavailable for longer
+// than the configured threshold. A repository is unavailable if none of its up to date replicas are
+// available. Depending on the configured mode, the Recoverer either promotes the best remaining
+// replica to be the authoritative one, or records and logs it as a recommendation for an administrator
+// to accept the data loss. Every evaluation is recorded in the dataloss_recoveries table.
+type Recoverer struct {
+	log              log.Logger
+	db               *sql.DB
+	rs               datastore.RepositoryStore
+	storages         map[string][]string
+	conf             config.DatalossRecovery
+	listRefs         RefLister
+	evaluationsTotal *prometheus.CounterVec
+	// handleError is called with a possible error from a recovery run.
+	// If it returns an error, Run stops and returns with the error.
+	handleError func(error) error
+}
+
+// NewRecoverer returns a new Recoverer. The references of the replicas are listed with listRefs.
+func NewRecoverer(
+	log log.Logger,
+	db *sql.DB,
+	storages map[string][]string,
+	conf config.DatalossRecovery,
+	listRefs RefLister,
+) *Recoverer {
+	log = log.WithField("component", "dataloss_recoverer")
+
+	return &Recoverer{
+		log:      log,
+		db:       db,
+		rs:       datastore.NewPostgresRepositoryStore(db, storages),
+		storages: storages,
+		conf:     conf,
+		listRefs: listRefs,
+		evaluationsTotal: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "gitaly_praefect_dataloss_recovery_evaluations_total",
+				Help: "Number of unavailable repositories evaluated for dataloss recovery by the action taken.",
+			},
+			[]string{"virtual_storage", "action"},
+		),
+		handleError: func(err error) error {
+			log.WithError(err).Error("dataloss recovery failed")
+			return nil
+		},
+	}
+}
+
+// Describe describes the metrics exposed by the Recoverer.
+func (r *Recoverer) Describe(ch chan<- *prometheus.Desc) {
+	prometheus.DescribeByCollect(r, ch)
+}
+
+// Collect collects the metrics exposed by the Recoverer.
+func (r *Recoverer) Collect(ch chan<- prometheus.Metric) {
+	r.evaluationsTotal.Collect(ch)
+}
+
+// Run recovers unavailable repositories on each tick the Ticker emits. Run returns when the context
+// is canceled, returning the error from the context.
+func (r *Recoverer) Run(ctx context.Context, ticker helper.Ticker) error {
+	r.log.WithField("mode", r.conf.Mode).Info("dataloss recoverer started")
+	defer r.log.Info("dataloss recoverer stopped")
+
+	defer ticker.Stop()
+
+	for {
+		ticker.Reset()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C():
+			if err := r.recover(ctx); err != nil {
+				if err := r.handleError(err); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+func (r *Recoverer) recover(ctx context.Context) error {
+	virtualStorages := make([]string, 0, len(r.storages))
+	for virtualStorage := range r.storages {
+		virtualStorages = append(virtualStorages, virtualStorage)
+	}
+	sort.Strings(virtualStorages)
+
+	for _, virtualStorage := range virtualStorages {
+		if err := r.recoverVirtualStorage(ctx, virtualStorage); err != nil {
+			return fmt.Errorf("virtual storage %q: %w", virtualStorage, err)
+		}
+	}
+
+	return nil
+}
+
+func (r *Recoverer) recoverVirtualStorage(ctx context.Context, virtualStorage string) error {
+	repositories, err := r.rs.GetPartiallyAvailableRepositories(ctx, virtualStorage)
+	if err != nil {
+		return fmt.Errorf("get partially available repositories: %w", err)
+	}
+
+	var unavailable []datastore.RepositoryMetadata
+	for _, repository := range repositories {
+		if isUnavailable(repository) {
+			unavailable = append(unavailable, repository)
+		}
+	}
+
+	due, err := r.trackUnavailable(ctx, virtualStorage, unavailable)
+	if err != nil {
+		return fmt.Errorf("track unavailable repositories: %w", err)
+	}
+
+	for _, repository := range unavailable {
+		unavailableSince, ok := due[repository.RepositoryID]
+		if !ok {
+			continue
+		}
+
+		if err := r.recoverRepository(ctx, repository, unavailableSince); err != nil {
+			return fmt.Errorf("repository %d: %w", repository.RepositoryID, err)
+		}
+	}
+
+	return nil
+}
+
+// isUnavailable returns whether the repository has no replica that can serve as its primary. Healthy
+// replicas on the latest generation are not valid primaries if they are pending deletion or drained.
+// Their data is not lost though, so such repositories are not considered unavailable.
+func isUnavailable(repository datastore.RepositoryMetadata) bool {
+	for _, replica := range repository.Replicas {
+		if replica.ValidPrimary || (replica.Healthy && replica.Generation == repository.Generation) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// trackUnavailable records since when the repositories have been unavailable and forgets the
+// repositories of the virtual storage that are available again. It returns the time since when each
+// repository has been unavailable for the repositories that have been unavailable for longer than the
+// threshold and have not yet been evaluated.
+func (r *Recoverer) trackUnavailable(ctx context.Context, virtualStorage string, unavailable []datastore.RepositoryMetadata) (map[int64]time.Time, error) {
+	repositoryIDs := make([]int64, 0, len(unavailable))
+	for _, repository := range unavailable {
+		repositoryIDs = append(repositoryIDs, repository.RepositoryID)
+	}
+
+	if _, err := r.db.ExecContext(ctx, `
+DELETE FROM unavailable_repositories
+USING repositories
+WHERE repositories.repository_id = unavailable_repositories.repository_id
+AND repositories.virtual_storage = $1
+AND NOT unavailable_repositories.repository_id = ANY($2)
+`, virtualStorage, repositoryIDs); err != nil {
+		return nil, fmt.Errorf("delete available: %w", err)
+	}
+
+	if _, err := r.db.ExecContext(ctx, `
+INSERT INTO unavailable_repositories (repository_id)
+SELECT unnest($1::bigint[])
+ON CONFLICT (repository_id) DO NOTHING
+`, repositoryIDs); err != nil {
+		return nil, fmt.Errorf("insert unavailable: %w", err)
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+SELECT repository_id, unavailable_since
+FROM unavailable_repositories
+WHERE repository_id = ANY($1)
+AND unavailable_since <= NOW() - make_interval(secs => $2::float8)
+AND NOT EXISTS (
+	SELECT FROM dataloss_recoveries
+	WHERE dataloss_recoveries.repository_id = unavailable_repositories.repository_id
+	AND dataloss_recoveries.unavailable_since = unavailable_repositories.unavailable_since
+)
+`, repositoryIDs, r.conf.UnavailabilityThreshold.Duration().Seconds())
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+	defer rows.Close()
+
+	due := map[int64]time.Time{}
+	for rows.Next() {
+		var repositoryID int64
+		var unavailableSince time.Time
+		if err := rows.Scan(&repositoryID, &unavailableSince); err != nil {
+			return nil, fmt.Errorf("scan: %w", err)
+		}
+
+		due[repositoryID] = unavailableSince
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating rows: %w", err)
+	}
+
+	return due, nil
+}
+
+func (r *Recoverer) recoverRepository(ctx context.Context, repository datastore.RepositoryMetadata, unavailableSince time.Time) error {
+	replicas := map[string]replicaReferences{}
+	for _, replica := range repository.Replicas {
+		if !replica.Healthy || replica.Generation == datastore.GenerationUnknown {
+			continue
+		}
+
+		references, err := r.listRefs(ctx, repository.VirtualStorage, replica.Storage, repository.ReplicaPath)
+		replicas[replica.Storage] = replicaReferences{
+			generationGap: repository.Generation - replica.Generation,
+			references:    references,
+			err:           err,
+		}
+	}
+
+	if len(replicas) == 0 {
+		// There is nothing to evaluate until some of the replicas become healthy again.
+		return nil
+	}
+
+	evaluation := evaluate(replicas, int64(r.conf.MaxGenerationGap))
+
+	action := ActionRecommended
+	if r.conf.Mode == config.DatalossRecoveryModeAutomatic && evaluation.Promotable {
+		action = ActionPromoted
+	}
+
+	recorded, err := r.record(ctx, repository, unavailableSince, action, evaluation)
+	if err != nil {
+		return fmt.Errorf("record: %w", err)
+	}
+
+	if !recorded {
+		return nil
+	}
+
+	r.evaluationsTotal.WithLabelValues(repository.VirtualStorage, string(action)).Inc()
+
+	logger := r.log.WithFields(log.Fields{
+		"virtual_storage":   repository.VirtualStorage,
+		"relative_path":     repository.RelativePath,
+		"repository_id":     repository.RepositoryID,
+		"unavailable_since": unavailableSince,
+		"storage":           evaluation.Storage,
+		"generation_gap":    evaluation.GenerationGap,
+		"candidates":        evaluation.Candidates,
+	})
+
+	if action == ActionPromoted {
+		logger.Warn("promoted replica of unavailable repository to be the authoritative one")
+		return nil
+	}
+
+	logger.WithField("reason", evaluation.Reason).Error("repository is unavailable, accept data loss to recover it from the recommended storage")
+
+	return nil
+}
+
+// record records the evaluation and promotes the recommended replica if the action is to promote it.
+// Nothing is recorded if the repository has changed since it was evaluated, or if the evaluation has
+// already been recorded by another Praefect. It returns whether the evaluation was recorded.
+func (r *Recoverer) record(ctx context.Context, repository datastore.RepositoryMetadata, unavailableSince time.Time, action Action, evaluation Evaluation) (bool, error) {
+	candidates, err := json.Marshal(evaluation.Candidates)
+	if err != nil {
+		return false, fmt.Errorf("marshal candidates: %w", err)
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("begin: %w", err)
+	}
+	//nolint:errcheck
+	defer tx.Rollback()
+
+	var generation int64
+	if err := tx.QueryRowContext(ctx, `
+SELECT generation
+FROM repositories
+WHERE repository_id = $1
+FOR UPDATE
+`, repository.RepositoryID).Scan(&generation); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("lock repository: %w", err)
+	}
+
+	if generation != repository.Generation {
+		return false, nil
+	}
+
+	result, err := tx.ExecContext(ctx, `
+INSERT INTO dataloss_recoveries (repository_id, virtual_storage, relative_path, unavailable_since, action, storage, generation_gap, reason, candidates)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+ON CONFLICT (repository_id, unavailable_since) DO NOTHING
+`,
+		repository.RepositoryID,
+		repository.VirtualStorage,
+		repository.RelativePath,
+		unavailableSince,
+		action,
+		evaluation.Storage,
+		evaluation.GenerationGap,
+		evaluation.Reason,
+		candidates,
+	)
+	if err != nil {
+		return false, fmt.Errorf("insert: %w", err)
+	}
+
+	if affected, err := result.RowsAffected(); err != nil {
+		return false, fmt.Errorf("rows affected: %w", err)
+	} else if affected == 0 {
+		return false, nil
+	}
+
+	if action == ActionPromoted {
+		if err := datastore.NewPostgresRepositoryStore(tx, r.storages).SetAuthoritativeReplica(
+			datastore.WithAuditActor(ctx, "dataloss recovery", fmt.Sprintf("promote replica %d generations behind", evaluation.GenerationGap)),
+			repository.VirtualStorage, repository.RelativePath, evaluation.Storage,
+		); err != nil {
+			return false, fmt.Errorf("set authoritative replica: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("commit: %w", err)
+	}
+
+	return true, nil
+}
@@ -0,0 +1,135 @@
+package datalossrecovery
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/helper/duration"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/config"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/datastore"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper/testdb"
+)
+
+func TestRecoverer(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	db := testdb.New(t)
+
+	configuredStorages := map[string][]string{"virtual-storage": {"gitaly-1", "gitaly-2", "gitaly-3"}}
+
+	rs := datastore.NewPostgresRepositoryStore(db, configuredStorages)
+	for _, repository := range []struct {
+		id          int64
+		generations map[string]int
+	}{
+		// The first repository's remaining replicas are one generation behind and can be promoted.
+		{id: 1, generations: map[string]int{"gitaly-1": 2, "gitaly-2": 1, "gitaly-3": 1}},
+		// The second repository's remaining replicas are too far behind to be promoted automatically.
+		{id: 2, generations: map[string]int{"gitaly-1": 3, "gitaly-2": 1, "gitaly-3": 0}},
+		// The third repository is available from gitaly-2.
+		{id: 3, generations: map[string]int{"gitaly-1": 1, "gitaly-2": 1, "gitaly-3": 0}},
+	} {
+		relativePath := fmt.Sprintf("relative-path-%d", repository.id)
+		require.NoError(t, rs.CreateRepository(ctx, repository.id, "virtual-storage", relativePath, relativePath, "gitaly-1", nil, nil, true, false))
+		for storage, generation := range repository.generations {
+			require.NoError(t, rs.SetGeneration(ctx, repository.id, storage, relativePath, generation))
+		}
+	}
+
+	testdb.SetHealthyNodes(t, ctx, db, map[string]map[string][]string{
+		"praefect-0": {"virtual-storage": {"gitaly-2", "gitaly-3"}},
+	})
+
+	listRefs := func(_ context.Context, virtualStorage, storage, replicaPath string) (map[string]string, error) {
+		require.Equal(t, "virtual-storage", virtualStorage)
+		return map[string]string{"HEAD": "a", "refs/heads/main": "a"}, nil
+	}
+
+	newRecoverer := func(threshold time.Duration) *Recoverer {
+		return NewRecoverer(testhelper.NewLogger(t), db.DB, configuredStorages, config.DatalossRecovery{
+			UnavailabilityThreshold: duration.Duration(threshold),
+			Mode:                    config.DatalossRecoveryModeAutomatic,
+			MaxGenerationGap:        1,
+		}, listRefs)
+	}
+
+	requireUnavailable := func(t *testing.T, expected []int64) {
+		t.Helper()
+
+		var actual []int64
+		rows, err := db.QueryContext(ctx, "SELECT repository_id FROM unavailable_repositories ORDER BY repository_id")
+		require.NoError(t, err)
+		defer rows.Close()
+		for rows.Next() {
+			var id int64
+			require.NoError(t, rows.Scan(&id))
+			actual = append(actual, id)
+		}
+		require.NoError(t, rows.Err())
+		require.Equal(t, expected, actual)
+	}
+
+	type recovery struct {
+		repositoryID  int64
+		action        Action
+		storage       string
+		generationGap int64
+		reason        string
+	}
+
+	requireRecoveries := func(t *testing.T, expected []recovery) {
+		t.Helper()
+
+		var actual []recovery
+		rows, err := db.QueryContext(ctx, `
+			SELECT repository_id, action, storage, generation_gap, reason
+			FROM dataloss_recoveries
+			ORDER BY repository_id
+		`)
+		require.NoError(t, err)
+		defer rows.Close()
+		for rows.Next() {
+			var r recovery
+			require.NoError(t, rows.Scan(&r.repositoryID, &r.action, &r.storage, &r.generationGap, &r.reason))
+			actual = append(actual, r)
+		}
+		require.NoError(t, rows.Err())
+		require.Equal(t, expected, actual)
+	}
+
+	// The repositories are tracked but not evaluated until they have been unavailable longer than the
+	// threshold.
+	require.NoError(t, newRecoverer(time.Hour).recover(ctx))
+	requireUnavailable(t, []int64{1, 2})
+	requireRecoveries(t, nil)
+
+	require.NoError(t, newRecoverer(0).recover(ctx))
+	requireUnavailable(t, []int64{1, 2})
+	requireRecoveries(t, []recovery{
+		{repositoryID: 1, action: ActionPromoted, storage: "gitaly-2", generationGap: 1},
+		{repositoryID: 2, action: ActionRecommended, storage: "gitaly-2", generationGap: 2, reason: "generation gap 2 exceeds the maximum of 1"},
+	})
+
+	metadata, err := rs.GetRepositoryMetadata(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), metadata.Generation)
+	for _, replica := range metadata.Replicas {
+		if replica.Storage == "gitaly-2" {
+			require.Equal(t, int64(3), replica.Generation)
+		}
+	}
+
+	// The promoted repository is available again and no longer tracked. The evaluation of the
+	// recommended repository is not repeated.
+	require.NoError(t, newRecoverer(0).recover(ctx))
+	requireUnavailable(t, []int64{2})
+	requireRecoveries(t, []recovery{
+		{repositoryID: 1, action: ActionPromoted, storage: "gitaly-2", generationGap: 1},
+		{repositoryID: 2, action: ActionRecommended, storage: "gitaly-2", generationGap: 2, reason: "generation gap 2 exceeds the maximum of 1"},
+	})
+}
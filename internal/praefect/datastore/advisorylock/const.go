@@ -5,4 +5,6 @@ package advisorylock
 const (
 	// Reconcile is an advisory lock that must be acquired for each reconciliation run.
 	Reconcile = 1
+	// Rebalance is an advisory lock that must be acquired for each background rebalancing run.
+	Rebalance = 2
 )
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/config"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/datastore/glsql"
 )
 
@@ -30,11 +31,13 @@ func newRepositoryNotFoundError(virtualStorage, relativePath string) error {
 type AssignmentStore struct {
 	db                 glsql.Querier
 	configuredStorages map[string][]string
+	failureDomains     config.FailureDomains
 }
 
-// NewAssignmentStore returns a new AssignmentStore using the passed in database.
-func NewAssignmentStore(db glsql.Querier, configuredStorages map[string][]string) AssignmentStore {
-	return AssignmentStore{db: db, configuredStorages: configuredStorages}
+// NewAssignmentStore returns a new AssignmentStore using the passed in database. Assignments are
+// spread across the failure domains of the storages.
+func NewAssignmentStore(db glsql.Querier, configuredStorages map[string][]string, failureDomains config.FailureDomains) AssignmentStore {
+	return AssignmentStore{db: db, configuredStorages: configuredStorages, failureDomains: failureDomains}
 }
 
 //nolint:revive // This is unintentionally missing documentation.
@@ -92,6 +95,11 @@ func (s AssignmentStore) SetReplicationFactor(ctx context.Context, virtualStorag
 		return nil, newUnattainableReplicationFactorError(replicationFactor, max)
 	}
 
+	candidateFailureDomains := make([]string, 0, len(candidateStorages))
+	for _, storage := range candidateStorages {
+		candidateFailureDomains = append(candidateFailureDomains, s.failureDomains.FailureDomain(virtualStorage, storage))
+	}
+
 	// The query works as follows:
 	//
 	// 1. `repository` CTE locks the repository's record for the duration of the update.
@@ -109,19 +117,29 @@ func (s AssignmentStore) SetReplicationFactor(ctx context.Context, virtualStorag
 	// 3. `created_assignments` CTE assigns new hosts to the repository if the replication
 	//    factor has been increased. Random storages which are not yet assigned to the repository
 	//    are picked until the replication factor is met. The primary of a repository is always
-	//    assigned first.
+	//    assigned first. Storages in failure domains that hold fewer assignments are preferred
+	//    so the assignments are spread across as many failure domains as possible.
 	//
 	// 4. `removed_assignments` CTE removes host assignments if the replication factor has been
 	//    decreased. Primary is never removed as it needs a copy of the repository in order to
-	//    accept writes. Random hosts are removed until the replication factor is met.
+	//    accept writes. Random hosts are removed until the replication factor is met, starting
+	//    with hosts in the failure domains that hold the most assignments.
 	//
 	// 6. Finally we return the current set of assignments. CTE updates are not visible in the
 	//    tables during the transaction. To account for that, we filter out removed assignments
 	//    from the existing assignments. If the replication factor was increased, we'll include the
 	//    created assignments. If the replication factor did not change, the query returns the
 	//    current assignments.
+	actor, reason, notify := AuditSettings(ctx)
 	rows, err := s.db.QueryContext(ctx, `
-WITH repository AS (
+WITH audit_settings AS (
+	SELECT
+		set_config('praefect.audit_actor', $6, true),
+		set_config('praefect.audit_reason', $7, true),
+		set_config('praefect.audit_log_notify', $8, true)
+),
+
+repository AS (
 	SELECT repository_id, virtual_storage, relative_path, "primary"
 	FROM repositories
 	WHERE virtual_storage = $1
@@ -130,19 +148,31 @@ WITH repository AS (
 ),
 
 existing_assignments AS (
-	SELECT storage
+	SELECT storage, failure_domain
 	FROM repository
 	JOIN repository_assignments USING (virtual_storage, relative_path)
-	WHERE storage = ANY($4::text[])
+	JOIN unnest($4::text[], $5::text[]) AS configured_storages(storage, failure_domain) USING (storage)
 ),
 
 created_assignments AS (
 	INSERT INTO repository_assignments
 	SELECT virtual_storage, relative_path, storage, repository_id
-	FROM repository
-	CROSS JOIN ( SELECT unnest($4::text[]) AS storage ) AS configured_storages
-	WHERE storage NOT IN ( SELECT storage FROM existing_assignments )
-	ORDER BY CASE WHEN storage = "primary" THEN 1 ELSE 0 END DESC, random()
+	FROM (
+		SELECT virtual_storage, relative_path, storage, repository_id, "primary",
+			ROW_NUMBER() OVER (
+				PARTITION BY configured_storages.failure_domain
+				ORDER BY CASE WHEN storage = "primary" THEN 1 ELSE 0 END DESC, random()
+			) + (
+				SELECT COUNT(*)
+				FROM existing_assignments
+				WHERE existing_assignments.failure_domain = configured_storages.failure_domain
+			) AS failure_domain_rank
+		FROM repository
+		CROSS JOIN unnest($4::text[], $5::text[]) AS configured_storages(storage, failure_domain)
+		WHERE storage NOT IN ( SELECT storage FROM existing_assignments )
+	) AS candidates
+	WHERE ( SELECT true FROM audit_settings )
+	ORDER BY CASE WHEN storage = "primary" THEN 1 ELSE 0 END DESC, failure_domain_rank, random()
 	LIMIT ( SELECT GREATEST(COUNT(*), $3) - COUNT(*) FROM existing_assignments )
 	RETURNING storage
 ),
@@ -151,14 +181,22 @@ removed_assignments AS (
 	DELETE FROM repository_assignments
 	USING (
 		SELECT virtual_storage, relative_path, storage
-		FROM repository, existing_assignments
+		FROM (
+			SELECT virtual_storage, relative_path, storage, "primary",
+				ROW_NUMBER() OVER (
+					PARTITION BY failure_domain
+					ORDER BY CASE WHEN storage = "primary" THEN 1 ELSE 0 END DESC, random()
+				) AS failure_domain_rank
+			FROM repository, existing_assignments
+		) AS assignments
 		WHERE storage != "primary"
-		ORDER BY random()
+		ORDER BY failure_domain_rank DESC, random()
 		LIMIT ( SELECT COUNT(*) - LEAST(COUNT(*), $3)  FROM existing_assignments )
 	) AS removals
 	WHERE repository_assignments.virtual_storage = removals.virtual_storage
 	AND   repository_assignments.relative_path   = removals.relative_path
 	AND   repository_assignments.storage         = removals.storage
+	AND   ( SELECT true FROM audit_settings )
 	RETURNING removals.storage
 )
 
@@ -169,7 +207,7 @@ UNION
 SELECT storage
 FROM created_assignments
 ORDER BY storage
-	`, virtualStorage, relativePath, replicationFactor, candidateStorages)
+	`, virtualStorage, relativePath, replicationFactor, candidateStorages, candidateFailureDomains, actor, reason, notify)
 	if err != nil {
 		return nil, fmt.Errorf("query: %w", err)
 	}
@@ -192,3 +230,62 @@ ORDER BY storage
 
 	return storages, rows.Err()
 }
+
+// CountRepositoriesSharingFailureDomains counts the repositories whose assigned storages share a
+// failure domain even though the virtual storage has storages in further failure domains the
+// repository could be assigned to. Repositories without assignments are not counted as they are
+// replicated to every storage.
+func CountRepositoriesSharingFailureDomains(ctx context.Context, db glsql.Querier, configuredStorages map[string][]string, failureDomains config.FailureDomains) (map[string]int, error) {
+	var virtualStorages, storages, storageFailureDomains []string
+	for virtualStorage, configured := range configuredStorages {
+		for _, storage := range configured {
+			virtualStorages = append(virtualStorages, virtualStorage)
+			storages = append(storages, storage)
+			storageFailureDomains = append(storageFailureDomains, failureDomains.FailureDomain(virtualStorage, storage))
+		}
+	}
+
+	rows, err := db.QueryContext(ctx, `
+WITH configured_storages AS (
+	SELECT unnest($1::text[]) AS virtual_storage,
+	       unnest($2::text[]) AS storage,
+	       unnest($3::text[]) AS failure_domain
+),
+
+available_failure_domains AS (
+	SELECT virtual_storage, COUNT(DISTINCT failure_domain) AS available_failure_domains
+	FROM configured_storages
+	GROUP BY virtual_storage
+)
+
+SELECT virtual_storage, COUNT(*)
+FROM (
+	SELECT virtual_storage, repository_id,
+		COUNT(*) AS assignments,
+		COUNT(DISTINCT failure_domain) AS assigned_failure_domains
+	FROM repository_assignments
+	JOIN configured_storages USING (virtual_storage, storage)
+	GROUP BY virtual_storage, repository_id
+) AS repository_failure_domains
+JOIN available_failure_domains USING (virtual_storage)
+WHERE assigned_failure_domains < LEAST(assignments, available_failure_domains)
+GROUP BY virtual_storage
+	`, virtualStorages, storages, storageFailureDomains)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+	defer rows.Close()
+
+	sharingFailureDomains := make(map[string]int)
+	for rows.Next() {
+		var virtualStorage string
+		var count int
+		if err := rows.Scan(&virtualStorage, &count); err != nil {
+			return nil, fmt.Errorf("scan: %w", err)
+		}
+
+		sharingFailureDomains[virtualStorage] = count
+	}
+
+	return sharingFailureDomains, rows.Err()
+}
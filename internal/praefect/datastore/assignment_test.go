@@ -1,254 +1,318 @@
 package datastore
 
 import (
-	"errors"
+	"context"
 	"sort"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/config"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/datastore/glsql"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper/testdb"
 )
 
-func TestAssignmentStore_GetHostAssignments(t *testing.T) {
-	t.Parallel()
-	type assignment struct {
-		virtualStorage string
-		relativePath   string
-		storage        string
-	}
+// assignmentStore is the interface implemented by the assignment stores of all backends.
+type assignmentStore interface {
+	GetHostAssignments(ctx context.Context, virtualStorage string, repositoryID int64) ([]string, error)
+	SetReplicationFactor(ctx context.Context, virtualStorage, relativePath string, replicationFactor int) ([]string, error)
+}
+
+// assignmentStoreBackend provides an assignment store implementation for the shared test suite
+// alongside the helpers to inspect and manipulate the state of its database.
+type assignmentStoreBackend struct {
+	// newStores returns a RepositoryStore and an assignment store sharing an empty database.
+	newStores func(t *testing.T, configuredStorages map[string][]string, failureDomains config.FailureDomains) (RepositoryStore, assignmentStore)
+	// setAssignments assigns the storages to a repository which has no assignments yet. The
+	// storages don't have to be configured.
+	setAssignments func(t *testing.T, ctx context.Context, repositoryID int64, storages []string)
+	// requireAssignments asserts the stored assignments of a repository, including the ones on
+	// storages which are not configured.
+	requireAssignments func(t *testing.T, ctx context.Context, repositoryID int64, expected []string)
+}
 
+func TestAssignmentStore_Postgres(t *testing.T) {
+	t.Parallel()
 	db := testdb.New(t)
 
-	configuredStorages := []string{"storage-1", "storage-2", "storage-3"}
-	for _, tc := range []struct {
-		desc                string
-		virtualStorage      string
-		existingAssignments []assignment
-		expectedAssignments []string
-		error               error
-	}{
-		{
-			desc:           "virtual storage not found",
-			virtualStorage: "invalid-virtual-storage",
-			error:          newVirtualStorageNotFoundError("invalid-virtual-storage"),
+	testAssignmentStore(t, assignmentStoreBackend{
+		newStores: func(t *testing.T, configuredStorages map[string][]string, failureDomains config.FailureDomains) (RepositoryStore, assignmentStore) {
+			db.TruncateAll(t)
+			return NewPostgresRepositoryStore(db, configuredStorages), NewAssignmentStore(db, configuredStorages, failureDomains)
 		},
-		{
-			desc:                "configured storages fallback when no records",
-			virtualStorage:      "virtual-storage",
-			expectedAssignments: configuredStorages,
+		setAssignments: func(t *testing.T, ctx context.Context, repositoryID int64, storages []string) {
+			t.Helper()
+
+			_, err := db.ExecContext(ctx, `
+				INSERT INTO repository_assignments (repository_id, virtual_storage, relative_path, storage)
+				SELECT repository_id, virtual_storage, relative_path, unnest($2::text[])
+				FROM repositories
+				WHERE repository_id = $1
+			`, repositoryID, storages)
+			require.NoError(t, err)
 		},
-		{
-			desc:           "configured storages fallback when a repo exists in different virtual storage",
-			virtualStorage: "virtual-storage",
-			existingAssignments: []assignment{
-				{virtualStorage: "other-virtual-storage", relativePath: "relative-path", storage: "storage-1"},
-			},
-			expectedAssignments: configuredStorages,
+		requireAssignments: func(t *testing.T, ctx context.Context, repositoryID int64, expected []string) {
+			t.Helper()
+
+			var storages glsql.StringArray
+			require.NoError(t, db.QueryRowContext(ctx, `
+				SELECT array_agg(storage)
+				FROM repository_assignments
+				WHERE repository_id = $1
+			`, repositoryID).Scan(&storages))
+			require.ElementsMatch(t, expected, storages.Slice())
+
+			var storagesWithIncorrectRepositoryID glsql.StringArray
+			require.NoError(t, db.QueryRowContext(ctx, `
+				SELECT array_agg(storage)
+				FROM repository_assignments
+				WHERE COALESCE(repository_id != $1, true)
+			`, repositoryID).Scan(&storagesWithIncorrectRepositoryID))
+			require.Empty(t, storagesWithIncorrectRepositoryID.Slice())
 		},
-		{
-			desc:           "configured storages fallback when a different repo exists in the virtual storage ",
-			virtualStorage: "virtual-storage",
-			existingAssignments: []assignment{
-				{virtualStorage: "virtual-storage", relativePath: "other-relative-path", storage: "storage-1"},
+	})
+}
+
+func testAssignmentStore(t *testing.T, backend assignmentStoreBackend) {
+	ctx := testhelper.Context(t)
+
+	t.Run("GetHostAssignments", func(t *testing.T) {
+		type repository struct {
+			repositoryID   int64
+			virtualStorage string
+			relativePath   string
+			assignments    []string
+		}
+
+		configuredStorages := []string{"storage-1", "storage-2", "storage-3"}
+		for _, tc := range []struct {
+			desc                 string
+			existingRepositories []repository
+			virtualStorage       string
+			repositoryID         int64
+			expectedAssignments  []string
+			error                error
+		}{
+			{
+				desc:           "virtual storage not found",
+				virtualStorage: "invalid-virtual-storage",
+				repositoryID:   1,
+				error:          newVirtualStorageNotFoundError("invalid-virtual-storage"),
 			},
-			expectedAssignments: configuredStorages,
-		},
-		{
-			desc:           "unconfigured storages are ignored",
-			virtualStorage: "virtual-storage",
-			existingAssignments: []assignment{
-				{virtualStorage: "virtual-storage", relativePath: "relative-path", storage: "unconfigured-storage"},
+			{
+				desc:                "configured storages fallback when no records",
+				virtualStorage:      "virtual-storage",
+				repositoryID:        1,
+				expectedAssignments: configuredStorages,
 			},
-			expectedAssignments: configuredStorages,
-		},
-		{
-			desc:           "assignments found",
-			virtualStorage: "virtual-storage",
-			existingAssignments: []assignment{
-				{virtualStorage: "virtual-storage", relativePath: "relative-path", storage: "storage-1"},
-				{virtualStorage: "virtual-storage", relativePath: "relative-path", storage: "storage-2"},
-				{virtualStorage: "virtual-storage", relativePath: "relative-path", storage: "unconfigured"},
-			},
-			expectedAssignments: []string{"storage-1", "storage-2"},
-		},
-	} {
-		t.Run(tc.desc, func(t *testing.T) {
-			ctx := testhelper.Context(t)
+			{
+				desc: "configured storages fallback when a repo exists in different virtual storage",
+				existingRepositories: []repository{
+					{repositoryID: 1, virtualStorage: "other-virtual-storage", relativePath: "relative-path", assignments: []string{"storage-1"}},
+				},
+				virtualStorage:      "virtual-storage",
+				repositoryID:        2,
+				expectedAssignments: configuredStorages,
+			},
+			{
+				desc: "configured storages fallback when a different repo exists in the virtual storage",
+				existingRepositories: []repository{
+					{repositoryID: 1, virtualStorage: "virtual-storage", relativePath: "other-relative-path", assignments: []string{"storage-1"}},
+				},
+				virtualStorage:      "virtual-storage",
+				repositoryID:        2,
+				expectedAssignments: configuredStorages,
+			},
+			{
+				desc: "configured storages fallback when no assignments",
+				existingRepositories: []repository{
+					{repositoryID: 1, virtualStorage: "virtual-storage", relativePath: "relative-path"},
+				},
+				virtualStorage:      "virtual-storage",
+				repositoryID:        1,
+				expectedAssignments: configuredStorages,
+			},
+			{
+				desc: "unconfigured storages are ignored",
+				existingRepositories: []repository{
+					{repositoryID: 1, virtualStorage: "virtual-storage", relativePath: "relative-path", assignments: []string{"unconfigured-storage"}},
+				},
+				virtualStorage:      "virtual-storage",
+				repositoryID:        1,
+				expectedAssignments: configuredStorages,
+			},
+			{
+				desc: "assignments found",
+				existingRepositories: []repository{
+					{repositoryID: 1, virtualStorage: "virtual-storage", relativePath: "relative-path", assignments: []string{"storage-1", "storage-2", "unconfigured"}},
+				},
+				virtualStorage:      "virtual-storage",
+				repositoryID:        1,
+				expectedAssignments: []string{"storage-1", "storage-2"},
+			},
+		} {
+			t.Run(tc.desc, func(t *testing.T) {
+				rs, store := backend.newStores(t, map[string][]string{"virtual-storage": configuredStorages}, nil)
 
-			db.TruncateAll(t)
+				for _, repository := range tc.existingRepositories {
+					require.NoError(t, rs.CreateRepository(ctx, repository.repositoryID, repository.virtualStorage, repository.relativePath, repository.relativePath, "storage-1", nil, nil, false, false))
+					backend.setAssignments(t, ctx, repository.repositoryID, repository.assignments)
+				}
 
-			rs := NewPostgresRepositoryStore(db, nil)
-			for _, assignment := range tc.existingAssignments {
-				repositoryID, err := rs.GetRepositoryID(ctx, assignment.virtualStorage, assignment.relativePath)
-				if errors.Is(err, ErrRepositoryNotFound) {
-					repositoryID, err = rs.ReserveRepositoryID(ctx, assignment.virtualStorage, assignment.relativePath)
-					require.NoError(t, err)
+				actualAssignments, err := store.GetHostAssignments(ctx, tc.virtualStorage, tc.repositoryID)
+				require.Equal(t, tc.error, err)
+				require.ElementsMatch(t, tc.expectedAssignments, actualAssignments)
+			})
+		}
+	})
 
-					require.NoError(t, rs.CreateRepository(ctx, repositoryID, assignment.virtualStorage, assignment.relativePath, assignment.relativePath, assignment.storage, nil, nil, false, false))
-				}
+	t.Run("SetReplicationFactor", func(t *testing.T) {
+		type matcher func(testing.TB, []string)
 
-				_, err = db.ExecContext(ctx, `
-					INSERT INTO repository_assignments (repository_id, virtual_storage, relative_path, storage)
-					VALUES ($1, $2, $3, $4)
-				`, repositoryID, assignment.virtualStorage, assignment.relativePath, assignment.storage)
-				require.NoError(t, err)
+		equal := func(expected []string) matcher {
+			return func(tb testing.TB, actual []string) {
+				tb.Helper()
+				require.Equal(tb, expected, actual)
 			}
+		}
 
-			repositoryID, err := rs.GetRepositoryID(ctx, tc.virtualStorage, "relative-path")
-			if err != nil {
-				require.Equal(t, ErrRepositoryNotFound, err)
+		contains := func(expecteds ...[]string) matcher {
+			return func(tb testing.TB, actual []string) {
+				tb.Helper()
+				require.Contains(tb, expecteds, actual)
 			}
-
-			actualAssignments, err := NewAssignmentStore(
-				db,
-				map[string][]string{"virtual-storage": configuredStorages},
-			).GetHostAssignments(ctx, tc.virtualStorage, repositoryID)
-			require.Equal(t, tc.error, err)
-			require.ElementsMatch(t, tc.expectedAssignments, actualAssignments)
-		})
-	}
-}
-
-func TestAssignmentStore_SetReplicationFactor(t *testing.T) {
-	t.Parallel()
-	type matcher func(testing.TB, []string)
-
-	equal := func(expected []string) matcher {
-		return func(tb testing.TB, actual []string) {
-			tb.Helper()
-			require.Equal(tb, expected, actual)
 		}
-	}
 
-	contains := func(expecteds ...[]string) matcher {
-		return func(tb testing.TB, actual []string) {
-			tb.Helper()
-			require.Contains(tb, expecteds, actual)
-		}
-	}
+		failureDomains := config.FailureDomains{"virtual-storage": {
+			"primary":     "zone-a",
+			"secondary-1": "zone-a",
+			"secondary-2": "zone-b",
+		}}
 
-	db := testdb.New(t)
+		for _, tc := range []struct {
+			desc                  string
+			existingAssignments   []string
+			nonExistentRepository bool
+			failureDomains        config.FailureDomains
+			replicationFactor     int
+			requireStorages       matcher
+			// expectedAssignments are the stored assignments if they differ from the
+			// assigned storages.
+			expectedAssignments []string
+			error               error
+		}{
+			{
+				desc:                  "increase replication factor of non-existent repository",
+				nonExistentRepository: true,
+				replicationFactor:     1,
+				error:                 newRepositoryNotFoundError("virtual-storage", "relative-path"),
+			},
+			{
+				desc:              "primary prioritized when setting the first assignments",
+				replicationFactor: 1,
+				requireStorages:   equal([]string{"primary"}),
+			},
+			{
+				desc:                "increasing replication factor ignores unconfigured storages",
+				existingAssignments: []string{"unconfigured-storage"},
+				replicationFactor:   1,
+				requireStorages:     equal([]string{"primary"}),
+				expectedAssignments: []string{"primary", "unconfigured-storage"},
+			},
+			{
+				desc:                "replication factor already achieved",
+				existingAssignments: []string{"primary", "secondary-1"},
+				replicationFactor:   2,
+				requireStorages:     equal([]string{"primary", "secondary-1"}),
+			},
+			{
+				desc:                "increase replication factor by a step",
+				existingAssignments: []string{"primary"},
+				replicationFactor:   2,
+				requireStorages:     contains([]string{"primary", "secondary-1"}, []string{"primary", "secondary-2"}),
+			},
+			{
+				desc:                "increase replication factor to maximum",
+				existingAssignments: []string{"primary"},
+				replicationFactor:   3,
+				requireStorages:     equal([]string{"primary", "secondary-1", "secondary-2"}),
+			},
+			{
+				desc:                "increased replication factor unattainable",
+				existingAssignments: []string{"primary"},
+				replicationFactor:   4,
+				error:               newUnattainableReplicationFactorError(4, 3),
+			},
+			{
+				desc:                "decreasing replication factor ignores unconfigured storages",
+				existingAssignments: []string{"secondary-1", "unconfigured-storage"},
+				replicationFactor:   1,
+				requireStorages:     equal([]string{"secondary-1"}),
+				expectedAssignments: []string{"secondary-1", "unconfigured-storage"},
+			},
+			{
+				desc:                "decrease replication factor by a step",
+				existingAssignments: []string{"primary", "secondary-1", "secondary-2"},
+				replicationFactor:   2,
+				requireStorages:     contains([]string{"primary", "secondary-1"}, []string{"primary", "secondary-2"}),
+			},
+			{
+				desc:                "decrease replication factor to minimum",
+				existingAssignments: []string{"primary", "secondary-1", "secondary-2"},
+				replicationFactor:   1,
+				requireStorages:     equal([]string{"primary"}),
+			},
+			{
+				desc:                "increasing replication factor spreads assignments across failure domains",
+				existingAssignments: []string{"primary"},
+				failureDomains:      failureDomains,
+				replicationFactor:   2,
+				requireStorages:     equal([]string{"primary", "secondary-2"}),
+			},
+			{
+				desc:                "decreasing replication factor removes assignments sharing a failure domain",
+				existingAssignments: []string{"primary", "secondary-1", "secondary-2"},
+				failureDomains:      failureDomains,
+				replicationFactor:   2,
+				requireStorages:     equal([]string{"primary", "secondary-2"}),
+			},
+			{
+				desc:              "minimum replication factor is enforced",
+				replicationFactor: 0,
+				error:             newMinimumReplicationFactorError(0),
+			},
+		} {
+			t.Run(tc.desc, func(t *testing.T) {
+				configuredStorages := map[string][]string{"virtual-storage": {"primary", "secondary-1", "secondary-2"}}
 
-	for _, tc := range []struct {
-		desc                  string
-		existingAssignments   []string
-		nonExistentRepository bool
-		replicationFactor     int
-		requireStorages       matcher
-		error                 error
-	}{
-		{
-			desc:                  "increase replication factor of non-existent repository",
-			nonExistentRepository: true,
-			replicationFactor:     1,
-			error:                 newRepositoryNotFoundError("virtual-storage", "relative-path"),
-		},
-		{
-			desc:              "primary prioritized when setting the first assignments",
-			replicationFactor: 1,
-			requireStorages:   equal([]string{"primary"}),
-		},
-		{
-			desc:                "increasing replication factor ignores unconfigured storages",
-			existingAssignments: []string{"unconfigured-storage"},
-			replicationFactor:   1,
-			requireStorages:     equal([]string{"primary"}),
-		},
-		{
-			desc:                "replication factor already achieved",
-			existingAssignments: []string{"primary", "secondary-1"},
-			replicationFactor:   2,
-			requireStorages:     equal([]string{"primary", "secondary-1"}),
-		},
-		{
-			desc:                "increase replication factor by a step",
-			existingAssignments: []string{"primary"},
-			replicationFactor:   2,
-			requireStorages:     contains([]string{"primary", "secondary-1"}, []string{"primary", "secondary-2"}),
-		},
-		{
-			desc:                "increase replication factor to maximum",
-			existingAssignments: []string{"primary"},
-			replicationFactor:   3,
-			requireStorages:     equal([]string{"primary", "secondary-1", "secondary-2"}),
-		},
-		{
-			desc:                "increased replication factor unattainable",
-			existingAssignments: []string{"primary"},
-			replicationFactor:   4,
-			error:               newUnattainableReplicationFactorError(4, 3),
-		},
-		{
-			desc:                "decreasing replication factor ignores unconfigured storages",
-			existingAssignments: []string{"secondary-1", "unconfigured-storage"},
-			replicationFactor:   1,
-			requireStorages:     equal([]string{"secondary-1"}),
-		},
-		{
-			desc:                "decrease replication factor by a step",
-			existingAssignments: []string{"primary", "secondary-1", "secondary-2"},
-			replicationFactor:   2,
-			requireStorages:     contains([]string{"primary", "secondary-1"}, []string{"primary", "secondary-2"}),
-		},
-		{
-			desc:                "decrease replication factor to minimum",
-			existingAssignments: []string{"primary", "secondary-1", "secondary-2"},
-			replicationFactor:   1,
-			requireStorages:     equal([]string{"primary"}),
-		},
-		{
-			desc:              "minimum replication factor is enforced",
-			replicationFactor: 0,
-			error:             newMinimumReplicationFactorError(0),
-		},
-	} {
-		t.Run(tc.desc, func(t *testing.T) {
-			ctx := testhelper.Context(t)
+				rs, store := backend.newStores(t, configuredStorages, tc.failureDomains)
 
-			db.TruncateAll(t)
+				if !tc.nonExistentRepository {
+					require.NoError(t, rs.CreateRepository(ctx, 1, "virtual-storage", "relative-path", "replica-path", "primary", nil, nil, true, false))
+					backend.setAssignments(t, ctx, 1, tc.existingAssignments)
+				}
 
-			configuredStorages := map[string][]string{"virtual-storage": {"primary", "secondary-1", "secondary-2"}}
+				setStorages, err := store.SetReplicationFactor(ctx, "virtual-storage", "relative-path", tc.replicationFactor)
+				require.Equal(t, tc.error, err)
+				if tc.error != nil {
+					return
+				}
 
-			if !tc.nonExistentRepository {
-				_, err := db.ExecContext(ctx, `
-					INSERT INTO repositories (virtual_storage, relative_path, "primary", repository_id)
-					VALUES ('virtual-storage', 'relative-path', 'primary', 1)
-				`)
-				require.NoError(t, err)
-			}
+				tc.requireStorages(t, setStorages)
 
-			for _, storage := range tc.existingAssignments {
-				_, err := db.ExecContext(ctx, `
-					INSERT INTO repository_assignments VALUES ('virtual-storage', 'relative-path', $1, 1)
-				`, storage)
+				assignedStorages, err := store.GetHostAssignments(ctx, "virtual-storage", 1)
 				require.NoError(t, err)
-			}
 
-			store := NewAssignmentStore(db, configuredStorages)
-
-			setStorages, err := store.SetReplicationFactor(ctx, "virtual-storage", "relative-path", tc.replicationFactor)
-			require.Equal(t, tc.error, err)
-			if tc.error != nil {
-				return
-			}
+				sort.Strings(assignedStorages)
+				tc.requireStorages(t, assignedStorages)
 
-			tc.requireStorages(t, setStorages)
-
-			assignedStorages, err := store.GetHostAssignments(ctx, "virtual-storage", 1)
-			require.NoError(t, err)
-
-			sort.Strings(assignedStorages)
-			tc.requireStorages(t, assignedStorages)
+				expectedAssignments := tc.expectedAssignments
+				if expectedAssignments == nil {
+					expectedAssignments = assignedStorages
+				}
 
-			var storagesWithIncorrectRepositoryID glsql.StringArray
-			require.NoError(t, db.QueryRowContext(ctx, `
-				SELECT array_agg(storage)
-				FROM repository_assignments
-				WHERE COALESCE(repository_id != 1, true)
-			`).Scan(&storagesWithIncorrectRepositoryID))
-			require.Empty(t, storagesWithIncorrectRepositoryID.Slice())
-		})
-	}
+				backend.requireAssignments(t, ctx, 1, expectedAssignments)
+			})
+		}
+	})
 }
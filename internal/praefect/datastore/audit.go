@@ -0,0 +1,332 @@
+package datastore
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"gitlab.com/gitlab-org/gitaly/v16/internal/helper"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/log"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/datastore/glsql"
+)
+
+// RepositoryAuditLogChannel is a name of the database event channel used to send the entries added to the
+// 'repository_audit_log' table.
+const RepositoryAuditLogChannel = "repository_audit_log"
+
+type auditAnnotationKey struct{}
+
+type auditAnnotation struct {
+	actor  string
+	reason string
+}
+
+type auditLogNotificationsKey struct{}
+
+// WithAuditActor returns a context which attributes the changes made to the repository metadata with it to
+// the given actor and reason. The changes are recorded in the repository audit log by database triggers which
+// read the actor and the reason from transaction-local settings. See AuditSettings.
+func WithAuditActor(ctx context.Context, actor, reason string) context.Context {
+	return context.WithValue(ctx, auditAnnotationKey{}, auditAnnotation{actor: actor, reason: reason})
+}
+
+// WithAuditLogNotifications returns a context whose changes to the repository metadata are sent to the
+// RepositoryAuditLogChannel once recorded in the repository audit log. Only the AuditLogger listens on the
+// channel, so the notifications are only sent if logging the changes is enabled.
+func WithAuditLogNotifications(ctx context.Context) context.Context {
+	return context.WithValue(ctx, auditLogNotificationsKey{}, true)
+}
+
+// AuditSettings returns the values of the transaction-local settings the audit triggers read when recording
+// the changes made with the context: the actor and the reason the changes are attributed to, and whether the
+// recorded entries are sent to the RepositoryAuditLogChannel. The actor and the reason are empty if the context
+// is not annotated with WithAuditActor, in which case the changes are attributed to an unknown actor.
+//
+// Rather than setting them in a separate statement, the statements changing the metadata set the settings
+// with set_config in an `audit_settings` CTE which they check in the qualification of their changes. This
+// ensures the CTE is evaluated and saves a round trip on the hot write paths. The audit triggers fire at the
+// end of the statement and thus see the settings. The settings are reset when the transaction ends, so they
+// don't leak to other transactions using the same connection, even if it is pooled by PgBouncer in
+// transaction mode.
+func AuditSettings(ctx context.Context) (actor, reason, notify string) {
+	annotation, _ := ctx.Value(auditAnnotationKey{}).(auditAnnotation)
+	if enabled, _ := ctx.Value(auditLogNotificationsKey{}).(bool); enabled {
+		notify = "on"
+	}
+
+	return annotation.actor, annotation.reason, notify
+}
+
+// AuditEntry is an entry in the repository audit log. It records a single change to a repository's metadata.
+type AuditEntry struct {
+	// ID is the ID of the entry. IDs increase in the order the entries were recorded.
+	ID int64 `json:"id"`
+	// OccurredAt is the time the change was made.
+	OccurredAt time.Time `json:"occurred_at"`
+	// TransactionID is the ID of the database transaction the change was made in. Changes made in the
+	// same transaction have the same ID.
+	TransactionID int64 `json:"transaction_id"`
+	// RepositoryID is the ID of the changed repository.
+	RepositoryID int64 `json:"repository_id"`
+	// VirtualStorage is the virtual storage of the changed repository.
+	VirtualStorage string `json:"virtual_storage"`
+	// RelativePath is the relative path of the changed repository.
+	RelativePath string `json:"relative_path"`
+	// Storage is the storage the change concerns. It is empty for changes to the repository as a whole.
+	Storage string `json:"storage"`
+	// Change is the type of the change, for example "generation_changed" or "assignment_removed".
+	Change string `json:"change"`
+	// OldValue is the value before the change. It is empty if the change has no previous value.
+	OldValue string `json:"old_value"`
+	// NewValue is the value after the change. It is empty if the change has no new value.
+	NewValue string `json:"new_value"`
+	// Actor is what made the change, for example "grpc" or "reconciler". It is "unknown" if the change
+	// was not annotated.
+	Actor string `json:"actor"`
+	// Reason is why the change was made, for example the RPC that triggered it.
+	Reason string `json:"reason"`
+}
+
+// AuditLogFilter selects entries in the repository audit log. Fields which are not set match every entry.
+type AuditLogFilter struct {
+	// VirtualStorage is the virtual storage of the changed repositories.
+	VirtualStorage string
+	// RelativePath is the relative path of the changed repositories.
+	RelativePath string
+	// RepositoryID is the ID of the changed repository.
+	RepositoryID int64
+	// Actor is what made the changes.
+	Actor string
+	// Since is the earliest time of the changes.
+	Since time.Time
+	// Until is the latest time of the changes.
+	Until time.Time
+}
+
+// ListAuditLog returns the entries of the repository audit log matching the filter ordered by their ID. If
+// limit is greater than 0, only the limit most recent matching entries are returned.
+func (rs *PostgresRepositoryStore) ListAuditLog(ctx context.Context, filter AuditLogFilter, limit uint) ([]AuditEntry, error) {
+	var since, until, sqlLimit interface{}
+	if !filter.Since.IsZero() {
+		since = filter.Since
+	}
+
+	if !filter.Until.IsZero() {
+		until = filter.Until
+	}
+
+	if limit > 0 {
+		sqlLimit = limit
+	}
+
+	rows, err := rs.db.QueryContext(ctx, `
+		SELECT id, occurred_at, transaction_id, repository_id, virtual_storage, relative_path, storage, change, old_value, new_value, actor, reason
+		FROM (
+			SELECT
+				id,
+				occurred_at,
+				transaction_id,
+				COALESCE(repository_id, 0) AS repository_id,
+				virtual_storage,
+				relative_path,
+				COALESCE(storage, '') AS storage,
+				change,
+				COALESCE(old_value, '') AS old_value,
+				COALESCE(new_value, '') AS new_value,
+				actor,
+				reason
+			FROM repository_audit_log
+			WHERE ($1 = '' OR virtual_storage = $1)
+			AND ($2 = '' OR relative_path = $2)
+			AND ($3 = 0 OR repository_id = $3)
+			AND ($4 = '' OR actor = $4)
+			AND ($5::timestamptz IS NULL OR occurred_at >= $5)
+			AND ($6::timestamptz IS NULL OR occurred_at <= $6)
+			ORDER BY id DESC
+			LIMIT $7
+		) AS entries
+		ORDER BY id
+	`, filter.VirtualStorage, filter.RelativePath, filter.RepositoryID, filter.Actor, since, until, sqlLimit)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []AuditEntry
+	for rows.Next() {
+		var entry AuditEntry
+		if err := rows.Scan(
+			&entry.ID,
+			&entry.OccurredAt,
+			&entry.TransactionID,
+			&entry.RepositoryID,
+			&entry.VirtualStorage,
+			&entry.RelativePath,
+			&entry.Storage,
+			&entry.Change,
+			&entry.OldValue,
+			&entry.NewValue,
+			&entry.Actor,
+			&entry.Reason,
+		); err != nil {
+			return nil, fmt.Errorf("scan: %w", err)
+		}
+
+		entries = append(entries, entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating rows: %w", err)
+	}
+
+	return entries, nil
+}
+
+// auditLogPurgeBatchSize is the maximum number of entries deleted from the audit log in a single
+// transaction.
+const auditLogPurgeBatchSize = 10000
+
+// AuditLogPurger deletes the entries older than the retention period from the repository audit log.
+type AuditLogPurger struct {
+	logger    log.Logger
+	db        *sql.DB
+	retention time.Duration
+}
+
+// NewAuditLogPurger returns a new AuditLogPurger purging the entries older than the retention period.
+func NewAuditLogPurger(logger log.Logger, db *sql.DB, retention time.Duration) *AuditLogPurger {
+	return &AuditLogPurger{
+		logger:    logger.WithField("component", "repository_audit_log_purger"),
+		db:        db,
+		retention: retention,
+	}
+}
+
+// Run purges the audit log on every tick of the ticker until the context is canceled. Every Praefect
+// may run the purger as the concurrent runs skip the entries being deleted by the others.
+func (p *AuditLogPurger) Run(ctx context.Context, ticker helper.Ticker) error {
+	defer ticker.Stop()
+
+	for {
+		ticker.Reset()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C():
+			purged, err := p.Purge(ctx)
+			if err != nil {
+				p.logger.WithError(err).Error("failed purging repository audit log")
+			} else if purged > 0 {
+				p.logger.WithField("purged_entries", purged).Info("purged expired repository audit log entries")
+			}
+		}
+	}
+}
+
+// Purge deletes the entries older than the retention period in batches. It returns the number of
+// entries deleted.
+func (p *AuditLogPurger) Purge(ctx context.Context) (int64, error) {
+	var total int64
+	for {
+		purged, err := p.purgeBatch(ctx)
+		if err != nil {
+			return total, err
+		}
+
+		total += purged
+		if purged < auditLogPurgeBatchSize {
+			return total, nil
+		}
+	}
+}
+
+func (p *AuditLogPurger) purgeBatch(ctx context.Context) (int64, error) {
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("begin: %w", err)
+	}
+	//nolint:errcheck
+	defer tx.Rollback()
+
+	// The trigger keeping the audit log append-only allows deleting the entries older than the
+	// retention period set in the transaction.
+	retention := strconv.FormatInt(int64(p.retention.Seconds()), 10)
+	if _, err := tx.ExecContext(ctx, "SELECT set_config('praefect.audit_log_retention', $1, true)", retention); err != nil {
+		return 0, fmt.Errorf("set retention: %w", err)
+	}
+
+	result, err := tx.ExecContext(ctx, `
+DELETE FROM repository_audit_log
+WHERE id IN (
+	SELECT id
+	FROM repository_audit_log
+	WHERE occurred_at < NOW() - MAKE_INTERVAL(secs => $1::DOUBLE PRECISION)
+	ORDER BY id
+	LIMIT $2
+	FOR UPDATE SKIP LOCKED
+)
+`, retention, auditLogPurgeBatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("delete: %w", err)
+	}
+
+	purged, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("rows affected: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("commit: %w", err)
+	}
+
+	return purged, nil
+}
+
+// AuditLogger logs the entries added to the repository audit log as they are received from the
+// RepositoryAuditLogChannel. Every Praefect listening on the channel logs every entry, so the logger is
+// only started if enabled with the audit_log.log_changes configuration option. The entries are only sent
+// to the channel for the changes made with a context set up by WithAuditLogNotifications.
+type AuditLogger struct {
+	logger log.Logger
+}
+
+// NewAuditLogger returns a ListenHandler which logs the received repository audit log entries.
+func NewAuditLogger(logger log.Logger) *AuditLogger {
+	return &AuditLogger{logger: logger.WithField("component", "repository_audit_log")}
+}
+
+// Notification logs the audit log entry in the notification.
+func (l *AuditLogger) Notification(n glsql.Notification) {
+	var entry AuditEntry
+	if err := json.Unmarshal([]byte(n.Payload), &entry); err != nil {
+		l.logger.WithError(err).WithField("channel", n.Channel).Error("received payload can't be processed")
+		return
+	}
+
+	l.logger.WithFields(log.Fields{
+		"audit_id":        entry.ID,
+		"transaction_id":  entry.TransactionID,
+		"repository_id":   entry.RepositoryID,
+		"virtual_storage": entry.VirtualStorage,
+		"relative_path":   entry.RelativePath,
+		"storage":         entry.Storage,
+		"change":          entry.Change,
+		"old_value":       entry.OldValue,
+		"new_value":       entry.NewValue,
+		"actor":           entry.Actor,
+		"reason":          entry.Reason,
+	}).Info("repository metadata changed")
+}
+
+// Connected is called when the listener has connected to Postgres.
+func (l *AuditLogger) Connected() {}
+
+// Disconnect is called when the listener's connection to Postgres has been lost. Entries recorded while
+// disconnected are not logged but remain in the audit log.
+func (l *AuditLogger) Disconnect(err error) {
+	l.logger.WithError(err).Warn("repository audit log stream disconnected")
+}
@@ -0,0 +1,228 @@
+package datastore
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/datastore/glsql"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper/testdb"
+)
+
+func TestAuditSettings(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+
+	actor, reason, notify := AuditSettings(ctx)
+	require.Empty(t, actor)
+	require.Empty(t, reason)
+	require.Empty(t, notify)
+
+	actor, reason, notify = AuditSettings(WithAuditActor(ctx, "grpc", "/gitaly.RepositoryService/WriteRef"))
+	require.Equal(t, "grpc", actor)
+	require.Equal(t, "/gitaly.RepositoryService/WriteRef", reason)
+	require.Empty(t, notify)
+
+	actor, reason, notify = AuditSettings(WithAuditActor(WithAuditLogNotifications(ctx), "reconciler", "reconcile replicas"))
+	require.Equal(t, "reconciler", actor)
+	require.Equal(t, "reconcile replicas", reason)
+	require.Equal(t, "on", notify)
+}
+
+func TestAuditLogger(t *testing.T) {
+	t.Parallel()
+
+	logger := testhelper.NewLogger(t)
+	hook := testhelper.AddLoggerHook(logger)
+
+	auditLogger := NewAuditLogger(logger)
+	auditLogger.Notification(glsql.Notification{
+		Channel: RepositoryAuditLogChannel,
+		Payload: `{"id": 1, "occurred_at": "2023-08-30T10:00:00.123456+00:00", "transaction_id": 2, "repository_id": 3, "virtual_storage": "virtual-storage", "relative_path": "relative-path", "storage": "gitaly-1", "change": "primary_changed", "old_value": null, "new_value": "gitaly-1", "actor": "grpc", "reason": "/gitaly.RepositoryService/CreateRepository"}`,
+	})
+	auditLogger.Notification(glsql.Notification{Channel: RepositoryAuditLogChannel, Payload: "invalid"})
+
+	entries := hook.AllEntries()
+	require.Len(t, entries, 2)
+
+	require.Equal(t, logrus.InfoLevel, entries[0].Level)
+	require.Equal(t, "repository metadata changed", entries[0].Message)
+	require.Equal(t, logrus.Fields{
+		"component":       "repository_audit_log",
+		"audit_id":        int64(1),
+		"transaction_id":  int64(2),
+		"repository_id":   int64(3),
+		"virtual_storage": "virtual-storage",
+		"relative_path":   "relative-path",
+		"storage":         "gitaly-1",
+		"change":          "primary_changed",
+		"old_value":       "",
+		"new_value":       "gitaly-1",
+		"actor":           "grpc",
+		"reason":          "/gitaly.RepositoryService/CreateRepository",
+	}, entries[0].Data)
+
+	require.Equal(t, logrus.ErrorLevel, entries[1].Level)
+	require.Equal(t, "received payload can't be processed", entries[1].Message)
+}
+
+func TestPostgresRepositoryStore_auditLog(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	db := testdb.New(t)
+
+	rs := NewPostgresRepositoryStore(db, map[string][]string{"virtual-storage": {"gitaly-1", "gitaly-2", "gitaly-3"}})
+
+	start := time.Now()
+	require.NoError(t, rs.CreateRepository(
+		WithAuditActor(ctx, "grpc", "/gitaly.RepositoryService/CreateRepository"),
+		1, "virtual-storage", "relative-path", "replica-path", "gitaly-1", []string{"gitaly-2"}, []string{"gitaly-3"}, true, true,
+	))
+	_, err := rs.IncrementGeneration(
+		WithAuditActor(ctx, "grpc", "/gitaly.RepositoryService/WriteRef"),
+		1, "gitaly-1", nil,
+	)
+	require.NoError(t, err)
+	// Changes made without an annotated context are attributed to an unknown actor.
+	db.MustExec(t, `UPDATE repositories SET "primary" = 'gitaly-2' WHERE repository_id = 1`)
+	require.NoError(t, rs.SetAuthoritativeReplica(
+		WithAuditActor(ctx, "grpc", "/gitaly.PraefectInfoService/SetAuthoritativeStorage"),
+		"virtual-storage", "relative-path", "gitaly-2",
+	))
+	_, _, err = rs.DeleteRepository(WithAuditActor(ctx, "grpc", "/gitaly.RepositoryService/RemoveRepository"), "virtual-storage", "relative-path")
+	require.NoError(t, err)
+
+	type change struct {
+		storage  string
+		change   string
+		oldValue string
+		newValue string
+		actor    string
+		reason   string
+	}
+
+	entries, err := rs.ListAuditLog(ctx, AuditLogFilter{VirtualStorage: "virtual-storage", RelativePath: "relative-path"}, 0)
+	require.NoError(t, err)
+
+	changes := make([]change, 0, len(entries))
+	for _, entry := range entries {
+		require.Equal(t, int64(1), entry.RepositoryID)
+		require.False(t, entry.OccurredAt.Before(start.Add(-time.Minute)))
+		changes = append(changes, change{
+			storage:  entry.Storage,
+			change:   entry.Change,
+			oldValue: entry.OldValue,
+			newValue: entry.NewValue,
+			actor:    entry.Actor,
+			reason:   entry.Reason,
+		})
+	}
+
+	const (
+		create    = "/gitaly.RepositoryService/CreateRepository"
+		writeRef  = "/gitaly.RepositoryService/WriteRef"
+		authority = "/gitaly.PraefectInfoService/SetAuthoritativeStorage"
+		remove    = "/gitaly.RepositoryService/RemoveRepository"
+	)
+
+	require.ElementsMatch(t, []change{
+		{storage: "gitaly-1", change: "repository_created", newValue: "0", actor: "grpc", reason: create},
+		{storage: "gitaly-1", change: "replica_created", newValue: "0", actor: "grpc", reason: create},
+		{storage: "gitaly-2", change: "replica_created", newValue: "0", actor: "grpc", reason: create},
+		{storage: "gitaly-1", change: "assignment_added", actor: "grpc", reason: create},
+		{storage: "gitaly-2", change: "assignment_added", actor: "grpc", reason: create},
+		{storage: "gitaly-3", change: "assignment_added", actor: "grpc", reason: create},
+		{change: "generation_changed", oldValue: "0", newValue: "1", actor: "grpc", reason: writeRef},
+		{storage: "gitaly-1", change: "replica_generation_changed", oldValue: "0", newValue: "1", actor: "grpc", reason: writeRef},
+		{storage: "gitaly-2", change: "primary_changed", oldValue: "gitaly-1", newValue: "gitaly-2", actor: "unknown"},
+		{change: "generation_changed", oldValue: "1", newValue: "2", actor: "grpc", reason: authority},
+		{storage: "gitaly-2", change: "replica_generation_changed", oldValue: "0", newValue: "2", actor: "grpc", reason: authority},
+		{storage: "gitaly-2", change: "repository_deleted", oldValue: "2", actor: "grpc", reason: remove},
+		{storage: "gitaly-1", change: "replica_deleted", oldValue: "1", actor: "grpc", reason: remove},
+		{storage: "gitaly-2", change: "replica_deleted", oldValue: "2", actor: "grpc", reason: remove},
+		{storage: "gitaly-1", change: "assignment_removed", actor: "grpc", reason: remove},
+		{storage: "gitaly-2", change: "assignment_removed", actor: "grpc", reason: remove},
+		{storage: "gitaly-3", change: "assignment_removed", actor: "grpc", reason: remove},
+	}, changes)
+
+	limited, err := rs.ListAuditLog(ctx, AuditLogFilter{Actor: "unknown"}, 0)
+	require.NoError(t, err)
+	require.Len(t, limited, 1)
+	require.Equal(t, "primary_changed", limited[0].Change)
+
+	limited, err = rs.ListAuditLog(ctx, AuditLogFilter{RepositoryID: 1}, 2)
+	require.NoError(t, err)
+	require.Equal(t, entries[len(entries)-2:], limited)
+
+	limited, err = rs.ListAuditLog(ctx, AuditLogFilter{Until: start.Add(-time.Hour)}, 0)
+	require.NoError(t, err)
+	require.Empty(t, limited)
+
+	_, err = db.ExecContext(ctx, "DELETE FROM repository_audit_log")
+	require.ErrorContains(t, err, "repository_audit_log is append-only")
+
+	// Entries within the retention period are kept.
+	purged, err := NewAuditLogPurger(testhelper.NewLogger(t), db.DB, time.Hour).Purge(ctx)
+	require.NoError(t, err)
+	require.Zero(t, purged)
+
+	// Entries made before the purging transaction started are beyond a retention period of 0.
+	purged, err = NewAuditLogPurger(testhelper.NewLogger(t), db.DB, 0).Purge(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(len(entries)), purged)
+
+	remaining, err := rs.ListAuditLog(ctx, AuditLogFilter{}, 0)
+	require.NoError(t, err)
+	require.Empty(t, remaining)
+}
+
+func TestPostgresRepositoryStore_auditLogNotifications(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	db := testdb.New(t)
+	dbConf := testdb.GetConfig(t, db.Name)
+
+	rs := NewPostgresRepositoryStore(db, map[string][]string{"virtual-storage": {"gitaly-1"}})
+
+	t.Run("without notifications", func(t *testing.T) {
+		verifyListener(
+			t,
+			ctx,
+			dbConf,
+			RepositoryAuditLogChannel,
+			func(t *testing.T) {},
+			func(t *testing.T) {
+				require.NoError(t, rs.CreateRepository(
+					WithAuditActor(ctx, "grpc", "/gitaly.RepositoryService/CreateRepository"),
+					1, "virtual-storage", "relative-path-1", "replica-path-1", "gitaly-1", nil, nil, true, false,
+				))
+			},
+			nil,
+		)
+	})
+
+	t.Run("with notifications", func(t *testing.T) {
+		verifyListener(
+			t,
+			ctx,
+			dbConf,
+			RepositoryAuditLogChannel,
+			func(t *testing.T) {},
+			func(t *testing.T) {
+				require.NoError(t, rs.CreateRepository(
+					WithAuditActor(WithAuditLogNotifications(ctx), "grpc", "/gitaly.RepositoryService/CreateRepository"),
+					2, "virtual-storage", "relative-path-2", "replica-path-2", "gitaly-1", nil, nil, true, false,
+				))
+			},
+			func(t *testing.T, notification glsql.Notification) {
+				require.Equal(t, RepositoryAuditLogChannel, notification.Channel)
+				require.Contains(t, notification.Payload, `"actor":"grpc"`)
+			},
+		)
+	})
+}
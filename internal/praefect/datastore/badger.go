@@ -0,0 +1,300 @@
+package datastore
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dgraph-io/badger/v4"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/log"
+)
+
+// BadgerDB is an embedded database Praefect can store its metadata in instead of PostgreSQL. As the
+// database can only be opened by a single process, it can only be used by installations running a
+// single Praefect.
+//
+// The records are stored as JSON encoded values under the following keys:
+//   - `migrations/<id>` records the migrations that have been applied to the database.
+//   - `sequences/<name>` holds the last value handed out by a sequence.
+//   - `repositories/<repository id>` holds a repository's record including its replicas and
+//     assignments.
+//   - `repository_paths/<virtual storage>\0<relative path>` maps a repository's virtual path to its ID.
+//   - `replication_queue/<job id>` holds a replication job.
+//   - `replication_queue_lock/<lock id>` holds a repository level lock of the replication queue.
+//   - `replication_queue_job_lock/<job id>` tracks the jobs being processed and the locks they hold.
+type BadgerDB struct {
+	db *badger.DB
+	// writeMutex serializes the write transactions. There is only a single Praefect writing to
+	// the database so this avoids having to retry transactions on conflicts.
+	writeMutex sync.Mutex
+	// now returns the current time. It can be overridden in tests.
+	now func() time.Time
+}
+
+// OpenBadgerDB opens the embedded database at the given path and applies the pending migrations.
+// An error is returned if the database contains migrations this version of Praefect does not know
+// about.
+func OpenBadgerDB(logger log.Logger, path string) (*BadgerDB, error) {
+	options := badger.DefaultOptions(path)
+	// Enable SyncWrites to ensure all writes are persisted to disk before considering
+	// them committed.
+	options.SyncWrites = true
+	options.Logger = badgerLogger{logger.WithField("component", "embedded_database")}
+
+	db, err := badger.Open(options)
+	if err != nil {
+		return nil, fmt.Errorf("open: %w", err)
+	}
+
+	bdb := &BadgerDB{db: db, now: time.Now}
+	if _, err := bdb.Migrate(); err != nil {
+		if closeErr := db.Close(); closeErr != nil {
+			return nil, fmt.Errorf("migrate: %w, close: %v", err, closeErr)
+		}
+
+		return nil, fmt.Errorf("migrate: %w", err)
+	}
+
+	return bdb, nil
+}
+
+// Close closes the database.
+func (db *BadgerDB) Close() error {
+	return db.db.Close()
+}
+
+// view runs a read-only transaction.
+func (db *BadgerDB) view(fn func(txn *badger.Txn) error) error {
+	return db.db.View(fn)
+}
+
+// update runs a read-write transaction.
+func (db *BadgerDB) update(fn func(txn *badger.Txn) error) error {
+	db.writeMutex.Lock()
+	defer db.writeMutex.Unlock()
+
+	return db.db.Update(fn)
+}
+
+// badgerMigration is a migration of the embedded database.
+type badgerMigration struct {
+	// id identifies the migration. Migrations are applied in the lexicographical order of their
+	// IDs.
+	id string
+	// up applies the migration.
+	up func(txn *badger.Txn) error
+}
+
+// badgerMigrations contains the migrations of the embedded database.
+var badgerMigrations = []badgerMigration{
+	{
+		id: "20230817120000_initial_schema",
+		up: func(txn *badger.Txn) error {
+			for _, sequence := range []string{repositoryIDSequence, replicationJobIDSequence} {
+				if err := setJSON(txn, sequenceKey(sequence), uint64(0)); err != nil {
+					return fmt.Errorf("initialize sequence %q: %w", sequence, err)
+				}
+			}
+
+			return nil
+		},
+	},
+}
+
+// MigrationStatus returns the IDs of the applied migrations mapped to the time they were applied at.
+func (db *BadgerDB) MigrationStatus() (map[string]time.Time, error) {
+	applied := map[string]time.Time{}
+	if err := db.view(func(txn *badger.Txn) error {
+		return iterateJSON(txn, []byte(migrationPrefix), func(key []byte, appliedAt time.Time) error {
+			applied[strings.TrimPrefix(string(key), migrationPrefix)] = appliedAt
+			return nil
+		})
+	}); err != nil {
+		return nil, err
+	}
+
+	return applied, nil
+}
+
+// Migrate applies the pending migrations in order. Each migration is applied in its own transaction.
+// It returns the number of applied migrations.
+func (db *BadgerDB) Migrate() (int, error) {
+	return db.migrate(badgerMigrations)
+}
+
+func (db *BadgerDB) migrate(migrations []badgerMigration) (int, error) {
+	applied, err := db.MigrationStatus()
+	if err != nil {
+		return 0, fmt.Errorf("migration status: %w", err)
+	}
+
+	known := make(map[string]struct{}, len(migrations))
+	for _, migration := range migrations {
+		known[migration.id] = struct{}{}
+	}
+
+	var unknown []string
+	for id := range applied {
+		if _, ok := known[id]; !ok {
+			unknown = append(unknown, id)
+		}
+	}
+
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return 0, fmt.Errorf("database contains unknown migrations: %s", strings.Join(unknown, ", "))
+	}
+
+	sorted := append([]badgerMigration(nil), migrations...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].id < sorted[j].id })
+
+	var count int
+	for _, migration := range sorted {
+		if _, ok := applied[migration.id]; ok {
+			continue
+		}
+
+		if err := db.update(func(txn *badger.Txn) error {
+			if err := migration.up(txn); err != nil {
+				return err
+			}
+
+			return setJSON(txn, []byte(migrationPrefix+migration.id), db.now().UTC())
+		}); err != nil {
+			return count, fmt.Errorf("apply migration %q: %w", migration.id, err)
+		}
+
+		count++
+	}
+
+	return count, nil
+}
+
+const (
+	migrationPrefix               = "migrations/"
+	sequencePrefix                = "sequences/"
+	repositoryPrefix              = "repositories/"
+	repositoryPathPrefix          = "repository_paths/"
+	replicationJobPrefix          = "replication_queue/"
+	replicationQueueLockPrefix    = "replication_queue_lock/"
+	replicationQueueJobLockPrefix = "replication_queue_job_lock/"
+	drainedStoragePrefix          = "drained_storages/"
+
+	repositoryIDSequence     = "repository_id"
+	replicationJobIDSequence = "replication_queue_id"
+)
+
+func sequenceKey(name string) []byte {
+	return []byte(sequencePrefix + name)
+}
+
+func repositoryKey(repositoryID int64) []byte {
+	return []byte(fmt.Sprintf("%s%020d", repositoryPrefix, repositoryID))
+}
+
+// repositoryPathKey returns the key mapping a repository's virtual path to its ID. The relative path
+// is separated from the virtual storage with a NUL byte as both may contain slashes.
+func repositoryPathKey(virtualStorage, relativePath string) []byte {
+	return []byte(repositoryPathPrefix + virtualStorage + "\x00" + relativePath)
+}
+
+func replicationJobKey(id uint64) []byte {
+	return []byte(fmt.Sprintf("%s%020d", replicationJobPrefix, id))
+}
+
+func replicationQueueLockKey(lockID string) []byte {
+	return []byte(replicationQueueLockPrefix + lockID)
+}
+
+func replicationQueueJobLockKey(jobID uint64) []byte {
+	return []byte(fmt.Sprintf("%s%020d", replicationQueueJobLockPrefix, jobID))
+}
+
+// drainedStorageKey returns the key marking a storage as drained. The storage is separated from the
+// virtual storage with a NUL byte.
+func drainedStorageKey(virtualStorage, storage string) []byte {
+	return []byte(drainedStoragePrefix + virtualStorage + "\x00" + storage)
+}
+
+// nextSequenceValue increments the sequence and returns its new value.
+func nextSequenceValue(txn *badger.Txn, name string) (uint64, error) {
+	var value uint64
+	if err := getJSON(txn, sequenceKey(name), &value); err != nil {
+		return 0, fmt.Errorf("get sequence: %w", err)
+	}
+
+	value++
+	if err := setJSON(txn, sequenceKey(name), value); err != nil {
+		return 0, fmt.Errorf("set sequence: %w", err)
+	}
+
+	return value, nil
+}
+
+// getJSON decodes the value stored under the key into v. badger.ErrKeyNotFound is returned if the
+// key does not exist.
+func getJSON(txn *badger.Txn, key []byte, v any) error {
+	item, err := txn.Get(key)
+	if err != nil {
+		return err
+	}
+
+	return item.Value(func(value []byte) error {
+		return json.Unmarshal(value, v)
+	})
+}
+
+// setJSON stores the JSON encoding of v under the key.
+func setJSON(txn *badger.Txn, key []byte, v any) error {
+	value, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("marshal: %w", err)
+	}
+
+	return txn.Set(key, value)
+}
+
+// iterateJSON decodes every value stored under the prefix and calls fn with it in the order of the
+// keys. The callback must not modify the keys under the prefix.
+func iterateJSON[T any](txn *badger.Txn, prefix []byte, fn func(key []byte, value T) error) error {
+	it := txn.NewIterator(badger.IteratorOptions{Prefix: prefix, PrefetchValues: true, PrefetchSize: 100})
+	defer it.Close()
+
+	for it.Rewind(); it.Valid(); it.Next() {
+		var value T
+		if err := it.Item().Value(func(data []byte) error {
+			return json.Unmarshal(data, &value)
+		}); err != nil {
+			return fmt.Errorf("unmarshal %q: %w", it.Item().Key(), err)
+		}
+
+		if err := fn(it.Item().KeyCopy(nil), value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type badgerLogger struct {
+	log.Logger
+}
+
+func (l badgerLogger) Debugf(msg string, args ...any) {
+	l.Debug(fmt.Sprintf(msg, args...))
+}
+
+func (l badgerLogger) Infof(msg string, args ...any) {
+	l.Info(fmt.Sprintf(msg, args...))
+}
+
+func (l badgerLogger) Warningf(msg string, args ...any) {
+	l.Warn(fmt.Sprintf(msg, args...))
+}
+
+func (l badgerLogger) Errorf(msg string, args ...any) {
+	l.Error(fmt.Sprintf(msg, args...))
+}
@@ -0,0 +1,173 @@
+package datastore
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"sort"
+
+	"github.com/dgraph-io/badger/v4"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/datastructure"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/config"
+)
+
+// BadgerAssignmentStore manages host assignments in the embedded database.
+type BadgerAssignmentStore struct {
+	db                 *BadgerDB
+	configuredStorages map[string][]string
+	failureDomains     config.FailureDomains
+}
+
+// NewBadgerAssignmentStore returns a new BadgerAssignmentStore using the passed in database.
+// Assignments are spread across the failure domains of the storages.
+func NewBadgerAssignmentStore(db *BadgerDB, configuredStorages map[string][]string, failureDomains config.FailureDomains) BadgerAssignmentStore {
+	return BadgerAssignmentStore{db: db, configuredStorages: configuredStorages, failureDomains: failureDomains}
+}
+
+// GetHostAssignments returns the names of the storages assigned to host the repository. If the
+// repository has no assignments on the configured storages, all of the configured storages are
+// returned.
+func (s BadgerAssignmentStore) GetHostAssignments(ctx context.Context, virtualStorage string, repositoryID int64) ([]string, error) {
+	configuredStorages, ok := s.configuredStorages[virtualStorage]
+	if !ok {
+		return nil, newVirtualStorageNotFoundError(virtualStorage)
+	}
+
+	var assignedStorages []string
+	if err := s.db.view(func(txn *badger.Txn) error {
+		repository, err := getBadgerRepository(txn, repositoryID)
+		if err != nil {
+			if errors.Is(err, ErrRepositoryNotFound) {
+				return nil
+			}
+
+			return err
+		}
+
+		for _, storage := range configuredStorages {
+			if repository.isAssigned(storage) {
+				assignedStorages = append(assignedStorages, storage)
+			}
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if len(assignedStorages) == 0 {
+		return configuredStorages, nil
+	}
+
+	return assignedStorages, nil
+}
+
+// SetReplicationFactor assigns or unassigns a repository's host nodes until the desired replication
+// factor is met. Refer to AssignmentStore.SetReplicationFactor for details.
+func (s BadgerAssignmentStore) SetReplicationFactor(ctx context.Context, virtualStorage, relativePath string, replicationFactor int) ([]string, error) {
+	candidateStorages, ok := s.configuredStorages[virtualStorage]
+	if !ok {
+		return nil, newVirtualStorageNotFoundError(virtualStorage)
+	}
+
+	if replicationFactor < 1 {
+		return nil, newMinimumReplicationFactorError(replicationFactor)
+	}
+
+	if max := len(candidateStorages); replicationFactor > max {
+		return nil, newUnattainableReplicationFactorError(replicationFactor, max)
+	}
+
+	var assignments []string
+	if err := s.db.update(func(txn *badger.Txn) error {
+		repository, err := getBadgerRepositoryByPath(txn, virtualStorage, relativePath)
+		if err != nil {
+			if errors.Is(err, ErrRepositoryNotFound) {
+				return newRepositoryNotFoundError(virtualStorage, relativePath)
+			}
+
+			return err
+		}
+
+		// Assignments of storages that were removed from the configuration are ignored but kept
+		// in place as they are in Postgres.
+		configured := datastructure.SetFromSlice(candidateStorages)
+		existing := datastructure.NewSet[string]()
+		var unconfigured []string
+		for _, storage := range repository.Assignments {
+			if configured.HasValue(storage) {
+				existing.Add(storage)
+			} else {
+				unconfigured = append(unconfigured, storage)
+			}
+		}
+
+		switch {
+		case existing.Len() < replicationFactor:
+			var candidates []string
+			for _, storage := range candidateStorages {
+				if !existing.HasValue(storage) {
+					candidates = append(candidates, storage)
+				}
+			}
+
+			for _, storage := range s.rankByFailureDomain(virtualStorage, repository.Primary, candidates, existing)[:replicationFactor-existing.Len()] {
+				existing.Add(storage)
+			}
+		case existing.Len() > replicationFactor:
+			ranked := s.rankByFailureDomain(virtualStorage, repository.Primary, existing.Values(), datastructure.NewSet[string]())
+
+			removals := existing.Len() - replicationFactor
+			for i := len(ranked) - 1; i >= 0 && removals > 0; i-- {
+				if ranked[i] == repository.Primary {
+					continue
+				}
+
+				existing.Remove(ranked[i])
+				removals--
+			}
+		}
+
+		assignments = existing.Values()
+		sort.Strings(assignments)
+
+		repository.Assignments = append(append([]string{}, assignments...), unconfigured...)
+		sort.Strings(repository.Assignments)
+
+		return setBadgerRepository(txn, repository)
+	}); err != nil {
+		return nil, err
+	}
+
+	return assignments, nil
+}
+
+// rankByFailureDomain orders the storages so that the primary comes first, followed by storages in
+// failure domains that hold the fewest assignments. Storages with the same rank are ordered randomly.
+func (s BadgerAssignmentStore) rankByFailureDomain(virtualStorage, primary string, storages []string, assigned *datastructure.Set[string]) []string {
+	ranked := append([]string{}, storages...)
+	rand.Shuffle(len(ranked), func(i, j int) { ranked[i], ranked[j] = ranked[j], ranked[i] })
+	sort.SliceStable(ranked, func(i, j int) bool { return ranked[i] == primary && ranked[j] != primary })
+
+	failureDomainAssignments := map[string]int{}
+	for _, storage := range assigned.Values() {
+		failureDomainAssignments[s.failureDomains.FailureDomain(virtualStorage, storage)]++
+	}
+
+	ranks := make(map[string]int, len(ranked))
+	for _, storage := range ranked {
+		failureDomain := s.failureDomains.FailureDomain(virtualStorage, storage)
+		failureDomainAssignments[failureDomain]++
+		ranks[storage] = failureDomainAssignments[failureDomain]
+	}
+
+	sort.SliceStable(ranked, func(i, j int) bool {
+		if (ranked[i] == primary) != (ranked[j] == primary) {
+			return ranked[i] == primary
+		}
+
+		return ranks[ranked[i]] < ranks[ranked[j]]
+	})
+
+	return ranked
+}
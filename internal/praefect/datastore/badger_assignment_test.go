@@ -0,0 +1,46 @@
+package datastore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dgraph-io/badger/v4"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/config"
+)
+
+func TestAssignmentStore_Badger(t *testing.T) {
+	t.Parallel()
+
+	var db *BadgerDB
+	testAssignmentStore(t, assignmentStoreBackend{
+		newStores: func(t *testing.T, configuredStorages map[string][]string, failureDomains config.FailureDomains) (RepositoryStore, assignmentStore) {
+			db = newBadgerDB(t, t.TempDir())
+			return NewBadgerRepositoryStore(db, configuredStorages, nil), NewBadgerAssignmentStore(db, configuredStorages, failureDomains)
+		},
+		setAssignments: func(t *testing.T, ctx context.Context, repositoryID int64, storages []string) {
+			t.Helper()
+
+			require.NoError(t, db.update(func(txn *badger.Txn) error {
+				repository, err := getBadgerRepository(txn, repositoryID)
+				if err != nil {
+					return err
+				}
+
+				repository.Assignments = storages
+
+				return setBadgerRepository(txn, repository)
+			}))
+		},
+		requireAssignments: func(t *testing.T, ctx context.Context, repositoryID int64, expected []string) {
+			t.Helper()
+
+			require.NoError(t, db.view(func(txn *badger.Txn) error {
+				repository, err := getBadgerRepository(txn, repositoryID)
+				require.NoError(t, err)
+				require.ElementsMatch(t, expected, repository.Assignments)
+				return nil
+			}))
+		},
+	})
+}
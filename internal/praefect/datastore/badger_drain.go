@@ -0,0 +1,77 @@
+package datastore
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/dgraph-io/badger/v4"
+)
+
+// badgerDrainedStorage is the record marking a storage as drained.
+type badgerDrainedStorage struct {
+	VirtualStorage string    `json:"virtual_storage"`
+	Storage        string    `json:"storage"`
+	DrainedAt      time.Time `json:"drained_at"`
+}
+
+// BadgerDrainStore is an implementation of DrainStore using the embedded database.
+type BadgerDrainStore struct {
+	db *BadgerDB
+}
+
+// NewBadgerDrainStore returns a new BadgerDrainStore using the passed in database.
+func NewBadgerDrainStore(db *BadgerDB) *BadgerDrainStore {
+	return &BadgerDrainStore{db: db}
+}
+
+// DrainStorage marks the storage as drained.
+func (s *BadgerDrainStore) DrainStorage(ctx context.Context, virtualStorage, storage string) error {
+	return s.db.update(func(txn *badger.Txn) error {
+		drained, err := isBadgerStorageDrained(txn, virtualStorage, storage)
+		if err != nil || drained {
+			return err
+		}
+
+		return setJSON(txn, drainedStorageKey(virtualStorage, storage), badgerDrainedStorage{
+			VirtualStorage: virtualStorage,
+			Storage:        storage,
+			DrainedAt:      s.db.now().UTC(),
+		})
+	})
+}
+
+// UndrainStorage removes the storage's drain mark.
+func (s *BadgerDrainStore) UndrainStorage(ctx context.Context, virtualStorage, storage string) error {
+	return s.db.update(func(txn *badger.Txn) error {
+		return txn.Delete(drainedStorageKey(virtualStorage, storage))
+	})
+}
+
+// GetDrainedStorages returns the drained storages by virtual storage.
+func (s *BadgerDrainStore) GetDrainedStorages(ctx context.Context) (map[string][]string, error) {
+	drained := map[string][]string{}
+	if err := s.db.view(func(txn *badger.Txn) error {
+		return iterateJSON(txn, []byte(drainedStoragePrefix), func(_ []byte, record badgerDrainedStorage) error {
+			drained[record.VirtualStorage] = append(drained[record.VirtualStorage], record.Storage)
+			return nil
+		})
+	}); err != nil {
+		return nil, err
+	}
+
+	return drained, nil
+}
+
+// isBadgerStorageDrained returns whether the storage has been drained.
+func isBadgerStorageDrained(txn *badger.Txn, virtualStorage, storage string) (bool, error) {
+	if _, err := txn.Get(drainedStorageKey(virtualStorage, storage)); err != nil {
+		if errors.Is(err, badger.ErrKeyNotFound) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return true, nil
+}
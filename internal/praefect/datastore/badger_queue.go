@@ -0,0 +1,727 @@
+package datastore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/dgraph-io/badger/v4"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/datastructure"
+)
+
+// errDeleteReplicaExists is returned when enqueuing a 'delete_replica' job for a repository that
+// already has one pending. This mirrors the `delete_replica_unique_index` of the Postgres queue.
+var errDeleteReplicaExists = errors.New("delete_replica job for the repository already exists")
+
+// badgerReplicationJob is the record of a replication job in the embedded database.
+type badgerReplicationJob struct {
+	ID        uint64         `json:"id"`
+	State     JobState       `json:"state"`
+	Attempt   int            `json:"attempt"`
+	LockID    string         `json:"lock_id"`
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt *time.Time     `json:"updated_at,omitempty"`
+	Job       ReplicationJob `json:"job"`
+	Meta      Params         `json:"meta,omitempty"`
+	Error     string         `json:"error,omitempty"`
+}
+
+func (j badgerReplicationJob) event() ReplicationEvent {
+	return ReplicationEvent{
+		ID:        j.ID,
+		State:     j.State,
+		Attempt:   j.Attempt,
+		LockID:    j.LockID,
+		CreatedAt: j.CreatedAt,
+		UpdatedAt: j.UpdatedAt,
+		Job:       j.Job,
+		Meta:      j.Meta,
+	}
+}
+
+// badgerQueueLock is the record of a repository level lock of the replication queue.
+type badgerQueueLock struct {
+	ID       string `json:"id"`
+	Acquired bool   `json:"acquired"`
+}
+
+// badgerQueueJobLock is the record of a job being processed while holding a repository level lock.
+type badgerQueueJobLock struct {
+	JobID       uint64    `json:"job_id"`
+	LockID      string    `json:"lock_id"`
+	TriggeredAt time.Time `json:"triggered_at"`
+}
+
+func listBadgerReplicationJobs(txn *badger.Txn, filter func(badgerReplicationJob) bool) ([]badgerReplicationJob, error) {
+	var jobs []badgerReplicationJob
+	if err := iterateJSON(txn, []byte(replicationJobPrefix), func(_ []byte, job badgerReplicationJob) error {
+		if filter(job) {
+			jobs = append(jobs, job)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("iterate replication jobs: %w", err)
+	}
+
+	return jobs, nil
+}
+
+func listBadgerQueueJobLocks(txn *badger.Txn) (map[uint64]badgerQueueJobLock, error) {
+	jobLocks := map[uint64]badgerQueueJobLock{}
+	if err := iterateJSON(txn, []byte(replicationQueueJobLockPrefix), func(_ []byte, jobLock badgerQueueJobLock) error {
+		jobLocks[jobLock.JobID] = jobLock
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("iterate job locks: %w", err)
+	}
+
+	return jobLocks, nil
+}
+
+// releaseBadgerQueueLocks releases the given locks if no job is holding them anymore.
+func releaseBadgerQueueLocks(txn *badger.Txn, lockIDs *datastructure.Set[string]) error {
+	if lockIDs.IsEmpty() {
+		return nil
+	}
+
+	jobLocks, err := listBadgerQueueJobLocks(txn)
+	if err != nil {
+		return err
+	}
+
+	held := datastructure.NewSet[string]()
+	for _, jobLock := range jobLocks {
+		held.Add(jobLock.LockID)
+	}
+
+	for _, lockID := range lockIDs.Values() {
+		if held.HasValue(lockID) {
+			continue
+		}
+
+		if err := setJSON(txn, replicationQueueLockKey(lockID), badgerQueueLock{ID: lockID}); err != nil {
+			return fmt.Errorf("release lock: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// deleteBadgerReplicationJobs deletes the replication jobs of the virtual storage alongside their
+// locks.
+func deleteBadgerReplicationJobs(txn *badger.Txn, virtualStorage string) error {
+	jobs, err := listBadgerReplicationJobs(txn, func(job badgerReplicationJob) bool {
+		return job.Job.VirtualStorage == virtualStorage
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, job := range jobs {
+		if err := txn.Delete(replicationJobKey(job.ID)); err != nil {
+			return fmt.Errorf("delete job: %w", err)
+		}
+
+		if err := txn.Delete(replicationQueueJobLockKey(job.ID)); err != nil {
+			return fmt.Errorf("delete job lock: %w", err)
+		}
+	}
+
+	var lockKeys [][]byte
+	if err := iterateJSON(txn, []byte(replicationQueueLockPrefix+virtualStorage+"|"), func(key []byte, _ badgerQueueLock) error {
+		lockKeys = append(lockKeys, key)
+		return nil
+	}); err != nil {
+		return fmt.Errorf("iterate locks: %w", err)
+	}
+
+	for _, key := range lockKeys {
+		if err := txn.Delete(key); err != nil {
+			return fmt.Errorf("delete lock: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// pendingReplicaDeletions returns the storages which have a pending 'delete_replica' job for the
+// repository.
+func pendingReplicaDeletions(txn *badger.Txn, repositoryID int64) (*datastructure.Set[string], error) {
+	jobs, err := listBadgerReplicationJobs(txn, func(job badgerReplicationJob) bool {
+		return job.Job.RepositoryID == repositoryID &&
+			job.Job.Change == DeleteReplica &&
+			(job.State == JobStateReady || job.State == JobStateInProgress || job.State == JobStateFailed)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	storages := datastructure.NewSet[string]()
+	for _, job := range jobs {
+		storages.Add(job.Job.TargetNodeStorage)
+	}
+
+	return storages, nil
+}
+
+// interface implementation protection
+var _ ReplicationEventQueue = (*BadgerReplicationEventQueue)(nil)
+
+// BadgerReplicationEventQueue is an implementation of ReplicationEventQueue on top of the embedded
+// database. It follows the same locking semantics as the PostgresReplicationEventQueue. Refer to it
+// for the details on how the queue works.
+type BadgerReplicationEventQueue struct {
+	db *BadgerDB
+}
+
+// NewBadgerReplicationEventQueue returns a replication event queue backed by the embedded database.
+func NewBadgerReplicationEventQueue(db *BadgerDB) *BadgerReplicationEventQueue {
+	return &BadgerReplicationEventQueue{db: db}
+}
+
+// Enqueue puts the provided event into the persistent queue.
+func (rq *BadgerReplicationEventQueue) Enqueue(ctx context.Context, event ReplicationEvent) (ReplicationEvent, error) {
+	var enqueued badgerReplicationJob
+	if err := rq.db.update(func(txn *badger.Txn) error {
+		existing, err := listBadgerReplicationJobs(txn, func(job badgerReplicationJob) bool {
+			if job.Job.VirtualStorage != event.Job.VirtualStorage || job.Job.RelativePath != event.Job.RelativePath {
+				return false
+			}
+
+			if job.State == JobStateReady && job.Job.TargetNodeStorage == event.Job.TargetNodeStorage && job.Job.Change == event.Job.Change {
+				return true
+			}
+
+			return event.Job.Change == DeleteReplica && job.Job.Change == DeleteReplica &&
+				(job.State == JobStateReady || job.State == JobStateInProgress || job.State == JobStateFailed)
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, job := range existing {
+			if job.State == JobStateReady && job.Job.TargetNodeStorage == event.Job.TargetNodeStorage && job.Job.Change == event.Job.Change {
+				return ReplicationEventExistsError{
+					state:             event.State.String(),
+					virtualStorage:    event.Job.VirtualStorage,
+					targetNodeStorage: event.Job.TargetNodeStorage,
+					relativePath:      event.Job.RelativePath,
+				}
+			}
+		}
+
+		if len(existing) > 0 {
+			return errDeleteReplicaExists
+		}
+
+		lockID := event.Job.VirtualStorage + "|" + event.Job.TargetNodeStorage + "|" + event.Job.RelativePath
+		if err := getJSON(txn, replicationQueueLockKey(lockID), &badgerQueueLock{}); err != nil {
+			if !errors.Is(err, badger.ErrKeyNotFound) {
+				return fmt.Errorf("get lock: %w", err)
+			}
+
+			if err := setJSON(txn, replicationQueueLockKey(lockID), badgerQueueLock{ID: lockID}); err != nil {
+				return fmt.Errorf("set lock: %w", err)
+			}
+		}
+
+		id, err := nextSequenceValue(txn, replicationJobIDSequence)
+		if err != nil {
+			return err
+		}
+
+		enqueued = badgerReplicationJob{
+			ID:        id,
+			State:     JobStateReady,
+			Attempt:   3,
+			LockID:    lockID,
+			CreatedAt: rq.db.now().UTC(),
+			Job:       event.Job,
+			Meta:      event.Meta,
+		}
+
+		return setJSON(txn, replicationJobKey(id), enqueued)
+	}); err != nil {
+		return ReplicationEvent{}, err
+	}
+
+	return enqueued.event(), nil
+}
+
+// Dequeue retrieves events from the persistent queue using provided limitations and filters. Refer
+// to PostgresReplicationEventQueue.Dequeue for details.
+func (rq *BadgerReplicationEventQueue) Dequeue(ctx context.Context, virtualStorage, nodeStorage string, count int) ([]ReplicationEvent, error) {
+	var dequeued []ReplicationEvent
+	if err := rq.db.update(func(txn *badger.Txn) error {
+		locks := map[string]badgerQueueLock{}
+		if err := iterateJSON(txn, []byte(replicationQueueLockPrefix+virtualStorage+"|"+nodeStorage+"|"), func(_ []byte, lock badgerQueueLock) error {
+			if !lock.Acquired {
+				locks[lock.ID] = lock
+			}
+
+			return nil
+		}); err != nil {
+			return fmt.Errorf("iterate locks: %w", err)
+		}
+
+		if len(locks) == 0 {
+			return nil
+		}
+
+		jobLocks, err := listBadgerQueueJobLocks(txn)
+		if err != nil {
+			return err
+		}
+
+		heldLocks := datastructure.NewSet[string]()
+		for _, jobLock := range jobLocks {
+			heldLocks.Add(jobLock.LockID)
+		}
+
+		// The jobs are iterated in the order of their IDs, which is also the order they were
+		// created in. Only the first job of each lock and change is a candidate.
+		type candidateKey struct {
+			lockID string
+			change ChangeType
+		}
+
+		seen := map[candidateKey]struct{}{}
+		var candidates []badgerReplicationJob
+		if _, err := listBadgerReplicationJobs(txn, func(job badgerReplicationJob) bool {
+			if _, ok := locks[job.LockID]; !ok || heldLocks.HasValue(job.LockID) {
+				return false
+			}
+
+			if job.State != JobStateReady && job.State != JobStateFailed {
+				return false
+			}
+
+			key := candidateKey{lockID: job.LockID, change: job.Job.Change}
+			if _, ok := seen[key]; ok {
+				return false
+			}
+
+			seen[key] = struct{}{}
+			candidates = append(candidates, job)
+			return false
+		}); err != nil {
+			return err
+		}
+
+		sort.SliceStable(candidates, func(i, j int) bool {
+			return candidates[i].CreatedAt.Before(candidates[j].CreatedAt)
+		})
+
+		if len(candidates) > count {
+			candidates = candidates[:count]
+		}
+
+		now := rq.db.now().UTC()
+		acquired := datastructure.NewSet[string]()
+		for _, job := range candidates {
+			if job.Job.Change != DeleteReplica {
+				job.Attempt--
+			}
+
+			updatedAt := now
+			job.State = JobStateInProgress
+			job.UpdatedAt = &updatedAt
+
+			if err := setJSON(txn, replicationJobKey(job.ID), job); err != nil {
+				return fmt.Errorf("set job: %w", err)
+			}
+
+			if err := setJSON(txn, replicationQueueJobLockKey(job.ID), badgerQueueJobLock{
+				JobID:       job.ID,
+				LockID:      job.LockID,
+				TriggeredAt: now,
+			}); err != nil {
+				return fmt.Errorf("set job lock: %w", err)
+			}
+
+			if acquired.Add(job.LockID) {
+				if err := setJSON(txn, replicationQueueLockKey(job.LockID), badgerQueueLock{ID: job.LockID, Acquired: true}); err != nil {
+					return fmt.Errorf("acquire lock: %w", err)
+				}
+			}
+
+			dequeued = append(dequeued, job.event())
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(dequeued, func(i, j int) bool { return dequeued[i].ID < dequeued[j].ID })
+
+	return dequeued, nil
+}
+
+// Acknowledge updates previously dequeued events with the new state and releases resources acquired
+// for them. Refer to PostgresReplicationEventQueue.Acknowledge for details.
+func (rq *BadgerReplicationEventQueue) Acknowledge(ctx context.Context, state JobState, ids []uint64) ([]uint64, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	if err := allowToAck(state); err != nil {
+		return nil, err
+	}
+
+	var acknowledged []uint64
+	if err := rq.db.update(func(txn *badger.Txn) error {
+		requested := datastructure.SetFromSlice(ids)
+		existing, err := listBadgerReplicationJobs(txn, func(job badgerReplicationJob) bool {
+			return requested.HasValue(job.ID) && job.State == JobStateInProgress
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(existing) == 0 {
+			return nil
+		}
+
+		toRelease := datastructure.NewSet[string]()
+		now := rq.db.now().UTC()
+		for _, job := range existing {
+			acknowledged = append(acknowledged, job.ID)
+			toRelease.Add(job.LockID)
+
+			if err := txn.Delete(replicationQueueJobLockKey(job.ID)); err != nil {
+				return fmt.Errorf("delete job lock: %w", err)
+			}
+
+			if state != JobStateCompleted {
+				updatedAt := now
+				job.State = state
+				job.UpdatedAt = &updatedAt
+				if err := setJSON(txn, replicationJobKey(job.ID), job); err != nil {
+					return fmt.Errorf("set job: %w", err)
+				}
+
+				continue
+			}
+
+			if err := txn.Delete(replicationJobKey(job.ID)); err != nil {
+				return fmt.Errorf("delete job: %w", err)
+			}
+
+			// Jobs that were not yet attempted and were created before the completed job was
+			// dequeued would not have any effect, so they are removed as well.
+			similar, err := listBadgerReplicationJobs(txn, func(queued badgerReplicationJob) bool {
+				return queued.State == JobStateReady &&
+					job.UpdatedAt != nil && queued.CreatedAt.Before(*job.UpdatedAt) &&
+					queued.LockID == job.LockID &&
+					queued.Job.Change == job.Job.Change &&
+					queued.Job.SourceNodeStorage == job.Job.SourceNodeStorage
+			})
+			if err != nil {
+				return err
+			}
+
+			for _, queued := range similar {
+				if err := txn.Delete(replicationJobKey(queued.ID)); err != nil {
+					return fmt.Errorf("delete similar job: %w", err)
+				}
+			}
+		}
+
+		return releaseBadgerQueueLocks(txn, toRelease)
+	}); err != nil {
+		return nil, err
+	}
+
+	return acknowledged, nil
+}
+
+// StartHealthUpdate starts periodical update of the event's health identifier. Refer to
+// PostgresReplicationEventQueue.StartHealthUpdate for details.
+func (rq *BadgerReplicationEventQueue) StartHealthUpdate(ctx context.Context, trigger <-chan time.Time, events []ReplicationEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-trigger:
+			var updated int
+			if err := rq.db.update(func(txn *badger.Txn) error {
+				now := rq.db.now().UTC()
+				for _, event := range events {
+					var jobLock badgerQueueJobLock
+					if err := getJSON(txn, replicationQueueJobLockKey(event.ID), &jobLock); err != nil {
+						if errors.Is(err, badger.ErrKeyNotFound) {
+							continue
+						}
+
+						return fmt.Errorf("get job lock: %w", err)
+					}
+
+					if jobLock.LockID != event.LockID {
+						continue
+					}
+
+					jobLock.TriggeredAt = now
+					if err := setJSON(txn, replicationQueueJobLockKey(event.ID), jobLock); err != nil {
+						return fmt.Errorf("set job lock: %w", err)
+					}
+
+					updated++
+				}
+
+				return nil
+			}); err != nil {
+				return err
+			}
+
+			if updated == 0 {
+				return nil
+			}
+		}
+	}
+}
+
+// AcknowledgeStale moves replication events that are 'in_progress' state for too long into the next
+// state and removes expired 'dead' events. Refer to PostgresReplicationEventQueue.AcknowledgeStale
+// for details. It returns the number of released locks.
+func (rq *BadgerReplicationEventQueue) AcknowledgeStale(ctx context.Context, staleAfter time.Duration) (int64, error) {
+	var released int64
+	if err := rq.db.update(func(txn *badger.Txn) error {
+		now := rq.db.now().UTC()
+
+		jobLocks, err := listBadgerQueueJobLocks(txn)
+		if err != nil {
+			return err
+		}
+
+		staleLocks := datastructure.NewSet[string]()
+		for _, jobLock := range jobLocks {
+			if !jobLock.TriggeredAt.Before(now.Add(-staleAfter)) {
+				continue
+			}
+
+			staleLocks.Add(jobLock.LockID)
+			delete(jobLocks, jobLock.JobID)
+			if err := txn.Delete(replicationQueueJobLockKey(jobLock.JobID)); err != nil {
+				return fmt.Errorf("delete job lock: %w", err)
+			}
+
+			var job badgerReplicationJob
+			if err := getJSON(txn, replicationJobKey(jobLock.JobID), &job); err != nil {
+				if errors.Is(err, badger.ErrKeyNotFound) {
+					continue
+				}
+
+				return fmt.Errorf("get job: %w", err)
+			}
+
+			updatedAt := now
+			job.State = JobStateDead
+			if job.Attempt >= 1 {
+				job.State = JobStateFailed
+			}
+			job.UpdatedAt = &updatedAt
+
+			if err := setJSON(txn, replicationJobKey(job.ID), job); err != nil {
+				return fmt.Errorf("set job: %w", err)
+			}
+		}
+
+		expired, err := listBadgerReplicationJobs(txn, func(job badgerReplicationJob) bool {
+			_, locked := jobLocks[job.ID]
+			return job.State == JobStateDead && !locked &&
+				job.UpdatedAt != nil && job.UpdatedAt.Before(now.Add(-DeadJobRetention))
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, job := range expired {
+			if err := txn.Delete(replicationJobKey(job.ID)); err != nil {
+				return fmt.Errorf("delete dead job: %w", err)
+			}
+		}
+
+		held := datastructure.NewSet[string]()
+		for _, jobLock := range jobLocks {
+			held.Add(jobLock.LockID)
+		}
+
+		for _, lockID := range staleLocks.Values() {
+			if held.HasValue(lockID) {
+				continue
+			}
+
+			if err := setJSON(txn, replicationQueueLockKey(lockID), badgerQueueLock{ID: lockID}); err != nil {
+				return fmt.Errorf("release lock: %w", err)
+			}
+
+			released++
+		}
+
+		return nil
+	}); err != nil {
+		return 0, fmt.Errorf("acknowledge stale: %w", err)
+	}
+
+	return released, nil
+}
+
+// RecordError records the error the last attempt to process the event failed with.
+func (rq *BadgerReplicationEventQueue) RecordError(ctx context.Context, id uint64, message string) error {
+	return rq.db.update(func(txn *badger.Txn) error {
+		var job badgerReplicationJob
+		if err := getJSON(txn, replicationJobKey(id), &job); err != nil {
+			if errors.Is(err, badger.ErrKeyNotFound) {
+				return nil
+			}
+
+			return fmt.Errorf("get job: %w", err)
+		}
+
+		job.Error = message
+
+		return setJSON(txn, replicationJobKey(id), job)
+	})
+}
+
+// matches returns whether the job matches the filter.
+func (f ReplicationJobFilter) matches(job badgerReplicationJob) bool {
+	if len(f.IDs) > 0 && !datastructure.SetFromSlice(f.IDs).HasValue(job.ID) {
+		return false
+	}
+
+	if len(f.States) > 0 && !datastructure.SetFromSlice(f.States).HasValue(job.State) {
+		return false
+	}
+
+	return (f.VirtualStorage == "" || job.Job.VirtualStorage == f.VirtualStorage) &&
+		(f.RelativePath == "" || job.Job.RelativePath == f.RelativePath) &&
+		(f.TargetStorage == "" || job.Job.TargetNodeStorage == f.TargetStorage) &&
+		(f.Change == "" || job.Job.Change == f.Change)
+}
+
+// ListJobs returns the replication jobs matching the filter ordered by their ID. At most limit
+// jobs are returned. If limit is 0, all of the matching jobs are returned.
+func (rq *BadgerReplicationEventQueue) ListJobs(ctx context.Context, filter ReplicationJobFilter, limit uint) ([]ReplicationJobDetails, error) {
+	var details []ReplicationJobDetails
+	if err := rq.db.view(func(txn *badger.Txn) error {
+		jobs, err := listBadgerReplicationJobs(txn, filter.matches)
+		if err != nil {
+			return err
+		}
+
+		if limit > 0 && uint(len(jobs)) > limit {
+			jobs = jobs[:limit]
+		}
+
+		jobLocks, err := listBadgerQueueJobLocks(txn)
+		if err != nil {
+			return err
+		}
+
+		for _, job := range jobs {
+			detail := ReplicationJobDetails{ReplicationEvent: job.event(), Error: job.Error}
+
+			var lock badgerQueueLock
+			if err := getJSON(txn, replicationQueueLockKey(job.LockID), &lock); err == nil {
+				detail.LockAcquired = lock.Acquired
+			} else if !errors.Is(err, badger.ErrKeyNotFound) {
+				return fmt.Errorf("get lock: %w", err)
+			}
+
+			if jobLock, ok := jobLocks[job.ID]; ok {
+				triggeredAt := jobLock.TriggeredAt
+				detail.LockTriggeredAt = &triggeredAt
+			}
+
+			details = append(details, detail)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return details, nil
+}
+
+// RetryJobs moves the 'dead' replication jobs matching the filter back into the 'ready' state and
+// resets their attempts so they are processed again. The IDs of the retried jobs are returned.
+func (rq *BadgerReplicationEventQueue) RetryJobs(ctx context.Context, filter ReplicationJobFilter) ([]uint64, error) {
+	var retried []uint64
+	if err := rq.db.update(func(txn *badger.Txn) error {
+		jobs, err := listBadgerReplicationJobs(txn, func(job badgerReplicationJob) bool {
+			return job.State == JobStateDead && filter.matches(job)
+		})
+		if err != nil {
+			return err
+		}
+
+		now := rq.db.now().UTC()
+		for _, job := range jobs {
+			updatedAt := now
+			job.State = JobStateReady
+			job.Attempt = 3
+			job.UpdatedAt = &updatedAt
+
+			if err := setJSON(txn, replicationJobKey(job.ID), job); err != nil {
+				return fmt.Errorf("set job: %w", err)
+			}
+
+			retried = append(retried, job.ID)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return retried, nil
+}
+
+// CancelJobs removes the replication jobs matching the filter from the queue. Jobs which are being
+// processed are not cancelled. The IDs of the cancelled jobs are returned. ErrEmptyReplicationJobFilter
+// is returned if the filter is empty.
+func (rq *BadgerReplicationEventQueue) CancelJobs(ctx context.Context, filter ReplicationJobFilter) ([]uint64, error) {
+	if filter.IsEmpty() {
+		return nil, ErrEmptyReplicationJobFilter
+	}
+
+	var cancelled []uint64
+	if err := rq.db.update(func(txn *badger.Txn) error {
+		jobLocks, err := listBadgerQueueJobLocks(txn)
+		if err != nil {
+			return err
+		}
+
+		jobs, err := listBadgerReplicationJobs(txn, func(job badgerReplicationJob) bool {
+			_, locked := jobLocks[job.ID]
+			return job.State != JobStateInProgress && !locked && filter.matches(job)
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, job := range jobs {
+			if err := txn.Delete(replicationJobKey(job.ID)); err != nil {
+				return fmt.Errorf("delete job: %w", err)
+			}
+
+			cancelled = append(cancelled, job.ID)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return cancelled, nil
+}
@@ -0,0 +1,41 @@
+package datastore
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestReplicationEventQueue_Badger(t *testing.T) {
+	t.Parallel()
+
+	var db *BadgerDB
+	// elapsed is the duration the clock of the database has been moved forward by.
+	var elapsed atomic.Int64
+	testReplicationEventQueue(t, replicationEventQueueBackend{
+		newQueue: func(t *testing.T) administrableReplicationEventQueue {
+			db = newBadgerDB(t, t.TempDir())
+			elapsed.Store(0)
+			db.now = func() time.Time { return time.Now().Add(time.Duration(elapsed.Load())) }
+
+			return NewBadgerReplicationEventQueue(db)
+		},
+		requireEvents: func(t *testing.T, ctx context.Context, expected []ReplicationEvent) {
+			t.Helper()
+			requireBadgerEvents(t, db, expected)
+		},
+		requireLocks: func(t *testing.T, ctx context.Context, expected []LockRow) {
+			t.Helper()
+			requireBadgerLocks(t, db, expected)
+		},
+		requireJobLocks: func(t *testing.T, ctx context.Context, expected []JobLockRow) {
+			t.Helper()
+			requireBadgerJobLocks(t, db, expected)
+		},
+		elapse: func(t *testing.T, ctx context.Context, d time.Duration) {
+			elapsed.Add(int64(d))
+		},
+		deleteReplicaExistsError: errDeleteReplicaExists.Error(),
+	})
+}
@@ -0,0 +1,930 @@
+package datastore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/dgraph-io/badger/v4"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/datastructure"
+)
+
+// HealthChecker returns the healthy storages of each virtual storage.
+type HealthChecker interface {
+	// HealthyNodes returns the healthy storages by their virtual storage.
+	HealthyNodes() map[string][]string
+}
+
+// badgerRepository is the record of a repository in the embedded database. It holds the information
+// the `repositories`, `storage_repositories` and `repository_assignments` tables hold in Postgres.
+type badgerRepository struct {
+	RepositoryID   int64  `json:"repository_id"`
+	VirtualStorage string `json:"virtual_storage"`
+	RelativePath   string `json:"relative_path"`
+	ReplicaPath    string `json:"replica_path"`
+	Generation     int    `json:"generation"`
+	// Primary is the repository's primary. It is empty if the repository has no primary.
+	Primary string `json:"primary,omitempty"`
+	// Assignments contains the sorted names of the storages assigned to host the repository.
+	Assignments []string `json:"assignments,omitempty"`
+	// Replicas contains the replicas of the repository keyed by their storage.
+	Replicas map[string]badgerReplica `json:"replicas"`
+}
+
+// badgerReplica is the record of a repository's replica in the embedded database.
+type badgerReplica struct {
+	// RelativePath is the relative path of the replica. It differs from the repository's relative
+	// path if the replica has not yet been renamed.
+	RelativePath string     `json:"relative_path"`
+	Generation   int        `json:"generation"`
+	VerifiedAt   *time.Time `json:"verified_at,omitempty"`
+}
+
+// storages returns the sorted names of the storages that have a replica of the repository.
+func (r badgerRepository) storages() []string {
+	storages := make([]string, 0, len(r.Replicas))
+	for storage := range r.Replicas {
+		storages = append(storages, storage)
+	}
+	sort.Strings(storages)
+
+	return storages
+}
+
+// isAssigned returns whether the storage is explicitly assigned to host the repository.
+func (r badgerRepository) isAssigned(storage string) bool {
+	for _, assigned := range r.Assignments {
+		if assigned == storage {
+			return true
+		}
+	}
+
+	return false
+}
+
+func getBadgerRepository(txn *badger.Txn, repositoryID int64) (badgerRepository, error) {
+	var repository badgerRepository
+	if err := getJSON(txn, repositoryKey(repositoryID), &repository); err != nil {
+		if errors.Is(err, badger.ErrKeyNotFound) {
+			return badgerRepository{}, ErrRepositoryNotFound
+		}
+
+		return badgerRepository{}, fmt.Errorf("get repository: %w", err)
+	}
+
+	if repository.Replicas == nil {
+		repository.Replicas = map[string]badgerReplica{}
+	}
+
+	return repository, nil
+}
+
+func getBadgerRepositoryID(txn *badger.Txn, virtualStorage, relativePath string) (int64, error) {
+	var repositoryID int64
+	if err := getJSON(txn, repositoryPathKey(virtualStorage, relativePath), &repositoryID); err != nil {
+		if errors.Is(err, badger.ErrKeyNotFound) {
+			return 0, ErrRepositoryNotFound
+		}
+
+		return 0, fmt.Errorf("get repository id: %w", err)
+	}
+
+	return repositoryID, nil
+}
+
+func getBadgerRepositoryByPath(txn *badger.Txn, virtualStorage, relativePath string) (badgerRepository, error) {
+	repositoryID, err := getBadgerRepositoryID(txn, virtualStorage, relativePath)
+	if err != nil {
+		return badgerRepository{}, err
+	}
+
+	return getBadgerRepository(txn, repositoryID)
+}
+
+func setBadgerRepository(txn *badger.Txn, repository badgerRepository) error {
+	if err := setJSON(txn, repositoryKey(repository.RepositoryID), repository); err != nil {
+		return fmt.Errorf("set repository: %w", err)
+	}
+
+	if err := setJSON(txn, repositoryPathKey(repository.VirtualStorage, repository.RelativePath), repository.RepositoryID); err != nil {
+		return fmt.Errorf("set repository path: %w", err)
+	}
+
+	return nil
+}
+
+func deleteBadgerRepository(txn *badger.Txn, repository badgerRepository) error {
+	if err := txn.Delete(repositoryKey(repository.RepositoryID)); err != nil {
+		return fmt.Errorf("delete repository: %w", err)
+	}
+
+	if err := txn.Delete(repositoryPathKey(repository.VirtualStorage, repository.RelativePath)); err != nil {
+		return fmt.Errorf("delete repository path: %w", err)
+	}
+
+	return nil
+}
+
+// listBadgerRepositories returns the repositories matching the filter ordered by their ID.
+func listBadgerRepositories(txn *badger.Txn, filter func(badgerRepository) bool) ([]badgerRepository, error) {
+	var repositories []badgerRepository
+	if err := iterateJSON(txn, []byte(repositoryPrefix), func(_ []byte, repository badgerRepository) error {
+		if repository.Replicas == nil {
+			repository.Replicas = map[string]badgerReplica{}
+		}
+
+		if filter(repository) {
+			repositories = append(repositories, repository)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("iterate repositories: %w", err)
+	}
+
+	return repositories, nil
+}
+
+// BadgerRepositoryStore is an implementation of RepositoryStore on top of the embedded database.
+// Refer to the interface for method documentation.
+type BadgerRepositoryStore struct {
+	db *BadgerDB
+	storages
+	healthChecker HealthChecker
+}
+
+// NewBadgerRepositoryStore returns an implementation of RepositoryStore on top of the embedded
+// database. As there is only a single Praefect using the database, the health checker's view of
+// the storages' health is used as is instead of forming a consensus between Praefects.
+func NewBadgerRepositoryStore(db *BadgerDB, configuredStorages map[string][]string, healthChecker HealthChecker) *BadgerRepositoryStore {
+	return &BadgerRepositoryStore{db: db, storages: storages(configuredStorages), healthChecker: healthChecker}
+}
+
+// markUnverified marks the replicas of the repositories matching the filter as unverified. The
+// replicas are additionally filtered by their storage if a storage is given.
+func (rs *BadgerRepositoryStore) markUnverified(filter func(badgerRepository) bool, storage string) (int64, error) {
+	var marked int64
+	if err := rs.db.update(func(txn *badger.Txn) error {
+		repositories, err := listBadgerRepositories(txn, filter)
+		if err != nil {
+			return err
+		}
+
+		for _, repository := range repositories {
+			modified := false
+			for replicaStorage, replica := range repository.Replicas {
+				if replica.VerifiedAt == nil || (storage != "" && replicaStorage != storage) {
+					continue
+				}
+
+				replica.VerifiedAt = nil
+				repository.Replicas[replicaStorage] = replica
+				modified = true
+				marked++
+			}
+
+			if modified {
+				if err := setBadgerRepository(txn, repository); err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	}); err != nil {
+		return 0, fmt.Errorf("update: %w", err)
+	}
+
+	return marked, nil
+}
+
+// MarkUnverified marks replicas of the repository unverified.
+func (rs *BadgerRepositoryStore) MarkUnverified(ctx context.Context, repositoryID int64) (int64, error) {
+	return rs.markUnverified(func(repository badgerRepository) bool {
+		return repository.RepositoryID == repositoryID
+	}, "")
+}
+
+// MarkVirtualStorageUnverified marks all replicas on the virtual storage as unverified.
+func (rs *BadgerRepositoryStore) MarkVirtualStorageUnverified(ctx context.Context, virtualStorage string) (int64, error) {
+	return rs.markUnverified(func(repository badgerRepository) bool {
+		return repository.VirtualStorage == virtualStorage
+	}, "")
+}
+
+// MarkStorageUnverified marks all replicas on the storage as unverified.
+func (rs *BadgerRepositoryStore) MarkStorageUnverified(ctx context.Context, virtualStorage, storage string) (int64, error) {
+	return rs.markUnverified(func(repository badgerRepository) bool {
+		return repository.VirtualStorage == virtualStorage
+	}, storage)
+}
+
+// GetGeneration gets the repository's generation on a given storage.
+func (rs *BadgerRepositoryStore) GetGeneration(ctx context.Context, repositoryID int64, storage string) (int, error) {
+	generation := GenerationUnknown
+	if err := rs.db.view(func(txn *badger.Txn) error {
+		repository, err := getBadgerRepository(txn, repositoryID)
+		if err != nil {
+			if errors.Is(err, ErrRepositoryNotFound) {
+				return nil
+			}
+
+			return err
+		}
+
+		if replica, ok := repository.Replicas[storage]; ok {
+			generation = replica.Generation
+		}
+
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+
+	return generation, nil
+}
+
+// IncrementGeneration increments the generations of up to date nodes. It returns the repository's
+// new generation.
+func (rs *BadgerRepositoryStore) IncrementGeneration(ctx context.Context, repositoryID int64, primary string, secondaries []string) (int, error) {
+	var generation int
+	if err := rs.db.update(func(txn *badger.Txn) error {
+		repository, err := getBadgerRepository(txn, repositoryID)
+		if err != nil {
+			return err
+		}
+
+		updated := false
+		for _, storage := range append(secondaries, primary) {
+			replica, ok := repository.Replicas[storage]
+			if !ok || replica.Generation != repository.Generation {
+				continue
+			}
+
+			replica.Generation++
+			repository.Replicas[storage] = replica
+			updated = true
+		}
+
+		if !updated {
+			return errWriteToOutdatedNodes
+		}
+
+		repository.Generation++
+		generation = repository.Generation
+
+		return setBadgerRepository(txn, repository)
+	}); err != nil {
+		return 0, err
+	}
+
+	return generation, nil
+}
+
+// SetGeneration sets the repository's generation on the given storage. If the generation is higher
+// than the virtual storage's generation, it is set to match as well to guarantee monotonic increments.
+func (rs *BadgerRepositoryStore) SetGeneration(ctx context.Context, repositoryID int64, storage, relativePath string, generation int) error {
+	return rs.db.update(func(txn *badger.Txn) error {
+		repository, err := getBadgerRepository(txn, repositoryID)
+		if err != nil {
+			if errors.Is(err, ErrRepositoryNotFound) {
+				return nil
+			}
+
+			return err
+		}
+
+		if repository.Generation < generation {
+			repository.Generation = generation
+		}
+
+		replica := repository.Replicas[storage]
+		replica.RelativePath = relativePath
+		replica.Generation = generation
+		repository.Replicas[storage] = replica
+
+		return setBadgerRepository(txn, repository)
+	})
+}
+
+// SetAuthoritativeReplica sets the given replica of a repsitory as the authoritative one by setting its generation as the latest one.
+func (rs *BadgerRepositoryStore) SetAuthoritativeReplica(ctx context.Context, virtualStorage, relativePath, storage string) error {
+	return rs.db.update(func(txn *badger.Txn) error {
+		repository, err := getBadgerRepositoryByPath(txn, virtualStorage, relativePath)
+		if err != nil {
+			return err
+		}
+
+		repository.Generation++
+
+		replica := repository.Replicas[storage]
+		replica.RelativePath = relativePath
+		replica.Generation = repository.Generation
+		repository.Replicas[storage] = replica
+
+		return setBadgerRepository(txn, repository)
+	})
+}
+
+// GetReplicatedGeneration returns the generation propagated by applying the replication. If the generation would
+// downgrade, a DowngradeAttemptedError is returned.
+func (rs *BadgerRepositoryStore) GetReplicatedGeneration(ctx context.Context, repositoryID int64, source, target string) (int, error) {
+	sourceGeneration := GenerationUnknown
+	targetGeneration := GenerationUnknown
+	if err := rs.db.view(func(txn *badger.Txn) error {
+		repository, err := getBadgerRepository(txn, repositoryID)
+		if err != nil {
+			if errors.Is(err, ErrRepositoryNotFound) {
+				return nil
+			}
+
+			return err
+		}
+
+		if replica, ok := repository.Replicas[source]; ok {
+			sourceGeneration = replica.Generation
+		}
+
+		if replica, ok := repository.Replicas[target]; ok {
+			targetGeneration = replica.Generation
+		}
+
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+
+	if targetGeneration != GenerationUnknown && targetGeneration >= sourceGeneration {
+		return 0, DowngradeAttemptedError{
+			Storage:             target,
+			CurrentGeneration:   targetGeneration,
+			AttemptedGeneration: sourceGeneration,
+		}
+	}
+
+	return sourceGeneration, nil
+}
+
+// CreateRepository creates a record for a repository in the specified virtual storage and relative path.
+// Refer to the interface for details.
+func (rs *BadgerRepositoryStore) CreateRepository(ctx context.Context, repositoryID int64, virtualStorage, relativePath, replicaPath, primary string, updatedSecondaries, outdatedSecondaries []string, storePrimary, storeAssignments bool) error {
+	return rs.db.update(func(txn *badger.Txn) error {
+		if _, err := getBadgerRepository(txn, repositoryID); err == nil {
+			return fmt.Errorf("repository id %d already in use", repositoryID)
+		} else if !errors.Is(err, ErrRepositoryNotFound) {
+			return err
+		}
+
+		if _, err := getBadgerRepositoryID(txn, virtualStorage, relativePath); err == nil {
+			return ErrRepositoryAlreadyExists
+		} else if !errors.Is(err, ErrRepositoryNotFound) {
+			return err
+		}
+
+		repository := badgerRepository{
+			RepositoryID:   repositoryID,
+			VirtualStorage: virtualStorage,
+			RelativePath:   relativePath,
+			ReplicaPath:    replicaPath,
+			Replicas:       map[string]badgerReplica{},
+		}
+
+		if storePrimary {
+			repository.Primary = primary
+		}
+
+		for _, storage := range append([]string{primary}, updatedSecondaries...) {
+			repository.Replicas[storage] = badgerReplica{RelativePath: relativePath}
+		}
+
+		if storeAssignments {
+			assignments := datastructure.NewSet[string]()
+			assignments.Add(primary)
+			for _, storage := range append(updatedSecondaries, outdatedSecondaries...) {
+				assignments.Add(storage)
+			}
+
+			repository.Assignments = assignments.Values()
+			sort.Strings(repository.Assignments)
+		}
+
+		return setBadgerRepository(txn, repository)
+	})
+}
+
+// DeleteRepository deletes the records associated with the repository. Refer to the interface for
+// details.
+func (rs *BadgerRepositoryStore) DeleteRepository(ctx context.Context, virtualStorage, relativePath string) (string, []string, error) {
+	var repository badgerRepository
+	if err := rs.db.update(func(txn *badger.Txn) error {
+		var err error
+		repository, err = getBadgerRepositoryByPath(txn, virtualStorage, relativePath)
+		if err != nil {
+			return err
+		}
+
+		return deleteBadgerRepository(txn, repository)
+	}); err != nil {
+		return "", nil, err
+	}
+
+	return repository.ReplicaPath, repository.storages(), nil
+}
+
+// DeleteAllRepositories deletes the records associated with repositories in the specified virtual
+// storage. The replication jobs of the virtual storage are deleted as well.
+func (rs *BadgerRepositoryStore) DeleteAllRepositories(ctx context.Context, virtualStorage string) error {
+	return rs.db.update(func(txn *badger.Txn) error {
+		repositories, err := listBadgerRepositories(txn, func(repository badgerRepository) bool {
+			return repository.VirtualStorage == virtualStorage
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, repository := range repositories {
+			if err := deleteBadgerRepository(txn, repository); err != nil {
+				return err
+			}
+		}
+
+		return deleteBadgerReplicationJobs(txn, virtualStorage)
+	})
+}
+
+// DeleteReplica deletes a replica of a repository from a storage without affecting other state in the virtual storage.
+func (rs *BadgerRepositoryStore) DeleteReplica(ctx context.Context, repositoryID int64, storage string) error {
+	return rs.db.update(func(txn *badger.Txn) error {
+		repository, err := getBadgerRepository(txn, repositoryID)
+		if err != nil {
+			if errors.Is(err, ErrRepositoryNotFound) {
+				return ErrNoRowsAffected
+			}
+
+			return err
+		}
+
+		if _, ok := repository.Replicas[storage]; !ok {
+			return ErrNoRowsAffected
+		}
+
+		delete(repository.Replicas, storage)
+
+		return setBadgerRepository(txn, repository)
+	})
+}
+
+// RenameRepositoryInPlace renames the repository without changing the replica path.
+func (rs *BadgerRepositoryStore) RenameRepositoryInPlace(ctx context.Context, virtualStorage, relativePath, newRelativePath string) error {
+	return rs.db.update(func(txn *badger.Txn) error {
+		repository, err := getBadgerRepositoryByPath(txn, virtualStorage, relativePath)
+		if err != nil {
+			return err
+		}
+
+		if _, err := getBadgerRepositoryID(txn, virtualStorage, newRelativePath); err == nil {
+			return ErrRepositoryAlreadyExists
+		} else if !errors.Is(err, ErrRepositoryNotFound) {
+			return err
+		}
+
+		if err := deleteBadgerRepository(txn, repository); err != nil {
+			return err
+		}
+
+		repository.RelativePath = newRelativePath
+		for storage, replica := range repository.Replicas {
+			replica.RelativePath = newRelativePath
+			repository.Replicas[storage] = replica
+		}
+
+		return setBadgerRepository(txn, repository)
+	})
+}
+
+// RenameRepository updates a repository's relative path and the relative path of the storage's
+// replica. Refer to the interface for details.
+func (rs *BadgerRepositoryStore) RenameRepository(ctx context.Context, virtualStorage, relativePath, storage, newRelativePath string) error {
+	return rs.db.update(func(txn *badger.Txn) error {
+		// The replicas are renamed one by one. The replicas of the repository that were not yet
+		// renamed keep the old relative path after the repository itself has been renamed, so the
+		// replica has to be looked up from all of the virtual storage's repositories.
+		repositories, err := listBadgerRepositories(txn, func(repository badgerRepository) bool {
+			if repository.VirtualStorage != virtualStorage {
+				return false
+			}
+
+			if repository.RelativePath == relativePath {
+				return true
+			}
+
+			replica, ok := repository.Replicas[storage]
+			return ok && replica.RelativePath == relativePath
+		})
+		if err != nil {
+			return err
+		}
+
+		renamedReplica := false
+		for _, repository := range repositories {
+			if repository.RelativePath == relativePath {
+				if _, err := getBadgerRepositoryID(txn, virtualStorage, newRelativePath); err == nil {
+					return ErrRepositoryAlreadyExists
+				} else if !errors.Is(err, ErrRepositoryNotFound) {
+					return err
+				}
+
+				if err := deleteBadgerRepository(txn, repository); err != nil {
+					return err
+				}
+
+				repository.RelativePath = newRelativePath
+				repository.ReplicaPath = newRelativePath
+			}
+
+			if replica, ok := repository.Replicas[storage]; ok && replica.RelativePath == relativePath {
+				replica.RelativePath = newRelativePath
+				repository.Replicas[storage] = replica
+				renamedReplica = true
+			}
+
+			if err := setBadgerRepository(txn, repository); err != nil {
+				return err
+			}
+		}
+
+		if !renamedReplica {
+			return ErrRepositoryNotFound
+		}
+
+		return nil
+	})
+}
+
+// consistentStorages returns the storages which have the latest generation of the repository under
+// the repository's current relative path.
+func (r badgerRepository) consistentStorages() *datastructure.Set[string] {
+	consistent := datastructure.NewSet[string]()
+	for storage, replica := range r.Replicas {
+		if replica.Generation == r.Generation && replica.RelativePath == r.RelativePath {
+			consistent.Add(storage)
+		}
+	}
+
+	return consistent
+}
+
+// GetConsistentStoragesByRepositoryID returns the replica path and the set of up to date storages for the given repository keyed by repository ID.
+func (rs *BadgerRepositoryStore) GetConsistentStoragesByRepositoryID(ctx context.Context, repositoryID int64) (string, *datastructure.Set[string], error) {
+	return rs.getConsistentStorages(func(txn *badger.Txn) (badgerRepository, error) {
+		return getBadgerRepository(txn, repositoryID)
+	})
+}
+
+// GetConsistentStorages returns the replica path and the set of up to date storages for the given repository keyed by virtual storage and relative path.
+func (rs *BadgerRepositoryStore) GetConsistentStorages(ctx context.Context, virtualStorage, relativePath string) (string, *datastructure.Set[string], error) {
+	return rs.getConsistentStorages(func(txn *badger.Txn) (badgerRepository, error) {
+		return getBadgerRepositoryByPath(txn, virtualStorage, relativePath)
+	})
+}
+
+func (rs *BadgerRepositoryStore) getConsistentStorages(getRepository func(*badger.Txn) (badgerRepository, error)) (string, *datastructure.Set[string], error) {
+	var repository badgerRepository
+	if err := rs.db.view(func(txn *badger.Txn) error {
+		var err error
+		repository, err = getRepository(txn)
+		return err
+	}); err != nil {
+		return "", nil, err
+	}
+
+	consistent := repository.consistentStorages()
+	if consistent.IsEmpty() {
+		return "", nil, ErrRepositoryNotFound
+	}
+
+	return repository.ReplicaPath, consistent, nil
+}
+
+// RepositoryExists returns whether the repository exists on a virtual storage.
+func (rs *BadgerRepositoryStore) RepositoryExists(ctx context.Context, virtualStorage, relativePath string) (bool, error) {
+	if _, err := rs.GetRepositoryID(ctx, virtualStorage, relativePath); err != nil {
+		if errors.Is(err, ErrRepositoryNotFound) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return true, nil
+}
+
+// DeleteInvalidRepository deletes the given replica. If the replica was the only replica of the
+// repository, then the repository will be deleted, as well.
+func (rs *BadgerRepositoryStore) DeleteInvalidRepository(ctx context.Context, repositoryID int64, storage string) error {
+	return rs.db.update(func(txn *badger.Txn) error {
+		repository, err := getBadgerRepository(txn, repositoryID)
+		if err != nil {
+			if errors.Is(err, ErrRepositoryNotFound) {
+				return nil
+			}
+
+			return err
+		}
+
+		delete(repository.Replicas, storage)
+		if len(repository.Replicas) == 0 {
+			return deleteBadgerRepository(txn, repository)
+		}
+
+		return setBadgerRepository(txn, repository)
+	})
+}
+
+// GetRepositoryMetadata retrieves a repository's metadata.
+func (rs *BadgerRepositoryStore) GetRepositoryMetadata(ctx context.Context, repositoryID int64) (RepositoryMetadata, error) {
+	var metadata RepositoryMetadata
+	if err := rs.db.view(func(txn *badger.Txn) error {
+		repository, err := getBadgerRepository(txn, repositoryID)
+		if err != nil {
+			return err
+		}
+
+		metadata, err = rs.repositoryMetadata(txn, repository)
+		return err
+	}); err != nil {
+		return RepositoryMetadata{}, err
+	}
+
+	return metadata, nil
+}
+
+// GetRepositoryMetadataByPath retrieves a repository's metadata by its virtual path.
+func (rs *BadgerRepositoryStore) GetRepositoryMetadataByPath(ctx context.Context, virtualStorage, relativePath string) (RepositoryMetadata, error) {
+	var metadata RepositoryMetadata
+	if err := rs.db.view(func(txn *badger.Txn) error {
+		repository, err := getBadgerRepositoryByPath(txn, virtualStorage, relativePath)
+		if err != nil {
+			return err
+		}
+
+		metadata, err = rs.repositoryMetadata(txn, repository)
+		return err
+	}); err != nil {
+		return RepositoryMetadata{}, err
+	}
+
+	return metadata, nil
+}
+
+// GetPartiallyAvailableRepositories returns information on repositories which have assigned replicas which
+// are not able to serve requests at the moment.
+func (rs *BadgerRepositoryStore) GetPartiallyAvailableRepositories(ctx context.Context, virtualStorage string) ([]RepositoryMetadata, error) {
+	if _, ok := rs.storages[virtualStorage]; !ok {
+		return nil, fmt.Errorf("unknown virtual storage: %q", virtualStorage)
+	}
+
+	var partiallyAvailable []RepositoryMetadata
+	if err := rs.db.view(func(txn *badger.Txn) error {
+		repositories, err := listBadgerRepositories(txn, func(repository badgerRepository) bool {
+			return repository.VirtualStorage == virtualStorage
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, repository := range repositories {
+			metadata, err := rs.repositoryMetadata(txn, repository)
+			if err != nil {
+				return err
+			}
+
+			for _, replica := range metadata.Replicas {
+				if replica.Assigned && !replica.ValidPrimary {
+					partiallyAvailable = append(partiallyAvailable, metadata)
+					break
+				}
+			}
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return partiallyAvailable, nil
+}
+
+// healthyStorages returns the healthy storages of the virtual storage.
+func (rs *BadgerRepositoryStore) healthyStorages(virtualStorage string) *datastructure.Set[string] {
+	if rs.healthChecker == nil {
+		return datastructure.NewSet[string]()
+	}
+
+	return datastructure.SetFromSlice(rs.healthChecker.HealthyNodes()[virtualStorage])
+}
+
+// assignedStorages returns the configured storages assigned to host the repository. If the
+// repository has no assignments on the configured storages, all of the configured storages are
+// considered assigned.
+func (rs *BadgerRepositoryStore) assignedStorages(repository badgerRepository) *datastructure.Set[string] {
+	assigned := datastructure.NewSet[string]()
+	for _, storage := range rs.storages[repository.VirtualStorage] {
+		if repository.isAssigned(storage) {
+			assigned.Add(storage)
+		}
+	}
+
+	if assigned.IsEmpty() {
+		return datastructure.SetFromSlice(rs.storages[repository.VirtualStorage])
+	}
+
+	return assigned
+}
+
+// validPrimaries returns the storages which are eligible to serve as the repository's primary.
+// A storage is a valid primary if it is healthy, not drained, contains the latest generation of the
+// repository and has no pending deletion job. If the repository has assignments, only assigned storages are
+// eligible unless none of the candidates is assigned.
+func (rs *BadgerRepositoryStore) validPrimaries(txn *badger.Txn, repository badgerRepository) (*datastructure.Set[string], error) {
+	pendingDeletions, err := pendingReplicaDeletions(txn, repository.RepositoryID)
+	if err != nil {
+		return nil, fmt.Errorf("pending replica deletions: %w", err)
+	}
+
+	healthy := rs.healthyStorages(repository.VirtualStorage)
+
+	var candidates []string
+	assignedCandidate := false
+	for storage, replica := range repository.Replicas {
+		if replica.Generation != repository.Generation || !healthy.HasValue(storage) || pendingDeletions.HasValue(storage) {
+			continue
+		}
+
+		drained, err := isBadgerStorageDrained(txn, repository.VirtualStorage, storage)
+		if err != nil {
+			return nil, fmt.Errorf("check drained: %w", err)
+		}
+
+		if drained {
+			continue
+		}
+
+		candidates = append(candidates, storage)
+		if repository.isAssigned(storage) {
+			assignedCandidate = true
+		}
+	}
+
+	valid := datastructure.NewSet[string]()
+	for _, storage := range candidates {
+		if !assignedCandidate || repository.isAssigned(storage) {
+			valid.Add(storage)
+		}
+	}
+
+	return valid, nil
+}
+
+func (rs *BadgerRepositoryStore) repositoryMetadata(txn *badger.Txn, repository badgerRepository) (RepositoryMetadata, error) {
+	validPrimaries, err := rs.validPrimaries(txn, repository)
+	if err != nil {
+		return RepositoryMetadata{}, err
+	}
+
+	healthy := rs.healthyStorages(repository.VirtualStorage)
+	assigned := rs.assignedStorages(repository)
+
+	storages := datastructure.SetFromSlice(repository.storages())
+	for _, storage := range assigned.Values() {
+		storages.Add(storage)
+	}
+
+	sortedStorages := storages.Values()
+	sort.Strings(sortedStorages)
+
+	metadata := RepositoryMetadata{
+		RepositoryID:   repository.RepositoryID,
+		VirtualStorage: repository.VirtualStorage,
+		RelativePath:   repository.RelativePath,
+		ReplicaPath:    repository.ReplicaPath,
+		Primary:        repository.Primary,
+		Generation:     int64(repository.Generation),
+		Replicas:       make([]Replica, 0, len(sortedStorages)),
+	}
+
+	for _, storage := range sortedStorages {
+		replica := Replica{
+			Storage:      storage,
+			Generation:   GenerationUnknown,
+			Assigned:     assigned.HasValue(storage),
+			Healthy:      healthy.HasValue(storage),
+			ValidPrimary: validPrimaries.HasValue(storage),
+		}
+
+		if record, ok := repository.Replicas[storage]; ok {
+			replica.Generation = int64(record.Generation)
+			if record.VerifiedAt != nil {
+				replica.VerifiedAt = *record.VerifiedAt
+			}
+		}
+
+		metadata.Replicas = append(metadata.Replicas, replica)
+	}
+
+	return metadata, nil
+}
+
+// ElectPrimary returns the primary of the repository. If the current primary is not a valid primary
+// anymore, a new primary is elected at random from the valid primaries. The previous primary is
+// returned alongside the current one. Either may be empty if the repository had or has no primary.
+func (rs *BadgerRepositoryStore) ElectPrimary(ctx context.Context, repositoryID int64) (current string, previous string, _ error) {
+	if err := rs.db.update(func(txn *badger.Txn) error {
+		repository, err := getBadgerRepository(txn, repositoryID)
+		if err != nil {
+			return err
+		}
+
+		previous = repository.Primary
+		current = repository.Primary
+
+		validPrimaries, err := rs.validPrimaries(txn, repository)
+		if err != nil {
+			return err
+		}
+
+		if validPrimaries.IsEmpty() || validPrimaries.HasValue(repository.Primary) {
+			return nil
+		}
+
+		candidates := validPrimaries.Values()
+		sort.Strings(candidates)
+
+		repository.Primary = candidates[rand.Intn(len(candidates))]
+		current = repository.Primary
+
+		return setBadgerRepository(txn, repository)
+	}); err != nil {
+		return "", "", err
+	}
+
+	return current, previous, nil
+}
+
+// ReserveRepositoryID reserves an ID for a repository that is about to be created and returns it. If a repository already
+// exists with the given virtual storage and relative path combination, an error is returned.
+func (rs *BadgerRepositoryStore) ReserveRepositoryID(ctx context.Context, virtualStorage, relativePath string) (int64, error) {
+	var repositoryID uint64
+	if err := rs.db.update(func(txn *badger.Txn) error {
+		if _, err := getBadgerRepositoryID(txn, virtualStorage, relativePath); err == nil {
+			return ErrRepositoryAlreadyExists
+		} else if !errors.Is(err, ErrRepositoryNotFound) {
+			return err
+		}
+
+		var err error
+		repositoryID, err = nextSequenceValue(txn, repositoryIDSequence)
+		return err
+	}); err != nil {
+		return 0, err
+	}
+
+	return int64(repositoryID), nil
+}
+
+// GetRepositoryID gets the ID of the repository identified via the given virtual storage and relative path. Returns a
+// ErrRepositoryNotFound error if the repository doesn't exist.
+func (rs *BadgerRepositoryStore) GetRepositoryID(ctx context.Context, virtualStorage, relativePath string) (int64, error) {
+	var repositoryID int64
+	if err := rs.db.view(func(txn *badger.Txn) error {
+		var err error
+		repositoryID, err = getBadgerRepositoryID(txn, virtualStorage, relativePath)
+		return err
+	}); err != nil {
+		return 0, err
+	}
+
+	return repositoryID, nil
+}
+
+// GetReplicaPath gets the replica path of a repository. Returns a ErrRepositoryNotFound if a record
+// for the repository ID is not found.
+func (rs *BadgerRepositoryStore) GetReplicaPath(ctx context.Context, repositoryID int64) (string, error) {
+	var replicaPath string
+	if err := rs.db.view(func(txn *badger.Txn) error {
+		repository, err := getBadgerRepository(txn, repositoryID)
+		if err != nil {
+			return err
+		}
+
+		replicaPath = repository.ReplicaPath
+		return nil
+	}); err != nil {
+		return "", err
+	}
+
+	return replicaPath, nil
+}
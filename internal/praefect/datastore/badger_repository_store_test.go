@@ -0,0 +1,260 @@
+package datastore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dgraph-io/badger/v4"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
+)
+
+func TestRepositoryStore_Badger(t *testing.T) {
+	t.Parallel()
+
+	var db *BadgerDB
+	testRepositoryStore(t, repositoryStoreBackend{
+		newRepositoryStore: func(t *testing.T, storages map[string][]string) RepositoryStore {
+			db = newBadgerDB(t, t.TempDir())
+			return NewBadgerRepositoryStore(db, storages, nil)
+		},
+		newQueue: func(t *testing.T) ReplicationEventQueue {
+			return NewBadgerReplicationEventQueue(db)
+		},
+		requireState: func(tb testing.TB, ctx context.Context, vss virtualStorageState, ss storageState) {
+			tb.Helper()
+			requireBadgerState(tb, db, vss, ss)
+		},
+		requireLocks: func(t *testing.T, ctx context.Context, expected []LockRow) {
+			t.Helper()
+			requireBadgerLocks(t, db, expected)
+		},
+		requireJobLocks: func(t *testing.T, ctx context.Context, expected []JobLockRow) {
+			t.Helper()
+			requireBadgerJobLocks(t, db, expected)
+		},
+	})
+}
+
+func TestBadgerRepositoryStore_GetRepositoryMetadata(t *testing.T) {
+	t.Parallel()
+
+	testGetRepositoryMetadata(t, func(t *testing.T, ctx context.Context, configuredStorages map[string][]string, healthyStorages []string) repositoryMetadataBackend {
+		db := newBadgerDB(t, t.TempDir())
+
+		updateRepository := func(repositoryID int64, update func(*badgerRepository)) {
+			require.NoError(t, db.update(func(txn *badger.Txn) error {
+				repository, err := getBadgerRepository(txn, repositoryID)
+				if err != nil {
+					return err
+				}
+
+				update(&repository)
+
+				return setBadgerRepository(txn, repository)
+			}))
+		}
+
+		return repositoryMetadataBackend{
+			rs: NewBadgerRepositoryStore(db, configuredStorages, staticHealthChecker{"virtual-storage": healthyStorages}),
+			createRepository: func(repositoryID int64, virtualStorage, relativePath, replicaPath, primary string) {
+				require.NoError(t, db.update(func(txn *badger.Txn) error {
+					return setBadgerRepository(txn, badgerRepository{
+						RepositoryID:   repositoryID,
+						VirtualStorage: virtualStorage,
+						RelativePath:   relativePath,
+						ReplicaPath:    replicaPath,
+						Primary:        primary,
+					})
+				}))
+			},
+			markVerified: func(storage string, verifiedAt time.Time) {
+				require.NoError(t, db.update(func(txn *badger.Txn) error {
+					repositories, err := listBadgerRepositories(txn, func(badgerRepository) bool { return true })
+					if err != nil {
+						return err
+					}
+
+					for _, repository := range repositories {
+						if replica, ok := repository.Replicas[storage]; ok {
+							replica.VerifiedAt = &verifiedAt
+							repository.Replicas[storage] = replica
+						}
+
+						if err := setBadgerRepository(txn, repository); err != nil {
+							return err
+						}
+					}
+
+					return nil
+				}))
+			},
+			assign: func(repositoryID int64, virtualStorage, relativePath, storage string) {
+				updateRepository(repositoryID, func(repository *badgerRepository) {
+					repository.Assignments = append(repository.Assignments, storage)
+				})
+			},
+			deleteRepository: func(repositoryID int64) {
+				require.NoError(t, db.update(func(txn *badger.Txn) error {
+					repository, err := getBadgerRepository(txn, repositoryID)
+					if err != nil {
+						return err
+					}
+
+					return deleteBadgerRepository(txn, repository)
+				}))
+			},
+		}
+	})
+}
+
+func TestBadgerRepositoryStore_ElectPrimary(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+
+	for _, tc := range []struct {
+		desc              string
+		healthyStorages   []string
+		primary           string
+		generations       map[string]int
+		assignments       []string
+		pendingDeletion   string
+		drained           []string
+		expectedCurrent   []string
+		expectedPrevious  string
+		expectedErr       error
+		nonExistentRecord bool
+	}{
+		{
+			desc:              "repository not found",
+			nonExistentRecord: true,
+			expectedErr:       ErrRepositoryNotFound,
+		},
+		{
+			desc:             "valid primary is kept",
+			healthyStorages:  []string{"gitaly-1", "gitaly-2"},
+			primary:          "gitaly-1",
+			generations:      map[string]int{"gitaly-1": 0, "gitaly-2": 0},
+			expectedCurrent:  []string{"gitaly-1"},
+			expectedPrevious: "gitaly-1",
+		},
+		{
+			desc:            "elects a primary if there is none",
+			healthyStorages: []string{"gitaly-1", "gitaly-2"},
+			generations:     map[string]int{"gitaly-1": 0, "gitaly-2": 0},
+			expectedCurrent: []string{"gitaly-1", "gitaly-2"},
+		},
+		{
+			desc:             "unhealthy primary is demoted",
+			healthyStorages:  []string{"gitaly-2"},
+			primary:          "gitaly-1",
+			generations:      map[string]int{"gitaly-1": 0, "gitaly-2": 0},
+			expectedCurrent:  []string{"gitaly-2"},
+			expectedPrevious: "gitaly-1",
+		},
+		{
+			desc:             "outdated primary is demoted",
+			healthyStorages:  []string{"gitaly-1", "gitaly-2", "gitaly-3"},
+			primary:          "gitaly-1",
+			generations:      map[string]int{"gitaly-1": 0, "gitaly-2": 1, "gitaly-3": 0},
+			expectedCurrent:  []string{"gitaly-2"},
+			expectedPrevious: "gitaly-1",
+		},
+		{
+			desc:             "assigned storages are preferred",
+			healthyStorages:  []string{"gitaly-2", "gitaly-3"},
+			primary:          "gitaly-1",
+			generations:      map[string]int{"gitaly-1": 0, "gitaly-2": 0, "gitaly-3": 0},
+			assignments:      []string{"gitaly-1", "gitaly-3"},
+			expectedCurrent:  []string{"gitaly-3"},
+			expectedPrevious: "gitaly-1",
+		},
+		{
+			desc:             "replica pending deletion is not elected",
+			healthyStorages:  []string{"gitaly-2", "gitaly-3"},
+			primary:          "gitaly-1",
+			generations:      map[string]int{"gitaly-1": 0, "gitaly-2": 0, "gitaly-3": 0},
+			pendingDeletion:  "gitaly-2",
+			expectedCurrent:  []string{"gitaly-3"},
+			expectedPrevious: "gitaly-1",
+		},
+		{
+			desc:             "drained primary is demoted",
+			healthyStorages:  []string{"gitaly-1", "gitaly-2", "gitaly-3"},
+			primary:          "gitaly-1",
+			generations:      map[string]int{"gitaly-1": 0, "gitaly-2": 0, "gitaly-3": 0},
+			drained:          []string{"gitaly-1", "gitaly-2"},
+			expectedCurrent:  []string{"gitaly-3"},
+			expectedPrevious: "gitaly-1",
+		},
+		{
+			desc:             "primary is kept if there are no valid candidates",
+			primary:          "gitaly-1",
+			generations:      map[string]int{"gitaly-1": 0, "gitaly-2": 0},
+			expectedCurrent:  []string{"gitaly-1"},
+			expectedPrevious: "gitaly-1",
+		},
+	} {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			db := newBadgerDB(t, t.TempDir())
+			rs := NewBadgerRepositoryStore(db, map[string][]string{
+				"virtual-storage": {"gitaly-1", "gitaly-2", "gitaly-3"},
+			}, staticHealthChecker{"virtual-storage": tc.healthyStorages})
+
+			if !tc.nonExistentRecord {
+				require.NoError(t, rs.CreateRepository(ctx, 1, "virtual-storage", "relative-path", "replica-path", "gitaly-1", nil, nil, false, false))
+				require.NoError(t, db.update(func(txn *badger.Txn) error {
+					repository, err := getBadgerRepository(txn, 1)
+					require.NoError(t, err)
+
+					repository.Primary = tc.primary
+					repository.Assignments = tc.assignments
+					repository.Replicas = map[string]badgerReplica{}
+					for storage, generation := range tc.generations {
+						repository.Replicas[storage] = badgerReplica{RelativePath: "relative-path", Generation: generation}
+						if generation > repository.Generation {
+							repository.Generation = generation
+						}
+					}
+
+					return setBadgerRepository(txn, repository)
+				}))
+
+				if tc.pendingDeletion != "" {
+					_, err := NewBadgerReplicationEventQueue(db).Enqueue(ctx, ReplicationEvent{
+						Job: ReplicationJob{
+							RepositoryID:      1,
+							Change:            DeleteReplica,
+							VirtualStorage:    "virtual-storage",
+							RelativePath:      "relative-path",
+							TargetNodeStorage: tc.pendingDeletion,
+						},
+					})
+					require.NoError(t, err)
+				}
+
+				for _, storage := range tc.drained {
+					require.NoError(t, NewBadgerDrainStore(db).DrainStorage(ctx, "virtual-storage", storage))
+				}
+			}
+
+			current, previous, err := rs.ElectPrimary(ctx, 1)
+			require.Equal(t, tc.expectedErr, err)
+			if tc.expectedErr != nil {
+				return
+			}
+
+			require.Contains(t, tc.expectedCurrent, current)
+			require.Equal(t, tc.expectedPrevious, previous)
+
+			metadata, err := rs.GetRepositoryMetadata(ctx, 1)
+			require.NoError(t, err)
+			require.Equal(t, current, metadata.Primary)
+		})
+	}
+}
@@ -0,0 +1,201 @@
+package datastore
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dgraph-io/badger/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
+)
+
+func newBadgerDB(tb testing.TB, path string) *BadgerDB {
+	tb.Helper()
+
+	db, err := OpenBadgerDB(testhelper.NewLogger(tb), path)
+	require.NoError(tb, err)
+	tb.Cleanup(func() { require.NoError(tb, db.Close()) })
+
+	return db
+}
+
+func requireBadgerState(tb testing.TB, db *BadgerDB, vss virtualStorageState, ss storageState) {
+	tb.Helper()
+
+	actualVSS := make(virtualStorageState)
+	actualSS := make(storageState)
+	require.NoError(tb, db.view(func(txn *badger.Txn) error {
+		repositories, err := listBadgerRepositories(txn, func(badgerRepository) bool { return true })
+		if err != nil {
+			return err
+		}
+
+		for _, repository := range repositories {
+			if actualVSS[repository.VirtualStorage] == nil {
+				actualVSS[repository.VirtualStorage] = make(map[string]repositoryRecord)
+			}
+
+			actualVSS[repository.VirtualStorage][repository.RelativePath] = repositoryRecord{
+				repositoryID: repository.RepositoryID,
+				replicaPath:  repository.ReplicaPath,
+				primary:      repository.Primary,
+				assignments:  repository.Assignments,
+			}
+
+			for storage, replica := range repository.Replicas {
+				if actualSS[repository.VirtualStorage] == nil {
+					actualSS[repository.VirtualStorage] = make(map[string]map[string]replicaRecord)
+				}
+
+				if actualSS[repository.VirtualStorage][replica.RelativePath] == nil {
+					actualSS[repository.VirtualStorage][replica.RelativePath] = make(map[string]replicaRecord)
+				}
+
+				actualSS[repository.VirtualStorage][replica.RelativePath][storage] = replicaRecord{
+					repositoryID: repository.RepositoryID,
+					generation:   replica.Generation,
+				}
+			}
+		}
+
+		return nil
+	}))
+
+	require.Equal(tb, vss, actualVSS)
+	require.Equal(tb, ss, actualSS)
+}
+
+func requireBadgerEvents(tb testing.TB, db *BadgerDB, expected []ReplicationEvent) {
+	tb.Helper()
+
+	var actual []ReplicationEvent
+	require.NoError(tb, db.view(func(txn *badger.Txn) error {
+		jobs, err := listBadgerReplicationJobs(txn, func(badgerReplicationJob) bool { return true })
+		if err != nil {
+			return err
+		}
+
+		for _, job := range jobs {
+			event := job.event()
+			// The metadata isn't compared to match the events read from Postgres.
+			event.Meta = nil
+			actual = append(actual, event)
+		}
+
+		return nil
+	}))
+
+	require.Equal(tb, normalizeEvents(expected), normalizeEvents(actual))
+}
+
+func requireBadgerLocks(tb testing.TB, db *BadgerDB, expected []LockRow) {
+	tb.Helper()
+
+	var actual []LockRow
+	require.NoError(tb, db.view(func(txn *badger.Txn) error {
+		return iterateJSON(txn, []byte(replicationQueueLockPrefix), func(_ []byte, lock badgerQueueLock) error {
+			actual = append(actual, LockRow{ID: lock.ID, Acquired: lock.Acquired})
+			return nil
+		})
+	}))
+
+	require.ElementsMatch(tb, expected, actual)
+}
+
+func requireBadgerJobLocks(tb testing.TB, db *BadgerDB, expected []JobLockRow) {
+	tb.Helper()
+
+	actual := []JobLockRow{}
+	require.NoError(tb, db.view(func(txn *badger.Txn) error {
+		return iterateJSON(txn, []byte(replicationQueueJobLockPrefix), func(_ []byte, jobLock badgerQueueJobLock) error {
+			actual = append(actual, JobLockRow{JobID: jobLock.JobID, LockID: jobLock.LockID})
+			return nil
+		})
+	}))
+
+	require.ElementsMatch(tb, expected, actual)
+}
+
+func TestBadgerDB_Migrate(t *testing.T) {
+	t.Parallel()
+
+	path := t.TempDir()
+	now := time.Date(2023, 8, 17, 12, 0, 0, 0, time.UTC)
+
+	t.Run("applies pending migrations", func(t *testing.T) {
+		db, err := OpenBadgerDB(testhelper.NewLogger(t), path)
+		require.NoError(t, err)
+		db.now = func() time.Time { return now }
+
+		status, err := db.MigrationStatus()
+		require.NoError(t, err)
+		require.Len(t, status, len(badgerMigrations))
+
+		applied, err := db.migrate(append(badgerMigrations, badgerMigration{
+			id: "99990101000000_test_migration",
+			up: func(txn *badger.Txn) error {
+				return setJSON(txn, []byte("test-key"), "test-value")
+			},
+		}))
+		require.NoError(t, err)
+		require.Equal(t, 1, applied)
+
+		status, err = db.MigrationStatus()
+		require.NoError(t, err)
+		require.Equal(t, now, status["99990101000000_test_migration"])
+
+		applied, err = db.Migrate()
+		require.Equal(t, 0, applied)
+		require.EqualError(t, err, "database contains unknown migrations: 99990101000000_test_migration")
+
+		require.NoError(t, db.Close())
+	})
+
+	t.Run("refuses to open database with unknown migrations", func(t *testing.T) {
+		_, err := OpenBadgerDB(testhelper.NewLogger(t), path)
+		require.EqualError(t, err, "migrate: database contains unknown migrations: 99990101000000_test_migration")
+	})
+}
+
+func TestBadgerDB_migrateFailure(t *testing.T) {
+	t.Parallel()
+
+	db := newBadgerDB(t, t.TempDir())
+
+	applied, err := db.migrate(append(badgerMigrations,
+		badgerMigration{
+			id: "99990101000000_first",
+			up: func(txn *badger.Txn) error { return nil },
+		},
+		badgerMigration{
+			id: "99990101000001_failing",
+			up: func(txn *badger.Txn) error {
+				require.NoError(t, setJSON(txn, []byte("test-key"), "test-value"))
+				return assert.AnError
+			},
+		},
+	))
+	require.Equal(t, 1, applied)
+	require.EqualError(t, err, `apply migration "99990101000001_failing": `+assert.AnError.Error())
+
+	status, err := db.MigrationStatus()
+	require.NoError(t, err)
+	require.Contains(t, status, "99990101000000_first")
+	require.NotContains(t, status, "99990101000001_failing")
+
+	require.NoError(t, db.view(func(txn *badger.Txn) error {
+		_, err := txn.Get([]byte("test-key"))
+		require.Equal(t, badger.ErrKeyNotFound, err)
+		return nil
+	}))
+}
+
+// staticHealthChecker returns the configured healthy storages.
+type staticHealthChecker map[string][]string
+
+func (hc staticHealthChecker) HealthyNodes() map[string][]string {
+	return hc
+}
+
+var _ HealthChecker = staticHealthChecker(nil)
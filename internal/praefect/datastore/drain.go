@@ -0,0 +1,86 @@
+package datastore
+
+import (
+	"context"
+	"fmt"
+
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/datastore/glsql"
+)
+
+// DrainStore persists which storages have been drained. A drained storage doesn't receive new reads
+// and isn't eligible to become a repository's primary. It still participates in writes and replication
+// so it stays up to date and can be taken back into rotation without catching up first.
+type DrainStore interface {
+	// DrainStorage marks the storage as drained. Draining an already drained storage is a no-op.
+	DrainStorage(ctx context.Context, virtualStorage, storage string) error
+	// UndrainStorage removes the storage's drain mark. Undraining a storage that is not drained is
+	// a no-op.
+	UndrainStorage(ctx context.Context, virtualStorage, storage string) error
+	// GetDrainedStorages returns the drained storages by virtual storage.
+	GetDrainedStorages(ctx context.Context) (map[string][]string, error)
+}
+
+// PostgresDrainStore is a Postgres implementation of DrainStore.
+type PostgresDrainStore struct {
+	db glsql.Querier
+}
+
+// NewPostgresDrainStore returns a new PostgresDrainStore.
+func NewPostgresDrainStore(db glsql.Querier) *PostgresDrainStore {
+	return &PostgresDrainStore{db: db}
+}
+
+// DrainStorage marks the storage as drained.
+func (s *PostgresDrainStore) DrainStorage(ctx context.Context, virtualStorage, storage string) error {
+	if _, err := s.db.ExecContext(ctx, `
+INSERT INTO drained_storages (virtual_storage, storage)
+VALUES ($1, $2)
+ON CONFLICT (virtual_storage, storage) DO NOTHING
+	`, virtualStorage, storage); err != nil {
+		return fmt.Errorf("exec: %w", err)
+	}
+
+	return nil
+}
+
+// UndrainStorage removes the storage's drain mark.
+func (s *PostgresDrainStore) UndrainStorage(ctx context.Context, virtualStorage, storage string) error {
+	if _, err := s.db.ExecContext(ctx, `
+DELETE FROM drained_storages
+WHERE virtual_storage = $1
+AND storage = $2
+	`, virtualStorage, storage); err != nil {
+		return fmt.Errorf("exec: %w", err)
+	}
+
+	return nil
+}
+
+// GetDrainedStorages returns the drained storages by virtual storage.
+func (s *PostgresDrainStore) GetDrainedStorages(ctx context.Context) (map[string][]string, error) {
+	rows, err := s.db.QueryContext(ctx, `
+SELECT virtual_storage, storage
+FROM drained_storages
+ORDER BY virtual_storage, storage
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+	defer rows.Close()
+
+	drained := map[string][]string{}
+	for rows.Next() {
+		var virtualStorage, storage string
+		if err := rows.Scan(&virtualStorage, &storage); err != nil {
+			return nil, fmt.Errorf("scan: %w", err)
+		}
+
+		drained[virtualStorage] = append(drained[virtualStorage], storage)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows: %w", err)
+	}
+
+	return drained, nil
+}
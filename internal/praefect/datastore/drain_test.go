@@ -0,0 +1,65 @@
+package datastore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper/testdb"
+)
+
+func TestDrainStore_Postgres(t *testing.T) {
+	t.Parallel()
+
+	db := testdb.New(t)
+	testDrainStore(t, func(t *testing.T) DrainStore {
+		db.TruncateAll(t)
+		return NewPostgresDrainStore(db)
+	})
+}
+
+func TestDrainStore_Badger(t *testing.T) {
+	t.Parallel()
+
+	testDrainStore(t, func(t *testing.T) DrainStore {
+		return NewBadgerDrainStore(newBadgerDB(t, t.TempDir()))
+	})
+}
+
+func testDrainStore(t *testing.T, newStore func(t *testing.T) DrainStore) {
+	ctx := testhelper.Context(t)
+
+	t.Run("no drained storages", func(t *testing.T) {
+		drained, err := newStore(t).GetDrainedStorages(ctx)
+		require.NoError(t, err)
+		require.Empty(t, drained)
+	})
+
+	t.Run("drain and undrain", func(t *testing.T) {
+		store := newStore(t)
+
+		require.NoError(t, store.DrainStorage(ctx, "virtual-storage-1", "gitaly-1"))
+		require.NoError(t, store.DrainStorage(ctx, "virtual-storage-1", "gitaly-2"))
+		require.NoError(t, store.DrainStorage(ctx, "virtual-storage-2", "gitaly-1"))
+		// Draining an already drained storage is a no-op.
+		require.NoError(t, store.DrainStorage(ctx, "virtual-storage-1", "gitaly-1"))
+
+		drained, err := store.GetDrainedStorages(ctx)
+		require.NoError(t, err)
+		require.Equal(t, map[string][]string{
+			"virtual-storage-1": {"gitaly-1", "gitaly-2"},
+			"virtual-storage-2": {"gitaly-1"},
+		}, drained)
+
+		require.NoError(t, store.UndrainStorage(ctx, "virtual-storage-1", "gitaly-1"))
+		// Undraining a storage that is not drained is a no-op.
+		require.NoError(t, store.UndrainStorage(ctx, "virtual-storage-1", "gitaly-3"))
+
+		drained, err = store.GetDrainedStorages(ctx)
+		require.NoError(t, err)
+		require.Equal(t, map[string][]string{
+			"virtual-storage-1": {"gitaly-2"},
+			"virtual-storage-2": {"gitaly-1"},
+		}, drained)
+	})
+}
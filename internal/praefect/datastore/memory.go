@@ -205,6 +205,12 @@ func (s *memoryReplicationEventQueue) AcknowledgeStale(context.Context, time.Dur
 	return 0, nil
 }
 
+func (s *memoryReplicationEventQueue) RecordError(context.Context, uint64, string) error {
+	// this implementation doesn't keep failed events around for inspection, so there is no need
+	// to record why they have failed
+	return nil
+}
+
 // remove deletes i-th element from the queue and from the in-flight tracking map.
 // It doesn't check 'i' for the out of range and must be called with lock protection.
 func (s *memoryReplicationEventQueue) remove(i int) {
@@ -0,0 +1,17 @@
+package migrations
+
+import migrate "github.com/rubenv/sql-migrate"
+
+func init() {
+	m := &migrate.Migration{
+		Id: "20230816093000_replication_queue_error",
+		Up: []string{
+			"ALTER TABLE replication_queue ADD COLUMN error TEXT",
+		},
+		Down: []string{
+			"ALTER TABLE replication_queue DROP COLUMN error",
+		},
+	}
+
+	allMigrations = append(allMigrations, m)
+}
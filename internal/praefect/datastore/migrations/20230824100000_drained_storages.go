@@ -0,0 +1,79 @@
+package migrations
+
+import migrate "github.com/rubenv/sql-migrate"
+
+func init() {
+	m := &migrate.Migration{
+		Id: "20230824100000_drained_storages",
+		Up: []string{
+			`
+CREATE TABLE drained_storages (
+	virtual_storage TEXT NOT NULL,
+	storage TEXT NOT NULL,
+	drained_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	PRIMARY KEY (virtual_storage, storage)
+)
+			`,
+			`
+CREATE OR REPLACE VIEW valid_primaries AS
+	SELECT repository_id, virtual_storage, relative_path, storage
+	FROM (
+		SELECT
+			repository_id,
+			repositories.virtual_storage,
+			repositories.relative_path,
+			storage,
+			repository_assignments.storage IS NOT NULL
+				OR bool_and(repository_assignments.storage IS NULL) OVER (PARTITION BY repository_id) AS eligible
+		FROM repositories
+		JOIN (SELECT repository_id, storage, generation FROM storage_repositories) AS storage_repositories USING (repository_id, generation)
+		JOIN healthy_storages USING (virtual_storage, storage)
+		LEFT JOIN repository_assignments USING (repository_id, storage)
+		WHERE NOT EXISTS (
+			SELECT FROM replication_queue
+			WHERE state NOT IN ('completed', 'dead', 'cancelled')
+			AND job->>'change' = 'delete_replica'
+			AND (job->>'repository_id')::bigint = repository_id
+			AND job->>'target_node_storage' = storage
+		)
+		AND NOT EXISTS (
+			SELECT FROM drained_storages
+			WHERE drained_storages.virtual_storage = repositories.virtual_storage
+			AND drained_storages.storage = storage_repositories.storage
+		)
+	) AS candidates
+	WHERE eligible
+			`,
+		},
+		Down: []string{
+			`
+CREATE OR REPLACE VIEW valid_primaries AS
+	SELECT repository_id, virtual_storage, relative_path, storage
+	FROM (
+		SELECT
+			repository_id,
+			repositories.virtual_storage,
+			repositories.relative_path,
+			storage,
+			repository_assignments.storage IS NOT NULL
+				OR bool_and(repository_assignments.storage IS NULL) OVER (PARTITION BY repository_id) AS eligible
+		FROM repositories
+		JOIN (SELECT repository_id, storage, generation FROM storage_repositories) AS storage_repositories USING (repository_id, generation)
+		JOIN healthy_storages USING (virtual_storage, storage)
+		LEFT JOIN repository_assignments USING (repository_id, storage)
+		WHERE NOT EXISTS (
+			SELECT FROM replication_queue
+			WHERE state NOT IN ('completed', 'dead', 'cancelled')
+			AND job->>'change' = 'delete_replica'
+			AND (job->>'repository_id')::bigint = repository_id
+			AND job->>'target_node_storage' = storage
+		)
+	) AS candidates
+	WHERE eligible
+			`,
+			"DROP TABLE drained_storages",
+		},
+	}
+
+	allMigrations = append(allMigrations, m)
+}
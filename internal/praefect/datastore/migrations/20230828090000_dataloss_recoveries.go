@@ -0,0 +1,41 @@
+package migrations
+
+import migrate "github.com/rubenv/sql-migrate"
+
+func init() {
+	m := &migrate.Migration{
+		Id: "20230828090000_dataloss_recoveries",
+		Up: []string{
+			`
+CREATE TABLE unavailable_repositories (
+	repository_id BIGINT PRIMARY KEY REFERENCES repositories ON DELETE CASCADE,
+	unavailable_since TIMESTAMPTZ NOT NULL DEFAULT NOW()
+)
+			`,
+			// dataloss_recoveries has no foreign key to repositories so the audit records are kept
+			// after the repository has been deleted.
+			`
+CREATE TABLE dataloss_recoveries (
+	id BIGSERIAL PRIMARY KEY,
+	repository_id BIGINT NOT NULL,
+	virtual_storage TEXT NOT NULL,
+	relative_path TEXT NOT NULL,
+	unavailable_since TIMESTAMPTZ NOT NULL,
+	evaluated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	action TEXT NOT NULL,
+	storage TEXT NOT NULL,
+	generation_gap BIGINT NOT NULL,
+	reason TEXT NOT NULL,
+	candidates JSONB NOT NULL,
+	UNIQUE (repository_id, unavailable_since)
+)
+			`,
+		},
+		Down: []string{
+			"DROP TABLE dataloss_recoveries",
+			"DROP TABLE unavailable_repositories",
+		},
+	}
+
+	allMigrations = append(allMigrations, m)
+}
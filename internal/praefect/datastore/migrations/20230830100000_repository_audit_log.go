@@ -0,0 +1,170 @@
+package migrations
+
+import migrate "github.com/rubenv/sql-migrate"
+
+func init() {
+	m := &migrate.Migration{
+		Id: "20230830100000_repository_audit_log",
+		Up: []string{
+			// repository_audit_log has no foreign key to repositories so the entries are kept after
+			// the repository has been deleted.
+			`
+CREATE TABLE repository_audit_log (
+	id BIGSERIAL PRIMARY KEY,
+	occurred_at TIMESTAMPTZ NOT NULL DEFAULT CLOCK_TIMESTAMP(),
+	transaction_id BIGINT NOT NULL DEFAULT TXID_CURRENT(),
+	repository_id BIGINT,
+	virtual_storage TEXT NOT NULL,
+	relative_path TEXT NOT NULL,
+	storage TEXT,
+	change TEXT NOT NULL,
+	old_value TEXT,
+	new_value TEXT,
+	actor TEXT NOT NULL,
+	reason TEXT NOT NULL
+)
+			`,
+			"CREATE INDEX repository_audit_log_repository_idx ON repository_audit_log (virtual_storage, relative_path, id)",
+			"CREATE INDEX repository_audit_log_occurred_at_idx ON repository_audit_log (occurred_at)",
+			// Entries older than the retention period may be deleted. The retention period in seconds
+			// is taken from the transaction-local setting Praefect sets when purging the audit log.
+			// Entries can't be deleted if the setting is not set.
+			`-- +migrate StatementBegin
+			CREATE FUNCTION reject_audit_log_modification() RETURNS TRIGGER AS $$
+				DECLARE
+					retention TEXT := NULLIF(CURRENT_SETTING('praefect.audit_log_retention', true), '');
+				BEGIN
+					IF TG_OP = 'DELETE' AND retention IS NOT NULL
+						AND OLD.occurred_at < NOW() - MAKE_INTERVAL(secs => retention::DOUBLE PRECISION) THEN
+						RETURN OLD;
+					END IF;
+
+					RAISE EXCEPTION 'repository_audit_log is append-only';
+				END;
+			$$ LANGUAGE plpgsql;
+			-- +migrate StatementEnd`,
+			`CREATE TRIGGER repository_audit_log_append_only BEFORE UPDATE OR DELETE ON repository_audit_log
+				FOR EACH ROW EXECUTE FUNCTION reject_audit_log_modification()`,
+			// The actor and reason of a change are taken from the transaction-local settings Praefect
+			// sets in the statement making the change. Changes made outside of Praefect are attributed
+			// to an unknown actor. The settings are empty rather than unset if they have been set in an
+			// earlier transaction on the same connection. The entry is only sent to the Praefects
+			// logging the changes if the statement enables the notifications, as nobody listens on the
+			// channel otherwise.
+			`-- +migrate StatementBegin
+			CREATE FUNCTION log_repository_audit_entry(
+				_change TEXT, _repository_id BIGINT, _virtual_storage TEXT, _relative_path TEXT,
+				_storage TEXT, _old_value TEXT, _new_value TEXT
+			) RETURNS VOID AS $$
+				DECLARE
+					entry repository_audit_log;
+				BEGIN
+					INSERT INTO repository_audit_log (change, repository_id, virtual_storage, relative_path, storage, old_value, new_value, actor, reason)
+					VALUES (
+						_change, _repository_id, _virtual_storage, _relative_path, _storage, _old_value, _new_value,
+						COALESCE(NULLIF(CURRENT_SETTING('praefect.audit_actor', true), ''), 'unknown'),
+						COALESCE(CURRENT_SETTING('praefect.audit_reason', true), '')
+					)
+					RETURNING * INTO entry;
+
+					IF CURRENT_SETTING('praefect.audit_log_notify', true) = 'on' THEN
+						PERFORM PG_NOTIFY('repository_audit_log', ROW_TO_JSON(entry)::TEXT);
+					END IF;
+				END;
+			$$ LANGUAGE plpgsql;
+			-- +migrate StatementEnd`,
+			`-- +migrate StatementBegin
+			CREATE FUNCTION audit_repositories() RETURNS TRIGGER AS $$
+				BEGIN
+					CASE TG_OP
+					WHEN 'INSERT' THEN
+						PERFORM log_repository_audit_entry('repository_created', NEW.repository_id, NEW.virtual_storage, NEW.relative_path,
+							NEW."primary", NULL, NEW.generation::TEXT);
+					WHEN 'DELETE' THEN
+						PERFORM log_repository_audit_entry('repository_deleted', OLD.repository_id, OLD.virtual_storage, OLD.relative_path,
+							OLD."primary", OLD.generation::TEXT, NULL);
+					WHEN 'UPDATE' THEN
+						IF NEW.relative_path IS DISTINCT FROM OLD.relative_path THEN
+							PERFORM log_repository_audit_entry('repository_renamed', NEW.repository_id, NEW.virtual_storage, NEW.relative_path,
+								NULL, OLD.relative_path, NEW.relative_path);
+						END IF;
+
+						IF NEW.generation IS DISTINCT FROM OLD.generation THEN
+							PERFORM log_repository_audit_entry('generation_changed', NEW.repository_id, NEW.virtual_storage, NEW.relative_path,
+								NULL, OLD.generation::TEXT, NEW.generation::TEXT);
+						END IF;
+
+						IF NEW."primary" IS DISTINCT FROM OLD."primary" THEN
+							PERFORM log_repository_audit_entry('primary_changed', NEW.repository_id, NEW.virtual_storage, NEW.relative_path,
+								NEW."primary", OLD."primary", NEW."primary");
+						END IF;
+					END CASE;
+
+					RETURN NULL;
+				END;
+			$$ LANGUAGE plpgsql;
+			-- +migrate StatementEnd`,
+			`-- +migrate StatementBegin
+			CREATE FUNCTION audit_storage_repositories() RETURNS TRIGGER AS $$
+				BEGIN
+					CASE TG_OP
+					WHEN 'INSERT' THEN
+						PERFORM log_repository_audit_entry('replica_created', NEW.repository_id, NEW.virtual_storage, NEW.relative_path,
+							NEW.storage, NULL, NEW.generation::TEXT);
+					WHEN 'DELETE' THEN
+						PERFORM log_repository_audit_entry('replica_deleted', OLD.repository_id, OLD.virtual_storage, OLD.relative_path,
+							OLD.storage, OLD.generation::TEXT, NULL);
+					WHEN 'UPDATE' THEN
+						IF NEW.generation IS DISTINCT FROM OLD.generation THEN
+							PERFORM log_repository_audit_entry('replica_generation_changed', NEW.repository_id, NEW.virtual_storage, NEW.relative_path,
+								NEW.storage, OLD.generation::TEXT, NEW.generation::TEXT);
+						END IF;
+					END CASE;
+
+					RETURN NULL;
+				END;
+			$$ LANGUAGE plpgsql;
+			-- +migrate StatementEnd`,
+			`-- +migrate StatementBegin
+			CREATE FUNCTION audit_repository_assignments() RETURNS TRIGGER AS $$
+				BEGIN
+					CASE TG_OP
+					WHEN 'INSERT' THEN
+						PERFORM log_repository_audit_entry('assignment_added', NEW.repository_id, NEW.virtual_storage, NEW.relative_path,
+							NEW.storage, NULL, NULL);
+					WHEN 'DELETE' THEN
+						PERFORM log_repository_audit_entry('assignment_removed', OLD.repository_id, OLD.virtual_storage, OLD.relative_path,
+							OLD.storage, NULL, NULL);
+					WHEN 'UPDATE' THEN
+						IF NEW.storage IS DISTINCT FROM OLD.storage THEN
+							PERFORM log_repository_audit_entry('assignment_moved', NEW.repository_id, NEW.virtual_storage, NEW.relative_path,
+								NEW.storage, OLD.storage, NEW.storage);
+						END IF;
+					END CASE;
+
+					RETURN NULL;
+				END;
+			$$ LANGUAGE plpgsql;
+			-- +migrate StatementEnd`,
+			`CREATE TRIGGER repositories_audit AFTER INSERT OR UPDATE OR DELETE ON repositories
+				FOR EACH ROW EXECUTE FUNCTION audit_repositories()`,
+			`CREATE TRIGGER storage_repositories_audit AFTER INSERT OR UPDATE OR DELETE ON storage_repositories
+				FOR EACH ROW EXECUTE FUNCTION audit_storage_repositories()`,
+			`CREATE TRIGGER repository_assignments_audit AFTER INSERT OR UPDATE OR DELETE ON repository_assignments
+				FOR EACH ROW EXECUTE FUNCTION audit_repository_assignments()`,
+		},
+		Down: []string{
+			"DROP TRIGGER repository_assignments_audit ON repository_assignments",
+			"DROP TRIGGER storage_repositories_audit ON storage_repositories",
+			"DROP TRIGGER repositories_audit ON repositories",
+			"DROP FUNCTION audit_repository_assignments",
+			"DROP FUNCTION audit_storage_repositories",
+			"DROP FUNCTION audit_repositories",
+			"DROP FUNCTION log_repository_audit_entry",
+			"DROP TABLE repository_audit_log",
+			"DROP FUNCTION reject_audit_log_modification",
+		},
+	}
+
+	allMigrations = append(allMigrations, m)
+}
@@ -58,8 +58,15 @@ type ReplicationEventQueue interface {
 	//   'failed' - in case it has more attempts to be executed
 	//   'dead' - in case it has no more attempts to be executed
 	AcknowledgeStale(ctx context.Context, staleAfter time.Duration) (int64, error)
+	// RecordError records the error the last attempt to process the event failed with so it can be
+	// inspected by administrators.
+	RecordError(ctx context.Context, id uint64, message string) error
 }
 
+// DeadJobRetention is the duration 'dead' replication events are kept in the queue for. This gives
+// administrators the chance to inspect and retry them before they are removed.
+const DeadJobRetention = 7 * 24 * time.Hour
+
 func allowToAck(state JobState) error {
 	switch state {
 	case JobStateCompleted, JobStateFailed, JobStateDead:
@@ -372,10 +379,11 @@ func (rq PostgresReplicationEventQueue) Dequeue(ctx context.Context, virtualStor
 //     user `ids` could not exist in the table or the `state` of the event could differ from `in_progress` (it is
 //     possible to acknowledge only events previously fetched by the `Dequeue` method)
 //  2. Based on the list fetched on previous step the delete is executed on the `replication_queue` table. In case the
-//     new state for the entry is 'dead' it will be just deleted, but if the new state is 'completed' the event will
-//     be delete as well, but all events similar to it (events for the same repository with same change type and a source)
-//     that were created before processed events were queued for processing will also be deleted.
-//     In case the new state is something different ('failed') the event will be updated only with a new state.
+//     new state for the entry is 'completed' the event will be deleted, and all events similar to it (events for the
+//     same repository with same change type and a source) that were created before processed events were queued for
+//     processing will also be deleted.
+//     In case the new state is something different ('failed' or 'dead') the event will be updated only with a new
+//     state. 'dead' events are kept until they are retried, cancelled or older than DeadJobRetention.
 //     It returns a list of event `id`s and corresponding <lock>s of the affected events during this delete/update process.
 //  3. The removal of records in `replication_queue_job_lock` table happens that were created by step 4. of `Dequeue`
 //     method call.
@@ -405,8 +413,7 @@ func (rq PostgresReplicationEventQueue) Acknowledge(ctx context.Context, state J
 		, deleted AS (
 			DELETE FROM replication_queue AS queue
 			USING existing
-			WHERE ($2::REPLICATION_JOB_STATE = 'dead' AND existing.id = queue.id) OR (
-				$2::REPLICATION_JOB_STATE = 'completed'
+			WHERE $2::REPLICATION_JOB_STATE = 'completed'
 				AND (existing.id = queue.id OR (
 					-- this is an optimization to omit events that won't make any effect as the same event
 					-- was just applied, so we acknowledge similar events:
@@ -421,7 +428,6 @@ func (rq PostgresReplicationEventQueue) Acknowledge(ctx context.Context, state J
 					-- from the same source storage (if applicable, as 'gc' has no source)
 					AND COALESCE(queue.job->>'source_node_storage', '') = COALESCE(existing.job->>'source_node_storage', ''))
 				)
-			)
 			RETURNING queue.id, queue.lock_id
 		)
 		, updated AS (
@@ -526,6 +532,7 @@ func (rq PostgresReplicationEventQueue) StartHealthUpdate(ctx context.Context, t
 // The job considered 'in_progress' if it has corresponding entry in the 'replication_queue_job_lock' table.
 // When moving from 'in_progress' to other state the entry from 'replication_queue_job_lock' table will be
 // removed and entry in the 'replication_queue_lock' will be updated if needed (release of the lock).
+// 'dead' events which have been dead for longer than DeadJobRetention are removed.
 func (rq PostgresReplicationEventQueue) AcknowledgeStale(ctx context.Context, staleAfter time.Duration) (int64, error) {
 	query := `
 		WITH stale_job_lock AS (
@@ -534,14 +541,17 @@ func (rq PostgresReplicationEventQueue) AcknowledgeStale(ctx context.Context, st
 		)
 		, update_job AS (
 			UPDATE replication_queue AS queue
-			SET state = 'failed'::REPLICATION_JOB_STATE
+			SET state = CASE WHEN attempt >= 1 THEN 'failed' ELSE 'dead' END::REPLICATION_JOB_STATE,
+				updated_at = NOW() AT TIME ZONE 'UTC'
 			FROM stale_job_lock
-			WHERE stale_job_lock.job_id = queue.id AND attempt >= 1
+			WHERE stale_job_lock.job_id = queue.id
 			RETURNING queue.id, queue.lock_id
 		)
-		, delete_job AS (
+		, delete_dead_job AS (
 			DELETE FROM replication_queue AS queue
-			WHERE attempt = 0 AND id IN (SELECT job_id FROM stale_job_lock)
+			WHERE state = 'dead'
+			AND updated_at < NOW() AT TIME ZONE 'UTC' - INTERVAL '1 MILLISECOND' * $2
+			AND NOT EXISTS (SELECT FROM replication_queue_job_lock WHERE job_id = queue.id)
 		)
 		UPDATE replication_queue_lock
 		SET acquired = FALSE
@@ -555,7 +565,7 @@ func (rq PostgresReplicationEventQueue) AcknowledgeStale(ctx context.Context, st
 				GROUP BY lock_id
 			) AS existing ON removed.lock_id = existing.lock_id AND removed.amount = existing.amount
 		)`
-	result, err := rq.qc.ExecContext(ctx, query, staleAfter.Milliseconds())
+	result, err := rq.qc.ExecContext(ctx, query, staleAfter.Milliseconds(), DeadJobRetention.Milliseconds())
 	if err != nil {
 		return 0, fmt.Errorf("exec acknowledge stale: %w", err)
 	}
@@ -567,3 +577,12 @@ func (rq PostgresReplicationEventQueue) AcknowledgeStale(ctx context.Context, st
 
 	return n, nil
 }
+
+// RecordError records the error the last attempt to process the event failed with.
+func (rq PostgresReplicationEventQueue) RecordError(ctx context.Context, id uint64, message string) error {
+	if _, err := rq.qc.ExecContext(ctx, `UPDATE replication_queue SET error = $2 WHERE id = $1`, id, message); err != nil {
+		return fmt.Errorf("exec: %w", err)
+	}
+
+	return nil
+}
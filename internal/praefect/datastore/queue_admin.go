@@ -0,0 +1,205 @@
+package datastore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/datastore/glsql"
+)
+
+// ErrEmptyReplicationJobFilter is returned when cancelling replication jobs without a filter. This
+// protects from accidentally cancelling every job in the queue.
+var ErrEmptyReplicationJobFilter = errors.New("replication job filter must not be empty")
+
+// ReplicationJobFilter selects replication jobs in the queue. Fields which are not set match every job.
+type ReplicationJobFilter struct {
+	// IDs are the IDs of the jobs.
+	IDs []uint64
+	// VirtualStorage is the virtual storage the jobs belong to.
+	VirtualStorage string
+	// RelativePath is the relative path of the repository the jobs belong to.
+	RelativePath string
+	// TargetStorage is the storage the jobs are replicating to.
+	TargetStorage string
+	// Change is the type of change the jobs are replicating.
+	Change ChangeType
+	// States are the states the jobs are in.
+	States []JobState
+}
+
+// IsEmpty returns whether the filter matches every job.
+func (f ReplicationJobFilter) IsEmpty() bool {
+	return len(f.IDs) == 0 &&
+		f.VirtualStorage == "" &&
+		f.RelativePath == "" &&
+		f.TargetStorage == "" &&
+		f.Change == "" &&
+		len(f.States) == 0
+}
+
+// condition returns the SQL condition matching the filtered jobs of the replication_queue table
+// aliased as queue. The filter's arguments are passed as the first parameters of the query.
+func (f ReplicationJobFilter) condition() (string, []interface{}) {
+	states := make([]string, len(f.States))
+	for i, state := range f.States {
+		states[i] = state.String()
+	}
+
+	return `
+		(COALESCE(cardinality($1::bigint[]), 0) = 0 OR queue.id = ANY($1))
+		AND ($2 = '' OR queue.job->>'virtual_storage' = $2)
+		AND ($3 = '' OR queue.job->>'relative_path' = $3)
+		AND ($4 = '' OR queue.job->>'target_node_storage' = $4)
+		AND ($5 = '' OR queue.job->>'change' = $5)
+		AND (COALESCE(cardinality($6::text[]), 0) = 0 OR queue.state::text = ANY($6))`,
+		[]interface{}{
+			f.IDs,
+			f.VirtualStorage,
+			f.RelativePath,
+			f.TargetStorage,
+			f.Change.String(),
+			states,
+		}
+}
+
+// ReplicationJobDetails describes a replication job in the queue for administrative purposes.
+type ReplicationJobDetails struct {
+	ReplicationEvent
+	// Error is the error the last failed attempt to process the job failed with.
+	Error string
+	// LockAcquired is whether the lock of the repository on the target storage the job is
+	// replicating to is currently held by a Praefect processing jobs of the repository.
+	LockAcquired bool
+	// LockTriggeredAt is the last time the Praefect processing the job has refreshed its lock on
+	// the job. It is nil if the job is not being processed.
+	LockTriggeredAt *time.Time
+}
+
+// ListJobs returns the replication jobs matching the filter ordered by their ID. At most limit
+// jobs are returned. If limit is 0, all of the matching jobs are returned.
+func (rq PostgresReplicationEventQueue) ListJobs(ctx context.Context, filter ReplicationJobFilter, limit uint) ([]ReplicationJobDetails, error) {
+	condition, args := filter.condition()
+
+	var sqlLimit interface{}
+	if limit > 0 {
+		sqlLimit = limit
+	}
+
+	rows, err := rq.qc.QueryContext(ctx, `
+		SELECT
+			queue.id,
+			queue.state,
+			queue.created_at,
+			queue.updated_at,
+			queue.lock_id,
+			queue.attempt,
+			queue.job,
+			queue.meta,
+			COALESCE(queue.error, ''),
+			COALESCE(lock.acquired, false),
+			job_lock.triggered_at
+		FROM replication_queue AS queue
+		LEFT JOIN replication_queue_lock AS lock ON lock.id = queue.lock_id
+		LEFT JOIN replication_queue_job_lock AS job_lock ON job_lock.job_id = queue.id
+		WHERE `+condition+`
+		ORDER BY queue.id
+		LIMIT $7`, append(args, sqlLimit)...)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+	defer rows.Close()
+
+	var jobs []ReplicationJobDetails
+	for rows.Next() {
+		var job ReplicationJobDetails
+		if err := rows.Scan(
+			&job.ID,
+			&job.State,
+			&job.CreatedAt,
+			&job.UpdatedAt,
+			&job.LockID,
+			&job.Attempt,
+			&job.Job,
+			&job.Meta,
+			&job.Error,
+			&job.LockAcquired,
+			&job.LockTriggeredAt,
+		); err != nil {
+			return nil, fmt.Errorf("scan: %w", err)
+		}
+
+		jobs = append(jobs, job)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating rows: %w", err)
+	}
+
+	return jobs, nil
+}
+
+// RetryJobs moves the 'dead' replication jobs matching the filter back into the 'ready' state and
+// resets their attempts so they are processed again. Jobs in other states are not touched. The IDs
+// of the retried jobs are returned.
+func (rq PostgresReplicationEventQueue) RetryJobs(ctx context.Context, filter ReplicationJobFilter) ([]uint64, error) {
+	condition, args := filter.condition()
+
+	rows, err := rq.qc.QueryContext(ctx, `
+		UPDATE replication_queue AS queue
+		SET state = 'ready',
+			attempt = 3,
+			updated_at = NOW() AT TIME ZONE 'UTC'
+		WHERE queue.state = 'dead'
+		AND `+condition+`
+		RETURNING queue.id`, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+
+	return scanJobIDs(rows)
+}
+
+// CancelJobs removes the replication jobs matching the filter from the queue. Jobs which are being
+// processed are not cancelled. The IDs of the cancelled jobs are returned. ErrEmptyReplicationJobFilter
+// is returned if the filter is empty.
+func (rq PostgresReplicationEventQueue) CancelJobs(ctx context.Context, filter ReplicationJobFilter) ([]uint64, error) {
+	if filter.IsEmpty() {
+		return nil, ErrEmptyReplicationJobFilter
+	}
+
+	condition, args := filter.condition()
+
+	rows, err := rq.qc.QueryContext(ctx, `
+		DELETE FROM replication_queue AS queue
+		WHERE queue.state != 'in_progress'
+		AND NOT EXISTS (SELECT FROM replication_queue_job_lock WHERE job_id = queue.id)
+		AND `+condition+`
+		RETURNING queue.id`, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+
+	return scanJobIDs(rows)
+}
+
+func scanJobIDs(rows *sql.Rows) ([]uint64, error) {
+	defer rows.Close()
+
+	var ids glsql.Uint64Provider
+	if err := glsql.ScanAll(rows, &ids); err != nil {
+		return nil, fmt.Errorf("scan: %w", err)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating rows: %w", err)
+	}
+
+	values := ids.Values()
+	sort.Slice(values, func(i, j int) bool { return values[i] < values[j] })
+
+	return values, nil
+}
@@ -0,0 +1,121 @@
+package datastore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
+)
+
+func testReplicationJobAdministration(t *testing.T, queue administrableReplicationEventQueue) {
+	ctx := testhelper.Context(t)
+
+	enqueue := func(t *testing.T, relativePath, target string, change ChangeType) ReplicationEvent {
+		t.Helper()
+
+		event, err := queue.Enqueue(ctx, ReplicationEvent{
+			Job: ReplicationJob{
+				Change:            change,
+				RelativePath:      relativePath,
+				TargetNodeStorage: target,
+				SourceNodeStorage: "gitaly-0",
+				VirtualStorage:    "praefect",
+			},
+		})
+		require.NoError(t, err)
+
+		return event
+	}
+
+	dequeue := func(t *testing.T, event ReplicationEvent) {
+		t.Helper()
+
+		dequeued, err := queue.Dequeue(ctx, event.Job.VirtualStorage, event.Job.TargetNodeStorage, 1)
+		require.NoError(t, err)
+		require.Len(t, dequeued, 1)
+		require.Equal(t, event.ID, dequeued[0].ID)
+	}
+
+	ready := enqueue(t, "path-1", "gitaly-1", UpdateRepo)
+
+	inProgress := enqueue(t, "path-2", "gitaly-2", UpdateRepo)
+	dequeue(t, inProgress)
+
+	dead := enqueue(t, "path-3", "gitaly-3", DeleteReplica)
+	dequeue(t, dead)
+	require.NoError(t, queue.RecordError(ctx, dead.ID, "replica not found"))
+	_, err := queue.Acknowledge(ctx, JobStateDead, []uint64{dead.ID})
+	require.NoError(t, err)
+
+	listIDs := func(t *testing.T, filter ReplicationJobFilter, limit uint) []uint64 {
+		t.Helper()
+
+		jobs, err := queue.ListJobs(ctx, filter, limit)
+		require.NoError(t, err)
+
+		var ids []uint64
+		for _, job := range jobs {
+			ids = append(ids, job.ID)
+		}
+
+		return ids
+	}
+
+	t.Run("list", func(t *testing.T) {
+		require.Equal(t, []uint64{ready.ID, inProgress.ID, dead.ID}, listIDs(t, ReplicationJobFilter{}, 0))
+		require.Equal(t, []uint64{ready.ID, inProgress.ID}, listIDs(t, ReplicationJobFilter{}, 2))
+		require.Equal(t, []uint64{inProgress.ID}, listIDs(t, ReplicationJobFilter{IDs: []uint64{inProgress.ID}}, 0))
+		require.Equal(t, []uint64{ready.ID}, listIDs(t, ReplicationJobFilter{RelativePath: "path-1"}, 0))
+		require.Equal(t, []uint64{inProgress.ID}, listIDs(t, ReplicationJobFilter{TargetStorage: "gitaly-2"}, 0))
+		require.Equal(t, []uint64{dead.ID}, listIDs(t, ReplicationJobFilter{Change: DeleteReplica}, 0))
+		require.Equal(t, []uint64{ready.ID, dead.ID}, listIDs(t, ReplicationJobFilter{States: []JobState{JobStateReady, JobStateDead}}, 0))
+		require.Empty(t, listIDs(t, ReplicationJobFilter{VirtualStorage: "non-existent"}, 0))
+	})
+
+	t.Run("details", func(t *testing.T) {
+		jobs, err := queue.ListJobs(ctx, ReplicationJobFilter{IDs: []uint64{inProgress.ID, dead.ID}}, 0)
+		require.NoError(t, err)
+		require.Len(t, jobs, 2)
+
+		require.Equal(t, JobStateInProgress, jobs[0].State)
+		require.Equal(t, 2, jobs[0].Attempt)
+		require.Empty(t, jobs[0].Error)
+		require.True(t, jobs[0].LockAcquired)
+		require.NotNil(t, jobs[0].LockTriggeredAt)
+
+		require.Equal(t, JobStateDead, jobs[1].State)
+		require.Equal(t, dead.Job, jobs[1].Job)
+		require.Equal(t, "replica not found", jobs[1].Error)
+		require.False(t, jobs[1].LockAcquired)
+		require.Nil(t, jobs[1].LockTriggeredAt)
+	})
+
+	t.Run("retry", func(t *testing.T) {
+		// Only dead jobs are retried.
+		retried, err := queue.RetryJobs(ctx, ReplicationJobFilter{})
+		require.NoError(t, err)
+		require.Equal(t, []uint64{dead.ID}, retried)
+
+		jobs, err := queue.ListJobs(ctx, ReplicationJobFilter{IDs: []uint64{dead.ID}}, 0)
+		require.NoError(t, err)
+		require.Len(t, jobs, 1)
+		require.Equal(t, JobStateReady, jobs[0].State)
+		require.Equal(t, 3, jobs[0].Attempt)
+
+		retried, err = queue.RetryJobs(ctx, ReplicationJobFilter{})
+		require.NoError(t, err)
+		require.Empty(t, retried)
+	})
+
+	t.Run("cancel", func(t *testing.T) {
+		_, err := queue.CancelJobs(ctx, ReplicationJobFilter{})
+		require.Equal(t, ErrEmptyReplicationJobFilter, err)
+
+		// Jobs being processed are not cancelled.
+		cancelled, err := queue.CancelJobs(ctx, ReplicationJobFilter{VirtualStorage: "praefect"})
+		require.NoError(t, err)
+		require.Equal(t, []uint64{ready.ID, dead.ID}, cancelled)
+
+		require.Equal(t, []uint64{inProgress.ID}, listIDs(t, ReplicationJobFilter{}, 0))
+	})
+}
@@ -12,762 +12,1119 @@ import (
 	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper/testdb"
 )
 
-func TestPostgresReplicationEventQueue_DeleteReplicaUniqueIndex(t *testing.T) {
+// administrableReplicationEventQueue is a ReplicationEventQueue which also supports the
+// administration of its replication jobs.
+type administrableReplicationEventQueue interface {
+	ReplicationEventQueue
+	ListJobs(ctx context.Context, filter ReplicationJobFilter, limit uint) ([]ReplicationJobDetails, error)
+	RetryJobs(ctx context.Context, filter ReplicationJobFilter) ([]uint64, error)
+	CancelJobs(ctx context.Context, filter ReplicationJobFilter) ([]uint64, error)
+}
+
+// replicationEventQueueBackend provides a ReplicationEventQueue implementation for the shared test
+// suite alongside the helpers to inspect and manipulate the state of its database.
+type replicationEventQueueBackend struct {
+	// newQueue returns a ReplicationEventQueue backed by an empty database.
+	newQueue        func(t *testing.T) administrableReplicationEventQueue
+	requireEvents   func(t *testing.T, ctx context.Context, expected []ReplicationEvent)
+	requireLocks    func(t *testing.T, ctx context.Context, expected []LockRow)
+	requireJobLocks func(t *testing.T, ctx context.Context, expected []JobLockRow)
+	// elapse moves the time the events and job locks of the most recently created queue were last
+	// updated at into the past by the given duration.
+	elapse func(t *testing.T, ctx context.Context, d time.Duration)
+	// deleteReplicaExistsError is the error message returned when enqueueing a 'delete_replica'
+	// job while another one of the same repository is still pending.
+	deleteReplicaExistsError string
+}
+
+func TestReplicationEventQueue_Postgres(t *testing.T) {
 	t.Parallel()
 	db := testdb.New(t)
-	for _, tc := range []struct {
-		desc        string
-		existingJob *ReplicationEvent
-		succeeds    bool
-	}{
-		{
-			desc:     "allowed when no events",
-			succeeds: true,
+
+	testReplicationEventQueue(t, replicationEventQueueBackend{
+		newQueue: func(t *testing.T) administrableReplicationEventQueue {
+			db.TruncateAll(t)
+			return NewPostgresReplicationEventQueue(db)
+		},
+		requireEvents: func(t *testing.T, ctx context.Context, expected []ReplicationEvent) {
+			t.Helper()
+			requireEvents(t, ctx, db, expected)
+		},
+		requireLocks: func(t *testing.T, ctx context.Context, expected []LockRow) {
+			t.Helper()
+			requireLocks(t, ctx, db, expected)
 		},
-		{
-			desc: "allowed if existing completed job",
-			existingJob: &ReplicationEvent{
-				State: JobStateCompleted,
-				Job: ReplicationJob{
+		requireJobLocks: func(t *testing.T, ctx context.Context, expected []JobLockRow) {
+			t.Helper()
+			requireJobLocks(t, ctx, db, expected)
+		},
+		elapse: func(t *testing.T, ctx context.Context, d time.Duration) {
+			t.Helper()
+
+			_, err := db.ExecContext(ctx, `UPDATE replication_queue SET updated_at = updated_at - $1 * INTERVAL '1 MILLISECOND'`, d.Milliseconds())
+			require.NoError(t, err)
+
+			_, err = db.ExecContext(ctx, `UPDATE replication_queue_job_lock SET triggered_at = triggered_at - $1 * INTERVAL '1 MILLISECOND'`, d.Milliseconds())
+			require.NoError(t, err)
+		},
+		deleteReplicaExistsError: `query: ERROR: duplicate key value violates unique constraint "delete_replica_unique_index" (SQLSTATE 23505)`,
+	})
+}
+
+func testReplicationEventQueue(t *testing.T, backend replicationEventQueueBackend) {
+	ctx := testhelper.Context(t)
+
+	t.Run("DeleteReplicaUniqueness", func(t *testing.T) {
+		for _, tc := range []struct {
+			desc          string
+			existingJob   *ReplicationJob
+			existingState JobState
+			succeeds      bool
+		}{
+			{
+				desc:     "allowed when no events",
+				succeeds: true,
+			},
+			{
+				desc: "allowed if existing completed job",
+				existingJob: &ReplicationJob{
 					Change:         DeleteReplica,
 					VirtualStorage: "praefect",
 					RelativePath:   "relative-path",
 				},
+				existingState: JobStateCompleted,
+				succeeds:      true,
 			},
-			succeeds: true,
-		},
-		{
-			desc: "allowed if existing dead job",
-			existingJob: &ReplicationEvent{
-				State: JobStateDead,
-				Job: ReplicationJob{
+			{
+				desc: "allowed if existing dead job",
+				existingJob: &ReplicationJob{
 					Change:         DeleteReplica,
 					VirtualStorage: "praefect",
 					RelativePath:   "relative-path",
 				},
+				existingState: JobStateDead,
+				succeeds:      true,
 			},
-			succeeds: true,
-		},
-		{
-			desc: "allowed if existing different virtual storage",
-			existingJob: &ReplicationEvent{
-				State: JobStateReady,
-				Job: ReplicationJob{
+			{
+				desc: "allowed if existing different virtual storage",
+				existingJob: &ReplicationJob{
 					Change:         DeleteReplica,
 					VirtualStorage: "wrong-virtual-storage",
 					RelativePath:   "relative-path",
 				},
+				existingState: JobStateReady,
+				succeeds:      true,
 			},
-			succeeds: true,
-		},
-		{
-			desc: "allowed if existing different relative path",
-			existingJob: &ReplicationEvent{
-				State: JobStateReady,
-				Job: ReplicationJob{
+			{
+				desc: "allowed if existing different relative path",
+				existingJob: &ReplicationJob{
 					Change:         DeleteReplica,
 					VirtualStorage: "praefect",
 					RelativePath:   "wrong-relative-path",
 				},
+				existingState: JobStateReady,
+				succeeds:      true,
 			},
-			succeeds: true,
-		},
-		{
-			desc: "not allowed if existing ready job",
-			existingJob: &ReplicationEvent{
-				State: JobStateReady,
-				Job: ReplicationJob{
+			{
+				desc: "not allowed if existing ready job",
+				existingJob: &ReplicationJob{
 					Change:         DeleteReplica,
 					VirtualStorage: "praefect",
 					RelativePath:   "relative-path",
 				},
+				existingState: JobStateReady,
 			},
-		},
-		{
-			desc: "not allowed if existing in_progress job",
-			existingJob: &ReplicationEvent{
-				State: JobStateInProgress,
-				Job: ReplicationJob{
+			{
+				desc: "not allowed if existing in_progress job",
+				existingJob: &ReplicationJob{
 					Change:         DeleteReplica,
 					VirtualStorage: "praefect",
 					RelativePath:   "relative-path",
 				},
+				existingState: JobStateInProgress,
 			},
-		},
-		{
-			desc: "not allowed if existing failed job",
-			existingJob: &ReplicationEvent{
-				State: JobStateFailed,
-				Job: ReplicationJob{
+			{
+				desc: "not allowed if existing failed job",
+				existingJob: &ReplicationJob{
 					Change:         DeleteReplica,
 					VirtualStorage: "praefect",
 					RelativePath:   "relative-path",
 				},
+				existingState: JobStateFailed,
 			},
-		},
-	} {
-		t.Run(tc.desc, func(t *testing.T) {
-			db.TruncateAll(t)
-			ctx := testhelper.Context(t)
-
-			if tc.existingJob != nil {
-				_, err := db.ExecContext(ctx, `
-					INSERT INTO replication_queue (state, job)
-					VALUES ($1, $2)
-				`, tc.existingJob.State, tc.existingJob.Job)
-				require.NoError(t, err)
-			}
-
-			_, err := NewPostgresReplicationEventQueue(db).Enqueue(ctx, ReplicationEvent{
-				State: JobStateReady,
-				Job: ReplicationJob{
-					Change:            DeleteReplica,
-					VirtualStorage:    "praefect",
-					RelativePath:      "relative-path",
-					TargetNodeStorage: "gitaly-1",
-				},
+		} {
+			t.Run(tc.desc, func(t *testing.T) {
+				queue := backend.newQueue(t)
+
+				if tc.existingJob != nil {
+					job := *tc.existingJob
+					job.TargetNodeStorage = "gitaly-2"
+
+					existing, err := queue.Enqueue(ctx, ReplicationEvent{Job: job})
+					require.NoError(t, err)
+
+					// The existing job is moved into its state the same way the replicator would.
+					if tc.existingState != JobStateReady {
+						dequeued, err := queue.Dequeue(ctx, job.VirtualStorage, job.TargetNodeStorage, 1)
+						require.NoError(t, err)
+						require.Len(t, dequeued, 1)
+
+						if tc.existingState != JobStateInProgress {
+							acknowledged, err := queue.Acknowledge(ctx, tc.existingState, []uint64{existing.ID})
+							require.NoError(t, err)
+							require.Equal(t, []uint64{existing.ID}, acknowledged)
+						}
+					}
+				}
+
+				_, err := queue.Enqueue(ctx, ReplicationEvent{
+					State: JobStateReady,
+					Job: ReplicationJob{
+						Change:            DeleteReplica,
+						VirtualStorage:    "praefect",
+						RelativePath:      "relative-path",
+						TargetNodeStorage: "gitaly-1",
+					},
+				})
+
+				if tc.succeeds {
+					require.NoError(t, err)
+					return
+				}
+
+				require.EqualError(t, err, backend.deleteReplicaExistsError)
 			})
+		}
+	})
 
-			if tc.succeeds {
-				require.NoError(t, err)
-				return
-			}
+	t.Run("Enqueue", func(t *testing.T) {
+		queue := backend.newQueue(t)
+
+		eventType := ReplicationEvent{
+			Job: ReplicationJob{
+				Change:            UpdateRepo,
+				RelativePath:      "/project/path-1",
+				TargetNodeStorage: "gitaly-1",
+				SourceNodeStorage: "gitaly-0",
+				VirtualStorage:    "praefect",
+				Params:            nil,
+			},
+		}
 
-			require.EqualError(t, err, `query: ERROR: duplicate key value violates unique constraint "delete_replica_unique_index" (SQLSTATE 23505)`)
-		})
-	}
-}
+		actualEvent, err := queue.Enqueue(ctx, eventType) // initial event
+		require.NoError(t, err)
+		actualEvent.CreatedAt = time.Time{} // we need to setup it to default because it is not possible to get it beforehand for expected
+
+		expLock := LockRow{ID: "praefect|gitaly-1|/project/path-1", Acquired: false}
+
+		expEvent := ReplicationEvent{
+			ID:      1,
+			State:   JobStateReady,
+			Attempt: 3,
+			LockID:  "praefect|gitaly-1|/project/path-1",
+			Job: ReplicationJob{
+				Change:            UpdateRepo,
+				RelativePath:      "/project/path-1",
+				TargetNodeStorage: "gitaly-1",
+				SourceNodeStorage: "gitaly-0",
+				VirtualStorage:    "praefect",
+				Params:            nil,
+			},
+		}
 
-func TestPostgresReplicationEventQueue_Enqueue(t *testing.T) {
-	t.Parallel()
-	db := testdb.New(t)
-	ctx := testhelper.Context(t)
+		require.Equal(t, expEvent, actualEvent)
+		backend.requireEvents(t, ctx, []ReplicationEvent{expEvent})
+		backend.requireLocks(t, ctx, []LockRow{expLock}) // expected a new lock for new event
+		backend.requireJobLocks(t, ctx, nil)
+	})
 
-	queue := PostgresReplicationEventQueue{db.DB}
+	t.Run("DeleteReplicaInfiniteAttempts", func(t *testing.T) {
+		queue := backend.newQueue(t)
 
-	eventType := ReplicationEvent{
-		Job: ReplicationJob{
-			Change:            UpdateRepo,
-			RelativePath:      "/project/path-1",
-			TargetNodeStorage: "gitaly-1",
-			SourceNodeStorage: "gitaly-0",
-			VirtualStorage:    "praefect",
-			Params:            nil,
-		},
-	}
+		actualEvent, err := queue.Enqueue(ctx, ReplicationEvent{
+			Job: ReplicationJob{
+				Change:            DeleteReplica,
+				RelativePath:      "/project/path-1",
+				TargetNodeStorage: "gitaly-1",
+				VirtualStorage:    "praefect",
+			},
+		})
+		require.NoError(t, err)
 
-	actualEvent, err := queue.Enqueue(ctx, eventType) // initial event
-	require.NoError(t, err)
-	actualEvent.CreatedAt = time.Time{} // we need to setup it to default because it is not possible to get it beforehand for expected
+		expectedEvent := ReplicationEvent{
+			ID:      1,
+			State:   JobStateReady,
+			Attempt: 3,
+			LockID:  "praefect|gitaly-1|/project/path-1",
+			Job: ReplicationJob{
+				Change:            DeleteReplica,
+				RelativePath:      "/project/path-1",
+				TargetNodeStorage: "gitaly-1",
+				VirtualStorage:    "praefect",
+				Params:            nil,
+			},
+			CreatedAt: actualEvent.CreatedAt,
+		}
 
-	expLock := LockRow{ID: "praefect|gitaly-1|/project/path-1", Acquired: false}
+		require.Equal(t, expectedEvent, actualEvent)
 
-	expEvent := ReplicationEvent{
-		ID:      1,
-		State:   JobStateReady,
-		Attempt: 3,
-		LockID:  "praefect|gitaly-1|/project/path-1",
-		Job: ReplicationJob{
-			Change:            UpdateRepo,
-			RelativePath:      "/project/path-1",
-			TargetNodeStorage: "gitaly-1",
-			SourceNodeStorage: "gitaly-0",
-			VirtualStorage:    "praefect",
-			Params:            nil,
-		},
-	}
+		for i := 0; i < 2*actualEvent.Attempt; i++ {
+			actualEvents, err := queue.Dequeue(ctx, "praefect", "gitaly-1", 9999)
+			require.NoError(t, err)
+			require.Len(t, actualEvents, 1)
 
-	require.Equal(t, expEvent, actualEvent)
-	requireEvents(t, ctx, db, []ReplicationEvent{expEvent})
-	requireLocks(t, ctx, db, []LockRow{expLock}) // expected a new lock for new event
-	db.RequireRowsInTable(t, "replication_queue_job_lock", 0)
-}
+			expectedEvent.State = JobStateInProgress
+			expectedEvent.UpdatedAt = actualEvents[0].UpdatedAt
 
-func TestPostgresReplicationEventQueue_DeleteReplicaInfiniteAttempts(t *testing.T) {
-	t.Parallel()
-	queue := NewPostgresReplicationEventQueue(testdb.New(t))
-	ctx := testhelper.Context(t)
+			require.Equal(t, expectedEvent, actualEvents[0])
 
-	actualEvent, err := queue.Enqueue(ctx, ReplicationEvent{
-		Job: ReplicationJob{
-			Change:            DeleteReplica,
-			RelativePath:      "/project/path-1",
-			TargetNodeStorage: "gitaly-1",
-			VirtualStorage:    "praefect",
-		},
+			eventIDs := []uint64{actualEvent.ID}
+			ackedIDs, err := queue.Acknowledge(ctx, JobStateFailed, eventIDs)
+			require.NoError(t, err)
+			require.Equal(t, eventIDs, ackedIDs)
+		}
 	})
-	require.NoError(t, err)
 
-	expectedEvent := ReplicationEvent{
-		ID:      1,
-		State:   JobStateReady,
-		Attempt: 3,
-		LockID:  "praefect|gitaly-1|/project/path-1",
-		Job: ReplicationJob{
-			Change:            DeleteReplica,
-			RelativePath:      "/project/path-1",
-			TargetNodeStorage: "gitaly-1",
-			VirtualStorage:    "praefect",
-			Params:            nil,
-		},
-		CreatedAt: actualEvent.CreatedAt,
-	}
+	t.Run("EnqueueMultiple", func(t *testing.T) {
+		queue := backend.newQueue(t)
+
+		eventType1 := ReplicationEvent{
+			Job: ReplicationJob{
+				Change:            UpdateRepo,
+				RelativePath:      "/project/path-1",
+				TargetNodeStorage: "gitaly-1",
+				SourceNodeStorage: "gitaly-0",
+				VirtualStorage:    "praefect-0",
+				Params:            nil,
+			},
+		}
 
-	require.Equal(t, expectedEvent, actualEvent)
+		eventType2 := ReplicationEvent{
+			Job: ReplicationJob{
+				Change:            RenameRepo,
+				RelativePath:      "/project/path-1",
+				TargetNodeStorage: "gitaly-2",
+				SourceNodeStorage: "",
+				VirtualStorage:    "praefect-0",
+				Params:            Params{"RelativePath": "/project/path-1-renamed"},
+			},
+		}
+
+		eventType3 := ReplicationEvent{
+			Job: ReplicationJob{
+				Change:            UpdateRepo,
+				RelativePath:      "/project/path-2",
+				TargetNodeStorage: "gitaly-1",
+				SourceNodeStorage: "gitaly-0",
+				VirtualStorage:    "praefect-1",
+				Params:            nil,
+			},
+		}
 
-	for i := 0; i < 2*actualEvent.Attempt; i++ {
-		actualEvents, err := queue.Dequeue(ctx, "praefect", "gitaly-1", 9999)
+		event1, err := queue.Enqueue(ctx, eventType1) // initial event
 		require.NoError(t, err)
-		require.Len(t, actualEvents, 1)
 
-		expectedEvent.State = JobStateInProgress
-		expectedEvent.UpdatedAt = actualEvents[0].UpdatedAt
+		expLock1 := LockRow{ID: "praefect-0|gitaly-1|/project/path-1", Acquired: false}
+		expLock2 := LockRow{ID: "praefect-0|gitaly-2|/project/path-1", Acquired: false}
+		expLock3 := LockRow{ID: "praefect-1|gitaly-1|/project/path-2", Acquired: false}
+
+		expEvent1 := ReplicationEvent{
+			ID:      event1.ID,
+			State:   "ready",
+			Attempt: 3,
+			LockID:  "praefect-0|gitaly-1|/project/path-1",
+			Job: ReplicationJob{
+				Change:            UpdateRepo,
+				RelativePath:      "/project/path-1",
+				TargetNodeStorage: "gitaly-1",
+				SourceNodeStorage: "gitaly-0",
+				VirtualStorage:    "praefect-0",
+				Params:            nil,
+			},
+		}
 
-		require.Equal(t, expectedEvent, actualEvents[0])
+		backend.requireEvents(t, ctx, []ReplicationEvent{expEvent1})
+		backend.requireLocks(t, ctx, []LockRow{expLock1}) // expected a new lock for new event
+		backend.requireJobLocks(t, ctx, nil)
 
-		eventIDs := []uint64{actualEvent.ID}
-		ackedIDs, err := queue.Acknowledge(ctx, JobStateFailed, eventIDs)
+		_, err = queue.Enqueue(ctx, eventType1) // repeat of the same event
+		require.ErrorAs(t, err, &ReplicationEventExistsError{})
+
+		// doesn't insert the same event again
+		backend.requireEvents(t, ctx, []ReplicationEvent{expEvent1})
+		// expected still one the same lock for repeated event
+		backend.requireLocks(t, ctx, []LockRow{expLock1})
+
+		event2, err := queue.Enqueue(ctx, eventType2) // event for another target
 		require.NoError(t, err)
-		require.Equal(t, eventIDs, ackedIDs)
-	}
-}
 
-func TestPostgresReplicationEventQueue_EnqueueMultiple(t *testing.T) {
-	t.Parallel()
-	db := testdb.New(t)
-	ctx := testhelper.Context(t)
+		expEvent2 := ReplicationEvent{
+			ID:      event2.ID,
+			State:   JobStateReady,
+			Attempt: 3,
+			LockID:  "praefect-0|gitaly-2|/project/path-1",
+			Job: ReplicationJob{
+				Change:            RenameRepo,
+				RelativePath:      "/project/path-1",
+				TargetNodeStorage: "gitaly-2",
+				SourceNodeStorage: "",
+				VirtualStorage:    "praefect-0",
+				Params:            Params{"RelativePath": "/project/path-1-renamed"},
+			},
+		}
 
-	queue := PostgresReplicationEventQueue{db.DB}
+		backend.requireEvents(t, ctx, []ReplicationEvent{expEvent1, expEvent2})
+		backend.requireLocks(t, ctx, []LockRow{expLock1, expLock2}) // the new lock for another target repeated event
 
-	eventType1 := ReplicationEvent{
-		Job: ReplicationJob{
-			Change:            UpdateRepo,
-			RelativePath:      "/project/path-1",
-			TargetNodeStorage: "gitaly-1",
-			SourceNodeStorage: "gitaly-0",
-			VirtualStorage:    "praefect-0",
-			Params:            nil,
-		},
-	}
+		event3, err := queue.Enqueue(ctx, eventType3) // event for another repo
+		require.NoError(t, err)
 
-	eventType2 := ReplicationEvent{
-		Job: ReplicationJob{
-			Change:            RenameRepo,
-			RelativePath:      "/project/path-1",
-			TargetNodeStorage: "gitaly-2",
-			SourceNodeStorage: "",
-			VirtualStorage:    "praefect-0",
-			Params:            Params{"RelativePath": "/project/path-1-renamed"},
-		},
-	}
+		expEvent3 := ReplicationEvent{
+			ID:      event3.ID,
+			State:   JobStateReady,
+			Attempt: 3,
+			LockID:  "praefect-1|gitaly-1|/project/path-2",
+			Job: ReplicationJob{
+				Change:            UpdateRepo,
+				RelativePath:      "/project/path-2",
+				TargetNodeStorage: "gitaly-1",
+				SourceNodeStorage: "gitaly-0",
+				VirtualStorage:    "praefect-1",
+				Params:            nil,
+			},
+		}
 
-	eventType3 := ReplicationEvent{
-		Job: ReplicationJob{
-			Change:            UpdateRepo,
-			RelativePath:      "/project/path-2",
-			TargetNodeStorage: "gitaly-1",
-			SourceNodeStorage: "gitaly-0",
-			VirtualStorage:    "praefect-1",
-			Params:            nil,
-		},
-	}
+		backend.requireEvents(t, ctx, []ReplicationEvent{expEvent1, expEvent2, expEvent3})
+		backend.requireLocks(t, ctx, []LockRow{expLock1, expLock2, expLock3}) // the new lock for same target but for another repo
 
-	event1, err := queue.Enqueue(ctx, eventType1) // initial event
-	require.NoError(t, err)
+		backend.requireJobLocks(t, ctx, nil) // there is no fetches it must be empty
+	})
 
-	expLock1 := LockRow{ID: "praefect-0|gitaly-1|/project/path-1", Acquired: false}
-	expLock2 := LockRow{ID: "praefect-0|gitaly-2|/project/path-1", Acquired: false}
-	expLock3 := LockRow{ID: "praefect-1|gitaly-1|/project/path-2", Acquired: false}
+	t.Run("Dequeue", func(t *testing.T) {
+		queue := backend.newQueue(t)
+
+		event := ReplicationEvent{
+			Job: ReplicationJob{
+				Change:            UpdateRepo,
+				RelativePath:      "/project/path-1",
+				TargetNodeStorage: "gitaly-1",
+				SourceNodeStorage: "gitaly-0",
+				VirtualStorage:    "praefect",
+				Params:            nil,
+			},
+		}
 
-	expEvent1 := ReplicationEvent{
-		ID:      event1.ID,
-		State:   "ready",
-		Attempt: 3,
-		LockID:  "praefect-0|gitaly-1|/project/path-1",
-		Job: ReplicationJob{
-			Change:            UpdateRepo,
-			RelativePath:      "/project/path-1",
-			TargetNodeStorage: "gitaly-1",
-			SourceNodeStorage: "gitaly-0",
-			VirtualStorage:    "praefect-0",
-			Params:            nil,
-		},
-	}
+		event, err := queue.Enqueue(ctx, event)
+		require.NoError(t, err, "failed to fill in event queue")
 
-	requireEvents(t, ctx, db, []ReplicationEvent{expEvent1})
-	requireLocks(t, ctx, db, []LockRow{expLock1}) // expected a new lock for new event
-	db.RequireRowsInTable(t, "replication_queue_job_lock", 0)
+		noEvents, err := queue.Dequeue(ctx, "praefect", "not existing storage", 5)
+		require.NoError(t, err)
+		require.Len(t, noEvents, 0, "there must be no events dequeued for not existing storage")
 
-	_, err = queue.Enqueue(ctx, eventType1) // repeat of the same event
-	require.ErrorAs(t, err, &ReplicationEventExistsError{})
+		expectedEvent := event
+		expectedEvent.State = JobStateInProgress
+		expectedEvent.Attempt = 2
 
-	// doesn't insert the same event again
-	requireEvents(t, ctx, db, []ReplicationEvent{expEvent1})
-	// expected still one the same lock for repeated event
-	requireLocks(t, ctx, db, []LockRow{expLock1})
+		expectedLock := LockRow{ID: event.LockID, Acquired: true} // as we deque events we acquire lock for processing
 
-	event2, err := queue.Enqueue(ctx, eventType2) // event for another target
-	require.NoError(t, err)
+		expectedJobLock := JobLockRow{JobID: event.ID, LockID: event.LockID} // and there is a track if job is under processing in separate table
 
-	expEvent2 := ReplicationEvent{
-		ID:      event2.ID,
-		State:   JobStateReady,
-		Attempt: 3,
-		LockID:  "praefect-0|gitaly-2|/project/path-1",
-		Job: ReplicationJob{
-			Change:            RenameRepo,
-			RelativePath:      "/project/path-1",
-			TargetNodeStorage: "gitaly-2",
-			SourceNodeStorage: "",
-			VirtualStorage:    "praefect-0",
-			Params:            Params{"RelativePath": "/project/path-1-renamed"},
-		},
-	}
+		actual, err := queue.Dequeue(ctx, event.Job.VirtualStorage, event.Job.TargetNodeStorage, 5)
+		require.NoError(t, err)
 
-	requireEvents(t, ctx, db, []ReplicationEvent{expEvent1, expEvent2})
-	requireLocks(t, ctx, db, []LockRow{expLock1, expLock2}) // the new lock for another target repeated event
+		for i := range actual {
+			actual[i].UpdatedAt = nil // it is not possible to determine update_at value as it is generated on UPDATE in database
+		}
+		require.Equal(t, []ReplicationEvent{expectedEvent}, actual)
 
-	event3, err := queue.Enqueue(ctx, eventType3) // event for another repo
-	require.NoError(t, err)
+		// there is only one single lock for all fetched events
+		backend.requireLocks(t, ctx, []LockRow{expectedLock})
+		backend.requireJobLocks(t, ctx, []JobLockRow{expectedJobLock})
+	})
 
-	expEvent3 := ReplicationEvent{
-		ID:      event3.ID,
-		State:   JobStateReady,
-		Attempt: 3,
-		LockID:  "praefect-1|gitaly-1|/project/path-2",
-		Job: ReplicationJob{
-			Change:            UpdateRepo,
-			RelativePath:      "/project/path-2",
-			TargetNodeStorage: "gitaly-1",
-			SourceNodeStorage: "gitaly-0",
-			VirtualStorage:    "praefect-1",
-			Params:            nil,
-		},
-	}
+	// expected results are listed as literals on purpose to be more explicit about what is going on with data
+	t.Run("DequeueMultiple", func(t *testing.T) {
+		queue := backend.newQueue(t)
+
+		eventType1 := ReplicationEvent{
+			Job: ReplicationJob{
+				Change:            UpdateRepo,
+				RelativePath:      "/project/path-1",
+				TargetNodeStorage: "gitaly-1",
+				SourceNodeStorage: "gitaly-0",
+				VirtualStorage:    "praefect",
+				Params:            nil,
+			},
+		}
 
-	requireEvents(t, ctx, db, []ReplicationEvent{expEvent1, expEvent2, expEvent3})
-	requireLocks(t, ctx, db, []LockRow{expLock1, expLock2, expLock3}) // the new lock for same target but for another repo
+		eventType2 := ReplicationEvent{
+			Job: ReplicationJob{
+				Change:            DeleteRepo,
+				RelativePath:      "/project/path-1",
+				TargetNodeStorage: "gitaly-1",
+				SourceNodeStorage: "",
+				VirtualStorage:    "praefect",
+				Params:            nil,
+			},
+		}
 
-	db.RequireRowsInTable(t, "replication_queue_job_lock", 0) // there is no fetches it must be empty
-}
+		eventType3 := ReplicationEvent{
+			Job: ReplicationJob{
+				Change:            RenameRepo,
+				RelativePath:      "/project/path-2",
+				TargetNodeStorage: "gitaly-1",
+				SourceNodeStorage: "gitaly-0",
+				VirtualStorage:    "praefect",
+				Params:            Params{"RelativePath": "/project/path-2-renamed"},
+			},
+		}
 
-func TestPostgresReplicationEventQueue_Dequeue(t *testing.T) {
-	t.Parallel()
-	db := testdb.New(t)
-	ctx := testhelper.Context(t)
+		eventType4 := ReplicationEvent{
+			Job: ReplicationJob{
+				Change:            UpdateRepo,
+				RelativePath:      "/project/path-1",
+				TargetNodeStorage: "gitaly-1",
+				SourceNodeStorage: "gitaly-0",
+				VirtualStorage:    "backup",
+			},
+		}
 
-	queue := PostgresReplicationEventQueue{db.DB}
+		// events to fill in the queue
+		events := []ReplicationEvent{eventType1, eventType2, eventType3, eventType4}
+		for i := range events {
+			var err error
+			events[i], err = queue.Enqueue(ctx, events[i])
+			require.NoError(t, err, "failed to fill in event queue")
+		}
 
-	event := ReplicationEvent{
-		Job: ReplicationJob{
-			Change:            UpdateRepo,
-			RelativePath:      "/project/path-1",
-			TargetNodeStorage: "gitaly-1",
-			SourceNodeStorage: "gitaly-0",
-			VirtualStorage:    "praefect",
-			Params:            nil,
-		},
-	}
+		// first request to deque
+		expectedEvents1 := []ReplicationEvent{events[0], events[1], events[2]}
+		expectedJobLocks1 := []JobLockRow{
+			{JobID: events[0].ID, LockID: "praefect|gitaly-1|/project/path-1"},
+			{JobID: events[1].ID, LockID: "praefect|gitaly-1|/project/path-1"},
+			{JobID: events[2].ID, LockID: "praefect|gitaly-1|/project/path-2"},
+		}
 
-	event, err := queue.Enqueue(ctx, event)
-	require.NoError(t, err, "failed to fill in event queue")
+		// we expect only first two types of events by limiting count to 3
+		dequeuedEvents1, err := queue.Dequeue(ctx, "praefect", "gitaly-1", 3)
+		require.NoError(t, err)
+		require.Len(t, dequeuedEvents1, len(expectedEvents1))
+		for i := range dequeuedEvents1 {
+			dequeuedEvents1[i].UpdatedAt = nil // it is not possible to determine update_at value as it is generated on UPDATE in database
+			expectedEvents1[i].State = JobStateInProgress
+			expectedEvents1[i].Attempt--
+		}
+		require.Equal(t, expectedEvents1, dequeuedEvents1)
 
-	noEvents, err := queue.Dequeue(ctx, "praefect", "not existing storage", 5)
-	require.NoError(t, err)
-	require.Len(t, noEvents, 0, "there must be no events dequeued for not existing storage")
+		backend.requireLocks(t, ctx, []LockRow{
+			// there is only one single lock for all fetched events because of their 'repo' and 'target' combination
+			{ID: "praefect|gitaly-1|/project/path-1", Acquired: true},
+			{ID: "praefect|gitaly-1|/project/path-2", Acquired: true},
+			{ID: "backup|gitaly-1|/project/path-1", Acquired: false},
+		})
+		backend.requireJobLocks(t, ctx, expectedJobLocks1)
 
-	expectedEvent := event
-	expectedEvent.State = JobStateInProgress
-	expectedEvent.Attempt = 2
+		// second request to deque
+		// there must be only last event fetched from the queue
+		expectedEvents2 := []ReplicationEvent{events[3]}
+		expectedEvents2[0].State = JobStateInProgress
+		expectedEvents2[0].Attempt = 2
 
-	expectedLock := LockRow{ID: event.LockID, Acquired: true} // as we deque events we acquire lock for processing
+		expectedJobLocks2 := []JobLockRow{{JobID: 4, LockID: "backup|gitaly-1|/project/path-1"}}
 
-	expectedJobLock := JobLockRow{JobID: event.ID, LockID: event.LockID} // and there is a track if job is under processing in separate table
+		dequeuedEvents2, err := queue.Dequeue(ctx, "backup", "gitaly-1", 100500)
+		require.NoError(t, err)
+		require.Len(t, dequeuedEvents2, 1, "only one event must be fetched from the queue")
 
-	actual, err := queue.Dequeue(ctx, event.Job.VirtualStorage, event.Job.TargetNodeStorage, 5)
-	require.NoError(t, err)
+		dequeuedEvents2[0].UpdatedAt = nil // it is not possible to determine update_at value as it is generated on UPDATE in database
+		require.Equal(t, expectedEvents2, dequeuedEvents2)
 
-	for i := range actual {
-		actual[i].UpdatedAt = nil // it is not possible to determine update_at value as it is generated on UPDATE in database
-	}
-	require.Equal(t, []ReplicationEvent{expectedEvent}, actual)
+		backend.requireLocks(t, ctx, []LockRow{
+			{ID: "praefect|gitaly-1|/project/path-1", Acquired: true},
+			{ID: "praefect|gitaly-1|/project/path-2", Acquired: true},
+			{ID: "backup|gitaly-1|/project/path-1", Acquired: true},
+		})
+		backend.requireJobLocks(t, ctx, append(expectedJobLocks1, expectedJobLocks2...))
+	})
 
-	// there is only one single lock for all fetched events
-	requireLocks(t, ctx, db, []LockRow{expectedLock})
-	requireJobLocks(t, ctx, db, []JobLockRow{expectedJobLock})
-}
+	t.Run("DequeueSameStorageOtherRepository", func(t *testing.T) {
+		queue := backend.newQueue(t)
+
+		eventType1 := ReplicationEvent{
+			Job: ReplicationJob{
+				Change:            UpdateRepo,
+				RelativePath:      "/project/path-1",
+				TargetNodeStorage: "gitaly-1",
+				SourceNodeStorage: "gitaly-0",
+				VirtualStorage:    "praefect",
+				Params:            nil,
+			},
+		}
 
-// expected results are listed as literals on purpose to be more explicit about what is going on with data
-func TestPostgresReplicationEventQueue_DequeueMultiple(t *testing.T) {
-	t.Parallel()
-	db := testdb.New(t)
-	ctx := testhelper.Context(t)
+		eventType2 := ReplicationEvent{
+			Job: ReplicationJob{
+				Change:            UpdateRepo,
+				RelativePath:      "/project/path-2",
+				TargetNodeStorage: "gitaly-1",
+				SourceNodeStorage: "gitaly-0",
+				VirtualStorage:    "praefect",
+				Params:            nil,
+			},
+		}
 
-	queue := PostgresReplicationEventQueue{db.DB}
+		_, err := queue.Enqueue(ctx, eventType1)
+		require.NoError(t, err, "failed to fill in event queue")
 
-	eventType1 := ReplicationEvent{
-		Job: ReplicationJob{
-			Change:            UpdateRepo,
-			RelativePath:      "/project/path-1",
-			TargetNodeStorage: "gitaly-1",
-			SourceNodeStorage: "gitaly-0",
-			VirtualStorage:    "praefect",
-			Params:            nil,
-		},
-	}
+		dequeuedEvents1, err := queue.Dequeue(ctx, "praefect", "gitaly-1", 1)
+		require.NoError(t, err)
+		require.Len(t, dequeuedEvents1, 1)
+		backend.requireLocks(t, ctx, []LockRow{
+			// there is only one single lock for all fetched events because of their 'repo' and 'target' combination
+			{ID: "praefect|gitaly-1|/project/path-1", Acquired: true},
+		})
+		backend.requireJobLocks(t, ctx, []JobLockRow{{JobID: 1, LockID: "praefect|gitaly-1|/project/path-1"}})
 
-	eventType2 := ReplicationEvent{
-		Job: ReplicationJob{
-			Change:            DeleteRepo,
-			RelativePath:      "/project/path-1",
-			TargetNodeStorage: "gitaly-1",
-			SourceNodeStorage: "",
-			VirtualStorage:    "praefect",
-			Params:            nil,
-		},
-	}
+		_, err = queue.Enqueue(ctx, eventType2)
+		require.NoError(t, err, "failed to fill in event queue")
 
-	eventType3 := ReplicationEvent{
-		Job: ReplicationJob{
-			Change:            RenameRepo,
-			RelativePath:      "/project/path-2",
-			TargetNodeStorage: "gitaly-1",
-			SourceNodeStorage: "gitaly-0",
-			VirtualStorage:    "praefect",
-			Params:            Params{"RelativePath": "/project/path-2-renamed"},
-		},
-	}
+		dequeuedEvents2, err := queue.Dequeue(ctx, "praefect", "gitaly-1", 1)
+		require.NoError(t, err)
+		require.Len(t, dequeuedEvents2, 1)
+		backend.requireLocks(t, ctx, []LockRow{
+			{ID: "praefect|gitaly-1|/project/path-1", Acquired: true},
+			{ID: "praefect|gitaly-1|/project/path-2", Acquired: true},
+		})
+		backend.requireJobLocks(t, ctx, []JobLockRow{
+			{JobID: 1, LockID: "praefect|gitaly-1|/project/path-1"},
+			{JobID: 2, LockID: "praefect|gitaly-1|/project/path-2"},
+		})
+	})
 
-	eventType4 := ReplicationEvent{
-		Job: ReplicationJob{
-			Change:            UpdateRepo,
-			RelativePath:      "/project/path-1",
-			TargetNodeStorage: "gitaly-1",
-			SourceNodeStorage: "gitaly-0",
-			VirtualStorage:    "backup",
-		},
-	}
+	t.Run("Acknowledge", func(t *testing.T) {
+		queue := backend.newQueue(t)
+
+		event := ReplicationEvent{
+			Job: ReplicationJob{
+				Change:            UpdateRepo,
+				RelativePath:      "/project/path-1",
+				TargetNodeStorage: "gitaly-1",
+				SourceNodeStorage: "gitaly-0",
+				VirtualStorage:    "praefect",
+				Params:            nil,
+			},
+		}
 
-	// events to fill in the queue
-	events := []ReplicationEvent{eventType1, eventType2, eventType3, eventType4}
-	for i := range events {
-		var err error
-		events[i], err = queue.Enqueue(ctx, events[i])
+		event, err := queue.Enqueue(ctx, event)
 		require.NoError(t, err, "failed to fill in event queue")
-	}
 
-	// first request to deque
-	expectedEvents1 := []ReplicationEvent{events[0], events[1], events[2]}
-	expectedJobLocks1 := []JobLockRow{
-		{JobID: events[0].ID, LockID: "praefect|gitaly-1|/project/path-1"},
-		{JobID: events[1].ID, LockID: "praefect|gitaly-1|/project/path-1"},
-		{JobID: events[2].ID, LockID: "praefect|gitaly-1|/project/path-2"},
-	}
+		actual, err := queue.Dequeue(ctx, event.Job.VirtualStorage, event.Job.TargetNodeStorage, 100)
+		require.NoError(t, err)
 
-	// we expect only first two types of events by limiting count to 3
-	dequeuedEvents1, err := queue.Dequeue(ctx, "praefect", "gitaly-1", 3)
-	require.NoError(t, err)
-	require.Len(t, dequeuedEvents1, len(expectedEvents1))
-	for i := range dequeuedEvents1 {
-		dequeuedEvents1[i].UpdatedAt = nil // it is not possible to determine update_at value as it is generated on UPDATE in database
-		expectedEvents1[i].State = JobStateInProgress
-		expectedEvents1[i].Attempt--
-	}
-	require.Equal(t, expectedEvents1, dequeuedEvents1)
+		// as we deque events we acquire lock for processing
+		backend.requireLocks(t, ctx, []LockRow{{ID: event.LockID, Acquired: true}})
+		backend.requireJobLocks(t, ctx, []JobLockRow{{JobID: event.ID, LockID: event.LockID}})
 
-	requireLocks(t, ctx, db, []LockRow{
-		// there is only one single lock for all fetched events because of their 'repo' and 'target' combination
-		{ID: "praefect|gitaly-1|/project/path-1", Acquired: true},
-		{ID: "praefect|gitaly-1|/project/path-2", Acquired: true},
-		{ID: "backup|gitaly-1|/project/path-1", Acquired: false},
+		_, err = queue.Acknowledge(ctx, JobStateInProgress, []uint64{actual[0].ID})
+		require.EqualError(t, err, `event state is not supported: "in_progress"`)
+
+		acknowledged, err := queue.Acknowledge(ctx, JobStateCompleted, []uint64{actual[0].ID, 100500})
+		require.NoError(t, err)
+		require.Equal(t, []uint64{actual[0].ID}, acknowledged)
+
+		// events acknowledged with 'completed' state expected to be removed
+		backend.requireEvents(t, ctx, nil)
+		// all associated with acknowledged event tracking bindings between lock and event must be removed
+		backend.requireJobLocks(t, ctx, nil)
+		// lock must be released as the event was acknowledged and there are no other events left protected under this lock
+		backend.requireLocks(t, ctx, []LockRow{{ID: event.LockID, Acquired: false}})
 	})
-	requireJobLocks(t, ctx, db, expectedJobLocks1)
 
-	// second request to deque
-	// there must be only last event fetched from the queue
-	expectedEvents2 := []ReplicationEvent{events[3]}
-	expectedEvents2[0].State = JobStateInProgress
-	expectedEvents2[0].Attempt = 2
+	t.Run("AcknowledgeMultiple", func(t *testing.T) {
+		queue := backend.newQueue(t)
+
+		eventType1 := ReplicationEvent{
+			Job: ReplicationJob{
+				Change:            UpdateRepo,
+				RelativePath:      "/project/path-1",
+				TargetNodeStorage: "gitaly-1",
+				SourceNodeStorage: "gitaly-0",
+				VirtualStorage:    "praefect",
+				Params:            nil,
+			},
+		}
 
-	expectedJobLocks2 := []JobLockRow{{JobID: 4, LockID: "backup|gitaly-1|/project/path-1"}}
+		eventType2 := ReplicationEvent{
+			Job: ReplicationJob{
+				Change:            DeleteRepo,
+				RelativePath:      "/project/path-2",
+				TargetNodeStorage: "gitaly-1",
+				SourceNodeStorage: "",
+				VirtualStorage:    "praefect",
+				Params:            nil,
+			},
+		}
 
-	dequeuedEvents2, err := queue.Dequeue(ctx, "backup", "gitaly-1", 100500)
-	require.NoError(t, err)
-	require.Len(t, dequeuedEvents2, 1, "only one event must be fetched from the queue")
+		eventType3 := ReplicationEvent{
+			Job: ReplicationJob{
+				Change:            UpdateRepo,
+				RelativePath:      "/project/path-3",
+				TargetNodeStorage: "gitaly-1",
+				SourceNodeStorage: "gitaly-0",
+				VirtualStorage:    "praefect",
+				Params:            nil,
+			},
+		}
 
-	dequeuedEvents2[0].UpdatedAt = nil // it is not possible to determine update_at value as it is generated on UPDATE in database
-	require.Equal(t, expectedEvents2, dequeuedEvents2)
+		eventType4 := ReplicationEvent{
+			Job: ReplicationJob{
+				Change:            UpdateRepo,
+				RelativePath:      "/project/path-1",
+				TargetNodeStorage: "gitaly-2",
+				SourceNodeStorage: "gitaly-0",
+				VirtualStorage:    "praefect",
+				Params:            nil,
+			},
+		}
 
-	requireLocks(t, ctx, db, []LockRow{
-		{ID: "praefect|gitaly-1|/project/path-1", Acquired: true},
-		{ID: "praefect|gitaly-1|/project/path-2", Acquired: true},
-		{ID: "backup|gitaly-1|/project/path-1", Acquired: true},
-	})
-	requireJobLocks(t, ctx, db, append(expectedJobLocks1, expectedJobLocks2...))
-}
+		events := []ReplicationEvent{eventType1, eventType2, eventType3, eventType4}
+		for i := range events {
+			var err error
+			events[i], err = queue.Enqueue(ctx, events[i])
+			require.NoError(t, err, "failed to fill in event queue")
+		}
 
-func TestPostgresReplicationEventQueue_DequeueSameStorageOtherRepository(t *testing.T) {
-	t.Parallel()
-	db := testdb.New(t)
-	ctx := testhelper.Context(t)
+		// we expect only first two types events by limiting count to 2
+		dequeuedEvents1, err := queue.Dequeue(ctx, "praefect", "gitaly-1", 2)
+		require.NoError(t, err)
+		require.Len(t, dequeuedEvents1, 2)
+		backend.requireLocks(t, ctx, []LockRow{
+			{ID: events[0].LockID, Acquired: true},
+			{ID: events[1].LockID, Acquired: true},
+			{ID: events[2].LockID, Acquired: false},
+			{ID: events[3].LockID, Acquired: false},
+		})
+		backend.requireJobLocks(t, ctx, []JobLockRow{
+			{JobID: events[0].ID, LockID: events[0].LockID},
+			{JobID: events[1].ID, LockID: events[1].LockID},
+		})
+
+		// release lock for events of second type
+		acknowledge1, err := queue.Acknowledge(ctx, JobStateFailed, []uint64{events[1].ID})
+		require.NoError(t, err)
+		require.Equal(t, []uint64{2}, acknowledge1)
+		backend.requireLocks(t, ctx, []LockRow{
+			{ID: events[0].LockID, Acquired: true},
+			{ID: events[1].LockID, Acquired: false},
+			{ID: events[2].LockID, Acquired: false},
+			{ID: events[3].LockID, Acquired: false},
+		})
+		backend.requireJobLocks(t, ctx, []JobLockRow{
+			{JobID: events[0].ID, LockID: events[0].LockID},
+		})
 
-	queue := PostgresReplicationEventQueue{db.DB}
+		dequeuedEvents2, err := queue.Dequeue(ctx, "praefect", "gitaly-1", 3)
+		require.NoError(t, err)
+		require.Len(t, dequeuedEvents2, 2, "expected: events of type 2 ('failed' will  be fetched for retry)")
+		backend.requireLocks(t, ctx, []LockRow{
+			{ID: events[0].LockID, Acquired: true},
+			{ID: events[1].LockID, Acquired: true},
+			{ID: events[2].LockID, Acquired: true},
+			{ID: events[3].LockID, Acquired: false},
+		})
+		backend.requireJobLocks(t, ctx, []JobLockRow{
+			{JobID: events[0].ID, LockID: events[0].LockID},
+			{JobID: events[1].ID, LockID: events[1].LockID},
+			{JobID: events[2].ID, LockID: events[2].LockID},
+		})
+
+		// creation of the new event that is equal to those already dequeue and processed
+		// it is used to verify that the event created after consuming events from queue won't be marked
+		// with previously created events as it may cause delay in replication
+		newEvent, err := queue.Enqueue(ctx, eventType1)
+		require.NoError(t, err)
+
+		acknowledge2, err := queue.Acknowledge(ctx, JobStateCompleted, []uint64{events[0].ID, events[2].ID})
+		require.NoError(t, err)
+		require.Equal(t, []uint64{events[0].ID, events[2].ID}, acknowledge2)
+		backend.requireLocks(t, ctx, []LockRow{
+			{ID: events[0].LockID, Acquired: false},
+			{ID: events[1].LockID, Acquired: true},
+			{ID: events[2].LockID, Acquired: false},
+			{ID: events[3].LockID, Acquired: false},
+		})
+		backend.requireJobLocks(t, ctx, []JobLockRow{
+			{JobID: events[1].ID, LockID: events[1].LockID},
+		})
+
+		inProgressEvent := events[1]
+		inProgressEvent.State = JobStateInProgress
+		inProgressEvent.Attempt = 1
+		backend.requireEvents(t, ctx, []ReplicationEvent{inProgressEvent, events[3], newEvent})
+
+		dequeuedEvents3, err := queue.Dequeue(ctx, "praefect", "gitaly-2", 3)
+		require.NoError(t, err)
+		require.Len(t, dequeuedEvents3, 1, "expected: event of type 4")
+		backend.requireLocks(t, ctx, []LockRow{
+			{ID: events[0].LockID, Acquired: false},
+			{ID: events[1].LockID, Acquired: true},
+			{ID: events[2].LockID, Acquired: false},
+			{ID: events[3].LockID, Acquired: true},
+		})
+		backend.requireJobLocks(t, ctx, []JobLockRow{
+			{JobID: events[1].ID, LockID: events[1].LockID},
+			{JobID: events[3].ID, LockID: events[3].LockID},
+		})
+
+		acknowledged3, err := queue.Acknowledge(ctx, JobStateCompleted, []uint64{events[1].ID, events[3].ID})
+		require.NoError(t, err)
+		require.Equal(t, []uint64{events[1].ID, events[3].ID}, acknowledged3)
+		backend.requireLocks(t, ctx, []LockRow{
+			{ID: events[0].LockID, Acquired: false},
+			{ID: events[1].LockID, Acquired: false},
+			{ID: events[2].LockID, Acquired: false},
+			{ID: events[3].LockID, Acquired: false},
+		})
+		backend.requireJobLocks(t, ctx, nil)
+		backend.requireEvents(t, ctx, []ReplicationEvent{newEvent})
+
+		acknowledge4, err := queue.Acknowledge(ctx, JobStateCompleted, []uint64{newEvent.ID})
+		require.NoError(t, err)
+		require.Equal(t, ([]uint64)(nil), acknowledge4) // event that was not dequeued can't be acknowledged
+
+		// no way to acknowledge event that is not in in_progress state(was not dequeued)
+		backend.requireEvents(t, ctx, []ReplicationEvent{newEvent})
+		backend.requireLocks(t, ctx, []LockRow{
+			{ID: events[0].LockID, Acquired: false},
+			{ID: events[1].LockID, Acquired: false},
+			{ID: events[2].LockID, Acquired: false},
+			{ID: events[3].LockID, Acquired: false},
+		})
+		backend.requireJobLocks(t, ctx, nil)
+	})
 
-	eventType1 := ReplicationEvent{
-		Job: ReplicationJob{
+	t.Run("AcknowledgeStale", func(t *testing.T) {
+		eventType := ReplicationEvent{Job: ReplicationJob{
 			Change:            UpdateRepo,
 			RelativePath:      "/project/path-1",
 			TargetNodeStorage: "gitaly-1",
 			SourceNodeStorage: "gitaly-0",
-			VirtualStorage:    "praefect",
-			Params:            nil,
-		},
-	}
+			VirtualStorage:    "praefect-1",
+		}}
 
-	eventType2 := ReplicationEvent{
-		Job: ReplicationJob{
-			Change:            UpdateRepo,
-			RelativePath:      "/project/path-2",
-			TargetNodeStorage: "gitaly-1",
-			SourceNodeStorage: "gitaly-0",
-			VirtualStorage:    "praefect",
-			Params:            nil,
-		},
-	}
+		eventType1 := eventType
 
-	_, err := queue.Enqueue(ctx, eventType1)
-	require.NoError(t, err, "failed to fill in event queue")
+		eventType2 := eventType
+		eventType2.Job.VirtualStorage = "praefect-2"
 
-	dequeuedEvents1, err := queue.Dequeue(ctx, "praefect", "gitaly-1", 1)
-	require.NoError(t, err)
-	require.Len(t, dequeuedEvents1, 1)
-	requireLocks(t, ctx, db, []LockRow{
-		// there is only one single lock for all fetched events because of their 'repo' and 'target' combination
-		{ID: "praefect|gitaly-1|/project/path-1", Acquired: true},
-	})
-	requireJobLocks(t, ctx, db, []JobLockRow{{JobID: 1, LockID: "praefect|gitaly-1|/project/path-1"}})
+		eventType3 := eventType2
+		eventType3.Job.RelativePath = "/project/path-2"
+		eventType3.Job.TargetNodeStorage = "gitaly-2"
 
-	_, err = queue.Enqueue(ctx, eventType2)
-	require.NoError(t, err, "failed to fill in event queue")
+		eventType4 := eventType3
+		eventType4.Job.TargetNodeStorage = "gitaly-3"
 
-	dequeuedEvents2, err := queue.Dequeue(ctx, "praefect", "gitaly-1", 1)
-	require.NoError(t, err)
-	require.Len(t, dequeuedEvents2, 1)
-	requireLocks(t, ctx, db, []LockRow{
-		{ID: "praefect|gitaly-1|/project/path-1", Acquired: true},
-		{ID: "praefect|gitaly-1|/project/path-2", Acquired: true},
-	})
-	requireJobLocks(t, ctx, db, []JobLockRow{
-		{JobID: 1, LockID: "praefect|gitaly-1|/project/path-1"},
-		{JobID: 2, LockID: "praefect|gitaly-1|/project/path-2"},
-	})
-}
+		t.Run("no stale jobs yet", func(t *testing.T) {
+			source := backend.newQueue(t)
 
-func TestPostgresReplicationEventQueue_Acknowledge(t *testing.T) {
-	t.Parallel()
-	db := testdb.New(t)
-	ctx := testhelper.Context(t)
+			event, err := source.Enqueue(ctx, eventType1)
+			require.NoError(t, err)
 
-	queue := PostgresReplicationEventQueue{db.DB}
+			devents, err := source.Dequeue(ctx, event.Job.VirtualStorage, event.Job.TargetNodeStorage, 1)
+			require.NoError(t, err)
 
-	event := ReplicationEvent{
-		Job: ReplicationJob{
-			Change:            UpdateRepo,
-			RelativePath:      "/project/path-1",
-			TargetNodeStorage: "gitaly-1",
-			SourceNodeStorage: "gitaly-0",
-			VirtualStorage:    "praefect",
-			Params:            nil,
-		},
-	}
+			// events triggered just now (< 1 sec ago), so nothing considered stale
+			n, err := source.AcknowledgeStale(ctx, time.Second)
+			require.NoError(t, err)
+			backend.requireEvents(t, ctx, devents)
+			require.Equal(t, n, int64(0))
+		})
 
-	event, err := queue.Enqueue(ctx, event)
-	require.NoError(t, err, "failed to fill in event queue")
+		t.Run("jobs considered stale only at 'in_progress' state", func(t *testing.T) {
+			source := backend.newQueue(t)
 
-	actual, err := queue.Dequeue(ctx, event.Job.VirtualStorage, event.Job.TargetNodeStorage, 100)
-	require.NoError(t, err)
+			// move event to 'ready' state
+			event1, err := source.Enqueue(ctx, eventType1)
+			require.NoError(t, err)
 
-	// as we deque events we acquire lock for processing
-	requireLocks(t, ctx, db, []LockRow{{ID: event.LockID, Acquired: true}})
-	requireJobLocks(t, ctx, db, []JobLockRow{{JobID: event.ID, LockID: event.LockID}})
+			// move event to 'failed' state
+			event2, err := source.Enqueue(ctx, eventType2)
+			require.NoError(t, err)
+			devents2, err := source.Dequeue(ctx, event2.Job.VirtualStorage, event2.Job.TargetNodeStorage, 1)
+			require.NoError(t, err)
+			require.Equal(t, event2.ID, devents2[0].ID)
+			_, err = source.Acknowledge(ctx, JobStateFailed, []uint64{devents2[0].ID})
+			require.NoError(t, err)
 
-	acknowledged, err := queue.Acknowledge(ctx, JobStateCompleted, []uint64{actual[0].ID, 100500})
-	require.NoError(t, err)
-	require.Equal(t, []uint64{actual[0].ID}, acknowledged)
-
-	event.State = JobStateCompleted
-	event.Attempt = 2
-	// events acknowledged with 'completed' or 'dead' states expected to be removed
-	db.RequireRowsInTable(t, "replication_queue", 0)
-	// all associated with acknowledged event tracking bindings between lock and event must be removed
-	db.RequireRowsInTable(t, "replication_queue_job_lock", 0)
-	// lock must be released as the event was acknowledged and there are no other events left protected under this lock
-	requireLocks(t, ctx, db, []LockRow{{ID: event.LockID, Acquired: false}})
-}
+			// move event to 'dead' state
+			event3, err := source.Enqueue(ctx, eventType3)
+			require.NoError(t, err)
+			devents3, err := source.Dequeue(ctx, event3.Job.VirtualStorage, event3.Job.TargetNodeStorage, 1)
+			require.NoError(t, err)
+			require.Equal(t, event3.ID, devents3[0].ID)
+			_, err = source.Acknowledge(ctx, JobStateDead, []uint64{devents3[0].ID})
+			require.NoError(t, err)
 
-func TestPostgresReplicationEventQueue_AcknowledgeMultiple(t *testing.T) {
-	t.Parallel()
-	db := testdb.New(t)
-	ctx := testhelper.Context(t)
+			event4, err := source.Enqueue(ctx, eventType4)
+			require.NoError(t, err)
+			devents4, err := source.Dequeue(ctx, event4.Job.VirtualStorage, event4.Job.TargetNodeStorage, 1)
+			require.NoError(t, err)
 
-	queue := PostgresReplicationEventQueue{db.DB}
+			backend.elapse(t, ctx, time.Minute)
 
-	eventType1 := ReplicationEvent{
-		Job: ReplicationJob{
-			Change:            UpdateRepo,
-			RelativePath:      "/project/path-1",
-			TargetNodeStorage: "gitaly-1",
-			SourceNodeStorage: "gitaly-0",
-			VirtualStorage:    "praefect",
-			Params:            nil,
-		},
-	}
+			n, err := source.AcknowledgeStale(ctx, time.Second)
+			require.NoError(t, err)
 
-	eventType2 := ReplicationEvent{
-		Job: ReplicationJob{
-			Change:            DeleteRepo,
-			RelativePath:      "/project/path-2",
-			TargetNodeStorage: "gitaly-1",
-			SourceNodeStorage: "",
-			VirtualStorage:    "praefect",
-			Params:            nil,
-		},
-	}
+			devents2[0].State = JobStateFailed
+			devents3[0].State = JobStateDead
+			devents4[0].Attempt = 2
+			devents4[0].State = JobStateFailed
+			backend.requireEvents(t, ctx, []ReplicationEvent{event1, devents2[0], devents3[0], devents4[0]})
+			require.Equal(t, n, int64(1))
+		})
 
-	eventType3 := ReplicationEvent{
-		Job: ReplicationJob{
-			Change:            UpdateRepo,
-			RelativePath:      "/project/path-3",
-			TargetNodeStorage: "gitaly-1",
-			SourceNodeStorage: "gitaly-0",
-			VirtualStorage:    "praefect",
-			Params:            nil,
-		},
-	}
+		t.Run("stale jobs updated for all virtual storages and storages at once", func(t *testing.T) {
+			source := backend.newQueue(t)
 
-	eventType4 := ReplicationEvent{
-		Job: ReplicationJob{
-			Change:            UpdateRepo,
-			RelativePath:      "/project/path-1",
-			TargetNodeStorage: "gitaly-2",
-			SourceNodeStorage: "gitaly-0",
-			VirtualStorage:    "praefect",
-			Params:            nil,
-		},
-	}
+			var events []ReplicationEvent
+			for _, eventType := range []ReplicationEvent{eventType1, eventType2, eventType3} {
+				event, err := source.Enqueue(ctx, eventType)
+				require.NoError(t, err)
+				devents, err := source.Dequeue(ctx, event.Job.VirtualStorage, event.Job.TargetNodeStorage, 1)
+				require.NoError(t, err)
+				events = append(events, devents...)
+			}
 
-	events := []ReplicationEvent{eventType1, eventType2, eventType3, eventType4}
-	for i := range events {
-		var err error
-		events[i], err = queue.Enqueue(ctx, events[i])
-		require.NoError(t, err, "failed to fill in event queue")
-	}
+			for event, i := events[0], 0; i < 2; i++ { // consume all processing attempts to verify that state will be changed to 'dead'
+				_, err := source.Acknowledge(ctx, JobStateFailed, []uint64{event.ID})
+				require.NoError(t, err)
+				_, err = source.Dequeue(ctx, event.Job.VirtualStorage, event.Job.TargetNodeStorage, 1)
+				require.NoError(t, err)
+			}
 
-	// we expect only first two types events by limiting count to 2
-	dequeuedEvents1, err := queue.Dequeue(ctx, "praefect", "gitaly-1", 2)
-	require.NoError(t, err)
-	require.Len(t, dequeuedEvents1, 2)
-	requireLocks(t, ctx, db, []LockRow{
-		{ID: events[0].LockID, Acquired: true},
-		{ID: events[1].LockID, Acquired: true},
-		{ID: events[2].LockID, Acquired: false},
-		{ID: events[3].LockID, Acquired: false},
-	})
-	requireJobLocks(t, ctx, db, []JobLockRow{
-		{JobID: events[0].ID, LockID: events[0].LockID},
-		{JobID: events[1].ID, LockID: events[1].LockID},
-	})
+			backend.elapse(t, ctx, time.Minute)
 
-	// release lock for events of second type
-	acknowledge1, err := queue.Acknowledge(ctx, JobStateFailed, []uint64{events[1].ID})
-	require.NoError(t, err)
-	require.Equal(t, []uint64{2}, acknowledge1)
-	requireLocks(t, ctx, db, []LockRow{
-		{ID: events[0].LockID, Acquired: true},
-		{ID: events[1].LockID, Acquired: false},
-		{ID: events[2].LockID, Acquired: false},
-		{ID: events[3].LockID, Acquired: false},
-	})
-	requireJobLocks(t, ctx, db, []JobLockRow{
-		{JobID: events[0].ID, LockID: events[0].LockID},
-	})
+			n, err := source.AcknowledgeStale(ctx, time.Second)
+			require.NoError(t, err)
+			require.Equal(t, n, int64(3))
+
+			// The first event has no attempts left and thus is moved into 'dead' state.
+			exp := []ReplicationEvent{events[0]}
+			exp[0].Attempt = 0
+			exp[0].State = JobStateDead
+			for _, e := range events[1:] {
+				e.State = JobStateFailed
+				exp = append(exp, e)
+			}
 
-	dequeuedEvents2, err := queue.Dequeue(ctx, "praefect", "gitaly-1", 3)
-	require.NoError(t, err)
-	require.Len(t, dequeuedEvents2, 2, "expected: events of type 2 ('failed' will  be fetched for retry)")
-	requireLocks(t, ctx, db, []LockRow{
-		{ID: events[0].LockID, Acquired: true},
-		{ID: events[1].LockID, Acquired: true},
-		{ID: events[2].LockID, Acquired: true},
-		{ID: events[3].LockID, Acquired: false},
-	})
-	requireJobLocks(t, ctx, db, []JobLockRow{
-		{JobID: events[0].ID, LockID: events[0].LockID},
-		{JobID: events[1].ID, LockID: events[1].LockID},
-		{JobID: events[2].ID, LockID: events[2].LockID},
-	})
+			backend.requireEvents(t, ctx, exp)
+		})
 
-	// creation of the new event that is equal to those already dequeue and processed
-	// it is used to verify that the event created after consuming events from queue won't be marked
-	// with previously created events as it may cause delay in replication
-	newEvent, err := queue.Enqueue(ctx, eventType1)
-	require.NoError(t, err)
+		t.Run("health updates keep jobs from going stale", func(t *testing.T) {
+			source := backend.newQueue(t)
 
-	acknowledge2, err := queue.Acknowledge(ctx, JobStateCompleted, []uint64{events[0].ID, events[2].ID})
-	require.NoError(t, err)
-	require.Equal(t, []uint64{events[0].ID, events[2].ID}, acknowledge2)
-	requireLocks(t, ctx, db, []LockRow{
-		{ID: events[0].LockID, Acquired: false},
-		{ID: events[1].LockID, Acquired: true},
-		{ID: events[2].LockID, Acquired: false},
-		{ID: events[3].LockID, Acquired: false},
-	})
-	requireJobLocks(t, ctx, db, []JobLockRow{
-		{JobID: events[1].ID, LockID: events[1].LockID},
-	})
-	db.RequireRowsInTable(t, "replication_queue", 3)
+			var events []ReplicationEvent
+			for _, eventType := range []ReplicationEvent{eventType1, eventType2} {
+				event, err := source.Enqueue(ctx, eventType)
+				require.NoError(t, err)
+				devents, err := source.Dequeue(ctx, event.Job.VirtualStorage, event.Job.TargetNodeStorage, 1)
+				require.NoError(t, err)
+				events = append(events, devents...)
+			}
 
-	dequeuedEvents3, err := queue.Dequeue(ctx, "praefect", "gitaly-2", 3)
-	require.NoError(t, err)
-	require.Len(t, dequeuedEvents3, 1, "expected: event of type 4")
-	requireLocks(t, ctx, db, []LockRow{
-		{ID: events[0].LockID, Acquired: false},
-		{ID: events[1].LockID, Acquired: true},
-		{ID: events[2].LockID, Acquired: false},
-		{ID: events[3].LockID, Acquired: true},
+			backend.elapse(t, ctx, 30*time.Second)
+
+			healthCtx, cancel := context.WithCancel(ctx)
+			trigger := make(chan time.Time)
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				assert.NoError(t, source.StartHealthUpdate(healthCtx, trigger, events[:1]))
+			}()
+
+			trigger <- time.Time{}
+			// once this consumed we are sure that the previous update has been executed
+			trigger <- time.Time{}
+			cancel()
+			<-done
+
+			backend.elapse(t, ctx, 45*time.Second)
+
+			n, err := source.AcknowledgeStale(ctx, time.Minute)
+			require.NoError(t, err)
+			require.Equal(t, int64(1), n)
+
+			events[1].State = JobStateFailed
+			backend.requireEvents(t, ctx, events)
+			backend.requireLocks(t, ctx, []LockRow{
+				{ID: events[0].LockID, Acquired: true},
+				{ID: events[1].LockID, Acquired: false},
+			})
+			backend.requireJobLocks(t, ctx, []JobLockRow{
+				{JobID: events[0].ID, LockID: events[0].LockID},
+			})
+		})
 	})
-	requireJobLocks(t, ctx, db, []JobLockRow{
-		{JobID: events[1].ID, LockID: events[1].LockID},
-		{JobID: events[3].ID, LockID: events[3].LockID},
+
+	// Check if the queue returns the expected error when adding a duplicate
+	// replication event.
+	t.Run("DuplicateEvent", func(t *testing.T) {
+		initialEvent := ReplicationEvent{
+			Job: ReplicationJob{
+				Change:            UpdateRepo,
+				RelativePath:      "/project/path-1",
+				TargetNodeStorage: "gitaly-1",
+				SourceNodeStorage: "gitaly-0",
+				VirtualStorage:    "praefect",
+				Params:            nil,
+			},
+		}
+
+		for _, tc := range []struct {
+			desc          string
+			event         ReplicationEvent
+			expectedErr   error
+			expectedEvent *ReplicationEvent
+			expectedLock  *LockRow
+		}{
+			{
+				desc:  "throws error when same event added again",
+				event: initialEvent,
+				expectedErr: ReplicationEventExistsError{
+					state:             "",
+					virtualStorage:    initialEvent.Job.VirtualStorage,
+					targetNodeStorage: initialEvent.Job.TargetNodeStorage,
+					relativePath:      initialEvent.Job.RelativePath,
+				},
+			},
+			{
+				desc: "Adds new event to the queue",
+				event: ReplicationEvent{
+					LockID: "praefect|gitaly-2|/project/path-2",
+					Job: ReplicationJob{
+						Change:            UpdateRepo,
+						RelativePath:      "/project/path-2",
+						TargetNodeStorage: "gitaly-2",
+						SourceNodeStorage: "gitaly-1",
+						VirtualStorage:    "praefect",
+						Params:            nil,
+					},
+				},
+				expectedEvent: &ReplicationEvent{
+					ID:      2,
+					State:   JobStateReady,
+					Attempt: 3,
+					LockID:  "praefect|gitaly-2|/project/path-2",
+					Job: ReplicationJob{
+						Change:            UpdateRepo,
+						RelativePath:      "/project/path-2",
+						TargetNodeStorage: "gitaly-2",
+						SourceNodeStorage: "gitaly-1",
+						VirtualStorage:    "praefect",
+						Params:            nil,
+					},
+				},
+				expectedLock: &LockRow{ID: "praefect|gitaly-2|/project/path-2", Acquired: false},
+			},
+		} {
+			t.Run(tc.desc, func(t *testing.T) {
+				queue := backend.newQueue(t)
+
+				actualEvent, err := queue.Enqueue(ctx, initialEvent)
+				require.NoError(t, err)
+				// we need to setup it to default because it is not possible to get it beforehand for expected
+				actualEvent.CreatedAt = time.Time{}
+
+				initialExpectedLock := LockRow{ID: "praefect|gitaly-1|/project/path-1", Acquired: false}
+				initialExpectedEvent := ReplicationEvent{
+					ID:      1,
+					State:   JobStateReady,
+					Attempt: 3,
+					LockID:  "praefect|gitaly-1|/project/path-1",
+					Job: ReplicationJob{
+						Change:            UpdateRepo,
+						RelativePath:      "/project/path-1",
+						TargetNodeStorage: "gitaly-1",
+						SourceNodeStorage: "gitaly-0",
+						VirtualStorage:    "praefect",
+						Params:            nil,
+					},
+				}
+
+				require.Equal(t, initialExpectedEvent, actualEvent)
+				backend.requireEvents(t, ctx, []ReplicationEvent{initialExpectedEvent})
+				// expected a new lock for new event
+				backend.requireLocks(t, ctx, []LockRow{initialExpectedLock})
+				backend.requireJobLocks(t, ctx, nil)
+
+				_, err = queue.Enqueue(ctx, tc.event)
+				require.Equal(t, tc.expectedErr, err)
+
+				if tc.expectedEvent != nil {
+					backend.requireEvents(t, ctx, []ReplicationEvent{initialExpectedEvent, *tc.expectedEvent})
+					backend.requireLocks(t, ctx, []LockRow{initialExpectedLock, *tc.expectedLock})
+				}
+			})
+		}
 	})
 
-	acknowledged3, err := queue.Acknowledge(ctx, JobStateCompleted, []uint64{events[1].ID, events[3].ID})
-	require.NoError(t, err)
-	require.Equal(t, []uint64{events[1].ID, events[3].ID}, acknowledged3)
-	requireLocks(t, ctx, db, []LockRow{
-		{ID: events[0].LockID, Acquired: false},
-		{ID: events[1].LockID, Acquired: false},
-		{ID: events[2].LockID, Acquired: false},
-		{ID: events[3].LockID, Acquired: false},
+	t.Run("DeadJobs", func(t *testing.T) {
+		queue := backend.newQueue(t)
+
+		event, err := queue.Enqueue(ctx, ReplicationEvent{
+			Job: ReplicationJob{
+				Change:            UpdateRepo,
+				RelativePath:      "/project/path-1",
+				TargetNodeStorage: "gitaly-1",
+				SourceNodeStorage: "gitaly-0",
+				VirtualStorage:    "praefect",
+			},
+		})
+		require.NoError(t, err)
+
+		dequeued, err := queue.Dequeue(ctx, event.Job.VirtualStorage, event.Job.TargetNodeStorage, 1)
+		require.NoError(t, err)
+		require.Len(t, dequeued, 1)
+
+		require.NoError(t, queue.RecordError(ctx, event.ID, "replication failed"))
+
+		acknowledged, err := queue.Acknowledge(ctx, JobStateDead, []uint64{event.ID})
+		require.NoError(t, err)
+		require.Equal(t, []uint64{event.ID}, acknowledged)
+
+		// The dead event is kept so it can be inspected, but its locks are released.
+		deadEvent := dequeued[0]
+		deadEvent.State = JobStateDead
+		backend.requireEvents(t, ctx, []ReplicationEvent{deadEvent})
+		backend.requireLocks(t, ctx, []LockRow{{ID: event.LockID, Acquired: false}})
+		backend.requireJobLocks(t, ctx, nil)
+
+		jobs, err := queue.ListJobs(ctx, ReplicationJobFilter{IDs: []uint64{event.ID}}, 0)
+		require.NoError(t, err)
+		require.Len(t, jobs, 1)
+		require.Equal(t, "replication failed", jobs[0].Error)
+
+		// Dead events are not dequeued again.
+		dequeued, err = queue.Dequeue(ctx, event.Job.VirtualStorage, event.Job.TargetNodeStorage, 1)
+		require.NoError(t, err)
+		require.Empty(t, dequeued)
+
+		// Dead events are removed once they have been dead for longer than the retention period.
+		backend.elapse(t, ctx, DeadJobRetention-time.Hour)
+
+		_, err = queue.AcknowledgeStale(ctx, time.Microsecond)
+		require.NoError(t, err)
+		backend.requireEvents(t, ctx, []ReplicationEvent{deadEvent})
+
+		backend.elapse(t, ctx, 2*time.Hour)
+
+		_, err = queue.AcknowledgeStale(ctx, time.Microsecond)
+		require.NoError(t, err)
+		backend.requireEvents(t, ctx, nil)
 	})
-	requireJobLocks(t, ctx, db, nil)
-	db.RequireRowsInTable(t, "replication_queue", 1)
 
-	acknowledge4, err := queue.Acknowledge(ctx, JobStateCompleted, []uint64{newEvent.ID})
-	require.NoError(t, err)
-	require.Equal(t, ([]uint64)(nil), acknowledge4) // event that was not dequeued can't be acknowledged
-	db.RequireRowsInTable(t, "replication_queue", 1)
-
-	var newEventState string
-	require.NoError(t, db.QueryRow("SELECT state FROM replication_queue WHERE id = $1", newEvent.ID).Scan(&newEventState))
-	require.Equal(t, "ready", newEventState, "no way to acknowledge event that is not in in_progress state(was not dequeued)")
-	requireLocks(t, ctx, db, []LockRow{
-		{ID: events[0].LockID, Acquired: false},
-		{ID: events[1].LockID, Acquired: false},
-		{ID: events[2].LockID, Acquired: false},
-		{ID: events[3].LockID, Acquired: false},
+	t.Run("ReplicationJobAdministration", func(t *testing.T) {
+		testReplicationJobAdministration(t, backend.newQueue(t))
 	})
-	requireJobLocks(t, ctx, db, nil)
 }
 
 func TestPostgresReplicationEventQueue_StartHealthUpdate(t *testing.T) {
@@ -904,248 +1261,12 @@ func TestPostgresReplicationEventQueue_StartHealthUpdate(t *testing.T) {
 	})
 }
 
-func TestPostgresReplicationEventQueue_AcknowledgeStale(t *testing.T) {
-	t.Parallel()
-	ctx := testhelper.Context(t)
-
-	eventType := ReplicationEvent{Job: ReplicationJob{
-		Change:            UpdateRepo,
-		RelativePath:      "/project/path-1",
-		TargetNodeStorage: "gitaly-1",
-		SourceNodeStorage: "gitaly-0",
-		VirtualStorage:    "praefect-1",
-	}}
-
-	eventType1 := eventType
-
-	eventType2 := eventType
-	eventType2.Job.VirtualStorage = "praefect-2"
-
-	eventType3 := eventType2
-	eventType3.Job.RelativePath = "/project/path-2"
-	eventType3.Job.TargetNodeStorage = "gitaly-2"
-
-	eventType4 := eventType3
-	eventType4.Job.TargetNodeStorage = "gitaly-3"
-
-	db := testdb.New(t)
-
-	t.Run("no stale jobs yet", func(t *testing.T) {
-		db.TruncateAll(t)
-		source := NewPostgresReplicationEventQueue(db)
-
-		event, err := source.Enqueue(ctx, eventType1)
-		require.NoError(t, err)
-
-		devents, err := source.Dequeue(ctx, event.Job.VirtualStorage, event.Job.TargetNodeStorage, 1)
-		require.NoError(t, err)
-
-		// events triggered just now (< 1 sec ago), so nothing considered stale
-		n, err := source.AcknowledgeStale(ctx, time.Second)
-		require.NoError(t, err)
-		requireEvents(t, ctx, db, devents)
-		require.Equal(t, n, int64(0))
-	})
-
-	t.Run("jobs considered stale only at 'in_progress' state", func(t *testing.T) {
-		db.TruncateAll(t)
-		source := NewPostgresReplicationEventQueue(db)
-
-		// move event to 'ready' state
-		event1, err := source.Enqueue(ctx, eventType1)
-		require.NoError(t, err)
-
-		// move event to 'failed' state
-		event2, err := source.Enqueue(ctx, eventType2)
-		require.NoError(t, err)
-		devents2, err := source.Dequeue(ctx, event2.Job.VirtualStorage, event2.Job.TargetNodeStorage, 1)
-		require.NoError(t, err)
-		require.Equal(t, event2.ID, devents2[0].ID)
-		_, err = source.Acknowledge(ctx, JobStateFailed, []uint64{devents2[0].ID})
-		require.NoError(t, err)
-
-		// move event to 'dead' state
-		event3, err := source.Enqueue(ctx, eventType3)
-		require.NoError(t, err)
-		devents3, err := source.Dequeue(ctx, event3.Job.VirtualStorage, event3.Job.TargetNodeStorage, 1)
-		require.NoError(t, err)
-		require.Equal(t, event3.ID, devents3[0].ID)
-		_, err = source.Acknowledge(ctx, JobStateDead, []uint64{devents3[0].ID})
-		require.NoError(t, err)
-
-		event4, err := source.Enqueue(ctx, eventType4)
-		require.NoError(t, err)
-		devents4, err := source.Dequeue(ctx, event4.Job.VirtualStorage, event4.Job.TargetNodeStorage, 1)
-		require.NoError(t, err)
-
-		n, err := source.AcknowledgeStale(ctx, time.Microsecond)
-		require.NoError(t, err)
-
-		devents2[0].State = JobStateFailed
-		devents4[0].Attempt = 2
-		devents4[0].State = JobStateFailed
-		requireEvents(t, ctx, db, []ReplicationEvent{event1, devents2[0], devents4[0]})
-		require.Equal(t, n, int64(1))
-	})
-
-	t.Run("stale jobs updated for all virtual storages and storages at once", func(t *testing.T) {
-		db.TruncateAll(t)
-		source := NewPostgresReplicationEventQueue(db)
-
-		var events []ReplicationEvent
-		for _, eventType := range []ReplicationEvent{eventType1, eventType2, eventType3} {
-			event, err := source.Enqueue(ctx, eventType)
-			require.NoError(t, err)
-			devents, err := source.Dequeue(ctx, event.Job.VirtualStorage, event.Job.TargetNodeStorage, 1)
-			require.NoError(t, err)
-			events = append(events, devents...)
-		}
-
-		for event, i := events[0], 0; i < 2; i++ { // consume all processing attempts to verify that state will be changed to 'dead'
-			_, err := source.Acknowledge(ctx, JobStateFailed, []uint64{event.ID})
-			require.NoError(t, err)
-			_, err = source.Dequeue(ctx, event.Job.VirtualStorage, event.Job.TargetNodeStorage, 1)
-			require.NoError(t, err)
-		}
-
-		n, err := source.AcknowledgeStale(ctx, time.Microsecond)
-		require.NoError(t, err)
-		require.Equal(t, n, int64(3))
-
-		var exp []ReplicationEvent
-		// The first event should be removed from table as its state changed to 'dead'.
-		for _, e := range events[1:] {
-			e.State = JobStateFailed
-			exp = append(exp, e)
-		}
-
-		requireEvents(t, ctx, db, exp)
-	})
-}
-
-// Check if the queue returns the expected error when adding a duplicate
-// replication event.
-func TestPostgresReplicationEventQueue_DuplicateEvent(t *testing.T) {
-	t.Parallel()
-
-	initialEvent := ReplicationEvent{
-		Job: ReplicationJob{
-			Change:            UpdateRepo,
-			RelativePath:      "/project/path-1",
-			TargetNodeStorage: "gitaly-1",
-			SourceNodeStorage: "gitaly-0",
-			VirtualStorage:    "praefect",
-			Params:            nil,
-		},
-	}
-
-	for _, tc := range []struct {
-		desc          string
-		event         ReplicationEvent
-		expectedErr   error
-		expectedEvent *ReplicationEvent
-		expectedLock  *LockRow
-	}{
-		{
-			desc:  "throws error when same event added again",
-			event: initialEvent,
-			expectedErr: ReplicationEventExistsError{
-				state:             "",
-				virtualStorage:    initialEvent.Job.VirtualStorage,
-				targetNodeStorage: initialEvent.Job.TargetNodeStorage,
-				relativePath:      initialEvent.Job.RelativePath,
-			},
-		},
-		{
-			desc: "Adds new event to the queue",
-			event: ReplicationEvent{
-				LockID: "praefect|gitaly-2|/project/path-2",
-				Job: ReplicationJob{
-					Change:            UpdateRepo,
-					RelativePath:      "/project/path-2",
-					TargetNodeStorage: "gitaly-2",
-					SourceNodeStorage: "gitaly-1",
-					VirtualStorage:    "praefect",
-					Params:            nil,
-				},
-			},
-			expectedEvent: &ReplicationEvent{
-				ID:      2,
-				State:   JobStateReady,
-				Attempt: 3,
-				LockID:  "praefect|gitaly-2|/project/path-2",
-				Job: ReplicationJob{
-					Change:            UpdateRepo,
-					RelativePath:      "/project/path-2",
-					TargetNodeStorage: "gitaly-2",
-					SourceNodeStorage: "gitaly-1",
-					VirtualStorage:    "praefect",
-					Params:            nil,
-				},
-			},
-			expectedLock: &LockRow{ID: "praefect|gitaly-2|/project/path-2", Acquired: false},
-		},
-	} {
-		tc := tc
-
-		t.Run(tc.desc, func(t *testing.T) {
-			t.Parallel()
-
-			db := testdb.New(t)
-			ctx := testhelper.Context(t)
-
-			queue := PostgresReplicationEventQueue{db.DB}
-
-			actualEvent, err := queue.Enqueue(ctx, initialEvent)
-			require.NoError(t, err)
-			// we need to setup it to default because it is not possible to get it beforehand for expected
-			actualEvent.CreatedAt = time.Time{}
-
-			initialExpectedLock := LockRow{ID: "praefect|gitaly-1|/project/path-1", Acquired: false}
-			initialExpectedEvent := ReplicationEvent{
-				ID:      1,
-				State:   JobStateReady,
-				Attempt: 3,
-				LockID:  "praefect|gitaly-1|/project/path-1",
-				Job: ReplicationJob{
-					Change:            UpdateRepo,
-					RelativePath:      "/project/path-1",
-					TargetNodeStorage: "gitaly-1",
-					SourceNodeStorage: "gitaly-0",
-					VirtualStorage:    "praefect",
-					Params:            nil,
-				},
-			}
-
-			require.Equal(t, initialExpectedEvent, actualEvent)
-			requireEvents(t, ctx, db, []ReplicationEvent{initialExpectedEvent})
-			// expected a new lock for new event
-			requireLocks(t, ctx, db, []LockRow{initialExpectedLock})
-			db.RequireRowsInTable(t, "replication_queue_job_lock", 0)
-
-			_, err = queue.Enqueue(ctx, tc.event)
-			require.Equal(t, tc.expectedErr, err)
-
-			if tc.expectedEvent != nil {
-				requireEvents(t, ctx, db, []ReplicationEvent{initialExpectedEvent, *tc.expectedEvent})
-				requireLocks(t, ctx, db, []LockRow{initialExpectedLock, *tc.expectedLock})
-			}
-		})
-	}
-}
-
 func requireEvents(t *testing.T, ctx context.Context, db testdb.DB, expected []ReplicationEvent) {
 	t.Helper()
 
 	// as it is not possible to expect exact time of entity creation/update we do not fetch it from database
 	// and we do not take it into account from expected values.
-	exp := make([]ReplicationEvent, len(expected)) // make a copy to avoid side effects for passed values
-	for i, e := range expected {
-		exp[i] = e
-		// set to default values as they would not be initialized from database
-		exp[i].CreatedAt = time.Time{}
-		exp[i].UpdatedAt = nil
-	}
+	exp := normalizeEvents(expected)
 
 	sqlStmt := `SELECT id, state, attempt, lock_id, job FROM replication_queue ORDER BY id`
 	rows, err := db.QueryContext(ctx, sqlStmt)
@@ -1156,6 +1277,20 @@ func requireEvents(t *testing.T, ctx context.Context, db testdb.DB, expected []R
 	require.Equal(t, exp, actual)
 }
 
+// normalizeEvents returns a copy of the events with the fields that can't be expected reset to
+// their default values.
+func normalizeEvents(events []ReplicationEvent) []ReplicationEvent {
+	var normalized []ReplicationEvent
+	for _, event := range events {
+		// as it is not possible to expect exact time of entity creation/update we reset it
+		event.CreatedAt = time.Time{}
+		event.UpdatedAt = nil
+		normalized = append(normalized, event)
+	}
+
+	return normalized
+}
+
 // LockRow exists only for testing purposes and represents entries from replication_queue_lock table.
 type LockRow struct {
 	ID       string
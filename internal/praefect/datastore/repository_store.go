@@ -50,8 +50,9 @@ var (
 type RepositoryStore interface {
 	// GetGeneration gets the repository's generation on a given storage.
 	GetGeneration(ctx context.Context, repositoryID int64, storage string) (int, error)
-	// IncrementGeneration increments the generations of up to date nodes.
-	IncrementGeneration(ctx context.Context, repositoryID int64, primary string, secondaries []string) error
+	// IncrementGeneration increments the generations of up to date nodes. It returns the repository's
+	// new generation.
+	IncrementGeneration(ctx context.Context, repositoryID int64, primary string, secondaries []string) (int, error)
 	// SetGeneration sets the repository's generation on the given storage. If the generation is higher
 	// than the virtual storage's generation, it is set to match as well to guarantee monotonic increments.
 	SetGeneration(ctx context.Context, repositoryID int64, storage, relativePath string, generation int) error
@@ -204,9 +205,16 @@ AND storage = $2
 }
 
 //nolint:revive // This is unintentionally missing documentation.
-func (rs *PostgresRepositoryStore) IncrementGeneration(ctx context.Context, repositoryID int64, primary string, secondaries []string) error {
+func (rs *PostgresRepositoryStore) IncrementGeneration(ctx context.Context, repositoryID int64, primary string, secondaries []string) (int, error) {
 	const q = `
-WITH updated_replicas AS (
+WITH audit_settings AS (
+	SELECT
+		set_config('praefect.audit_actor', $3, true),
+		set_config('praefect.audit_reason', $4, true),
+		set_config('praefect.audit_log_notify', $5, true)
+),
+
+updated_replicas AS (
 	UPDATE storage_repositories
 	SET generation = generation + 1
 	FROM (
@@ -219,6 +227,7 @@ WITH updated_replicas AS (
 	) AS to_update
 	WHERE storage_repositories.repository_id = to_update.repository_id
 	AND   storage_repositories.storage       = to_update.storage
+	AND   ( SELECT true FROM audit_settings )
 	RETURNING storage_repositories.repository_id
 ),
 
@@ -230,6 +239,7 @@ updated_repository AS (
 		FROM updated_replicas
 	) AS updated_repositories
 	WHERE repositories.repository_id = updated_repositories.repository_id
+	RETURNING repositories.generation
 )
 
 SELECT
@@ -237,33 +247,44 @@ SELECT
 		SELECT FROM repositories
 		WHERE repository_id = $1
 	) AS repository_exists,
-	EXISTS ( SELECT FROM updated_replicas ) AS repository_updated
+	( SELECT generation FROM updated_repository ) AS generation
 `
-	var repositoryExists, repositoryUpdated bool
+	actor, reason, notify := AuditSettings(ctx)
+
+	var repositoryExists bool
+	var generation sql.NullInt64
 	if err := rs.db.QueryRowContext(
-		ctx, q, repositoryID, append(secondaries, primary),
-	).Scan(&repositoryExists, &repositoryUpdated); err != nil {
-		return fmt.Errorf("scan: %w", err)
+		ctx, q, repositoryID, append(secondaries, primary), actor, reason, notify,
+	).Scan(&repositoryExists, &generation); err != nil {
+		return 0, fmt.Errorf("scan: %w", err)
 	}
 
 	if !repositoryExists {
-		return ErrRepositoryNotFound
+		return 0, ErrRepositoryNotFound
 	}
 
-	if !repositoryUpdated {
-		return errWriteToOutdatedNodes
+	if !generation.Valid {
+		return 0, errWriteToOutdatedNodes
 	}
 
-	return nil
+	return int(generation.Int64), nil
 }
 
 //nolint:revive // This is unintentionally missing documentation.
 func (rs *PostgresRepositoryStore) SetGeneration(ctx context.Context, repositoryID int64, storage, relativePath string, generation int) error {
 	const q = `
-WITH repository AS (
+WITH audit_settings AS (
+	SELECT
+		set_config('praefect.audit_actor', $5, true),
+		set_config('praefect.audit_reason', $6, true),
+		set_config('praefect.audit_log_notify', $7, true)
+),
+
+repository AS (
 	UPDATE repositories SET generation = $3
 	WHERE repository_id = $1
 	AND   COALESCE(repositories.generation, -1) < $3
+	AND   ( SELECT true FROM audit_settings )
 )
 
 INSERT INTO storage_repositories (
@@ -281,23 +302,34 @@ SELECT
 	$3
 FROM repositories
 WHERE repository_id = $1
+AND ( SELECT true FROM audit_settings )
 ON CONFLICT (repository_id, storage) DO UPDATE SET
 	relative_path = EXCLUDED.relative_path,
 	generation = EXCLUDED.generation
 `
 
-	_, err := rs.db.ExecContext(ctx, q, repositoryID, storage, generation, relativePath)
+	actor, reason, notify := AuditSettings(ctx)
+	_, err := rs.db.ExecContext(ctx, q, repositoryID, storage, generation, relativePath, actor, reason, notify)
 	return err
 }
 
 // SetAuthoritativeReplica sets the given replica of a repsitory as the authoritative one by setting its generation as the latest one.
 func (rs *PostgresRepositoryStore) SetAuthoritativeReplica(ctx context.Context, virtualStorage, relativePath, storageName string) error {
+	actor, reason, notify := AuditSettings(ctx)
 	result, err := rs.db.ExecContext(ctx, `
-WITH updated_repository AS (
+WITH audit_settings AS (
+	SELECT
+		set_config('praefect.audit_actor', $4, true),
+		set_config('praefect.audit_reason', $5, true),
+		set_config('praefect.audit_log_notify', $6, true)
+),
+
+updated_repository AS (
 	UPDATE repositories
 	SET generation = generation + 1
 	WHERE virtual_storage = $1
 	AND   relative_path   = $2
+	AND   ( SELECT true FROM audit_settings )
 	RETURNING repository_id, virtual_storage, relative_path, generation
 )
 
@@ -307,7 +339,7 @@ FROM updated_repository
 ON CONFLICT (virtual_storage, relative_path, storage) DO UPDATE
 	SET repository_id = EXCLUDED.repository_id,
 	    generation = EXCLUDED.generation
-	`, virtualStorage, relativePath, storageName)
+	`, virtualStorage, relativePath, storageName, actor, reason, notify)
 	if err != nil {
 		return fmt.Errorf("exec: %w", err)
 	}
@@ -383,7 +415,14 @@ AND storage = ANY($2)
 // secondaries are stored as the assigned hosts of the repository.
 func (rs *PostgresRepositoryStore) CreateRepository(ctx context.Context, repositoryID int64, virtualStorage, relativePath, replicaPath, primary string, updatedSecondaries, outdatedSecondaries []string, storePrimary, storeAssignments bool) error {
 	const q = `
-WITH repo AS (
+WITH audit_settings AS (
+	SELECT
+		set_config('praefect.audit_actor', $10, true),
+		set_config('praefect.audit_reason', $11, true),
+		set_config('praefect.audit_log_notify', $12, true)
+),
+
+repo AS (
 	INSERT INTO repositories (
 		repository_id,
 		virtual_storage,
@@ -391,7 +430,9 @@ WITH repo AS (
 		replica_path,
 		generation,
 		"primary"
-	) VALUES ($8, $1, $2, $9, 0, CASE WHEN $4 THEN $3 END)
+	)
+	SELECT $8, $1, $2, $9, 0, CASE WHEN $4 THEN $3 END
+	WHERE ( SELECT true FROM audit_settings )
 ),
 
 assignments AS (
@@ -410,6 +451,7 @@ assignments AS (
 		SELECT unnest($6::text[])
 	) AS storages
 	WHERE $7
+	AND ( SELECT true FROM audit_settings )
 )
 
 INSERT INTO storage_repositories (
@@ -425,8 +467,10 @@ FROM (
 	UNION
 	SELECT unnest($5::text[])
 ) AS updated_storages
+WHERE ( SELECT true FROM audit_settings )
 `
 
+	actor, reason, notify := AuditSettings(ctx)
 	_, err := rs.db.ExecContext(ctx, q,
 		virtualStorage,
 		relativePath,
@@ -437,6 +481,9 @@ FROM (
 		storeAssignments,
 		repositoryID,
 		replicaPath,
+		actor,
+		reason,
+		notify,
 	)
 	if err != nil {
 		if glsql.IsUniqueViolation(err, "repositories_pkey") {
@@ -460,11 +507,20 @@ func (rs *PostgresRepositoryStore) DeleteRepository(ctx context.Context, virtual
 		storages    glsql.StringArray
 	)
 
+	actor, reason, notify := AuditSettings(ctx)
 	if err := rs.db.QueryRowContext(ctx, `
-WITH repository AS (
+WITH audit_settings AS (
+	SELECT
+		set_config('praefect.audit_actor', $3, true),
+		set_config('praefect.audit_reason', $4, true),
+		set_config('praefect.audit_log_notify', $5, true)
+),
+
+repository AS (
 	DELETE FROM repositories
 	WHERE virtual_storage = $1
 	AND relative_path = $2
+	AND ( SELECT true FROM audit_settings )
 	RETURNING repository_id, replica_path
 )
 
@@ -472,7 +528,7 @@ SELECT replica_path, ARRAY_AGG(storage_repositories.storage)
 FROM repository
 LEFT JOIN storage_repositories USING (repository_id)
 GROUP BY replica_path
-		`, virtualStorage, relativePath,
+		`, virtualStorage, relativePath, actor, reason, notify,
 	).Scan(&replicaPath, &storages); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return "", nil, ErrRepositoryNotFound
@@ -486,8 +542,16 @@ GROUP BY replica_path
 
 //nolint:revive // This is unintentionally missing documentation.
 func (rs *PostgresRepositoryStore) DeleteAllRepositories(ctx context.Context, virtualStorage string) error {
+	actor, reason, notify := AuditSettings(ctx)
 	_, err := rs.db.ExecContext(ctx, `
-WITH delete_jobs AS (
+WITH audit_settings AS (
+	SELECT
+		set_config('praefect.audit_actor', $2, true),
+		set_config('praefect.audit_reason', $3, true),
+		set_config('praefect.audit_log_notify', $4, true)
+),
+
+delete_jobs AS (
   DELETE FROM replication_queue
   WHERE job->>'virtual_storage' = $1
   RETURNING id
@@ -505,8 +569,9 @@ delete_locks AS (
 )
 
 DELETE FROM repositories
-WHERE virtual_storage = $1;
-	`, virtualStorage)
+WHERE virtual_storage = $1
+AND ( SELECT true FROM audit_settings );
+	`, virtualStorage, actor, reason, notify)
 	if err != nil {
 		return err
 	}
@@ -516,11 +581,20 @@ WHERE virtual_storage = $1;
 
 // DeleteReplica deletes a record from the `storage_repositories`. See the interface documentation for details.
 func (rs *PostgresRepositoryStore) DeleteReplica(ctx context.Context, repositoryID int64, storage string) error {
+	actor, reason, notify := AuditSettings(ctx)
 	result, err := rs.db.ExecContext(ctx, `
+WITH audit_settings AS (
+	SELECT
+		set_config('praefect.audit_actor', $3, true),
+		set_config('praefect.audit_reason', $4, true),
+		set_config('praefect.audit_log_notify', $5, true)
+)
+
 DELETE FROM storage_repositories
 WHERE repository_id = $1
 AND storage = $2
-	`, repositoryID, storage)
+AND ( SELECT true FROM audit_settings )
+	`, repositoryID, storage, actor, reason, notify)
 	if err != nil {
 		return err
 	}
@@ -537,19 +611,28 @@ AND storage = $2
 // RenameRepositoryInPlace renames the repository in the database without changing the replica path. This will replace
 // RenameRepository which can be removed in a later release.
 func (rs *PostgresRepositoryStore) RenameRepositoryInPlace(ctx context.Context, virtualStorage, relativePath, newRelativePath string) error {
+	actor, reason, notify := AuditSettings(ctx)
 	result, err := rs.db.ExecContext(ctx, `
-WITH repository AS (
+WITH audit_settings AS (
+	SELECT
+		set_config('praefect.audit_actor', $4, true),
+		set_config('praefect.audit_reason', $5, true),
+		set_config('praefect.audit_log_notify', $6, true)
+),
+
+repository AS (
 	UPDATE repositories
 	SET relative_path = $3
 	WHERE virtual_storage = $1
 	AND   relative_path   = $2
+	AND   ( SELECT true FROM audit_settings )
 	RETURNING repository_id
 )
 
 UPDATE storage_repositories
 SET relative_path = $3
 WHERE repository_id = (SELECT repository_id FROM repository)
-	`, virtualStorage, relativePath, newRelativePath)
+	`, virtualStorage, relativePath, newRelativePath, actor, reason, notify)
 	if err != nil {
 		if glsql.IsUniqueViolation(err, "repository_lookup_index") {
 			return ErrRepositoryAlreadyExists
@@ -570,12 +653,20 @@ WHERE repository_id = (SELECT repository_id FROM repository)
 //nolint:revive // This is unintentionally missing documentation.
 func (rs *PostgresRepositoryStore) RenameRepository(ctx context.Context, virtualStorage, relativePath, storage, newRelativePath string) error {
 	const q = `
-WITH repo AS (
+WITH audit_settings AS (
+	SELECT
+		set_config('praefect.audit_actor', $5, true),
+		set_config('praefect.audit_reason', $6, true),
+		set_config('praefect.audit_log_notify', $7, true)
+),
+
+repo AS (
 	UPDATE repositories
 	SET relative_path = $4,
 	    replica_path  = $4
 	WHERE virtual_storage = $1
 	AND relative_path = $2
+	AND ( SELECT true FROM audit_settings )
 )
 
 UPDATE storage_repositories
@@ -585,7 +676,8 @@ AND relative_path = $2
 AND storage = $3
 `
 
-	result, err := rs.db.ExecContext(ctx, q, virtualStorage, relativePath, storage, newRelativePath)
+	actor, reason, notify := AuditSettings(ctx)
+	result, err := rs.db.ExecContext(ctx, q, virtualStorage, relativePath, storage, newRelativePath, actor, reason, notify)
 	if err != nil {
 		return err
 	}
@@ -662,8 +754,16 @@ AND relative_path = $2
 // DeleteInvalidRepository deletes the given replica. If the replica was the only replica of the
 // repository, then the repository will be deleted, as well.
 func (rs *PostgresRepositoryStore) DeleteInvalidRepository(ctx context.Context, repositoryID int64, storage string) error {
+	actor, reason, notify := AuditSettings(ctx)
 	_, err := rs.db.ExecContext(ctx, `
-WITH repository AS (
+WITH audit_settings AS (
+	SELECT
+		set_config('praefect.audit_actor', $3, true),
+		set_config('praefect.audit_reason', $4, true),
+		set_config('praefect.audit_log_notify', $5, true)
+),
+
+repository AS (
 	SELECT repository_id
 	FROM repositories
 	WHERE repository_id = $1
@@ -675,6 +775,7 @@ invalid_repository AS (
 	USING repository
 	WHERE storage_repositories.repository_id = repository.repository_id
 	AND storage = $2
+	AND ( SELECT true FROM audit_settings )
 )
 
 DELETE FROM repositories
@@ -686,7 +787,8 @@ AND NOT EXISTS (
 	WHERE repository_id = $1
 	AND storage != $2
 )
-	`, repositoryID, storage)
+AND ( SELECT true FROM audit_settings )
+	`, repositoryID, storage, actor, reason, notify)
 	return err
 }
 
@@ -11,7 +11,7 @@ import (
 type MockRepositoryStore struct {
 	RepositoryStore
 	GetGenerationFunc                       func(ctx context.Context, repositoryID int64, storage string) (int, error)
-	IncrementGenerationFunc                 func(ctx context.Context, repositoryID int64, primary string, secondaries []string) error
+	IncrementGenerationFunc                 func(ctx context.Context, repositoryID int64, primary string, secondaries []string) (int, error)
 	GetReplicatedGenerationFunc             func(ctx context.Context, repositoryID int64, source, target string) (int, error)
 	SetGenerationFunc                       func(ctx context.Context, repositoryID int64, storage, relativePath string, generation int) error
 	CreateRepositoryFunc                    func(ctx context.Context, repositoryID int64, virtualStorage, relativePath, replicaPath, primary string, updatedSecondaries, outdatedSecondaries []string, storePrimary, storeAssignments bool) error
@@ -42,9 +42,9 @@ func (m MockRepositoryStore) GetGeneration(ctx context.Context, repositoryID int
 }
 
 //nolint:revive // This is unintentionally missing documentation.
-func (m MockRepositoryStore) IncrementGeneration(ctx context.Context, repositoryID int64, primary string, secondaries []string) error {
+func (m MockRepositoryStore) IncrementGeneration(ctx context.Context, repositoryID int64, primary string, secondaries []string) (int, error) {
 	if m.IncrementGenerationFunc == nil {
-		return nil
+		return 0, nil
 	}
 
 	return m.IncrementGenerationFunc(ctx, repositoryID, primary, secondaries)
@@ -109,14 +109,47 @@ FROM storage_repositories
 	requireStorageState(tb, ctx, ss)
 }
 
+// repositoryStoreBackend provides a RepositoryStore implementation for the shared test suite
+// alongside the helpers to inspect the state of its database.
+type repositoryStoreBackend struct {
+	// newRepositoryStore returns a RepositoryStore backed by an empty database.
+	newRepositoryStore func(t *testing.T, storages map[string][]string) RepositoryStore
+	// newQueue returns a ReplicationEventQueue sharing the database with the most recently created
+	// RepositoryStore.
+	newQueue        func(t *testing.T) ReplicationEventQueue
+	requireState    func(tb testing.TB, ctx context.Context, vss virtualStorageState, ss storageState)
+	requireLocks    func(t *testing.T, ctx context.Context, expected []LockRow)
+	requireJobLocks func(t *testing.T, ctx context.Context, expected []JobLockRow)
+}
+
 func TestRepositoryStore_Postgres(t *testing.T) {
 	db := testdb.New(t)
 
-	newRepositoryStore := func(t *testing.T, storages map[string][]string) RepositoryStore {
-		db.TruncateAll(t)
-		gs := NewPostgresRepositoryStore(db, storages)
-		return gs
-	}
+	testRepositoryStore(t, repositoryStoreBackend{
+		newRepositoryStore: func(t *testing.T, storages map[string][]string) RepositoryStore {
+			db.TruncateAll(t)
+			return NewPostgresRepositoryStore(db, storages)
+		},
+		newQueue: func(t *testing.T) ReplicationEventQueue {
+			return PostgresReplicationEventQueue{db}
+		},
+		requireState: func(tb testing.TB, ctx context.Context, vss virtualStorageState, ss storageState) {
+			tb.Helper()
+			requireState(tb, ctx, db, vss, ss)
+		},
+		requireLocks: func(t *testing.T, ctx context.Context, expected []LockRow) {
+			t.Helper()
+			requireLocks(t, ctx, db, expected)
+		},
+		requireJobLocks: func(t *testing.T, ctx context.Context, expected []JobLockRow) {
+			t.Helper()
+			requireJobLocks(t, ctx, db, expected)
+		},
+	})
+}
+
+func testRepositoryStore(t *testing.T, backend repositoryStoreBackend) {
+	newRepositoryStore := backend.newRepositoryStore
 
 	ctx := testhelper.Context(t)
 
@@ -130,11 +163,9 @@ func TestRepositoryStore_Postgres(t *testing.T) {
 		t.Run("doesn't create new records", func(t *testing.T) {
 			rs := newRepositoryStore(t, nil)
 
-			require.Equal(t,
-				ErrRepositoryNotFound,
-				rs.IncrementGeneration(ctx, 1, "primary", []string{"secondary-1"}),
-			)
-			requireState(t, ctx, db, virtualStorageState{}, storageState{})
+			_, err := rs.IncrementGeneration(ctx, 1, "primary", []string{"secondary-1"})
+			require.Equal(t, ErrRepositoryNotFound, err)
+			backend.requireState(t, ctx, virtualStorageState{}, storageState{})
 		})
 
 		t.Run("write to outdated nodes", func(t *testing.T) {
@@ -143,11 +174,9 @@ func TestRepositoryStore_Postgres(t *testing.T) {
 			require.NoError(t, rs.CreateRepository(ctx, 1, vs, repo, "replica-path", "latest-node", []string{"outdated-primary", "outdated-secondary"}, nil, false, false))
 			require.NoError(t, rs.SetGeneration(ctx, 1, "latest-node", repo, 1))
 
-			require.Equal(t,
-				rs.IncrementGeneration(ctx, 1, "outdated-primary", []string{"outdated-secondary"}),
-				errWriteToOutdatedNodes,
-			)
-			requireState(t, ctx, db,
+			_, err := rs.IncrementGeneration(ctx, 1, "outdated-primary", []string{"outdated-secondary"})
+			require.Equal(t, errWriteToOutdatedNodes, err)
+			backend.requireState(t, ctx,
 				virtualStorageState{
 					"virtual-storage-1": {
 						"repository-1": {repositoryID: 1, replicaPath: "replica-path"},
@@ -178,9 +207,11 @@ func TestRepositoryStore_Postgres(t *testing.T) {
 				require.NoError(t, rs.CreateRepository(ctx, int64(id+1), pair.virtualStorage, pair.relativePath, fmt.Sprintf("replica-path-%d", id+1), "primary", []string{"up-to-date-secondary", "outdated-secondary"}, nil, false, false))
 			}
 
-			require.NoError(t, rs.IncrementGeneration(ctx, 1, "primary", []string{"up-to-date-secondary"}))
+			generation, err := rs.IncrementGeneration(ctx, 1, "primary", []string{"up-to-date-secondary"})
+			require.NoError(t, err)
+			require.Equal(t, 1, generation)
 
-			requireState(t, ctx, db,
+			backend.requireState(t, ctx,
 				virtualStorageState{
 					"virtual-storage-1": {
 						"repository-1":        {repositoryID: 1, replicaPath: "replica-path-1"},
@@ -213,10 +244,12 @@ func TestRepositoryStore_Postgres(t *testing.T) {
 				},
 			)
 
-			require.NoError(t, rs.IncrementGeneration(ctx, 1, "primary", []string{
+			generation, err = rs.IncrementGeneration(ctx, 1, "primary", []string{
 				"up-to-date-secondary", "outdated-secondary", "non-existing-secondary",
-			}))
-			requireState(t, ctx, db,
+			})
+			require.NoError(t, err)
+			require.Equal(t, 2, generation)
+			backend.requireState(t, ctx,
 				virtualStorageState{
 					"virtual-storage-1": {
 						"repository-1":        {repositoryID: 1, replicaPath: "replica-path-1"},
@@ -257,7 +290,7 @@ func TestRepositoryStore_Postgres(t *testing.T) {
 
 			require.NoError(t, rs.CreateRepository(ctx, 1, vs, repo, "replica-path", stor, nil, nil, false, false))
 			require.NoError(t, rs.SetGeneration(ctx, 1, "storage-2", repo, 0))
-			requireState(t, ctx, db,
+			backend.requireState(t, ctx,
 				virtualStorageState{"virtual-storage-1": {
 					"repository-1": {repositoryID: 1, replicaPath: "replica-path"},
 				}},
@@ -276,7 +309,7 @@ func TestRepositoryStore_Postgres(t *testing.T) {
 			rs := newRepositoryStore(t, nil)
 
 			require.NoError(t, rs.CreateRepository(ctx, 1, vs, "original-path", "replica-path", "storage-1", []string{"storage-2"}, nil, true, false))
-			requireState(t, ctx, db,
+			backend.requireState(t, ctx,
 				virtualStorageState{
 					"virtual-storage-1": {
 						"original-path": {repositoryID: 1, primary: "storage-1", replicaPath: "replica-path"},
@@ -293,7 +326,7 @@ func TestRepositoryStore_Postgres(t *testing.T) {
 			)
 
 			require.NoError(t, rs.RenameRepository(ctx, vs, "original-path", "storage-1", "new-path"))
-			requireState(t, ctx, db,
+			backend.requireState(t, ctx,
 				virtualStorageState{
 					"virtual-storage-1": {
 						"new-path": {repositoryID: 1, primary: "storage-1", replicaPath: "new-path"},
@@ -312,7 +345,7 @@ func TestRepositoryStore_Postgres(t *testing.T) {
 			)
 
 			require.NoError(t, rs.SetGeneration(ctx, 1, "storage-2", "new-path", 1))
-			requireState(t, ctx, db,
+			backend.requireState(t, ctx,
 				virtualStorageState{
 					"virtual-storage-1": {
 						"new-path": {repositoryID: 1, primary: "storage-1", replicaPath: "new-path"},
@@ -335,7 +368,7 @@ func TestRepositoryStore_Postgres(t *testing.T) {
 			require.NoError(t, rs.CreateRepository(ctx, 1, vs, repo, "replica-path", "storage-1", nil, nil, false, false))
 			require.NoError(t, rs.SetGeneration(ctx, 1, stor, repo, 1))
 			require.NoError(t, rs.SetGeneration(ctx, 1, stor, repo, 0))
-			requireState(t, ctx, db,
+			backend.requireState(t, ctx,
 				virtualStorageState{
 					"virtual-storage-1": {
 						"repository-1": {repositoryID: 1, replicaPath: "replica-path"},
@@ -366,7 +399,7 @@ func TestRepositoryStore_Postgres(t *testing.T) {
 			rs := newRepositoryStore(t, nil)
 
 			require.NoError(t, rs.CreateRepository(ctx, 1, vs, repo, "replica-path", "storage-1", []string{"storage-2"}, nil, false, false))
-			requireState(t, ctx, db,
+			backend.requireState(t, ctx,
 				virtualStorageState{
 					"virtual-storage-1": {
 						"repository-1": {repositoryID: 1, replicaPath: "replica-path"},
@@ -383,7 +416,7 @@ func TestRepositoryStore_Postgres(t *testing.T) {
 			)
 
 			require.NoError(t, rs.SetAuthoritativeReplica(ctx, vs, repo, "storage-1"))
-			requireState(t, ctx, db,
+			backend.requireState(t, ctx,
 				virtualStorageState{
 					"virtual-storage-1": {
 						"repository-1": {repositoryID: 1, replicaPath: "replica-path"},
@@ -404,7 +437,7 @@ func TestRepositoryStore_Postgres(t *testing.T) {
 			rs := newRepositoryStore(t, nil)
 
 			require.NoError(t, rs.CreateRepository(ctx, 1, vs, repo, "replica-path", "storage-1", nil, nil, false, false))
-			requireState(t, ctx, db,
+			backend.requireState(t, ctx,
 				virtualStorageState{
 					"virtual-storage-1": {
 						"repository-1": {repositoryID: 1, replicaPath: "replica-path"},
@@ -420,7 +453,7 @@ func TestRepositoryStore_Postgres(t *testing.T) {
 			)
 
 			require.NoError(t, rs.SetAuthoritativeReplica(ctx, vs, repo, "storage-2"))
-			requireState(t, ctx, db,
+			backend.requireState(t, ctx,
 				virtualStorageState{
 					"virtual-storage-1": {
 						"repository-1": {repositoryID: 1, replicaPath: "replica-path"},
@@ -470,7 +503,8 @@ func TestRepositoryStore_Postgres(t *testing.T) {
 			rs := newRepositoryStore(t, nil)
 
 			require.NoError(t, rs.CreateRepository(ctx, 1, vs, repo, "replica-path", "source", nil, nil, false, false))
-			require.NoError(t, rs.IncrementGeneration(ctx, 1, "source", nil))
+			_, err := rs.IncrementGeneration(ctx, 1, "source", nil)
+			require.NoError(t, err)
 
 			gen, err := rs.GetReplicatedGeneration(ctx, 1, "source", "target")
 			require.NoError(t, err)
@@ -486,9 +520,10 @@ func TestRepositoryStore_Postgres(t *testing.T) {
 			rs := newRepositoryStore(t, nil)
 
 			require.NoError(t, rs.CreateRepository(ctx, 1, vs, repo, "replica-path", "target", nil, nil, false, false))
-			require.NoError(t, rs.IncrementGeneration(ctx, 1, "target", nil))
+			_, err := rs.IncrementGeneration(ctx, 1, "target", nil)
+			require.NoError(t, err)
 
-			_, err := rs.GetReplicatedGeneration(ctx, 1, "source", "target")
+			_, err = rs.GetReplicatedGeneration(ctx, 1, "source", "target")
 			require.Equal(t, DowngradeAttemptedError{"target", 1, GenerationUnknown}, err)
 
 			require.NoError(t, rs.SetGeneration(ctx, 1, "source", repo, 1))
@@ -580,7 +615,7 @@ func TestRepositoryStore_Postgres(t *testing.T) {
 						expectedStorageState[vs][repo][updatedSecondary] = replicaRecord{repositoryID: 1, generation: 0}
 					}
 
-					requireState(t, ctx, db,
+					backend.requireState(t, ctx,
 						virtualStorageState{
 							vs: {
 								repo: {
@@ -619,8 +654,8 @@ func TestRepositoryStore_Postgres(t *testing.T) {
 	})
 
 	t.Run("DeleteAllRepositories", func(t *testing.T) {
-		queue := PostgresReplicationEventQueue{db}
 		rs := newRepositoryStore(t, nil)
+		queue := backend.newQueue(t)
 
 		require.NoError(t, rs.CreateRepository(ctx, 1, "virtual-storage-1", "repository-1", "replica-path-1", "storage-1", nil, nil, false, false))
 		require.NoError(t, rs.CreateRepository(ctx, 2, "virtual-storage-2", "repository-1", "replica-path-2", "storage-1", []string{"storage-2"}, nil, false, false))
@@ -686,15 +721,15 @@ func TestRepositoryStore_Postgres(t *testing.T) {
 		}
 		require.Equal(t, expectedEvents, dequeuedEvents)
 
-		requireLocks(t, ctx, db, []LockRow{
+		backend.requireLocks(t, ctx, []LockRow{
 			{ID: "virtual-storage-1|gitaly-1|/project/path-1", Acquired: false},
 			{ID: "virtual-storage-1|gitaly-1|/project/path-2", Acquired: false},
 			{ID: "virtual-storage-2|gitaly-1|/project/path-3", Acquired: true},
 			{ID: "virtual-storage-2|gitaly-1|/project/path-4", Acquired: false},
 		})
-		requireJobLocks(t, ctx, db, expectedJobLocks)
+		backend.requireJobLocks(t, ctx, expectedJobLocks)
 
-		requireState(t, ctx, db,
+		backend.requireState(t, ctx,
 			virtualStorageState{
 				"virtual-storage-1": {
 					"repository-1": {repositoryID: 1, replicaPath: "replica-path-1"},
@@ -724,13 +759,13 @@ func TestRepositoryStore_Postgres(t *testing.T) {
 
 		require.NoError(t, rs.DeleteAllRepositories(ctx, "virtual-storage-2"))
 
-		requireLocks(t, ctx, db, []LockRow{
+		backend.requireLocks(t, ctx, []LockRow{
 			{ID: "virtual-storage-1|gitaly-1|/project/path-1", Acquired: false},
 			{ID: "virtual-storage-1|gitaly-1|/project/path-2", Acquired: false},
 		})
-		requireJobLocks(t, ctx, db, []JobLockRow{})
+		backend.requireJobLocks(t, ctx, []JobLockRow{})
 
-		requireState(t, ctx, db,
+		backend.requireState(t, ctx,
 			virtualStorageState{
 				"virtual-storage-1": {
 					"repository-1": {repositoryID: 1, replicaPath: "replica-path-1"},
@@ -763,7 +798,7 @@ func TestRepositoryStore_Postgres(t *testing.T) {
 			require.NoError(t, rs.CreateRepository(ctx, 2, "virtual-storage-2", "repository-1", "replica-path-2", "storage-1", []string{"storage-2"}, nil, false, false))
 			require.NoError(t, rs.CreateRepository(ctx, 3, "virtual-storage-2", "repository-2", "replica-path-3", "storage-1", nil, nil, false, false))
 
-			requireState(t, ctx, db,
+			backend.requireState(t, ctx,
 				virtualStorageState{
 					"virtual-storage-1": {
 						"repository-1": {repositoryID: 1, replicaPath: "replica-path-1"},
@@ -796,7 +831,7 @@ func TestRepositoryStore_Postgres(t *testing.T) {
 			require.Equal(t, "replica-path-2", replicaPath)
 			require.Equal(t, []string{"storage-1", "storage-2"}, storages)
 
-			requireState(t, ctx, db,
+			backend.requireState(t, ctx,
 				virtualStorageState{
 					"virtual-storage-1": {
 						"repository-1": {repositoryID: 1, replicaPath: "replica-path-1"},
@@ -833,7 +868,7 @@ func TestRepositoryStore_Postgres(t *testing.T) {
 			require.NoError(t, rs.CreateRepository(ctx, 2, "virtual-storage-1", "relative-path-2", "replica-path-2", "storage-1", nil, nil, false, false))
 			require.NoError(t, rs.CreateRepository(ctx, 3, "virtual-storage-2", "relative-path-1", "replica-path-3", "storage-1", nil, nil, false, false))
 
-			requireState(t, ctx, db,
+			backend.requireState(t, ctx,
 				virtualStorageState{
 					"virtual-storage-1": {
 						"relative-path-1": {repositoryID: 1, replicaPath: "replica-path-1"},
@@ -863,7 +898,7 @@ func TestRepositoryStore_Postgres(t *testing.T) {
 
 			require.NoError(t, rs.DeleteReplica(ctx, 1, "storage-1"))
 
-			requireState(t, ctx, db,
+			backend.requireState(t, ctx,
 				virtualStorageState{
 					"virtual-storage-1": {
 						"relative-path-1": {repositoryID: 1, replicaPath: "replica-path-1"},
@@ -919,7 +954,7 @@ func TestRepositoryStore_Postgres(t *testing.T) {
 
 			require.NoError(t, rs.CreateRepository(ctx, 1, vs, "original-relative-path", "original-replica-path", "primary", nil, nil, false, false))
 			require.NoError(t, rs.RenameRepositoryInPlace(ctx, vs, "original-relative-path", "renamed-relative-path"))
-			requireState(t, ctx, db,
+			backend.requireState(t, ctx,
 				virtualStorageState{
 					vs: {
 						"renamed-relative-path": {repositoryID: 1, replicaPath: "original-replica-path"},
@@ -952,7 +987,7 @@ func TestRepositoryStore_Postgres(t *testing.T) {
 			require.NoError(t, rs.CreateRepository(ctx, 1, vs, "renamed-all", "replica-path-1", "storage-1", nil, nil, false, false))
 			require.NoError(t, rs.CreateRepository(ctx, 2, vs, "renamed-some", "replica-path-2", "storage-1", []string{"storage-2"}, nil, false, false))
 
-			requireState(t, ctx, db,
+			backend.requireState(t, ctx,
 				virtualStorageState{
 					"virtual-storage-1": {
 						"renamed-all":  {repositoryID: 1, replicaPath: "replica-path-1"},
@@ -975,7 +1010,7 @@ func TestRepositoryStore_Postgres(t *testing.T) {
 			require.NoError(t, rs.RenameRepository(ctx, vs, "renamed-all", "storage-1", "renamed-all-new"))
 			require.NoError(t, rs.RenameRepository(ctx, vs, "renamed-some", "storage-1", "renamed-some-new"))
 
-			requireState(t, ctx, db,
+			backend.requireState(t, ctx,
 				virtualStorageState{
 					"virtual-storage-1": {
 						"renamed-all-new":  {repositoryID: 1, replicaPath: "renamed-all-new"},
@@ -1017,9 +1052,10 @@ func TestRepositoryStore_Postgres(t *testing.T) {
 		})
 
 		require.NoError(t, rs.CreateRepository(ctx, 1, vs, repo, "replica-path", "primary", []string{"consistent-secondary"}, nil, false, false))
-		require.NoError(t, rs.IncrementGeneration(ctx, 1, "primary", []string{"consistent-secondary"}))
+		_, err := rs.IncrementGeneration(ctx, 1, "primary", []string{"consistent-secondary"})
+		require.NoError(t, err)
 		require.NoError(t, rs.SetGeneration(ctx, 1, "inconsistent-secondary", repo, 0))
-		requireState(t, ctx, db,
+		backend.requireState(t, ctx,
 			virtualStorageState{
 				"virtual-storage-1": {
 					"repository-1": {repositoryID: 1, replicaPath: "replica-path"},
@@ -1065,7 +1101,7 @@ func TestRepositoryStore_Postgres(t *testing.T) {
 		t.Run("storage with highest generation is not configured", func(t *testing.T) {
 			require.NoError(t, rs.SetGeneration(ctx, 1, "unknown", repo, 2))
 			require.NoError(t, rs.SetGeneration(ctx, 1, "primary", repo, 1))
-			requireState(t, ctx, db,
+			backend.requireState(t, ctx,
 				virtualStorageState{
 					"virtual-storage-1": {
 						"repository-1": {repositoryID: 1, replicaPath: "replica-path"},
@@ -1097,7 +1133,7 @@ func TestRepositoryStore_Postgres(t *testing.T) {
 		t.Run("returns not found for deleted repositories", func(t *testing.T) {
 			_, _, err := rs.DeleteRepository(ctx, vs, repo)
 			require.NoError(t, err)
-			requireState(t, ctx, db, virtualStorageState{}, storageState{})
+			backend.requireState(t, ctx, virtualStorageState{}, storageState{})
 
 			replicaPath, secondaries, err := rs.GetConsistentStorages(ctx, vs, repo)
 			require.Equal(t, ErrRepositoryNotFound, err)
@@ -1150,14 +1186,14 @@ func TestRepositoryStore_Postgres(t *testing.T) {
 			rs := newRepositoryStore(t, nil)
 			require.NoError(t, rs.CreateRepository(ctx, 1, vs, repo, "replica-path", "invalid-storage", nil, nil, false, false))
 			require.NoError(t, rs.DeleteInvalidRepository(ctx, 1, "invalid-storage"))
-			requireState(t, ctx, db, virtualStorageState{}, storageState{})
+			backend.requireState(t, ctx, virtualStorageState{}, storageState{})
 		})
 
 		t.Run("another replica", func(t *testing.T) {
 			rs := newRepositoryStore(t, nil)
 			require.NoError(t, rs.CreateRepository(ctx, 1, vs, repo, "replica-path", "invalid-storage", []string{"other-storage"}, nil, false, false))
 			require.NoError(t, rs.DeleteInvalidRepository(ctx, 1, "invalid-storage"))
-			requireState(t, ctx, db,
+			backend.requireState(t, ctx,
 				virtualStorageState{
 					"virtual-storage-1": {
 						"repository-1": {repositoryID: 1, replicaPath: "replica-path"},
@@ -1325,7 +1361,7 @@ func TestRepositoryStore_incrementGenerationConcurrently(t *testing.T) {
 			firstTx := db.Begin(t)
 			secondTx := db.Begin(t)
 
-			err := NewPostgresRepositoryStore(firstTx, nil).IncrementGeneration(ctx, 1, tc.first.primary, tc.first.secondaries)
+			_, err := NewPostgresRepositoryStore(firstTx, nil).IncrementGeneration(ctx, 1, tc.first.primary, tc.first.secondaries)
 			require.NoError(t, err)
 
 			go func() {
@@ -1333,7 +1369,7 @@ func TestRepositoryStore_incrementGenerationConcurrently(t *testing.T) {
 				firstTx.Commit(t)
 			}()
 
-			err = NewPostgresRepositoryStore(secondTx, nil).IncrementGeneration(ctx, 1, tc.second.primary, tc.second.secondaries)
+			_, err = NewPostgresRepositoryStore(secondTx, nil).IncrementGeneration(ctx, 1, tc.second.primary, tc.second.secondaries)
 			require.Equal(t, tc.error, err)
 			secondTx.Commit(t)
 
@@ -1345,10 +1381,61 @@ func TestRepositoryStore_incrementGenerationConcurrently(t *testing.T) {
 	}
 }
 
+// repositoryMetadataBackend provides a RepositoryStore for the GetRepositoryMetadata tests alongside
+// helpers to set up the records the RepositoryStore does not provide methods for.
+type repositoryMetadataBackend struct {
+	rs RepositoryStore
+	// createRepository creates the repository's record without any replicas.
+	createRepository func(repositoryID int64, virtualStorage, relativePath, replicaPath, primary string)
+	// markVerified marks the replicas on the storage as verified at the given time.
+	markVerified func(storage string, verifiedAt time.Time)
+	// assign assigns the storage to host the repository.
+	assign func(repositoryID int64, virtualStorage, relativePath, storage string)
+	// deleteRepository deletes the repository's record.
+	deleteRepository func(repositoryID int64)
+}
+
 func TestPostgresRepositoryStore_GetRepositoryMetadata(t *testing.T) {
 	t.Parallel()
 	db := testdb.New(t)
 
+	testGetRepositoryMetadata(t, func(t *testing.T, ctx context.Context, configuredStorages map[string][]string, healthyStorages []string) repositoryMetadataBackend {
+		tx := db.Begin(t)
+		t.Cleanup(func() { tx.Rollback(t) })
+
+		testdb.SetHealthyNodes(t, ctx, tx, map[string]map[string][]string{
+			"praefect-0": {"virtual-storage": healthyStorages},
+		})
+
+		return repositoryMetadataBackend{
+			rs: NewPostgresRepositoryStore(tx, configuredStorages),
+			createRepository: func(repositoryID int64, virtualStorage, relativePath, replicaPath, primary string) {
+				_, err := tx.ExecContext(ctx, `
+					INSERT INTO repositories (repository_id, virtual_storage, relative_path, replica_path, "primary")
+					VALUES ($1, $2, $3, $4, $5)
+				`, repositoryID, virtualStorage, relativePath, replicaPath, primary)
+				require.NoError(t, err)
+			},
+			markVerified: func(storage string, verifiedAt time.Time) {
+				_, err := tx.ExecContext(ctx, "UPDATE storage_repositories SET verified_at = $1 WHERE storage = $2", verifiedAt, storage)
+				require.NoError(t, err)
+			},
+			assign: func(repositoryID int64, virtualStorage, relativePath, storage string) {
+				_, err := tx.ExecContext(ctx, `
+					INSERT INTO repository_assignments (repository_id, virtual_storage, relative_path, storage)
+					VALUES ($1, $2, $3, $4)
+				`, repositoryID, virtualStorage, relativePath, storage)
+				require.NoError(t, err)
+			},
+			deleteRepository: func(repositoryID int64) {
+				_, err := tx.ExecContext(ctx, "DELETE FROM repositories WHERE repository_id = $1", repositoryID)
+				require.NoError(t, err)
+			},
+		}
+	})
+}
+
+func testGetRepositoryMetadata(t *testing.T, newBackend func(t *testing.T, ctx context.Context, configuredStorages map[string][]string, healthyStorages []string) repositoryMetadataBackend) {
 	// Truncate the time to millisecond as Postgres returns timestamps with that precision.
 	now := time.Now().UTC().Truncate(time.Microsecond)
 
@@ -1582,9 +1669,6 @@ func TestPostgresRepositoryStore_GetRepositoryMetadata(t *testing.T) {
 		t.Run(tc.desc, func(t *testing.T) {
 			ctx := testhelper.Context(t)
 
-			tx := db.Begin(t)
-			defer tx.Rollback(t)
-
 			configuredStorages := map[string][]string{"virtual-storage": {"primary", "secondary-1"}}
 
 			var healthyStorages []string
@@ -1596,9 +1680,7 @@ func TestPostgresRepositoryStore_GetRepositoryMetadata(t *testing.T) {
 				healthyStorages = append(healthyStorages, storage)
 			}
 
-			testdb.SetHealthyNodes(t, ctx, tx, map[string]map[string][]string{
-				"praefect-0": {"virtual-storage": healthyStorages},
-			})
+			backend := newBackend(t, ctx, configuredStorages, healthyStorages)
 
 			const (
 				virtualStorage = "virtual-storage"
@@ -1606,16 +1688,12 @@ func TestPostgresRepositoryStore_GetRepositoryMetadata(t *testing.T) {
 				replicaPath    = "replica-path"
 			)
 
-			rs := NewPostgresRepositoryStore(tx, configuredStorages)
+			rs := backend.rs
 
 			repositoryID, err := rs.ReserveRepositoryID(ctx, virtualStorage, relativePath)
 			require.NoError(t, err)
 
-			_, err = tx.ExecContext(ctx, `
-				INSERT INTO repositories (repository_id, virtual_storage, relative_path, replica_path, "primary")
-				VALUES ($1, $2, $3, $4, 'repository-primary')
-			`, repositoryID, virtualStorage, relativePath, replicaPath)
-			require.NoError(t, err)
+			backend.createRepository(repositoryID, virtualStorage, relativePath, replicaPath, "repository-primary")
 
 			maxGeneration := 0
 			for storage, generation := range tc.existingGenerations {
@@ -1628,22 +1706,16 @@ func TestPostgresRepositoryStore_GetRepositoryMetadata(t *testing.T) {
 
 			// Set the primary always having been verified. This is just to verify the data is correctly
 			// returned, there's no real logic to test.
-			_, err = tx.ExecContext(ctx, "UPDATE storage_repositories SET verified_at = $1 WHERE storage = 'primary'", now)
-			require.NoError(t, err)
+			backend.markVerified("primary", now)
 
 			for _, storage := range tc.existingAssignments {
-				_, err := tx.ExecContext(ctx, `
-					INSERT INTO repository_assignments (repository_id, virtual_storage, relative_path, storage)
-					VALUES ($1, $2, $3, $4)
-				`, repositoryID, virtualStorage, relativePath, storage)
-				require.NoError(t, err)
+				backend.assign(repositoryID, virtualStorage, relativePath, storage)
 			}
 
 			if tc.nonExistentRepository {
 				// The repository record should always be created anyway prior to the deletion to match the real
 				// scenario. This way any foreign key cascades are also applied correctly to other records.
-				_, err := tx.ExecContext(ctx, "DELETE FROM repositories WHERE repository_id = $1", repositoryID)
-				require.NoError(t, err)
+				backend.deleteRepository(repositoryID)
 			}
 
 			expectedMetadata := RepositoryMetadata{
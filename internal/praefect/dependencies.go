@@ -22,6 +22,7 @@ type Dependencies struct {
 	Queue           datastore.ReplicationEventQueue
 	RepositoryStore datastore.RepositoryStore
 	AssignmentStore AssignmentStore
+	DrainStore      datastore.DrainStore
 	Router          Router
 	Registry        *protoregistry.Registry
 	Conns           Connections
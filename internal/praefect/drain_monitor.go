@@ -0,0 +1,73 @@
+package praefect
+
+import (
+	"context"
+	"sync/atomic"
+
+	"gitlab.com/gitlab-org/gitaly/v16/internal/helper"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/log"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/datastore"
+)
+
+// DrainChecker reports the storages that have been drained of read traffic.
+type DrainChecker interface {
+	// DrainedNodes returns the drained storages by virtual storage.
+	DrainedNodes() map[string][]string
+}
+
+// DrainMonitor keeps track of the drained storages. The drain state is shared by all Praefect nodes
+// through the DrainStore and refreshed periodically, so a storage stops receiving reads from every Praefect
+// within a refresh interval after it was drained.
+type DrainMonitor struct {
+	logger  log.Logger
+	store   datastore.DrainStore
+	drained atomic.Value
+}
+
+// NewDrainMonitor returns a new DrainMonitor loading the drain state from the given store.
+func NewDrainMonitor(logger log.Logger, store datastore.DrainStore) *DrainMonitor {
+	m := &DrainMonitor{
+		logger: logger.WithField("component", "DrainMonitor"),
+		store:  store,
+	}
+
+	m.drained.Store(map[string][]string{})
+
+	return m
+}
+
+// Run refreshes the drained storages on every tick until the context is canceled. Returns the error from
+// the context.
+func (m *DrainMonitor) Run(ctx context.Context, ticker helper.Ticker) error {
+	defer ticker.Stop()
+
+	for {
+		if err := m.refresh(ctx); err != nil {
+			m.logger.WithError(err).Error("failed refreshing drained storages")
+		}
+
+		ticker.Reset()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C():
+		}
+	}
+}
+
+func (m *DrainMonitor) refresh(ctx context.Context) error {
+	drained, err := m.store.GetDrainedStorages(ctx)
+	if err != nil {
+		return err
+	}
+
+	m.drained.Store(drained)
+
+	return nil
+}
+
+// DrainedNodes returns the drained storages by virtual storage as of the latest refresh.
+func (m *DrainMonitor) DrainedNodes() map[string][]string {
+	return m.drained.Load().(map[string][]string)
+}
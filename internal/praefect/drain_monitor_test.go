@@ -0,0 +1,74 @@
+package praefect
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/helper"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/datastore"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
+)
+
+// mockDrainStore returns the drain state from the configured function.
+type mockDrainStore struct {
+	datastore.DrainStore
+	getDrainedStorages func() (map[string][]string, error)
+}
+
+func (m mockDrainStore) GetDrainedStorages(context.Context) (map[string][]string, error) {
+	return m.getDrainedStorages()
+}
+
+func TestDrainMonitor(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(testhelper.Context(t))
+	defer cancel()
+
+	type result struct {
+		drained map[string][]string
+		err     error
+	}
+
+	// refreshed is signalled when the monitor starts a refresh, the refresh then returns the result sent
+	// to results. As the refreshes are sequential, the previous refresh has completed once the next one
+	// starts.
+	refreshed := make(chan struct{})
+	results := make(chan result)
+	monitor := NewDrainMonitor(testhelper.NewLogger(t), mockDrainStore{
+		getDrainedStorages: func() (map[string][]string, error) {
+			refreshed <- struct{}{}
+			result := <-results
+			return result.drained, result.err
+		},
+	})
+
+	require.Empty(t, monitor.DrainedNodes())
+
+	ticker := helper.NewManualTicker()
+	done := make(chan error)
+	go func() { done <- monitor.Run(ctx, ticker) }()
+
+	// The drain state is loaded immediately when the monitor starts.
+	<-refreshed
+	results <- result{drained: map[string][]string{"virtual-storage": {"gitaly-1"}}}
+
+	ticker.Tick()
+	<-refreshed
+	require.Equal(t, map[string][]string{"virtual-storage": {"gitaly-1"}}, monitor.DrainedNodes())
+
+	// Failing to refresh keeps the previous state.
+	results <- result{err: errors.New("database unavailable")}
+
+	ticker.Tick()
+	<-refreshed
+	require.Equal(t, map[string][]string{"virtual-storage": {"gitaly-1"}}, monitor.DrainedNodes())
+
+	results <- result{drained: map[string][]string{"virtual-storage": {"gitaly-2"}}}
+
+	cancel()
+	require.Equal(t, context.Canceled, <-done)
+	require.Equal(t, map[string][]string{"virtual-storage": {"gitaly-2"}}, monitor.DrainedNodes())
+}
@@ -35,7 +35,7 @@ func GetObjectPoolHandler(repoStore datastore.RepositoryStore, router Router) gr
 		// Object pool information can be retrieved from any up-to-date replica. Generate a route to
 		// a valid repository replica.
 		route, err := router.RouteRepositoryAccessor(
-			ctx, virtualStorage, relativePath, false,
+			ctx, virtualStorage, relativePath, false, datastore.GenerationUnknown,
 		)
 		switch {
 		case errors.Is(err, nodes.ErrVirtualStorageNotExist):
@@ -49,6 +49,10 @@ func GetObjectPoolHandler(repoStore datastore.RepositoryStore, router Router) gr
 			return fmt.Errorf("route RPC: %w", err)
 		}
 
+		if route.Done != nil {
+			defer route.Done()
+		}
+
 		// To connect to the correct repository on the Gitaly node, the repository's relative path
 		// and storage need to be rewritten in the RPC request.
 		client := gitalypb.NewObjectPoolServiceClient(route.Node.Connection)
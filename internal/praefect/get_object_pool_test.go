@@ -85,9 +85,12 @@ func TestGetObjectPoolHandler(t *testing.T) {
 				StaticHealthChecker(cfg.StorageNames()),
 				NewLockedRandom(rand.New(rand.NewSource(0))),
 				repoStore,
-				datastore.NewAssignmentStore(db, cfg.StorageNames()),
+				datastore.NewAssignmentStore(db, cfg.StorageNames(), nil),
 				repoStore,
 				nil,
+				nil,
+				nil,
+				nil,
 			),
 			Registry: protoregistry.GitalyProtoPreregistered,
 			Conns:    nodeSet.Connections(),
@@ -99,9 +99,12 @@ func TestInfoService_RepositoryReplicas(t *testing.T) {
 			StaticHealthChecker{virtualStorage: storages},
 			NewLockedRandom(rand.New(rand.NewSource(0))),
 			rs,
-			datastore.NewAssignmentStore(db, conf.StorageNames()),
+			datastore.NewAssignmentStore(db, conf.StorageNames(), nil),
 			rs,
 			conf.DefaultReplicationFactors(),
+			nil,
+			nil,
+			nil,
 		),
 		WithPrimaryGetter: elector,
 		WithTxMgr:         txManager,
@@ -124,7 +127,8 @@ func TestInfoService_RepositoryReplicas(t *testing.T) {
 
 	// Increment the generation of the unmodified repositories so the below CalculateChecksum calls goes to one of them
 	// as the test expects the primary to have that checksum.
-	require.NoError(t, rs.IncrementGeneration(ctx, 1, cfgs[0].Storages[0].Name, []string{cfgs[2].Storages[0].Name}))
+	_, err = rs.IncrementGeneration(ctx, 1, cfgs[0].Storages[0].Name, []string{cfgs[2].Storages[0].Name})
+	require.NoError(t, err)
 
 	// CalculateChecksum through praefect will get the checksum of the primary
 	checksum, err := gitalypb.NewRepositoryServiceClient(cc).CalculateChecksum(ctx, &gitalypb.CalculateChecksumRequest{Repository: testRepository})
@@ -0,0 +1,41 @@
+package middleware
+
+import (
+	"context"
+
+	grpcmw "github.com/grpc-ecosystem/go-grpc-middleware"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/datastore"
+	"google.golang.org/grpc"
+)
+
+// auditActor is the actor the repository metadata changes made while handling RPCs are attributed to.
+const auditActor = "grpc"
+
+// AuditUnaryInterceptor returns a Unary Interceptor that attributes the repository metadata changes made while
+// handling the RPC to the RPC in the repository audit log. If notify is set, the recorded changes are sent to
+// the Praefects logging them.
+func AuditUnaryInterceptor(notify bool) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		return handler(auditContext(ctx, info.FullMethod, notify), req)
+	}
+}
+
+// AuditStreamInterceptor returns a Stream Interceptor that attributes the repository metadata changes made while
+// handling the RPC to the RPC in the repository audit log. If notify is set, the recorded changes are sent to
+// the Praefects logging them.
+func AuditStreamInterceptor(notify bool) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		wrapped := grpcmw.WrapServerStream(stream)
+		wrapped.WrappedContext = auditContext(stream.Context(), info.FullMethod, notify)
+
+		return handler(srv, wrapped)
+	}
+}
+
+func auditContext(ctx context.Context, fullMethod string, notify bool) context.Context {
+	if notify {
+		ctx = datastore.WithAuditLogNotifications(ctx)
+	}
+
+	return datastore.WithAuditActor(ctx, auditActor, fullMethod)
+}
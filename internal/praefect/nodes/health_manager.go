@@ -57,7 +57,8 @@ type HealthManager struct {
 }
 
 // NewHealthManager returns a new health manager that monitors which nodes in the cluster
-// are healthy.
+// are healthy. If db is nil, the health check results are not stored and only the local view
+// of the nodes' health is available.
 func NewHealthManager(
 	log log.Logger,
 	db glsql.Querier,
@@ -138,6 +139,23 @@ func (hm *HealthManager) updateHealthChecks(ctx context.Context, virtualStorages
 
 	hm.locallyHealthy.Store(locallyHealthy)
 
+	// Without a database, the Praefect is the only one in the cluster and its local view of the
+	// nodes' health is authoritative.
+	if hm.db != nil {
+		if err := hm.storeHealthChecks(ctx, virtualStorages, physicalStorages, healthy); err != nil {
+			return err
+		}
+	}
+
+	if hm.firstUpdate {
+		hm.firstUpdate = false
+		hm.updated <- struct{}{}
+	}
+
+	return nil
+}
+
+func (hm *HealthManager) storeHealthChecks(ctx context.Context, virtualStorages, physicalStorages []string, healthy []bool) error {
 	ctx, cancel := hm.databaseTimeout(ctx)
 	defer cancel()
 
@@ -163,11 +181,6 @@ ON CONFLICT (praefect_name, shard_name, node_name)
 		return fmt.Errorf("update checks: %w", err)
 	}
 
-	if hm.firstUpdate {
-		hm.firstUpdate = false
-		hm.updated <- struct{}{}
-	}
-
 	return nil
 }
 
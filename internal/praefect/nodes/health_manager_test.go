@@ -602,6 +602,35 @@ func TestHealthManager_databaseTimeout(t *testing.T) {
 	require.EqualError(t, <-blockedErr, "update checks: context canceled")
 }
 
+func TestHealthManager_withoutDatabase(t *testing.T) {
+	t.Parallel()
+	ctx := testhelper.Context(t)
+
+	hm := NewHealthManager(testhelper.SharedLogger(t), nil, "praefect", HealthClients{
+		"virtual-storage": {
+			"healthy-storage": mockHealthClient{
+				CheckFunc: func(context.Context, *grpc_health_v1.HealthCheckRequest, ...grpc.CallOption) (*grpc_health_v1.HealthCheckResponse, error) {
+					return &grpc_health_v1.HealthCheckResponse{Status: grpc_health_v1.HealthCheckResponse_SERVING}, nil
+				},
+			},
+			"unhealthy-storage": mockHealthClient{
+				CheckFunc: func(context.Context, *grpc_health_v1.HealthCheckRequest, ...grpc.CallOption) (*grpc_health_v1.HealthCheckResponse, error) {
+					return &grpc_health_v1.HealthCheckResponse{Status: grpc_health_v1.HealthCheckResponse_NOT_SERVING}, nil
+				},
+			},
+		},
+	})
+	hm.handleError = func(err error) error { return err }
+
+	runCtx, cancelRun := context.WithCancel(ctx)
+	runErr := make(chan error)
+	go func() { runErr <- hm.Run(runCtx, helper.NewCountTicker(1, cancelRun)) }()
+
+	<-hm.Updated()
+	require.Equal(t, context.Canceled, <-runErr)
+	require.Equal(t, map[string][]string{"virtual-storage": {"healthy-storage"}}, hm.HealthyNodes())
+}
+
 func predateHealthChecks(tb testing.TB, db testdb.DB, amount time.Duration) {
 	tb.Helper()
 
@@ -51,10 +51,18 @@ func (pr *PerRepositoryElector) GetPrimary(ctx context.Context, virtualStorage s
 	//      recent change
 	//   2. `reread`, as this indicates a concurrent transaction had potentially changed the primary.
 	//   3. `snapshot`, if the current primary was valid in the transcation's database snapshot.
+	//
+	// The query is hot, so instead of setting the audit settings in a CTE evaluated on every call, `reread`
+	// sets them only when an election may take place. The audit triggers fire at the end of the statement and
+	// thus see them.
+	actor, reason, notify := datastore.AuditSettings(ctx)
 	var current, previous sql.NullString
 	if err := pr.db.QueryRowContext(ctx, `
 WITH reread AS (
-	SELECT true AS valid, repository_id, "primary"
+	SELECT true AS valid, repository_id, "primary",
+		set_config('praefect.audit_actor', $2, true) AS audit_actor,
+		set_config('praefect.audit_reason', $3, true) AS audit_reason,
+		set_config('praefect.audit_log_notify', $4, true) AS audit_log_notify
 	FROM repositories
 	WHERE repository_id = $1
 	AND NOT EXISTS (
@@ -105,7 +113,7 @@ LEFT JOIN reread ON reread.valid
 LEFT JOIN election ON election.valid
 WHERE snapshot.repository_id = $1
 `,
-		repositoryID,
+		repositoryID, actor, reason, notify,
 	).Scan(&current, &previous); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return "", datastore.ErrRepositoryNotFound
@@ -0,0 +1,47 @@
+package nodes
+
+import (
+	"context"
+
+	"gitlab.com/gitlab-org/gitaly/v16/internal/log"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/datastore"
+)
+
+// BadgerPerRepositoryElector implements an elector that selects a primary for each repository
+// using the embedded database. It follows the same election rules as the PerRepositoryElector.
+type BadgerPerRepositoryElector struct {
+	logger log.Logger
+	rs     *datastore.BadgerRepositoryStore
+}
+
+// NewBadgerPerRepositoryElector returns a new per repository primary elector using the embedded
+// database.
+func NewBadgerPerRepositoryElector(logger log.Logger, rs *datastore.BadgerRepositoryStore) *BadgerPerRepositoryElector {
+	return &BadgerPerRepositoryElector{
+		logger: logger,
+		rs:     rs,
+	}
+}
+
+// GetPrimary returns the primary storage of a repository. If the current primary is invalid, a new primary
+// is elected if there are valid candidates for promotion.
+func (pr *BadgerPerRepositoryElector) GetPrimary(ctx context.Context, virtualStorage string, repositoryID int64) (string, error) {
+	current, previous, err := pr.rs.ElectPrimary(ctx, repositoryID)
+	if err != nil {
+		return "", err
+	}
+
+	if current != previous {
+		pr.logger.WithFields(log.Fields{
+			"repository_id":    repositoryID,
+			"current_primary":  current,
+			"previous_primary": previous,
+		}).InfoContext(ctx, "primary node changed")
+	}
+
+	if current == "" {
+		return "", ErrNoPrimary
+	}
+
+	return current, nil
+}
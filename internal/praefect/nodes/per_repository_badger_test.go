@@ -0,0 +1,61 @@
+package nodes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/datastore"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
+)
+
+type staticHealthChecker map[string][]string
+
+func (hc staticHealthChecker) HealthyNodes() map[string][]string {
+	return hc
+}
+
+func TestBadgerPerRepositoryElector(t *testing.T) {
+	t.Parallel()
+	ctx := testhelper.Context(t)
+
+	logger := testhelper.NewLogger(t)
+	hook := testhelper.AddLoggerHook(logger)
+
+	db, err := datastore.OpenBadgerDB(testhelper.SharedLogger(t), t.TempDir())
+	require.NoError(t, err)
+	defer func() { require.NoError(t, db.Close()) }()
+
+	healthChecker := staticHealthChecker{"virtual-storage": {"gitaly-1", "gitaly-2"}}
+	rs := datastore.NewBadgerRepositoryStore(db, map[string][]string{
+		"virtual-storage": {"gitaly-1", "gitaly-2", "gitaly-3"},
+	}, healthChecker)
+	elector := NewBadgerPerRepositoryElector(logger, rs)
+
+	_, err = elector.GetPrimary(ctx, "virtual-storage", 1)
+	require.Equal(t, datastore.ErrRepositoryNotFound, err)
+
+	require.NoError(t, rs.CreateRepository(ctx, 1, "virtual-storage", "relative-path", "replica-path", "gitaly-1", []string{"gitaly-2"}, nil, true, false))
+
+	primary, err := elector.GetPrimary(ctx, "virtual-storage", 1)
+	require.NoError(t, err)
+	require.Equal(t, "gitaly-1", primary)
+	require.Empty(t, hook.AllEntries())
+
+	// The primary fails over to the other up to date replica once it becomes unhealthy.
+	healthChecker["virtual-storage"] = []string{"gitaly-2", "gitaly-3"}
+
+	primary, err = elector.GetPrimary(ctx, "virtual-storage", 1)
+	require.NoError(t, err)
+	require.Equal(t, "gitaly-2", primary)
+
+	entry := hook.LastEntry()
+	require.Equal(t, "primary node changed", entry.Message)
+	require.Equal(t, "gitaly-1", entry.Data["previous_primary"])
+	require.Equal(t, "gitaly-2", entry.Data["current_primary"])
+
+	// Without valid candidates, the repository is left without a primary.
+	require.NoError(t, rs.CreateRepository(ctx, 2, "virtual-storage", "relative-path-2", "replica-path-2", "gitaly-1", nil, nil, false, false))
+
+	_, err = elector.GetPrimary(ctx, "virtual-storage", 2)
+	require.Equal(t, ErrNoPrimary, err)
+}
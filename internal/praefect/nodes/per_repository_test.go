@@ -52,6 +52,7 @@ func TestPerRepositoryElector(t *testing.T) {
 		state        state
 		steps        steps
 		existingJobs []datastore.ReplicationEvent
+		drained      map[string][]string
 	}{
 		{
 			desc: "elects the most up to date storage",
@@ -312,6 +313,27 @@ func TestPerRepositoryElector(t *testing.T) {
 				},
 			},
 		},
+		{
+			desc: "fails over from a drained primary",
+			state: state{
+				"virtual-storage-1": {
+					"relative-path-1": {
+						"gitaly-1": {generation: 0},
+						"gitaly-2": {generation: 0},
+						"gitaly-3": {generation: 0},
+					},
+				},
+			},
+			drained: map[string][]string{"virtual-storage-1": {"gitaly-1", "gitaly-2"}},
+			steps: steps{
+				{
+					healthyNodes: map[string][]string{
+						"virtual-storage-1": {"gitaly-1", "gitaly-2", "gitaly-3"},
+					},
+					primary: any("gitaly-3"),
+				},
+			},
+		},
 		{
 			desc: "doesnt elect replicas with delete_replica in ready state",
 			state: state{
@@ -524,6 +546,12 @@ func TestPerRepositoryElector(t *testing.T) {
 				require.NoError(t, err)
 			}
 
+			for virtualStorage, storages := range tc.drained {
+				for _, storage := range storages {
+					require.NoError(t, datastore.NewPostgresDrainStore(db).DrainStorage(ctx, virtualStorage, storage))
+				}
+			}
+
 			previousPrimary := ""
 			const repositoryID int64 = 1
 
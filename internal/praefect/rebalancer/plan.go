@@ -0,0 +1,235 @@
+package rebalancer
+
+import (
+	"sort"
+)
+
+// Repository describes a repository whose assignments may be moved between storages.
+type Repository struct {
+	// RepositoryID is the ID of the repository.
+	RepositoryID int64
+	// RelativePath is the relative path of the repository on the virtual storage.
+	RelativePath string
+	// ReplicaPath is the path where the replicas of the repository are stored on the storages.
+	ReplicaPath string
+	// Primary is the storage acting as the repository's primary.
+	Primary string
+	// Assignments are the storages the repository is assigned to.
+	Assignments []string
+	// Weight is the load the repository puts on each storage it is assigned to.
+	Weight int64
+	// Movable is false if the repository's assignments must not be moved, for example because
+	// some of the assigned replicas are outdated.
+	Movable bool
+
+	// generation is the repository's generation. It is used to invalidate cached sizes.
+	generation int64
+}
+
+// Move describes an assignment that is moved from one storage to another.
+type Move struct {
+	// RepositoryID is the ID of the repository whose assignment is moved.
+	RepositoryID int64
+	// RelativePath is the relative path of the repository on the virtual storage.
+	RelativePath string
+	// ReplicaPath is the path where the replicas of the repository are stored on the storages.
+	ReplicaPath string
+	// Primary is the storage acting as the repository's primary. It is used as the source of
+	// the replication job creating the replica on the target storage.
+	Primary string
+	// SourceStorage is the storage the repository is unassigned from.
+	SourceStorage string
+	// TargetStorage is the storage the repository is assigned to.
+	TargetStorage string
+}
+
+// Plan describes the assignments to move in order to balance the load of a virtual storage.
+type Plan struct {
+	// VirtualStorage is the virtual storage the plan applies to.
+	VirtualStorage string
+	// CurrentLoads is the load of each storage before the moves.
+	CurrentLoads map[string]int64
+	// PlannedLoads is the load of each storage after the moves.
+	PlannedLoads map[string]int64
+	// Moves are the assignments to move.
+	Moves []Move
+}
+
+// planVirtualStorage computes the moves balancing the load of the storages of a virtual storage.
+// Each move unassigns a repository from a heavily loaded storage and assigns it to a lightly loaded
+// healthy storage. A move is only planned if it reduces the load difference between the two
+// storages. The primary's assignment is never moved, and no repository is moved more than once.
+// Moves which would reduce the number of failure domains a repository's replicas are spread
+// across are not planned. At most maxMoves moves are planned.
+func planVirtualStorage(
+	virtualStorage string,
+	storages []string,
+	healthyStorages []string,
+	failureDomain func(storage string) string,
+	repositories []Repository,
+	maxMoves int,
+) Plan {
+	loads := make(map[string]int64, len(storages))
+	for _, storage := range storages {
+		loads[storage] = 0
+	}
+
+	assignments := make([]map[string]struct{}, len(repositories))
+	for i, repository := range repositories {
+		assignments[i] = make(map[string]struct{}, len(repository.Assignments))
+		for _, storage := range repository.Assignments {
+			if _, ok := loads[storage]; !ok {
+				// Assignments to storages which are no longer configured are ignored.
+				continue
+			}
+
+			assignments[i][storage] = struct{}{}
+			loads[storage] += repository.Weight
+		}
+	}
+
+	plan := Plan{
+		VirtualStorage: virtualStorage,
+		CurrentLoads:   make(map[string]int64, len(loads)),
+	}
+	for storage, load := range loads {
+		plan.CurrentLoads[storage] = load
+	}
+
+	healthy := make(map[string]struct{}, len(healthyStorages))
+	for _, storage := range healthyStorages {
+		if _, ok := loads[storage]; ok {
+			healthy[storage] = struct{}{}
+		}
+	}
+
+	moved := make(map[int]struct{})
+	for len(plan.Moves) < maxMoves {
+		sources := sortedByLoad(loads, nil)
+		targets := sortedByLoad(loads, healthy)
+
+		move, ok := func() (Move, bool) {
+			// Try the most loaded sources and the least loaded targets first.
+			for i := len(sources) - 1; i >= 0; i-- {
+				for _, target := range targets {
+					source := sources[i]
+					gap := loads[source] - loads[target]
+					if gap <= 0 {
+						break
+					}
+
+					candidate := -1
+					for j, repository := range repositories {
+						if _, ok := moved[j]; ok || !repository.Movable || repository.Primary == source {
+							continue
+						}
+
+						if _, ok := assignments[j][source]; !ok {
+							continue
+						}
+
+						if _, ok := assignments[j][target]; ok {
+							continue
+						}
+
+						// The move must reduce the difference between the source and the
+						// target.
+						if repository.Weight <= 0 || repository.Weight >= gap {
+							continue
+						}
+
+						if !keepsFailureDomains(assignments[j], source, target, failureDomain) {
+							continue
+						}
+
+						// Prefer the repository which gets the two storages closest to an
+						// equal load.
+						if candidate == -1 || abs(gap-2*repository.Weight) < abs(gap-2*repositories[candidate].Weight) {
+							candidate = j
+						}
+					}
+
+					if candidate == -1 {
+						continue
+					}
+
+					repository := repositories[candidate]
+					moved[candidate] = struct{}{}
+					delete(assignments[candidate], source)
+					assignments[candidate][target] = struct{}{}
+					loads[source] -= repository.Weight
+					loads[target] += repository.Weight
+
+					return Move{
+						RepositoryID:  repository.RepositoryID,
+						RelativePath:  repository.RelativePath,
+						ReplicaPath:   repository.ReplicaPath,
+						Primary:       repository.Primary,
+						SourceStorage: source,
+						TargetStorage: target,
+					}, true
+				}
+			}
+
+			return Move{}, false
+		}()
+		if !ok {
+			break
+		}
+
+		plan.Moves = append(plan.Moves, move)
+	}
+
+	plan.PlannedLoads = loads
+
+	return plan
+}
+
+// sortedByLoad returns the storages sorted by ascending load. Ties are broken by the storage's
+// name so plans are deterministic. If filter is set, only the storages in the filter are returned.
+func sortedByLoad(loads map[string]int64, filter map[string]struct{}) []string {
+	storages := make([]string, 0, len(loads))
+	for storage := range loads {
+		if filter != nil {
+			if _, ok := filter[storage]; !ok {
+				continue
+			}
+		}
+
+		storages = append(storages, storage)
+	}
+
+	sort.Slice(storages, func(i, j int) bool {
+		if loads[storages[i]] != loads[storages[j]] {
+			return loads[storages[i]] < loads[storages[j]]
+		}
+
+		return storages[i] < storages[j]
+	})
+
+	return storages
+}
+
+// keepsFailureDomains returns whether moving the assignment from source to target keeps the
+// replicas spread across at least as many failure domains as before.
+func keepsFailureDomains(assignments map[string]struct{}, source, target string, failureDomain func(string) string) bool {
+	before := make(map[string]struct{}, len(assignments))
+	after := make(map[string]struct{}, len(assignments))
+	for storage := range assignments {
+		before[failureDomain(storage)] = struct{}{}
+		if storage != source {
+			after[failureDomain(storage)] = struct{}{}
+		}
+	}
+	after[failureDomain(target)] = struct{}{}
+
+	return len(after) >= len(before)
+}
+
+func abs(value int64) int64 {
+	if value < 0 {
+		return -value
+	}
+
+	return value
+}
@@ -0,0 +1,162 @@
+package rebalancer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlanVirtualStorage(t *testing.T) {
+	t.Parallel()
+
+	repository := func(id int64, primary string, weight int64, assignments ...string) Repository {
+		return Repository{
+			RepositoryID: id,
+			RelativePath: "relative-path",
+			ReplicaPath:  "replica-path",
+			Primary:      primary,
+			Assignments:  assignments,
+			Weight:       weight,
+			Movable:      true,
+		}
+	}
+
+	move := func(id int64, primary, source, target string) Move {
+		return Move{
+			RepositoryID:  id,
+			RelativePath:  "relative-path",
+			ReplicaPath:   "replica-path",
+			Primary:       primary,
+			SourceStorage: source,
+			TargetStorage: target,
+		}
+	}
+
+	storages := []string{"gitaly-1", "gitaly-2", "gitaly-3"}
+	noFailureDomains := func(storage string) string { return storage }
+
+	for _, tc := range []struct {
+		desc                 string
+		healthyStorages      []string
+		failureDomain        func(string) string
+		repositories         []Repository
+		maxMoves             int
+		expectedMoves        []Move
+		expectedPlannedLoads map[string]int64
+	}{
+		{
+			desc:            "balanced",
+			healthyStorages: storages,
+			repositories: []Repository{
+				repository(1, "gitaly-1", 1, "gitaly-1"),
+				repository(2, "gitaly-2", 1, "gitaly-2"),
+				repository(3, "gitaly-3", 1, "gitaly-3"),
+			},
+			maxMoves:             10,
+			expectedPlannedLoads: map[string]int64{"gitaly-1": 1, "gitaly-2": 1, "gitaly-3": 1},
+		},
+		{
+			desc:            "moves secondaries to a new storage",
+			healthyStorages: storages,
+			repositories: []Repository{
+				repository(1, "gitaly-1", 1, "gitaly-1", "gitaly-2"),
+				repository(2, "gitaly-2", 1, "gitaly-1", "gitaly-2"),
+				repository(3, "gitaly-1", 1, "gitaly-1", "gitaly-2"),
+			},
+			maxMoves: 10,
+			expectedMoves: []Move{
+				move(1, "gitaly-1", "gitaly-2", "gitaly-3"),
+				move(2, "gitaly-2", "gitaly-1", "gitaly-3"),
+			},
+			expectedPlannedLoads: map[string]int64{"gitaly-1": 2, "gitaly-2": 2, "gitaly-3": 2},
+		},
+		{
+			desc:            "number of moves is limited",
+			healthyStorages: storages,
+			repositories: []Repository{
+				repository(1, "gitaly-1", 1, "gitaly-1", "gitaly-2"),
+				repository(2, "gitaly-2", 1, "gitaly-1", "gitaly-2"),
+				repository(3, "gitaly-1", 1, "gitaly-1", "gitaly-2"),
+			},
+			maxMoves: 1,
+			expectedMoves: []Move{
+				move(1, "gitaly-1", "gitaly-2", "gitaly-3"),
+			},
+			expectedPlannedLoads: map[string]int64{"gitaly-1": 3, "gitaly-2": 2, "gitaly-3": 1},
+		},
+		{
+			desc:            "unhealthy storages are not targeted",
+			healthyStorages: []string{"gitaly-1", "gitaly-2"},
+			repositories: []Repository{
+				repository(1, "gitaly-1", 1, "gitaly-1", "gitaly-2"),
+				repository(2, "gitaly-2", 1, "gitaly-1", "gitaly-2"),
+			},
+			maxMoves:             10,
+			expectedPlannedLoads: map[string]int64{"gitaly-1": 2, "gitaly-2": 2, "gitaly-3": 0},
+		},
+		{
+			desc:            "unmovable repositories are not moved",
+			healthyStorages: storages,
+			repositories: []Repository{
+				{RepositoryID: 1, Primary: "gitaly-1", Assignments: []string{"gitaly-1", "gitaly-2"}, Weight: 1},
+			},
+			maxMoves:             10,
+			expectedPlannedLoads: map[string]int64{"gitaly-1": 1, "gitaly-2": 1, "gitaly-3": 0},
+		},
+		{
+			desc:            "repository size",
+			healthyStorages: storages,
+			repositories: []Repository{
+				repository(1, "gitaly-1", 100, "gitaly-1"),
+				repository(2, "gitaly-1", 60, "gitaly-1", "gitaly-2"),
+				repository(3, "gitaly-2", 10, "gitaly-2"),
+			},
+			maxMoves: 10,
+			expectedMoves: []Move{
+				// Primaries are never moved, but the secondary replica of the medium
+				// repository is.
+				move(2, "gitaly-1", "gitaly-2", "gitaly-3"),
+			},
+			expectedPlannedLoads: map[string]int64{"gitaly-1": 160, "gitaly-2": 10, "gitaly-3": 60},
+		},
+		{
+			desc:            "failure domains are kept",
+			healthyStorages: storages,
+			failureDomain: func(storage string) string {
+				return map[string]string{"gitaly-1": "zone-a", "gitaly-2": "zone-b", "gitaly-3": "zone-a"}[storage]
+			},
+			repositories: []Repository{
+				repository(1, "gitaly-1", 1, "gitaly-1", "gitaly-2"),
+				repository(2, "gitaly-1", 1, "gitaly-1", "gitaly-2"),
+			},
+			maxMoves:             10,
+			expectedPlannedLoads: map[string]int64{"gitaly-1": 2, "gitaly-2": 2, "gitaly-3": 0},
+		},
+		{
+			desc:            "assignments to unconfigured storages are ignored",
+			healthyStorages: storages,
+			repositories: []Repository{
+				repository(1, "gitaly-1", 1, "gitaly-1", "unconfigured"),
+			},
+			maxMoves:             10,
+			expectedPlannedLoads: map[string]int64{"gitaly-1": 1, "gitaly-2": 0, "gitaly-3": 0},
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			failureDomain := tc.failureDomain
+			if failureDomain == nil {
+				failureDomain = noFailureDomains
+			}
+
+			plan := planVirtualStorage("virtual-storage", storages, tc.healthyStorages, failureDomain, tc.repositories, tc.maxMoves)
+			require.Equal(t, "virtual-storage", plan.VirtualStorage)
+			require.Equal(t, tc.expectedMoves, plan.Moves)
+			require.Equal(t, tc.expectedPlannedLoads, plan.PlannedLoads)
+			require.Equal(t, []string{"gitaly-1", "gitaly-2", "gitaly-3"}, plan.SortedStorages())
+		})
+	}
+}
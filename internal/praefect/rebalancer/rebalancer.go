@@ -0,0 +1,408 @@
+package rebalancer
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"sync"
+
+	"gitlab.com/gitlab-org/gitaly/v16/internal/helper"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/log"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/config"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/datastore"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/datastore/advisorylock"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/datastore/glsql"
+	"gitlab.com/gitlab-org/gitaly/v16/proto/go/gitalypb"
+)
+
+// RepositorySizer returns the on-disk size in bytes of the replica stored at replicaPath on the
+// given storage.
+type RepositorySizer func(ctx context.Context, virtualStorage, storage, replicaPath string) (int64, error)
+
+// NewRepositorySizer returns a RepositorySizer that queries the size of replicas from the Gitaly
+// nodes via the RepositoryInfo RPC.
+func NewRepositorySizer(conns praefect.Connections) RepositorySizer {
+	return func(ctx context.Context, virtualStorage, storage, replicaPath string) (int64, error) {
+		conn, ok := conns[virtualStorage][storage]
+		if !ok {
+			return 0, fmt.Errorf("no connection to storage %q of virtual storage %q", storage, virtualStorage)
+		}
+
+		response, err := gitalypb.NewRepositoryServiceClient(conn).RepositoryInfo(ctx, &gitalypb.RepositoryInfoRequest{
+			Repository: &gitalypb.Repository{
+				StorageName:  storage,
+				RelativePath: replicaPath,
+			},
+		})
+		if err != nil {
+			return 0, fmt.Errorf("repository info: %w", err)
+		}
+
+		return int64(response.GetSize()), nil
+	}
+}
+
+// Rebalancer moves repository assignments between the storages of a virtual storage so that the
+// storages are evenly loaded. This is useful after storages have been added to a virtual storage as
+// existing repositories are otherwise never assigned to them.
+type Rebalancer struct {
+	log            log.Logger
+	db             *sql.DB
+	hc             praefect.HealthChecker
+	storages       map[string][]string
+	failureDomains config.FailureDomains
+	strategy       config.RebalanceStrategy
+	maxMoves       uint
+	sizer          RepositorySizer
+	// sizesMu protects sizes.
+	sizesMu sync.Mutex
+	// sizes caches the sizes of the repositories of each virtual storage so that the sizer only
+	// needs to be called for repositories that have been modified since the previous run.
+	sizes map[string]map[int64]cachedSize
+	// handleError is called with a possible error from a rebalancing run.
+	// If it returns an error, Run stops and returns with the error.
+	handleError func(error) error
+}
+
+// NewRebalancer returns a new Rebalancer. The load of the storages is measured according to the
+// strategy. The sizer is only used with the repository size strategy. At most maxMoves assignments
+// are moved per virtual storage in a single run.
+func NewRebalancer(
+	log log.Logger,
+	db *sql.DB,
+	hc praefect.HealthChecker,
+	storages map[string][]string,
+	failureDomains config.FailureDomains,
+	strategy config.RebalanceStrategy,
+	maxMoves uint,
+	sizer RepositorySizer,
+) *Rebalancer {
+	log = log.WithField("component", "rebalancer")
+
+	return &Rebalancer{
+		log:            log,
+		db:             db,
+		hc:             hc,
+		storages:       storages,
+		failureDomains: failureDomains,
+		strategy:       strategy,
+		maxMoves:       maxMoves,
+		sizer:          sizer,
+		sizes:          map[string]map[int64]cachedSize{},
+		handleError: func(err error) error {
+			log.WithError(err).Error("automatic rebalancing failed")
+			return nil
+		},
+	}
+}
+
+// Run rebalances on each tick the Ticker emits. Run returns when the context is canceled,
+// returning the error from the context.
+func (r *Rebalancer) Run(ctx context.Context, ticker helper.Ticker) error {
+	r.log.WithField("strategy", r.strategy).Info("automatic rebalancer started")
+	defer r.log.Info("automatic rebalancer stopped")
+
+	defer ticker.Stop()
+
+	for {
+		ticker.Reset()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C():
+			if err := r.rebalance(ctx); err != nil {
+				if err := r.handleError(err); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+func (r *Rebalancer) rebalance(ctx context.Context) error {
+	ctx = datastore.WithAuditActor(ctx, "rebalancer", fmt.Sprintf("rebalance by %s", r.strategy))
+
+	// The lock is held for the duration of the run so that multiple Praefects don't plan and
+	// apply moves concurrently, which would move more assignments than necessary.
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin: %w", err)
+	}
+	//nolint:errcheck
+	defer tx.Rollback()
+
+	var acquired bool
+	if err := tx.QueryRowContext(ctx, `SELECT pg_try_advisory_xact_lock($1)`, advisorylock.Rebalance).Scan(&acquired); err != nil {
+		return fmt.Errorf("acquire lock: %w", err)
+	}
+
+	if !acquired {
+		return nil
+	}
+
+	virtualStorages := make([]string, 0, len(r.storages))
+	for virtualStorage := range r.storages {
+		virtualStorages = append(virtualStorages, virtualStorage)
+	}
+
+	plans, err := r.Plan(ctx, virtualStorages)
+	if err != nil {
+		return fmt.Errorf("plan: %w", err)
+	}
+
+	for _, plan := range plans {
+		moved, err := r.Apply(ctx, plan)
+		if err != nil {
+			return fmt.Errorf("apply: %w", err)
+		}
+
+		if len(moved) > 0 {
+			r.log.WithFields(log.Fields{
+				"virtual_storage": plan.VirtualStorage,
+				"moves":           moved,
+			}).Info("assignments moved to rebalance virtual storage")
+		}
+	}
+
+	return nil
+}
+
+// Plan computes the assignments to move in order to balance the given virtual storages. Only
+// repositories with explicit assignments are considered as repositories without assignments are
+// replicated to every storage anyway. The assignments of repositories which have outdated assigned
+// replicas are not moved.
+func (r *Rebalancer) Plan(ctx context.Context, virtualStorages []string) ([]Plan, error) {
+	healthyStorages := r.hc.HealthyNodes()
+
+	plans := make([]Plan, 0, len(virtualStorages))
+	for _, virtualStorage := range virtualStorages {
+		storages, ok := r.storages[virtualStorage]
+		if !ok {
+			return nil, fmt.Errorf("virtual storage %q not found", virtualStorage)
+		}
+
+		repositories, err := r.repositories(ctx, virtualStorage)
+		if err != nil {
+			return nil, fmt.Errorf("repositories: %w", err)
+		}
+
+		plans = append(plans, planVirtualStorage(
+			virtualStorage,
+			storages,
+			healthyStorages[virtualStorage],
+			func(storage string) string {
+				return r.failureDomains.FailureDomain(virtualStorage, storage)
+			},
+			repositories,
+			int(r.maxMoves),
+		))
+	}
+
+	return plans, nil
+}
+
+func (r *Rebalancer) repositories(ctx context.Context, virtualStorage string) ([]Repository, error) {
+	rows, err := r.db.QueryContext(ctx, `
+SELECT
+	repositories.repository_id,
+	repositories.relative_path,
+	repositories.replica_path,
+	COALESCE(repositories."primary", ''),
+	repositories.generation,
+	array_agg(repository_assignments.storage ORDER BY repository_assignments.storage),
+	bool_and(COALESCE(storage_repositories.generation = repositories.generation, false))
+FROM repositories
+JOIN repository_assignments USING (repository_id)
+LEFT JOIN storage_repositories
+	ON  storage_repositories.repository_id = repository_assignments.repository_id
+	AND storage_repositories.storage       = repository_assignments.storage
+WHERE repositories.virtual_storage = $1
+AND repository_assignments.storage = ANY($2)
+GROUP BY repositories.repository_id
+ORDER BY repositories.repository_id
+`, virtualStorage, r.storages[virtualStorage])
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+	defer rows.Close()
+
+	var repositories []Repository
+	for rows.Next() {
+		var repository Repository
+		var assignments glsql.StringArray
+		if err := rows.Scan(
+			&repository.RepositoryID,
+			&repository.RelativePath,
+			&repository.ReplicaPath,
+			&repository.Primary,
+			&repository.generation,
+			&assignments,
+			&repository.Movable,
+		); err != nil {
+			return nil, fmt.Errorf("scan: %w", err)
+		}
+
+		repository.Assignments = assignments.Slice()
+		// Repositories without a primary have no replica that could serve as the source of
+		// the replication to the target storage.
+		repository.Movable = repository.Movable && repository.Primary != ""
+		repositories = append(repositories, repository)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating rows: %w", err)
+	}
+
+	for i := range repositories {
+		repositories[i].Weight = 1
+	}
+
+	if r.strategy == config.RebalanceStrategyRepositorySize {
+		if err := r.sizeRepositories(ctx, virtualStorage, repositories); err != nil {
+			return nil, err
+		}
+	}
+
+	return repositories, nil
+}
+
+// cachedSize is the size of a repository at a given generation.
+type cachedSize struct {
+	generation int64
+	size       int64
+}
+
+// sizeRepositories sets the weight of the repositories to their size. Sizes are cached per
+// generation of the repository, so the sizer is only called for repositories that have been
+// modified since the previous run. Cached sizes of repositories that don't exist anymore are
+// dropped.
+func (r *Rebalancer) sizeRepositories(ctx context.Context, virtualStorage string, repositories []Repository) error {
+	r.sizesMu.Lock()
+	defer r.sizesMu.Unlock()
+
+	cachedSizes := r.sizes[virtualStorage]
+	sizes := make(map[int64]cachedSize, len(repositories))
+
+	for i := range repositories {
+		repository := &repositories[i]
+
+		if cached, ok := cachedSizes[repository.RepositoryID]; ok && cached.generation == repository.generation {
+			repository.Weight = cached.size
+			sizes[repository.RepositoryID] = cached
+			continue
+		}
+
+		// The primary's replica is the most up to date one, so its size is representative
+		// for the repository.
+		storage := repository.Primary
+		if storage == "" {
+			storage = repository.Assignments[0]
+		}
+
+		size, err := r.sizer(ctx, virtualStorage, storage, repository.ReplicaPath)
+		if err != nil {
+			return fmt.Errorf("size of repository %d: %w", repository.RepositoryID, err)
+		}
+
+		repository.Weight = size
+		sizes[repository.RepositoryID] = cachedSize{generation: repository.generation, size: size}
+	}
+
+	r.sizes[virtualStorage] = sizes
+
+	return nil
+}
+
+// Apply moves the assignments of the plan. For every moved assignment, a replication job is
+// scheduled to create the replica on the target storage. The replica on the source storage is
+// removed by the reconciler once all of the assigned replicas are up to date. Moves whose
+// assignments have changed since the plan was computed are skipped. The moves which have been
+// applied are returned.
+func (r *Rebalancer) Apply(ctx context.Context, plan Plan) ([]Move, error) {
+	var moved []Move
+	for _, move := range plan.Moves {
+		ok, err := r.applyMove(ctx, plan.VirtualStorage, move)
+		if err != nil {
+			return moved, fmt.Errorf("move repository %d: %w", move.RepositoryID, err)
+		}
+
+		if ok {
+			moved = append(moved, move)
+		}
+	}
+
+	return moved, nil
+}
+
+func (r *Rebalancer) applyMove(ctx context.Context, virtualStorage string, move Move) (bool, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("begin: %w", err)
+	}
+	//nolint:errcheck
+	defer tx.Rollback()
+
+	actor, reason, notify := datastore.AuditSettings(ctx)
+	result, err := tx.ExecContext(ctx, `
+WITH audit_settings AS (
+	SELECT
+		set_config('praefect.audit_actor', $4, true),
+		set_config('praefect.audit_reason', $5, true),
+		set_config('praefect.audit_log_notify', $6, true)
+)
+
+UPDATE repository_assignments
+SET storage = $3
+WHERE repository_id = $1
+AND storage = $2
+AND NOT EXISTS (
+	SELECT FROM repository_assignments
+	WHERE repository_id = $1
+	AND storage = $3
+)
+AND ( SELECT true FROM audit_settings )
+`, move.RepositoryID, move.SourceStorage, move.TargetStorage, actor, reason, notify)
+	if err != nil {
+		return false, fmt.Errorf("update assignment: %w", err)
+	}
+
+	if affected, err := result.RowsAffected(); err != nil {
+		return false, fmt.Errorf("rows affected: %w", err)
+	} else if affected == 0 {
+		return false, nil
+	}
+
+	if _, err := datastore.NewPostgresReplicationEventQueue(tx).Enqueue(ctx, datastore.ReplicationEvent{
+		Job: datastore.ReplicationJob{
+			RepositoryID:      move.RepositoryID,
+			Change:            datastore.UpdateRepo,
+			VirtualStorage:    virtualStorage,
+			RelativePath:      move.RelativePath,
+			ReplicaPath:       move.ReplicaPath,
+			SourceNodeStorage: move.Primary,
+			TargetNodeStorage: move.TargetStorage,
+		},
+	}); err != nil {
+		return false, fmt.Errorf("enqueue replication job: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("commit: %w", err)
+	}
+
+	return true, nil
+}
+
+// SortedStorages returns the storages of the plan sorted by name.
+func (p Plan) SortedStorages() []string {
+	storages := make([]string, 0, len(p.CurrentLoads))
+	for storage := range p.CurrentLoads {
+		storages = append(storages, storage)
+	}
+	sort.Strings(storages)
+
+	return storages
+}
@@ -0,0 +1,258 @@
+package rebalancer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/config"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/datastore"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/datastore/advisorylock"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper/testdb"
+)
+
+func TestRebalancer(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	db := testdb.New(t)
+
+	configuredStorages := map[string][]string{"virtual-storage": {"gitaly-1", "gitaly-2", "gitaly-3"}}
+
+	rs := datastore.NewPostgresRepositoryStore(db, configuredStorages)
+	require.NoError(t, rs.CreateRepository(ctx, 1, "virtual-storage", "relative-path-1", "replica-path-1", "gitaly-1", []string{"gitaly-2"}, nil, true, true))
+	require.NoError(t, rs.CreateRepository(ctx, 2, "virtual-storage", "relative-path-2", "replica-path-2", "gitaly-2", []string{"gitaly-1"}, nil, true, true))
+	// The third repository has an outdated secondary and is thus not moved.
+	require.NoError(t, rs.CreateRepository(ctx, 3, "virtual-storage", "relative-path-3", "replica-path-3", "gitaly-1", nil, []string{"gitaly-2"}, true, true))
+
+	sizes := map[int64]int64{1: 100, 2: 10, 3: 10}
+	sizer := func(_ context.Context, virtualStorage, storage, replicaPath string) (int64, error) {
+		require.Equal(t, "virtual-storage", virtualStorage)
+
+		var id int64
+		require.NoError(t, db.QueryRowContext(ctx, `
+			SELECT repository_id FROM repositories WHERE replica_path = $1 AND "primary" = $2
+		`, replicaPath, storage).Scan(&id))
+
+		return sizes[id], nil
+	}
+
+	for _, tc := range []struct {
+		desc         string
+		strategy     config.RebalanceStrategy
+		expectedPlan Plan
+	}{
+		{
+			desc:     "repository count",
+			strategy: config.RebalanceStrategyRepositoryCount,
+			expectedPlan: Plan{
+				VirtualStorage: "virtual-storage",
+				CurrentLoads:   map[string]int64{"gitaly-1": 3, "gitaly-2": 3, "gitaly-3": 0},
+				PlannedLoads:   map[string]int64{"gitaly-1": 2, "gitaly-2": 2, "gitaly-3": 2},
+				Moves: []Move{
+					{
+						RepositoryID:  1,
+						RelativePath:  "relative-path-1",
+						ReplicaPath:   "replica-path-1",
+						Primary:       "gitaly-1",
+						SourceStorage: "gitaly-2",
+						TargetStorage: "gitaly-3",
+					},
+					{
+						RepositoryID:  2,
+						RelativePath:  "relative-path-2",
+						ReplicaPath:   "replica-path-2",
+						Primary:       "gitaly-2",
+						SourceStorage: "gitaly-1",
+						TargetStorage: "gitaly-3",
+					},
+				},
+			},
+		},
+		{
+			desc:     "repository size",
+			strategy: config.RebalanceStrategyRepositorySize,
+			expectedPlan: Plan{
+				VirtualStorage: "virtual-storage",
+				CurrentLoads:   map[string]int64{"gitaly-1": 120, "gitaly-2": 120, "gitaly-3": 0},
+				PlannedLoads:   map[string]int64{"gitaly-1": 110, "gitaly-2": 20, "gitaly-3": 110},
+				Moves: []Move{
+					{
+						RepositoryID:  1,
+						RelativePath:  "relative-path-1",
+						ReplicaPath:   "replica-path-1",
+						Primary:       "gitaly-1",
+						SourceStorage: "gitaly-2",
+						TargetStorage: "gitaly-3",
+					},
+					{
+						RepositoryID:  2,
+						RelativePath:  "relative-path-2",
+						ReplicaPath:   "replica-path-2",
+						Primary:       "gitaly-2",
+						SourceStorage: "gitaly-1",
+						TargetStorage: "gitaly-3",
+					},
+				},
+			},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			rebalancer := NewRebalancer(
+				testhelper.SharedLogger(t),
+				db.DB,
+				praefect.StaticHealthChecker(configuredStorages),
+				configuredStorages,
+				nil,
+				tc.strategy,
+				10,
+				sizer,
+			)
+
+			plans, err := rebalancer.Plan(ctx, []string{"virtual-storage"})
+			require.NoError(t, err)
+			require.Equal(t, []Plan{tc.expectedPlan}, plans)
+		})
+	}
+
+	t.Run("apply", func(t *testing.T) {
+		rebalancer := NewRebalancer(
+			testhelper.SharedLogger(t),
+			db.DB,
+			praefect.StaticHealthChecker(configuredStorages),
+			configuredStorages,
+			nil,
+			config.RebalanceStrategyRepositoryCount,
+			1,
+			nil,
+		)
+
+		plans, err := rebalancer.Plan(ctx, []string{"virtual-storage"})
+		require.NoError(t, err)
+		require.Len(t, plans, 1)
+
+		moved, err := rebalancer.Apply(ctx, plans[0])
+		require.NoError(t, err)
+		require.Equal(t, plans[0].Moves, moved)
+
+		assignments, err := datastore.NewAssignmentStore(db, configuredStorages, nil).GetHostAssignments(ctx, "virtual-storage", 1)
+		require.NoError(t, err)
+		require.ElementsMatch(t, []string{"gitaly-1", "gitaly-3"}, assignments)
+
+		var job datastore.ReplicationJob
+		require.NoError(t, db.QueryRowContext(ctx, `SELECT job FROM replication_queue`).Scan(&job))
+		require.Equal(t, datastore.ReplicationJob{
+			RepositoryID:      1,
+			Change:            datastore.UpdateRepo,
+			VirtualStorage:    "virtual-storage",
+			RelativePath:      "relative-path-1",
+			ReplicaPath:       "replica-path-1",
+			SourceNodeStorage: "gitaly-1",
+			TargetNodeStorage: "gitaly-3",
+		}, job)
+
+		// Applying the same plan again doesn't move anything as the assignment has already
+		// been moved.
+		moved, err = rebalancer.Apply(ctx, plans[0])
+		require.NoError(t, err)
+		require.Empty(t, moved)
+	})
+}
+
+func TestRebalancer_cachesSizes(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	db := testdb.New(t)
+
+	configuredStorages := map[string][]string{"virtual-storage": {"gitaly-1", "gitaly-2", "gitaly-3"}}
+
+	rs := datastore.NewPostgresRepositoryStore(db, configuredStorages)
+	require.NoError(t, rs.CreateRepository(ctx, 1, "virtual-storage", "relative-path-1", "replica-path-1", "gitaly-1", []string{"gitaly-2"}, nil, true, true))
+	require.NoError(t, rs.CreateRepository(ctx, 2, "virtual-storage", "relative-path-2", "replica-path-2", "gitaly-2", []string{"gitaly-1"}, nil, true, true))
+
+	var sized []string
+	sizer := func(_ context.Context, virtualStorage, storage, replicaPath string) (int64, error) {
+		sized = append(sized, replicaPath)
+		return 10, nil
+	}
+
+	rebalancer := NewRebalancer(
+		testhelper.SharedLogger(t),
+		db.DB,
+		praefect.StaticHealthChecker(configuredStorages),
+		configuredStorages,
+		nil,
+		config.RebalanceStrategyRepositorySize,
+		10,
+		sizer,
+	)
+
+	_, err := rebalancer.Plan(ctx, []string{"virtual-storage"})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"replica-path-1", "replica-path-2"}, sized)
+
+	// Sizes of unmodified repositories are served from the cache.
+	sized = nil
+	_, err = rebalancer.Plan(ctx, []string{"virtual-storage"})
+	require.NoError(t, err)
+	require.Empty(t, sized)
+
+	// Modified repositories are sized again.
+	_, err = rs.IncrementGeneration(ctx, 1, "gitaly-1", []string{"gitaly-2"})
+	require.NoError(t, err)
+	_, err = rebalancer.Plan(ctx, []string{"virtual-storage"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"replica-path-1"}, sized)
+}
+
+func TestRebalancer_lock(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	db := testdb.New(t)
+
+	configuredStorages := map[string][]string{"virtual-storage": {"gitaly-1", "gitaly-2", "gitaly-3"}}
+
+	rs := datastore.NewPostgresRepositoryStore(db, configuredStorages)
+	require.NoError(t, rs.CreateRepository(ctx, 1, "virtual-storage", "relative-path-1", "replica-path-1", "gitaly-1", []string{"gitaly-2"}, nil, true, true))
+	require.NoError(t, rs.CreateRepository(ctx, 2, "virtual-storage", "relative-path-2", "replica-path-2", "gitaly-2", []string{"gitaly-1"}, nil, true, true))
+
+	rebalancer := NewRebalancer(
+		testhelper.SharedLogger(t),
+		db.DB,
+		praefect.StaticHealthChecker(configuredStorages),
+		configuredStorages,
+		nil,
+		config.RebalanceStrategyRepositoryCount,
+		10,
+		nil,
+	)
+
+	assignmentsOnNewStorage := func(t *testing.T) int {
+		t.Helper()
+
+		var count int
+		require.NoError(t, db.QueryRowContext(ctx, `
+			SELECT COUNT(*) FROM repository_assignments WHERE storage = 'gitaly-3'
+		`).Scan(&count))
+
+		return count
+	}
+
+	// Another Praefect is rebalancing, so nothing is moved.
+	tx, err := db.BeginTx(ctx, nil)
+	require.NoError(t, err)
+	_, err = tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock($1)`, advisorylock.Rebalance)
+	require.NoError(t, err)
+
+	require.NoError(t, rebalancer.rebalance(ctx))
+	require.Zero(t, assignmentsOnNewStorage(t))
+
+	require.NoError(t, tx.Rollback())
+
+	require.NoError(t, rebalancer.rebalance(ctx))
+	require.NotZero(t, assignmentsOnNewStorage(t))
+}
@@ -8,6 +8,8 @@ import (
 	"gitlab.com/gitlab-org/gitaly/v16/internal/helper"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/log"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/config"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/datastore"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/datastore/advisorylock"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/datastore/glsql"
 )
@@ -20,21 +22,28 @@ type Reconciler struct {
 	db                               glsql.Querier
 	hc                               praefect.HealthChecker
 	storages                         map[string][]string
+	failureDomains                   config.FailureDomains
+	maxAssignmentMoves               uint
 	reconciliationSchedulingDuration prometheus.Histogram
 	// handleError is called with a possible error from reconcile.
 	// If it returns an error, Run stops and returns with the error.
 	handleError func(error) error
 }
 
-// NewReconciler returns a new Reconciler for repairing outdated repositories.
-func NewReconciler(log log.Logger, db glsql.Querier, hc praefect.HealthChecker, storages map[string][]string, buckets []float64) *Reconciler {
+// NewReconciler returns a new Reconciler for repairing outdated repositories. If the storages are
+// located in distinct failure domains, the Reconciler moves assignments of repositories whose
+// assigned storages share a failure domain to storages in failure domains without a replica. At
+// most maxAssignmentMoves assignments are moved in a single run.
+func NewReconciler(log log.Logger, db glsql.Querier, hc praefect.HealthChecker, storages map[string][]string, failureDomains config.FailureDomains, maxAssignmentMoves uint, buckets []float64) *Reconciler {
 	log = log.WithField("component", "reconciler")
 
 	r := &Reconciler{
-		log:      log,
-		db:       db,
-		hc:       hc,
-		storages: storages,
+		log:                log,
+		db:                 db,
+		hc:                 hc,
+		storages:           storages,
+		failureDomains:     failureDomains,
+		maxAssignmentMoves: maxAssignmentMoves,
 		reconciliationSchedulingDuration: prometheus.NewHistogram(prometheus.HistogramOpts{
 			Name:    "gitaly_praefect_reconciliation_scheduling_seconds",
 			Help:    "The time spent performing a single reconciliation scheduling run.",
@@ -138,6 +147,10 @@ func (r *Reconciler) reconcile(ctx context.Context) error {
 		return nil
 	}
 
+	if err := r.spreadAssignments(ctx); err != nil {
+		return fmt.Errorf("spread assignments: %w", err)
+	}
+
 	rows, err := r.db.QueryContext(ctx, `
 WITH reconciliation_lock AS (
 	SELECT pg_try_advisory_xact_lock($1) AS acquired
@@ -341,6 +354,163 @@ FROM reconciliation_jobs
 	return nil
 }
 
+// assignment is an internal type for formatting log messages
+type assignment struct {
+	RepositoryID   int64  `json:"repository_id"`
+	VirtualStorage string `json:"virtual_storage"`
+	RelativePath   string `json:"relative_path"`
+	SourceStorage  string `json:"source_storage"`
+	TargetStorage  string `json:"target_storage"`
+}
+
+// spreadAssignments moves assignments of repositories whose assigned storages share a failure
+// domain to a healthy storage in a failure domain that doesn't hold a replica of the repository
+// yet. The primary's assignment is never moved. At most one assignment per repository and at most
+// maxAssignmentMoves assignments in total are moved in a single run so that enabling failure
+// domains on a large cluster doesn't schedule replication jobs for every repository at once. The
+// replica on the newly assigned storage is then created by an `update` job scheduled by
+// reconcile, and the replica on the previously assigned storage is deleted by a `delete_replica`
+// job once every assigned replica is up to date.
+//
+// Nothing is done if none of the storages have a zone configured.
+func (r *Reconciler) spreadAssignments(ctx context.Context) error {
+	ctx = datastore.WithAuditActor(ctx, "reconciler", "spread assignments across failure domains")
+	healthyStorages := r.hc.HealthyNodes()
+
+	var virtualStorages, storages, failureDomains []string
+	var healthy []bool
+	var zonesConfigured bool
+	for virtualStorage, configuredStorages := range r.storages {
+		for _, storage := range configuredStorages {
+			if r.failureDomains[virtualStorage][storage] != "" {
+				zonesConfigured = true
+			}
+
+			virtualStorages = append(virtualStorages, virtualStorage)
+			storages = append(storages, storage)
+			failureDomains = append(failureDomains, r.failureDomains.FailureDomain(virtualStorage, storage))
+
+			isHealthy := false
+			for _, healthyStorage := range healthyStorages[virtualStorage] {
+				if healthyStorage == storage {
+					isHealthy = true
+					break
+				}
+			}
+			healthy = append(healthy, isHealthy)
+		}
+	}
+
+	if !zonesConfigured {
+		return nil
+	}
+
+	actor, reason, notify := datastore.AuditSettings(ctx)
+	rows, err := r.db.QueryContext(ctx, `
+WITH reassignment_lock AS (
+	SELECT pg_try_advisory_xact_lock($1) AS acquired
+),
+
+audit_settings AS (
+	SELECT
+		set_config('praefect.audit_actor', $7, true),
+		set_config('praefect.audit_reason', $8, true),
+		set_config('praefect.audit_log_notify', $9, true)
+),
+
+configured_storages AS (
+	SELECT unnest($2::text[]) AS virtual_storage,
+	       unnest($3::text[]) AS storage,
+	       unnest($4::text[]) AS failure_domain,
+	       unnest($5::boolean[]) AS healthy
+),
+
+assignments AS (
+	SELECT repository_id, virtual_storage, relative_path, storage, failure_domain,
+		ROW_NUMBER() OVER (
+			PARTITION BY repository_id, failure_domain
+			ORDER BY CASE WHEN storage = "primary" THEN 1 ELSE 0 END DESC, storage
+		) AS failure_domain_rank
+	FROM repository_assignments
+	JOIN repositories USING (repository_id, virtual_storage, relative_path)
+	JOIN configured_storages USING (virtual_storage, storage)
+),
+
+moves AS (
+	SELECT DISTINCT ON (repository_id)
+		repository_id,
+		virtual_storage,
+		relative_path,
+		assignments.storage AS source_storage,
+		candidates.storage AS target_storage
+	FROM assignments
+	JOIN configured_storages AS candidates USING (virtual_storage)
+	WHERE assignments.failure_domain_rank > 1
+	AND candidates.healthy
+	AND candidates.failure_domain NOT IN (
+		SELECT failure_domain
+		FROM assignments AS assigned
+		WHERE assigned.repository_id = assignments.repository_id
+	)
+	ORDER BY repository_id, random()
+	LIMIT $6
+),
+
+removed_assignments AS (
+	DELETE FROM repository_assignments
+	USING moves
+	WHERE ( SELECT acquired FROM reassignment_lock )
+	AND ( SELECT true FROM audit_settings )
+	AND repository_assignments.repository_id = moves.repository_id
+	AND repository_assignments.storage       = moves.source_storage
+),
+
+created_assignments AS (
+	INSERT INTO repository_assignments (virtual_storage, relative_path, storage, repository_id)
+	SELECT virtual_storage, relative_path, target_storage, repository_id
+	FROM moves
+	-- only perform the moves if we managed to acquire the lock as otherwise
+	-- we'd race with another Praefect moving the same assignments
+	WHERE ( SELECT acquired FROM reassignment_lock )
+	AND ( SELECT true FROM audit_settings )
+	RETURNING repository_id
+)
+
+SELECT repository_id, virtual_storage, relative_path, source_storage, target_storage
+FROM moves
+WHERE repository_id IN ( SELECT repository_id FROM created_assignments )
+`, advisorylock.Reconcile, virtualStorages, storages, failureDomains, healthy, r.maxAssignmentMoves, actor, reason, notify)
+	if err != nil {
+		return fmt.Errorf("query: %w", err)
+	}
+
+	defer func() {
+		if err := rows.Close(); err != nil {
+			r.log.WithError(err).Error("error closing rows")
+		}
+	}()
+
+	var moved []assignment
+	for rows.Next() {
+		var a assignment
+		if err := rows.Scan(&a.RepositoryID, &a.VirtualStorage, &a.RelativePath, &a.SourceStorage, &a.TargetStorage); err != nil {
+			return fmt.Errorf("scan: %w", err)
+		}
+
+		moved = append(moved, a)
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("rows.Err: %w", err)
+	}
+
+	if len(moved) > 0 {
+		r.log.WithField("moved_assignments", moved).Info("assignments moved to spread replicas across failure domains")
+	}
+
+	return nil
+}
+
 func (r *Reconciler) logJobs(jobs []job) {
 	r.log.WithField("scheduled_jobs", jobs).Info("reconciliation jobs scheduled")
 }
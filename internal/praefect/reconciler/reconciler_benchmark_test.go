@@ -64,6 +64,8 @@ CROSS JOIN (SELECT unnest('{gitaly-1, gitaly-2, gitaly-3}'::text[]) AS storage)
 			db,
 			praefect.StaticHealthChecker(storages),
 			storages,
+			nil,
+			100,
 			prometheus.DefBuckets,
 		)
 
@@ -12,6 +12,7 @@ import (
 	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/storage"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/helper"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/config"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/datastore"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper/testdb"
@@ -1074,6 +1075,8 @@ func TestReconciler(t *testing.T) {
 					tx,
 					praefect.StaticHealthChecker(tc.healthyStorages),
 					configuredStorages,
+					nil,
+					100,
 					prometheus.DefBuckets,
 				)
 				reconciler.handleError = func(err error) error { return err }
@@ -1201,6 +1204,8 @@ func TestReconciler_renames(t *testing.T) {
 				db,
 				praefect.StaticHealthChecker(configuredStorages),
 				configuredStorages,
+				nil,
+				100,
 				prometheus.DefBuckets,
 			)
 
@@ -1234,3 +1239,159 @@ func TestReconciler_renames(t *testing.T) {
 		})
 	}
 }
+
+func TestReconciler_failureDomains(t *testing.T) {
+	ctx := testhelper.Context(t)
+
+	db := testdb.New(t)
+
+	configuredStorages := map[string][]string{"virtual-storage": {"storage-1", "storage-2", "storage-3"}}
+	failureDomains := config.FailureDomains{"virtual-storage": {
+		"storage-1": "zone-a",
+		"storage-2": "zone-a",
+		"storage-3": "zone-b",
+	}}
+
+	for _, tc := range []struct {
+		desc                string
+		healthyStorages     []string
+		secondaries         []string
+		outdatedStorage     string
+		expectedAssignments []string
+		expectedJobs        []datastore.ReplicationJob
+	}{
+		{
+			desc:                "secondary sharing the primary's failure domain is moved",
+			healthyStorages:     []string{"storage-1", "storage-2", "storage-3"},
+			secondaries:         []string{"storage-2"},
+			expectedAssignments: []string{"storage-1", "storage-3"},
+			expectedJobs: []datastore.ReplicationJob{
+				{
+					RepositoryID:      1,
+					VirtualStorage:    "virtual-storage",
+					RelativePath:      "relative-path",
+					SourceNodeStorage: "storage-1",
+					TargetNodeStorage: "storage-3",
+					Change:            datastore.UpdateRepo,
+				},
+			},
+		},
+		{
+			desc:                "assignments are kept without a healthy storage in another failure domain",
+			healthyStorages:     []string{"storage-1", "storage-2"},
+			secondaries:         []string{"storage-2"},
+			expectedAssignments: []string{"storage-1", "storage-2"},
+		},
+		{
+			desc:                "outdated replicas are reconciled when replicas are spread",
+			healthyStorages:     []string{"storage-1", "storage-2", "storage-3"},
+			secondaries:         []string{"storage-3"},
+			outdatedStorage:     "storage-3",
+			expectedAssignments: []string{"storage-1", "storage-3"},
+			expectedJobs: []datastore.ReplicationJob{
+				{
+					RepositoryID:      1,
+					VirtualStorage:    "virtual-storage",
+					RelativePath:      "relative-path",
+					SourceNodeStorage: "storage-1",
+					TargetNodeStorage: "storage-3",
+					Change:            datastore.UpdateRepo,
+				},
+			},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			db.TruncateAll(t)
+
+			reconciler := NewReconciler(
+				testhelper.SharedLogger(t),
+				db,
+				praefect.StaticHealthChecker{"virtual-storage": tc.healthyStorages},
+				configuredStorages,
+				failureDomains,
+				100,
+				prometheus.DefBuckets,
+			)
+			reconciler.handleError = func(err error) error { return err }
+
+			rs := datastore.NewPostgresRepositoryStore(db, configuredStorages)
+			require.NoError(t, rs.CreateRepository(ctx, 1, "virtual-storage", "relative-path", "replica-path", "storage-1", tc.secondaries, nil, true, true))
+			if tc.outdatedStorage != "" {
+				_, err := rs.IncrementGeneration(ctx, 1, "storage-1", nil)
+				require.NoError(t, err)
+			}
+
+			runCtx, cancelRun := context.WithCancel(ctx)
+			var resetted bool
+			ticker := helper.NewManualTicker()
+			ticker.ResetFunc = func() {
+				if resetted {
+					cancelRun()
+					return
+				}
+
+				resetted = true
+				ticker.Tick()
+			}
+
+			require.Equal(t, context.Canceled, reconciler.Run(runCtx, ticker))
+
+			assignments, err := datastore.NewAssignmentStore(db, configuredStorages, nil).GetHostAssignments(ctx, "virtual-storage", 1)
+			require.NoError(t, err)
+			require.ElementsMatch(t, tc.expectedAssignments, assignments)
+
+			rows, err := db.QueryContext(ctx, `SELECT job FROM replication_queue`)
+			require.NoError(t, err)
+			defer rows.Close()
+
+			var jobs []datastore.ReplicationJob
+			for rows.Next() {
+				var job datastore.ReplicationJob
+				require.NoError(t, rows.Scan(&job))
+				jobs = append(jobs, job)
+			}
+			require.NoError(t, rows.Err())
+			require.Equal(t, tc.expectedJobs, jobs)
+		})
+	}
+}
+
+func TestReconciler_failureDomainsMaxAssignmentMoves(t *testing.T) {
+	ctx := testhelper.Context(t)
+
+	db := testdb.New(t)
+
+	configuredStorages := map[string][]string{"virtual-storage": {"storage-1", "storage-2", "storage-3"}}
+
+	reconciler := NewReconciler(
+		testhelper.SharedLogger(t),
+		db,
+		praefect.StaticHealthChecker(configuredStorages),
+		configuredStorages,
+		config.FailureDomains{"virtual-storage": {
+			"storage-1": "zone-a",
+			"storage-2": "zone-a",
+			"storage-3": "zone-b",
+		}},
+		1,
+		prometheus.DefBuckets,
+	)
+
+	rs := datastore.NewPostgresRepositoryStore(db, configuredStorages)
+	require.NoError(t, rs.CreateRepository(ctx, 1, "virtual-storage", "relative-path-1", "replica-path-1", "storage-1", []string{"storage-2"}, nil, true, true))
+	require.NoError(t, rs.CreateRepository(ctx, 2, "virtual-storage", "relative-path-2", "replica-path-2", "storage-1", []string{"storage-2"}, nil, true, true))
+
+	movedAssignments := func(t *testing.T) int {
+		var count int
+		require.NoError(t, db.QueryRowContext(ctx, `
+			SELECT COUNT(*) FROM repository_assignments WHERE storage = 'storage-3'
+		`).Scan(&count))
+		return count
+	}
+
+	require.NoError(t, reconciler.reconcile(ctx))
+	require.Equal(t, 1, movedAssignments(t))
+
+	require.NoError(t, reconciler.reconcile(ctx))
+	require.Equal(t, 2, movedAssignments(t))
+}
@@ -0,0 +1,221 @@
+package praefect
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/config"
+)
+
+// nodeStatsDecay is the time constant of the exponentially weighted moving average of request
+// latencies. It determines how quickly older latencies lose their weight, and how quickly the
+// latency of a node that isn't receiving any requests decays so that it eventually gets probed
+// again.
+const nodeStatsDecay = 10 * time.Second
+
+type nodeStat struct {
+	outstanding int
+	latency     time.Duration
+	lastUpdate  time.Time
+}
+
+// NodeStats keeps track of the requests in flight and the latency of requests proxied to the
+// storage nodes. The statistics are used to select the replica that serves a read.
+type NodeStats struct {
+	now   func() time.Time
+	decay time.Duration
+
+	m     sync.Mutex
+	stats map[string]map[string]*nodeStat
+}
+
+// NewNodeStats returns a new NodeStats.
+func NewNodeStats() *NodeStats {
+	return &NodeStats{
+		now:   time.Now,
+		decay: nodeStatsDecay,
+		stats: make(map[string]map[string]*nodeStat),
+	}
+}
+
+// stat returns the statistics of the given storage. Must be called with the lock held.
+func (s *NodeStats) stat(virtualStorage, storage string) *nodeStat {
+	storages, ok := s.stats[virtualStorage]
+	if !ok {
+		storages = make(map[string]*nodeStat)
+		s.stats[virtualStorage] = storages
+	}
+
+	stat, ok := storages[storage]
+	if !ok {
+		stat = &nodeStat{}
+		storages[storage] = stat
+	}
+
+	return stat
+}
+
+// Begin records the start of a request proxied to the given storage. The returned function must
+// be called once the request has finished.
+func (s *NodeStats) Begin(virtualStorage, storage string) func() {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	s.stat(virtualStorage, storage).outstanding++
+	start := s.now()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			s.finish(virtualStorage, storage, start)
+		})
+	}
+}
+
+func (s *NodeStats) finish(virtualStorage, storage string, start time.Time) {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	now := s.now()
+	stat := s.stat(virtualStorage, storage)
+	stat.outstanding--
+
+	latency := now.Sub(start)
+	if stat.lastUpdate.IsZero() {
+		stat.latency = latency
+	} else {
+		// The weight of the previous average depends on the time passed since it was last
+		// updated so that the average is independent of the request rate.
+		weight := math.Exp(-float64(now.Sub(stat.lastUpdate)) / float64(s.decay))
+		stat.latency = time.Duration(weight*float64(stat.latency) + (1-weight)*float64(latency))
+	}
+	stat.lastUpdate = now
+}
+
+// Outstanding returns the number of requests in flight to the given storage.
+func (s *NodeStats) Outstanding(virtualStorage, storage string) int {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	return s.stat(virtualStorage, storage).outstanding
+}
+
+// Latency returns the moving average of request latencies of the given storage. The average
+// decays while no requests finish so that nodes which were slow in the past are eventually tried
+// again.
+func (s *NodeStats) Latency(virtualStorage, storage string) time.Duration {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	stat := s.stat(virtualStorage, storage)
+	if stat.lastUpdate.IsZero() {
+		return 0
+	}
+
+	weight := math.Exp(-float64(s.now().Sub(stat.lastUpdate)) / float64(s.decay))
+	return time.Duration(weight * float64(stat.latency))
+}
+
+// ReplicaSelector selects the replica that serves a read.
+type ReplicaSelector interface {
+	// Select selects one of the given nodes of the virtual storage. ErrNoSuitableNode is
+	// returned if no nodes are given.
+	Select(virtualStorage string, nodes []RouterNode) (RouterNode, error)
+}
+
+// NewReplicaSelector returns a ReplicaSelector implementing the given strategy. Random
+// selection is used if the strategy is not set.
+func NewReplicaSelector(strategy config.ReplicaSelection, stats *NodeStats, rand Random) ReplicaSelector {
+	switch strategy {
+	case config.ReplicaSelectionLeastOutstanding:
+		return costSelector{rand: rand, cost: func(virtualStorage, storage string) float64 {
+			return float64(stats.Outstanding(virtualStorage, storage))
+		}}
+	case config.ReplicaSelectionEWMA:
+		return costSelector{rand: rand, cost: func(virtualStorage, storage string) float64 {
+			// Weighting the latency with the requests in flight avoids piling up
+			// requests on the fastest node before their latency is known.
+			return float64(stats.Latency(virtualStorage, storage)) * float64(stats.Outstanding(virtualStorage, storage)+1)
+		}}
+	case config.ReplicaSelectionPowerOfTwoChoices:
+		return powerOfTwoChoicesSelector{rand: rand, stats: stats}
+	default:
+		return randomSelector{rand: rand}
+	}
+}
+
+type randomSelector struct {
+	rand Random
+}
+
+func (s randomSelector) Select(_ string, nodes []RouterNode) (RouterNode, error) {
+	if len(nodes) == 0 {
+		return RouterNode{}, ErrNoSuitableNode
+	}
+
+	return nodes[s.rand.Intn(len(nodes))], nil
+}
+
+// costSelector selects the node with the lowest cost. Ties are broken randomly so that load is
+// spread evenly across nodes with equal costs.
+type costSelector struct {
+	rand Random
+	cost func(virtualStorage, storage string) float64
+}
+
+func (s costSelector) Select(virtualStorage string, nodes []RouterNode) (RouterNode, error) {
+	if len(nodes) == 0 {
+		return RouterNode{}, ErrNoSuitableNode
+	}
+
+	offset := s.rand.Intn(len(nodes))
+
+	var selected RouterNode
+	minCost := math.Inf(1)
+	for i := range nodes {
+		node := nodes[(offset+i)%len(nodes)]
+		if cost := s.cost(virtualStorage, node.Storage); cost < minCost {
+			selected, minCost = node, cost
+		}
+	}
+
+	return selected, nil
+}
+
+// powerOfTwoChoicesSelector selects the node with fewer requests in flight out of two randomly
+// chosen nodes. This avoids the herd behaviour of always choosing the least loaded node while
+// still steering requests away from busy nodes.
+type powerOfTwoChoicesSelector struct {
+	rand  Random
+	stats *NodeStats
+}
+
+func (s powerOfTwoChoicesSelector) Select(virtualStorage string, nodes []RouterNode) (RouterNode, error) {
+	switch len(nodes) {
+	case 0:
+		return RouterNode{}, ErrNoSuitableNode
+	case 1:
+		return nodes[0], nil
+	}
+
+	first := s.rand.Intn(len(nodes))
+	// Pick the second node out of the remaining ones so that we always compare two distinct
+	// nodes.
+	second := (first + 1 + s.rand.Intn(len(nodes)-1)) % len(nodes)
+
+	a, b := nodes[first], nodes[second]
+	outstandingA, outstandingB := s.stats.Outstanding(virtualStorage, a.Storage), s.stats.Outstanding(virtualStorage, b.Storage)
+	if outstandingA != outstandingB {
+		if outstandingA < outstandingB {
+			return a, nil
+		}
+		return b, nil
+	}
+
+	if s.stats.Latency(virtualStorage, b.Storage) < s.stats.Latency(virtualStorage, a.Storage) {
+		return b, nil
+	}
+
+	return a, nil
+}
@@ -0,0 +1,196 @@
+package praefect
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/config"
+)
+
+func TestNodeStats(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	stats := NewNodeStats()
+	stats.now = func() time.Time {
+		return now
+	}
+
+	require.Zero(t, stats.Outstanding("virtual-storage", "gitaly-1"))
+	require.Zero(t, stats.Latency("virtual-storage", "gitaly-1"))
+
+	done1 := stats.Begin("virtual-storage", "gitaly-1")
+	done2 := stats.Begin("virtual-storage", "gitaly-1")
+	require.Equal(t, 2, stats.Outstanding("virtual-storage", "gitaly-1"))
+	require.Zero(t, stats.Outstanding("virtual-storage", "gitaly-2"))
+	require.Zero(t, stats.Outstanding("other-storage", "gitaly-1"))
+
+	now = now.Add(time.Second)
+	done1()
+	// Calling the function multiple times only finishes the request once.
+	done1()
+	require.Equal(t, 1, stats.Outstanding("virtual-storage", "gitaly-1"))
+	// The first latency initializes the average.
+	require.Equal(t, time.Second, stats.Latency("virtual-storage", "gitaly-1"))
+
+	now = now.Add(stats.decay)
+	done2()
+	require.Zero(t, stats.Outstanding("virtual-storage", "gitaly-1"))
+	// The previous average only has a weight of 1/e as a whole decay period has passed since
+	// it was updated. The second request took 11 seconds.
+	weight := math.Exp(-1)
+	require.InDelta(t, weight*1+(1-weight)*11, stats.Latency("virtual-storage", "gitaly-1").Seconds(), 0.001)
+
+	// Latencies decay while no requests finish.
+	now = now.Add(stats.decay)
+	require.InDelta(t, weight*(weight*1+(1-weight)*11), stats.Latency("virtual-storage", "gitaly-1").Seconds(), 0.001)
+}
+
+func TestReplicaSelector(t *testing.T) {
+	t.Parallel()
+
+	nodes := []RouterNode{{Storage: "gitaly-1"}, {Storage: "gitaly-2"}, {Storage: "gitaly-3"}}
+
+	// firstRandom always returns the first choice, which makes ties deterministic.
+	firstRandom := mockRandom{intnFunc: func(int) int { return 0 }}
+
+	for _, tc := range []struct {
+		desc            string
+		strategy        config.ReplicaSelection
+		rand            Random
+		setup           func(stats *NodeStats, now *time.Time)
+		expectedStorage string
+	}{
+		{
+			desc:     "random",
+			strategy: config.ReplicaSelectionRandom,
+			rand:     mockRandom{intnFunc: func(n int) int { return n - 1 }},
+			setup: func(stats *NodeStats, _ *time.Time) {
+				stats.Begin("virtual-storage", "gitaly-3")
+			},
+			expectedStorage: "gitaly-3",
+		},
+		{
+			desc:            "unset defaults to random",
+			rand:            mockRandom{intnFunc: func(n int) int { return 1 }},
+			setup:           func(*NodeStats, *time.Time) {},
+			expectedStorage: "gitaly-2",
+		},
+		{
+			desc:     "least outstanding",
+			strategy: config.ReplicaSelectionLeastOutstanding,
+			rand:     firstRandom,
+			setup: func(stats *NodeStats, _ *time.Time) {
+				stats.Begin("virtual-storage", "gitaly-1")
+				stats.Begin("virtual-storage", "gitaly-1")
+				stats.Begin("virtual-storage", "gitaly-2")
+				stats.Begin("virtual-storage", "gitaly-3")
+				stats.Begin("virtual-storage", "gitaly-3")
+			},
+			expectedStorage: "gitaly-2",
+		},
+		{
+			desc:            "least outstanding breaks ties randomly",
+			strategy:        config.ReplicaSelectionLeastOutstanding,
+			rand:            mockRandom{intnFunc: func(n int) int { return 2 }},
+			setup:           func(*NodeStats, *time.Time) {},
+			expectedStorage: "gitaly-3",
+		},
+		{
+			desc:     "ewma",
+			strategy: config.ReplicaSelectionEWMA,
+			rand:     firstRandom,
+			setup: func(stats *NodeStats, now *time.Time) {
+				slow := stats.Begin("virtual-storage", "gitaly-1")
+				fast := stats.Begin("virtual-storage", "gitaly-2")
+				medium := stats.Begin("virtual-storage", "gitaly-3")
+
+				*now = now.Add(time.Millisecond)
+				fast()
+				*now = now.Add(time.Millisecond)
+				medium()
+				*now = now.Add(time.Second)
+				slow()
+			},
+			expectedStorage: "gitaly-2",
+		},
+		{
+			desc:     "ewma weighs latency with outstanding requests",
+			strategy: config.ReplicaSelectionEWMA,
+			rand:     firstRandom,
+			setup: func(stats *NodeStats, now *time.Time) {
+				for _, storage := range []string{"gitaly-1", "gitaly-2", "gitaly-3"} {
+					done := stats.Begin("virtual-storage", storage)
+					*now = now.Add(time.Millisecond)
+					done()
+				}
+
+				stats.Begin("virtual-storage", "gitaly-1")
+				stats.Begin("virtual-storage", "gitaly-2")
+			},
+			expectedStorage: "gitaly-3",
+		},
+		{
+			desc:     "power of two choices picks the less loaded node",
+			strategy: config.ReplicaSelectionPowerOfTwoChoices,
+			// Chooses gitaly-1 and gitaly-2.
+			rand: firstRandom,
+			setup: func(stats *NodeStats, _ *time.Time) {
+				stats.Begin("virtual-storage", "gitaly-1")
+			},
+			expectedStorage: "gitaly-2",
+		},
+		{
+			desc:     "power of two choices only considers the chosen nodes",
+			strategy: config.ReplicaSelectionPowerOfTwoChoices,
+			// Chooses gitaly-1 and gitaly-2.
+			rand: firstRandom,
+			setup: func(stats *NodeStats, _ *time.Time) {
+				stats.Begin("virtual-storage", "gitaly-1")
+				stats.Begin("virtual-storage", "gitaly-2")
+				stats.Begin("virtual-storage", "gitaly-2")
+			},
+			expectedStorage: "gitaly-1",
+		},
+		{
+			desc:     "power of two choices breaks ties by latency",
+			strategy: config.ReplicaSelectionPowerOfTwoChoices,
+			// Chooses gitaly-1 and gitaly-2.
+			rand: firstRandom,
+			setup: func(stats *NodeStats, now *time.Time) {
+				slow := stats.Begin("virtual-storage", "gitaly-1")
+				fast := stats.Begin("virtual-storage", "gitaly-2")
+				*now = now.Add(time.Millisecond)
+				fast()
+				*now = now.Add(time.Second)
+				slow()
+			},
+			expectedStorage: "gitaly-2",
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+			stats := NewNodeStats()
+			stats.now = func() time.Time {
+				return now
+			}
+
+			tc.setup(stats, &now)
+
+			selector := NewReplicaSelector(tc.strategy, stats, tc.rand)
+
+			node, err := selector.Select("virtual-storage", nodes)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedStorage, node.Storage)
+
+			_, err = selector.Select("virtual-storage", nil)
+			require.Equal(t, ErrNoSuitableNode, err)
+		})
+	}
+}
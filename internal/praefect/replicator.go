@@ -534,6 +534,7 @@ func (r ReplMgr) startHealthUpdate(ctx context.Context, logger log.Logger, event
 func (r ReplMgr) handleNodeEvent(ctx context.Context, logger log.Logger, targetConnection *grpc.ClientConn, event datastore.ReplicationEvent) datastore.JobState {
 	cid := getCorrelationID(event.Meta)
 	ctx = correlation.ContextWithCorrelation(ctx, cid)
+	ctx = datastore.WithAuditActor(ctx, "replicator", fmt.Sprintf("%s replication job %d", event.Job.Change, event.ID))
 
 	// we want it to be queryable by common `json.correlation_id` filter
 	logger = logger.WithField(correlation.FieldName, cid)
@@ -547,6 +548,11 @@ func (r ReplMgr) handleNodeEvent(ctx context.Context, logger log.Logger, targetC
 		}
 
 		logger.WithError(err).WithField("new_state", newState).Error("replication job processing finished")
+
+		if err := r.queue.RecordError(ctx, event.ID, err.Error()); err != nil {
+			logger.WithError(err).Error("failed to record replication job error")
+		}
+
 		return newState
 	}
 
@@ -43,6 +43,9 @@ type RepositoryAccessorRoute struct {
 	ReplicaPath string
 	// Node contains the details of the node that should handle the request.
 	Node RouterNode
+	// Done must be called once the request has been handled by the node. It feeds the
+	// statistics used to select replicas. Done may be nil.
+	Done func()
 }
 
 func (r RepositoryAccessorRoute) addLogFields(ctx context.Context) {
@@ -137,8 +140,9 @@ type Router interface {
 	// storage mutator request.
 	RouteStorageMutator(ctx context.Context, virtualStorage string) (StorageMutatorRoute, error)
 	// RouteRepositoryAccessor returns the node that should serve the repository accessor
-	// request. If forcePrimary is set to `true`, it returns the primary node.
-	RouteRepositoryAccessor(ctx context.Context, virtualStorage, relativePath string, forcePrimary bool) (RepositoryAccessorRoute, error)
+	// request. If forcePrimary is set to `true`, it returns the primary node. If minimumGeneration is not
+	// datastore.GenerationUnknown, only replicas on at least the given generation are considered.
+	RouteRepositoryAccessor(ctx context.Context, virtualStorage, relativePath string, forcePrimary bool, minimumGeneration int) (RepositoryAccessorRoute, error)
 	// RouteRepositoryMutator returns a route to primary and secondary nodes that should handle the
 	// repository mutator request. Additionally, it returns nodes which do not participate in the
 	// transaction, but to which the change should be replicated. RouteRepositoryMutator should only
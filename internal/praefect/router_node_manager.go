@@ -35,8 +35,10 @@ func NewNodeManagerRouter(mgr nodes.Manager, rs datastore.RepositoryStore) Route
 	return &nodeManagerRouter{mgr: mgr, rs: rs}
 }
 
-func (r *nodeManagerRouter) RouteRepositoryAccessor(ctx context.Context, virtualStorage, relativePath string, forcePrimary bool) (RepositoryAccessorRoute, error) {
-	if forcePrimary {
+func (r *nodeManagerRouter) RouteRepositoryAccessor(ctx context.Context, virtualStorage, relativePath string, forcePrimary bool, minimumGeneration int) (RepositoryAccessorRoute, error) {
+	// The node manager doesn't track per-replica generations when routing. The primary is always on the
+	// latest generation, so reads requiring a minimum generation are routed there.
+	if forcePrimary || minimumGeneration != datastore.GenerationUnknown {
 		shard, err := r.mgr.GetShard(ctx, virtualStorage)
 		if err != nil {
 			return RepositoryAccessorRoute{}, fmt.Errorf("get shard: %w", err)
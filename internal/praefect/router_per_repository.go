@@ -4,8 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 
+	"gitlab.com/gitlab-org/gitaly/v16/internal/datastructure"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/storage"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/config"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/datastore"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/nodes"
 	"gitlab.com/gitlab-org/gitaly/v16/proto/go/gitalypb"
@@ -15,6 +18,13 @@ import (
 const (
 	routeRepositoryAccessorPolicy            = "gitaly-route-repository-accessor-policy"
 	routeRepositoryAccessorPolicyPrimaryOnly = "primary-only"
+	// repositoryGenerationKey is the trailer key Praefect sets on mutator responses. It contains the
+	// repository's generation after the write.
+	repositoryGenerationKey = "gitaly-repository-generation"
+	// minimumRepositoryGenerationKey is the metadata key clients can set on accessor requests to require
+	// the request to be served by a replica that is on at least the given generation. Passing the
+	// generation returned in a mutator's trailer gives read-your-writes consistency.
+	minimumRepositoryGenerationKey = "gitaly-minimum-repository-generation"
 )
 
 // errPrimaryUnassigned is returned when the primary node is not in the set of assigned nodes.
@@ -52,6 +62,10 @@ type PerRepositoryRouter struct {
 	csg                       datastore.ConsistentStoragesGetter
 	rs                        datastore.RepositoryStore
 	defaultReplicationFactors map[string]int
+	nodeStats                 *NodeStats
+	replicaSelectors          map[string]ReplicaSelector
+	failureDomains            config.FailureDomains
+	dc                        DrainChecker
 }
 
 // NewPerRepositoryRouter returns a new PerRepositoryRouter using the passed configuration.
@@ -64,7 +78,17 @@ func NewPerRepositoryRouter(
 	ag AssignmentGetter,
 	rs datastore.RepositoryStore,
 	defaultReplicationFactors map[string]int,
+	replicaSelections map[string]config.ReplicaSelection,
+	failureDomains config.FailureDomains,
+	dc DrainChecker,
 ) *PerRepositoryRouter {
+	nodeStats := NewNodeStats()
+
+	replicaSelectors := make(map[string]ReplicaSelector, len(conns))
+	for virtualStorage := range conns {
+		replicaSelectors[virtualStorage] = NewReplicaSelector(replicaSelections[virtualStorage], nodeStats, rand)
+	}
+
 	return &PerRepositoryRouter{
 		conns:                     conns,
 		pg:                        pg,
@@ -74,6 +98,10 @@ func NewPerRepositoryRouter(
 		ag:                        ag,
 		rs:                        rs,
 		defaultReplicationFactors: defaultReplicationFactors,
+		nodeStats:                 nodeStats,
+		replicaSelectors:          replicaSelectors,
+		failureDomains:            failureDomains,
+		dc:                        dc,
 	}
 }
 
@@ -103,6 +131,33 @@ func (r *PerRepositoryRouter) healthyNodes(virtualStorage string) ([]RouterNode,
 	return healthyNodes, nil
 }
 
+// withoutDrained filters out the drained nodes. Drained nodes should not receive new reads but if all of the
+// nodes are drained, the nodes are returned as is so the reads can still be served.
+func (r *PerRepositoryRouter) withoutDrained(virtualStorage string, nodes []RouterNode) []RouterNode {
+	if r.dc == nil {
+		return nodes
+	}
+
+	drained := r.dc.DrainedNodes()[virtualStorage]
+	if len(drained) == 0 {
+		return nodes
+	}
+
+	drainedSet := datastructure.SetFromSlice(drained)
+	undrained := make([]RouterNode, 0, len(nodes))
+	for _, node := range nodes {
+		if !drainedSet.HasValue(node.Storage) {
+			undrained = append(undrained, node)
+		}
+	}
+
+	if len(undrained) == 0 {
+		return nodes
+	}
+
+	return undrained
+}
+
 func (r *PerRepositoryRouter) pickRandom(nodes []RouterNode) (RouterNode, error) {
 	if len(nodes) == 0 {
 		return RouterNode{}, ErrNoSuitableNode
@@ -121,7 +176,7 @@ func (r *PerRepositoryRouter) RouteStorageAccessor(ctx context.Context, virtualS
 		return RouterNode{}, err
 	}
 
-	return r.pickRandom(healthyNodes)
+	return r.pickRandom(r.withoutDrained(virtualStorage, healthyNodes))
 }
 
 // RouteStorageMutator is not implemented here. The only storage scoped mutator RPC is related to namespace operations.
@@ -131,12 +186,16 @@ func (r *PerRepositoryRouter) RouteStorageMutator(ctx context.Context, virtualSt
 }
 
 //nolint:revive // This is unintentionally missing documentation.
-func (r *PerRepositoryRouter) RouteRepositoryAccessor(ctx context.Context, virtualStorage, relativePath string, forcePrimary bool) (RepositoryAccessorRoute, error) {
+func (r *PerRepositoryRouter) RouteRepositoryAccessor(ctx context.Context, virtualStorage, relativePath string, forcePrimary bool, minimumGeneration int) (RepositoryAccessorRoute, error) {
 	healthyNodes, err := r.healthyNodes(virtualStorage)
 	if err != nil {
 		return RepositoryAccessorRoute{}, err
 	}
 
+	if !forcePrimary && minimumGeneration != datastore.GenerationUnknown {
+		return r.routeRepositoryAccessorByGeneration(ctx, virtualStorage, relativePath, minimumGeneration, healthyNodes)
+	}
+
 	if forcePrimary {
 		repositoryID, err := r.rs.GetRepositoryID(ctx, virtualStorage, relativePath)
 		if err != nil {
@@ -158,6 +217,7 @@ func (r *PerRepositoryRouter) RouteRepositoryAccessor(ctx context.Context, virtu
 				return RepositoryAccessorRoute{
 					ReplicaPath: replicaPath,
 					Node:        node,
+					Done:        r.nodeStats.Begin(virtualStorage, node.Storage),
 				}, nil
 			}
 		}
@@ -179,7 +239,7 @@ func (r *PerRepositoryRouter) RouteRepositoryAccessor(ctx context.Context, virtu
 		healthyConsistentNodes = append(healthyConsistentNodes, node)
 	}
 
-	node, err := r.pickRandom(healthyConsistentNodes)
+	node, err := r.replicaSelectors[virtualStorage].Select(virtualStorage, r.withoutDrained(virtualStorage, healthyConsistentNodes))
 	if err != nil {
 		return RepositoryAccessorRoute{}, err
 	}
@@ -187,6 +247,67 @@ func (r *PerRepositoryRouter) RouteRepositoryAccessor(ctx context.Context, virtu
 	return RepositoryAccessorRoute{
 		ReplicaPath: replicaPath,
 		Node:        node,
+		Done:        r.nodeStats.Begin(virtualStorage, node.Storage),
+	}, nil
+}
+
+// routeRepositoryAccessorByGeneration routes the accessor to a healthy replica that is on at least the
+// minimum generation. The replicas' generations are read from the repository store rather than the
+// consistent storages cache as the cache may not yet reflect a write the client just performed. If no
+// replica satisfies the requirement, the request is routed to the primary as it always holds the latest
+// acknowledged writes.
+func (r *PerRepositoryRouter) routeRepositoryAccessorByGeneration(
+	ctx context.Context,
+	virtualStorage, relativePath string,
+	minimumGeneration int,
+	healthyNodes []RouterNode,
+) (RepositoryAccessorRoute, error) {
+	repositoryID, err := r.rs.GetRepositoryID(ctx, virtualStorage, relativePath)
+	if err != nil {
+		return RepositoryAccessorRoute{}, fmt.Errorf("get repository id: %w", err)
+	}
+
+	metadata, err := r.rs.GetRepositoryMetadata(ctx, repositoryID)
+	if err != nil {
+		return RepositoryAccessorRoute{}, fmt.Errorf("get repository metadata: %w", err)
+	}
+
+	generations := make(map[string]int64, len(metadata.Replicas))
+	for _, replica := range metadata.Replicas {
+		generations[replica.Storage] = replica.Generation
+	}
+
+	var primaryNode *RouterNode
+	candidates := make([]RouterNode, 0, len(healthyNodes))
+	for i, node := range healthyNodes {
+		if node.Storage == metadata.Primary {
+			primaryNode = &healthyNodes[i]
+		}
+
+		generation, ok := generations[node.Storage]
+		if !ok || generation < int64(minimumGeneration) {
+			continue
+		}
+
+		candidates = append(candidates, node)
+	}
+
+	var node RouterNode
+	if len(candidates) > 0 {
+		node, err = r.replicaSelectors[virtualStorage].Select(virtualStorage, r.withoutDrained(virtualStorage, candidates))
+		if err != nil {
+			return RepositoryAccessorRoute{}, err
+		}
+	} else if primaryNode != nil {
+		node = *primaryNode
+	} else {
+		return RepositoryAccessorRoute{}, ErrNoSuitableNode
+	}
+
+	return RepositoryAccessorRoute{
+		ReplicaPath: metadata.ReplicaPath,
+		Node:        node,
+		Done:        r.nodeStats.Begin(virtualStorage, node.Storage),
 	}, nil
 }
 
@@ -407,10 +528,12 @@ func (r *PerRepositoryRouter) assignRepositoryToNodes(
 		// fallback to the behavior of no assignments and replicate everywhere. Otherwise,
 		// if we have a positive replication factor, we pick a random set of secondaries.
 		if replicationFactor > 0 {
-			// Select random secondaries according to the default replication factor.
+			// Select random secondaries according to the default replication factor. The
+			// secondaries are spread across as many failure domains as possible.
 			r.rand.Shuffle(len(secondaryNodes), func(i, j int) {
 				secondaryNodes[i], secondaryNodes[j] = secondaryNodes[j], secondaryNodes[i]
 			})
+			r.spreadAcrossFailureDomains(virtualStorage, primary.Storage, secondaryNodes)
 
 			secondaryNodes = secondaryNodes[:replicationFactor-1]
 		}
@@ -445,6 +568,23 @@ func (r *PerRepositoryRouter) assignRepositoryToNodes(
 	}
 }
 
+// spreadAcrossFailureDomains reorders the secondaries so that secondaries in failure domains which
+// hold fewer replicas of the repository come first. The primary's failure domain is considered to
+// already hold a replica. The order of secondaries within a failure domain is retained.
+func (r *PerRepositoryRouter) spreadAcrossFailureDomains(virtualStorage, primary string, secondaries []RouterNode) {
+	replicas := map[string]int{r.failureDomains.FailureDomain(virtualStorage, primary): 1}
+	ranks := make(map[string]int, len(secondaries))
+	for _, secondary := range secondaries {
+		failureDomain := r.failureDomains.FailureDomain(virtualStorage, secondary.Storage)
+		ranks[secondary.Storage] = replicas[failureDomain]
+		replicas[failureDomain]++
+	}
+
+	sort.SliceStable(secondaries, func(i, j int) bool {
+		return ranks[secondaries[i].Storage] < ranks[secondaries[j].Storage]
+	})
+}
+
 // RouteRepositoryCreation routes an incoming repository creation to a set of target nodes that will
 // be designated to hold the new repository.
 func (r *PerRepositoryRouter) RouteRepositoryCreation(ctx context.Context, virtualStorage, relativePath, additionalRelativePath string) (RepositoryMutatorRoute, error) {
@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/git/gittest"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/gitaly/storage"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/config"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/datastore"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/nodes"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
@@ -34,12 +35,20 @@ func (st StaticRepositoryAssignments) GetHostAssignments(ctx context.Context, vi
 	return storages, nil
 }
 
+// staticDrainChecker returns the configured drained storages.
+type staticDrainChecker map[string][]string
+
+func (dc staticDrainChecker) DrainedNodes() map[string][]string {
+	return dc
+}
+
 func TestPerRepositoryRouter_RouteStorageAccessor(t *testing.T) {
 	ctx := testhelper.Context(t)
 
 	for _, tc := range []struct {
 		desc           string
 		virtualStorage string
+		drainedNodes   staticDrainChecker
 		numCandidates  int
 		pickCandidate  int
 		error          error
@@ -64,6 +73,22 @@ func TestPerRepositoryRouter_RouteStorageAccessor(t *testing.T) {
 			pickCandidate:  1,
 			node:           "valid-choice-2",
 		},
+		{
+			desc:           "drained node is not picked",
+			virtualStorage: "virtual-storage-1",
+			drainedNodes:   staticDrainChecker{"virtual-storage-1": {"valid-choice-1"}},
+			numCandidates:  1,
+			pickCandidate:  0,
+			node:           "valid-choice-2",
+		},
+		{
+			desc:           "drained nodes are picked if all nodes are drained",
+			virtualStorage: "virtual-storage-1",
+			drainedNodes:   staticDrainChecker{"virtual-storage-1": {"valid-choice-1", "valid-choice-2"}},
+			numCandidates:  2,
+			pickCandidate:  1,
+			node:           "valid-choice-2",
+		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			conns := Connections{
@@ -93,6 +118,9 @@ func TestPerRepositoryRouter_RouteStorageAccessor(t *testing.T) {
 				nil,
 				datastore.MockRepositoryStore{},
 				nil,
+				nil,
+				nil,
+				tc.drainedNodes,
 			)
 
 			node, err := router.RouteStorageAccessor(ctx, tc.virtualStorage)
@@ -116,12 +144,16 @@ func TestPerRepositoryRouter_RouteRepositoryAccessor(t *testing.T) {
 		desc           string
 		virtualStorage string
 		healthyNodes   StaticHealthChecker
+		drainedNodes   staticDrainChecker
 		metadata       map[string]string
 		forcePrimary   bool
-		numCandidates  int
-		pickCandidate  int
-		error          error
-		node           string
+		// requireGeneration determines whether minimumGeneration is passed to the router.
+		requireGeneration bool
+		minimumGeneration int
+		numCandidates     int
+		pickCandidate     int
+		error             error
+		node              string
 	}{
 		{
 			desc:           "unknown virtual storage",
@@ -189,6 +221,91 @@ func TestPerRepositoryRouter_RouteRepositoryAccessor(t *testing.T) {
 			forcePrimary: true,
 			error:        nodes.ErrPrimaryNotHealthy,
 		},
+		{
+			desc:           "drained primary not picked",
+			virtualStorage: "virtual-storage-1",
+			healthyNodes: map[string][]string{
+				"virtual-storage-1": {"primary", "consistent-secondary"},
+			},
+			drainedNodes:  staticDrainChecker{"virtual-storage-1": {"primary"}},
+			numCandidates: 1,
+			node:          "consistent-secondary",
+		},
+		{
+			desc:           "drained primary force-picked",
+			virtualStorage: "virtual-storage-1",
+			healthyNodes: map[string][]string{
+				"virtual-storage-1": {"primary", "consistent-secondary"},
+			},
+			drainedNodes: staticDrainChecker{"virtual-storage-1": {"primary"}},
+			forcePrimary: true,
+			node:         "primary",
+		},
+		{
+			desc:           "replica on minimum generation picked",
+			virtualStorage: "virtual-storage-1",
+			healthyNodes: map[string][]string{
+				"virtual-storage-1": {"primary", "consistent-secondary", "inconsistent-secondary"},
+			},
+			requireGeneration: true,
+			minimumGeneration: 1,
+			numCandidates:     2,
+			pickCandidate:     1,
+			node:              "consistent-secondary",
+		},
+		{
+			desc:           "replica beyond minimum generation picked",
+			virtualStorage: "virtual-storage-1",
+			healthyNodes: map[string][]string{
+				"virtual-storage-1": {"consistent-secondary"},
+			},
+			requireGeneration: true,
+			minimumGeneration: 0,
+			numCandidates:     1,
+			node:              "consistent-secondary",
+		},
+		{
+			desc:           "outdated replica picked if it satisfies minimum generation",
+			virtualStorage: "virtual-storage-1",
+			healthyNodes: map[string][]string{
+				"virtual-storage-1": {"inconsistent-secondary"},
+			},
+			requireGeneration: true,
+			minimumGeneration: 0,
+			numCandidates:     1,
+			node:              "inconsistent-secondary",
+		},
+		{
+			desc:           "primary picked if no replica satisfies minimum generation",
+			virtualStorage: "virtual-storage-1",
+			healthyNodes: map[string][]string{
+				"virtual-storage-1": {"primary", "consistent-secondary", "inconsistent-secondary"},
+			},
+			requireGeneration: true,
+			minimumGeneration: 2,
+			node:              "primary",
+		},
+		{
+			desc:           "no suitable nodes if minimum generation unsatisfiable and primary unhealthy",
+			virtualStorage: "virtual-storage-1",
+			healthyNodes: map[string][]string{
+				"virtual-storage-1": {"consistent-secondary", "inconsistent-secondary"},
+			},
+			requireGeneration: true,
+			minimumGeneration: 2,
+			error:             ErrNoSuitableNode,
+		},
+		{
+			desc:           "minimum generation ignored when force-picking primary",
+			virtualStorage: "virtual-storage-1",
+			healthyNodes: map[string][]string{
+				"virtual-storage-1": {"primary", "consistent-secondary"},
+			},
+			forcePrimary:      true,
+			requireGeneration: true,
+			minimumGeneration: 1,
+			node:              "primary",
+		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			ctx := testhelper.Context(t)
@@ -218,9 +335,8 @@ func TestPerRepositoryRouter_RouteRepositoryAccessor(t *testing.T) {
 				rs.CreateRepository(ctx, repositoryID, "virtual-storage-1", relativePath, relativePath, "primary",
 					[]string{"consistent-secondary", "unhealthy-secondary", "inconsistent-secondary"}, nil, true, true),
 			)
-			require.NoError(t,
-				rs.IncrementGeneration(ctx, repositoryID, "primary", []string{"consistent-secondary", "unhealthy-secondary"}),
-			)
+			_, err = rs.IncrementGeneration(ctx, repositoryID, "primary", []string{"consistent-secondary", "unhealthy-secondary"})
+			require.NoError(t, err)
 
 			router := NewPerRepositoryRouter(
 				conns,
@@ -236,11 +352,23 @@ func TestPerRepositoryRouter_RouteRepositoryAccessor(t *testing.T) {
 				nil,
 				rs,
 				nil,
+				nil,
+				nil,
+				tc.drainedNodes,
 			)
 
-			route, err := router.RouteRepositoryAccessor(ctx, tc.virtualStorage, relativePath, tc.forcePrimary)
+			minimumGeneration := datastore.GenerationUnknown
+			if tc.requireGeneration {
+				minimumGeneration = tc.minimumGeneration
+			}
+
+			route, err := router.RouteRepositoryAccessor(ctx, tc.virtualStorage, relativePath, tc.forcePrimary, minimumGeneration)
 			require.Equal(t, tc.error, err)
 			if tc.node != "" {
+				require.NotNil(t, route.Done)
+				route.Done()
+				route.Done = nil
+
 				require.Equal(t,
 					RepositoryAccessorRoute{
 						ReplicaPath: relativePath,
@@ -389,7 +517,8 @@ func TestPerRepositoryRouter_RouteRepositoryMutator(t *testing.T) {
 			)
 
 			if len(tc.consistentStorages) > 0 {
-				require.NoError(t, rs.IncrementGeneration(ctx, repositoryID, tc.consistentStorages[0], tc.consistentStorages[1:]))
+				_, err := rs.IncrementGeneration(ctx, repositoryID, tc.consistentStorages[0], tc.consistentStorages[1:])
+				require.NoError(t, err)
 			}
 
 			for virtualStorage, relativePaths := range tc.assignedNodes {
@@ -410,9 +539,12 @@ func TestPerRepositoryRouter_RouteRepositoryMutator(t *testing.T) {
 				tc.healthyNodes,
 				nil,
 				rs,
-				datastore.NewAssignmentStore(tx, configuredNodes),
+				datastore.NewAssignmentStore(tx, configuredNodes, nil),
 				rs,
 				nil,
+				nil,
+				nil,
+				nil,
 			)
 
 			requestAdditionalRelativePath := additionalRelativePath
@@ -638,7 +770,7 @@ func TestPerRepositoryRouter_RouteRepositoryMaintenance(t *testing.T) {
 
 			router := NewPerRepositoryRouter(conns, nil, StaticHealthChecker{
 				virtualStorage: tc.healthyStorages,
-			}, nil, nil, nil, rs, nil)
+			}, nil, nil, nil, rs, nil, nil, nil, nil)
 
 			route, err := router.RouteRepositoryMaintenance(ctx, tc.virtualStorage, relativePath)
 			require.Equal(t, tc.expectedErr, err)
@@ -1030,6 +1162,9 @@ func TestPerRepositoryRouterRouteRepositoryCreation(t *testing.T) {
 				nil,
 				rs,
 				map[string]int{"virtual-storage-1": tc.replicationFactor},
+				nil,
+				nil,
+				nil,
 			).RouteRepositoryCreation(ctx, tc.virtualStorage, tc.relativePath, tc.additionalRelativePath)
 
 			require.Equal(t, tc.expectedPrimaryCandidates, primaryCandidates)
@@ -1042,3 +1177,63 @@ func TestPerRepositoryRouterRouteRepositoryCreation(t *testing.T) {
 		})
 	}
 }
+
+func TestPerRepositoryRouter_spreadAcrossFailureDomains(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		desc             string
+		failureDomains   config.FailureDomains
+		secondaries      []string
+		expectedOrdering []string
+	}{
+		{
+			desc:             "no zones configured",
+			secondaries:      []string{"gitaly-4", "gitaly-2", "gitaly-3"},
+			expectedOrdering: []string{"gitaly-4", "gitaly-2", "gitaly-3"},
+		},
+		{
+			desc: "secondaries in unused zones come first",
+			failureDomains: config.FailureDomains{"virtual-storage": {
+				"gitaly-1": "zone-a",
+				"gitaly-2": "zone-a",
+				"gitaly-3": "zone-b",
+				"gitaly-4": "zone-b",
+				"gitaly-5": "zone-c",
+			}},
+			secondaries:      []string{"gitaly-2", "gitaly-4", "gitaly-3", "gitaly-5"},
+			expectedOrdering: []string{"gitaly-4", "gitaly-5", "gitaly-2", "gitaly-3"},
+		},
+		{
+			desc: "storages without a zone are a failure domain of their own",
+			failureDomains: config.FailureDomains{"virtual-storage": {
+				"gitaly-1": "zone-a",
+				"gitaly-2": "zone-a",
+			}},
+			secondaries:      []string{"gitaly-2", "gitaly-3", "gitaly-4"},
+			expectedOrdering: []string{"gitaly-3", "gitaly-4", "gitaly-2"},
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			router := NewPerRepositoryRouter(nil, nil, nil, nil, nil, nil, nil, nil, nil, tc.failureDomains, nil)
+
+			secondaries := make([]RouterNode, 0, len(tc.secondaries))
+			for _, storage := range tc.secondaries {
+				secondaries = append(secondaries, RouterNode{Storage: storage})
+			}
+
+			router.spreadAcrossFailureDomains("virtual-storage", "gitaly-1", secondaries)
+
+			actualOrdering := make([]string, 0, len(secondaries))
+			for _, secondary := range secondaries {
+				actualOrdering = append(actualOrdering, secondary.Storage)
+			}
+
+			require.Equal(t, tc.expectedOrdering, actualOrdering)
+		})
+	}
+}
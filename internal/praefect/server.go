@@ -125,6 +125,7 @@ func NewGRPCServer(
 		commonUnaryServerInterceptors(deps.Logger.WithField("component", "praefect.UnaryServerInterceptor"), logMsgProducer),
 		middleware.MethodTypeUnaryInterceptor(deps.Registry, deps.Logger),
 		auth.UnaryServerInterceptor(deps.Config.Auth),
+		middleware.AuditUnaryInterceptor(deps.Config.AuditLog.LogChanges),
 	)
 	unaryInterceptors = append(unaryInterceptors, serverCfg.unaryInterceptors...)
 
@@ -142,6 +143,7 @@ func NewGRPCServer(
 		statushandler.Stream, // Should be below LogHandler
 		grpctracing.StreamServerTracingInterceptor(),
 		auth.StreamServerInterceptor(deps.Config.Auth),
+		middleware.AuditStreamInterceptor(deps.Config.AuditLog.LogChanges),
 		// Panic handler should remain last so that application panics will be
 		// converted to errors and logged
 		panichandler.StreamPanicHandler(deps.Logger),
@@ -181,7 +183,10 @@ func NewGRPCServer(
 	warnDupeAddrs(deps.Logger, deps.Config)
 
 	srv := grpc.NewServer(grpcOpts...)
-	registerServices(srv, deps.Logger, deps.TxMgr, deps.Config, deps.RepositoryStore, deps.AssignmentStore, service.Connections(deps.Conns), deps.PrimaryGetter, deps.Checks)
+	// Replication jobs can only be administered with the SQL replication queue.
+	jobManager, _ := deps.Queue.(info.ReplicationJobManager)
+
+	registerServices(srv, deps.Logger, deps.TxMgr, deps.Config, deps.RepositoryStore, deps.AssignmentStore, service.Connections(deps.Conns), deps.PrimaryGetter, jobManager, deps.DrainStore, deps.Checks)
 
 	if deps.Config.Failover.ElectionStrategy == config.ElectionStrategyPerRepository {
 		proxy.RegisterStreamHandlers(srv, "gitaly.RepositoryService", map[string]grpc.StreamHandler{
@@ -217,11 +222,13 @@ func registerServices(
 	assignmentStore AssignmentStore,
 	conns service.Connections,
 	primaryGetter info.PrimaryGetter,
+	jobManager info.ReplicationJobManager,
+	drainStore datastore.DrainStore,
 	checks []service.CheckFunc,
 ) {
 	// ServerServiceServer is necessary for the ServerInfo RPC
 	gitalypb.RegisterServerServiceServer(srv, server.NewServer(conf, logger, conns, checks))
-	gitalypb.RegisterPraefectInfoServiceServer(srv, info.NewServer(conf, logger, rs, assignmentStore, conns, primaryGetter))
+	gitalypb.RegisterPraefectInfoServiceServer(srv, info.NewServer(conf, logger, rs, assignmentStore, conns, primaryGetter, jobManager, drainStore))
 	gitalypb.RegisterRefTransactionServer(srv, transaction.NewServer(tm))
 	healthpb.RegisterHealthServer(srv, health.NewServer())
 
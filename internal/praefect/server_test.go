@@ -630,9 +630,12 @@ func TestRenameRepository(t *testing.T) {
 			StaticHealthChecker(praefectCfg.StorageNames()),
 			NewLockedRandom(rand.New(rand.NewSource(0))),
 			rs,
-			datastore.NewAssignmentStore(db, praefectCfg.StorageNames()),
+			datastore.NewAssignmentStore(db, praefectCfg.StorageNames(), nil),
 			rs,
 			nil,
+			nil,
+			nil,
+			nil,
 		),
 		WithTxMgr: txManager,
 	})
@@ -58,6 +58,7 @@ func AllChecks() []CheckFunc {
 		NewGitalyNodeConnectivityCheck,
 		NewPostgresReadWriteCheck,
 		NewUnavailableReposCheck,
+		NewFailureDomainsCheck,
 		NewClockSyncCheck(helper.CheckClockSync),
 	}
 }
@@ -213,6 +214,62 @@ func NewUnavailableReposCheck(conf config.Config, w io.Writer, quiet bool) *Chec
 	}
 }
 
+// NewFailureDomainsCheck returns a check that finds repositories whose replicas share a failure domain
+func NewFailureDomainsCheck(conf config.Config, w io.Writer, quiet bool) *Check {
+	return &Check{
+		Name:        "failure domains",
+		Description: "lists repositories whose assigned storages share a failure domain although storages in other zones are available",
+		Run: func(ctx context.Context) error {
+			failureDomains := conf.FailureDomains()
+
+			zonesConfigured := false
+			for _, zones := range failureDomains {
+				if len(zones) > 0 {
+					zonesConfigured = true
+					break
+				}
+			}
+
+			if !zonesConfigured {
+				logMessage(quiet, w, "No zones are configured.")
+				return nil
+			}
+
+			db, err := glsql.OpenDB(ctx, conf.DB)
+			if err != nil {
+				return fmt.Errorf("error opening database connection: %w", err)
+			}
+			defer db.Close()
+
+			sharingRepositories, err := datastore.CountRepositoriesSharingFailureDomains(
+				ctx,
+				db,
+				conf.StorageNames(),
+				failureDomains,
+			)
+			if err != nil {
+				return err
+			}
+
+			if len(sharingRepositories) == 0 {
+				logMessage(quiet, w, "All repositories are spread across failure domains.")
+				return nil
+			}
+
+			for virtualStorage, sharingCount := range sharingRepositories {
+				format := "virtual-storage %q has %d repositories whose replicas share a failure domain."
+				if sharingCount == 1 {
+					format = "virtual-storage %q has %d repository whose replicas share a failure domain."
+				}
+				logMessage(quiet, w, format, virtualStorage, sharingCount)
+			}
+
+			return errors.New("replicas share failure domains")
+		},
+		Severity: Warning,
+	}
+}
+
 // NewClockSyncCheck returns a function that returns a check that verifies if system clock is in sync.
 func NewClockSyncCheck(clockDriftCheck func(ntpHost string, driftThreshold time.Duration) (bool, error)) CheckFunc {
 	return func(conf config.Config, w io.Writer, quite bool) *Check {
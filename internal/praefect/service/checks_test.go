@@ -505,6 +505,107 @@ func TestNewUnavailableReposCheck(t *testing.T) {
 	}
 }
 
+func TestNewFailureDomainsCheck(t *testing.T) {
+	t.Run("no zones configured", func(t *testing.T) {
+		ctx := testhelper.Context(t)
+
+		conf := config.Config{
+			VirtualStorages: []*config.VirtualStorage{
+				{
+					Name:  "virtual-storage-1",
+					Nodes: []*config.Node{{Storage: "storage-0"}, {Storage: "storage-1"}},
+				},
+			},
+		}
+
+		var stdout bytes.Buffer
+		check := NewFailureDomainsCheck(conf, &stdout, false)
+
+		require.NoError(t, check.Run(ctx))
+		require.Equal(t, "No zones are configured.\n", stdout.String())
+	})
+
+	conf := config.Config{
+		VirtualStorages: []*config.VirtualStorage{
+			{
+				Name: "virtual-storage-1",
+				Nodes: []*config.Node{
+					{Storage: "storage-0", Zone: "zone-a"},
+					{Storage: "storage-1", Zone: "zone-a"},
+					{Storage: "storage-2", Zone: "zone-b"},
+				},
+			},
+		},
+	}
+
+	for _, tc := range []struct {
+		desc        string
+		assignments map[string][]string
+		expectedMsg string
+		expectedErr error
+	}{
+		{
+			desc: "replicas spread across failure domains",
+			assignments: map[string][]string{
+				"repo-0": {"storage-0", "storage-2"},
+				"repo-1": {"storage-1"},
+				// There are more assignments than failure domains.
+				"repo-2": {"storage-0", "storage-1", "storage-2"},
+			},
+			expectedMsg: "All repositories are spread across failure domains.\n",
+		},
+		{
+			desc: "one repository sharing a failure domain",
+			assignments: map[string][]string{
+				"repo-0": {"storage-0", "storage-1"},
+				"repo-1": {"storage-1", "storage-2"},
+			},
+			expectedMsg: "virtual-storage \"virtual-storage-1\" has 1 repository whose replicas share a failure domain.\n",
+			expectedErr: errors.New("replicas share failure domains"),
+		},
+		{
+			desc: "multiple repositories sharing a failure domain",
+			assignments: map[string][]string{
+				"repo-0": {"storage-0", "storage-1"},
+				"repo-1": {"storage-1", "storage-0"},
+			},
+			expectedMsg: "virtual-storage \"virtual-storage-1\" has 2 repositories whose replicas share a failure domain.\n",
+			expectedErr: errors.New("replicas share failure domains"),
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			ctx := testhelper.Context(t)
+
+			db := testdb.New(t)
+			conf := conf
+			conf.DB = testdb.GetConfig(t, db.Name)
+
+			rs := datastore.NewPostgresRepositoryStore(db, nil)
+			for path, storages := range tc.assignments {
+				repositoryID, err := rs.ReserveRepositoryID(ctx, "virtual-storage-1", path)
+				require.NoError(t, err)
+				require.NoError(t, rs.CreateRepository(
+					ctx,
+					repositoryID,
+					"virtual-storage-1",
+					path,
+					path,
+					storages[0],
+					storages[1:], nil, true, true,
+				))
+			}
+
+			var stdout bytes.Buffer
+			check := NewFailureDomainsCheck(conf, &stdout, false)
+
+			assert.Equal(t, tc.expectedErr, check.Run(ctx))
+			assert.Equal(t, tc.expectedMsg, stdout.String())
+		})
+	}
+}
+
 func TestNewClockSyncCheck(t *testing.T) {
 	for _, tt := range []struct {
 		desc        string
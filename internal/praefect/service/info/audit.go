@@ -0,0 +1,101 @@
+package info
+
+import (
+	"context"
+	"time"
+
+	"gitlab.com/gitlab-org/gitaly/v16/internal/helper/chunk"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/datastore"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/structerr"
+	"gitlab.com/gitlab-org/gitaly/v16/proto/go/gitalypb"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var errAuditLogUnsupported = structerr.NewFailedPrecondition("the repository audit log is only recorded in the Postgres database")
+
+// RepositoryAuditLog is an interface for reading the repository audit log.
+type RepositoryAuditLog interface {
+	// ListAuditLog returns the audit log entries matching the filter. Only the limit most recent
+	// entries are returned unless limit is 0.
+	ListAuditLog(ctx context.Context, filter datastore.AuditLogFilter, limit uint) ([]datastore.AuditEntry, error)
+}
+
+type auditEntrySender struct {
+	entries []*gitalypb.RepositoryAuditLogEntry
+	send    func([]*gitalypb.RepositoryAuditLogEntry) error
+}
+
+func (t *auditEntrySender) Reset() {
+	t.entries = t.entries[:0]
+}
+
+func (t *auditEntrySender) Append(m proto.Message) {
+	t.entries = append(t.entries, m.(*gitalypb.RepositoryAuditLogEntry))
+}
+
+func (t *auditEntrySender) Send() error {
+	return t.send(t.entries)
+}
+
+// ListRepositoryAuditLog lists the entries of the repository audit log matching the filter.
+func (s *Server) ListRepositoryAuditLog(req *gitalypb.ListRepositoryAuditLogRequest, stream gitalypb.PraefectInfoService_ListRepositoryAuditLogServer) error {
+	auditLog, ok := s.rs.(RepositoryAuditLog)
+	if !ok {
+		return errAuditLogUnsupported
+	}
+
+	var since, until time.Time
+	if req.GetSince() != nil {
+		since = req.GetSince().AsTime()
+	}
+
+	if req.GetUntil() != nil {
+		until = req.GetUntil().AsTime()
+	}
+
+	entries, err := auditLog.ListAuditLog(stream.Context(), datastore.AuditLogFilter{
+		VirtualStorage: req.GetVirtualStorage(),
+		RelativePath:   req.GetRelativePath(),
+		RepositoryID:   req.GetRepositoryId(),
+		Actor:          req.GetActor(),
+		Since:          since,
+		Until:          until,
+	}, uint(req.GetLimit()))
+	if err != nil {
+		return structerr.NewInternal("list audit log: %w", err)
+	}
+
+	chunker := chunk.New(&auditEntrySender{
+		send: func(entries []*gitalypb.RepositoryAuditLogEntry) error {
+			return stream.Send(&gitalypb.ListRepositoryAuditLogResponse{
+				Entries: entries,
+			})
+		},
+	})
+
+	for _, entry := range entries {
+		if err := chunker.Send(&gitalypb.RepositoryAuditLogEntry{
+			Id:             entry.ID,
+			OccurredAt:     timestamppb.New(entry.OccurredAt),
+			TransactionId:  entry.TransactionID,
+			RepositoryId:   entry.RepositoryID,
+			VirtualStorage: entry.VirtualStorage,
+			RelativePath:   entry.RelativePath,
+			Storage:        entry.Storage,
+			Change:         entry.Change,
+			OldValue:       entry.OldValue,
+			NewValue:       entry.NewValue,
+			Actor:          entry.Actor,
+			Reason:         entry.Reason,
+		}); err != nil {
+			return structerr.NewInternal("sending entry: %w", err)
+		}
+	}
+
+	if err := chunker.Flush(); err != nil {
+		return structerr.NewInternal("flushing entries: %w", err)
+	}
+
+	return nil
+}
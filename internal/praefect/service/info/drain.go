@@ -0,0 +1,67 @@
+package info
+
+import (
+	"context"
+
+	"gitlab.com/gitlab-org/gitaly/v16/internal/log"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/structerr"
+	"gitlab.com/gitlab-org/gitaly/v16/proto/go/gitalypb"
+)
+
+var errDrainUnsupported = structerr.NewFailedPrecondition("storages can only be drained with the per_repository election strategy")
+
+// DrainStorage marks a storage as drained. A drained storage doesn't receive new reads and isn't
+// elected as a primary. Writes and replication to the storage continue so it stays up to date.
+func (s *Server) DrainStorage(ctx context.Context, req *gitalypb.DrainStorageRequest) (*gitalypb.DrainStorageResponse, error) {
+	if err := s.validateDrainTarget(req.GetVirtualStorage(), req.GetStorage()); err != nil {
+		return nil, err
+	}
+
+	if err := s.drainStore.DrainStorage(ctx, req.GetVirtualStorage(), req.GetStorage()); err != nil {
+		return nil, structerr.NewInternal("drain storage: %w", err)
+	}
+
+	s.logger.WithFields(log.Fields{
+		"virtual_storage": req.GetVirtualStorage(),
+		"storage":         req.GetStorage(),
+	}).Info("storage drained")
+
+	return &gitalypb.DrainStorageResponse{}, nil
+}
+
+// UndrainStorage takes a drained storage back into rotation.
+func (s *Server) UndrainStorage(ctx context.Context, req *gitalypb.UndrainStorageRequest) (*gitalypb.UndrainStorageResponse, error) {
+	if err := s.validateDrainTarget(req.GetVirtualStorage(), req.GetStorage()); err != nil {
+		return nil, err
+	}
+
+	if err := s.drainStore.UndrainStorage(ctx, req.GetVirtualStorage(), req.GetStorage()); err != nil {
+		return nil, structerr.NewInternal("undrain storage: %w", err)
+	}
+
+	s.logger.WithFields(log.Fields{
+		"virtual_storage": req.GetVirtualStorage(),
+		"storage":         req.GetStorage(),
+	}).Info("storage undrained")
+
+	return &gitalypb.UndrainStorageResponse{}, nil
+}
+
+func (s *Server) validateDrainTarget(virtualStorage, storage string) error {
+	if s.drainStore == nil {
+		return errDrainUnsupported
+	}
+
+	storages, ok := s.conf.StorageNames()[virtualStorage]
+	if !ok {
+		return structerr.NewInvalidArgument("unknown virtual storage: %q", virtualStorage)
+	}
+
+	for _, configuredStorage := range storages {
+		if configuredStorage == storage {
+			return nil
+		}
+	}
+
+	return structerr.NewInvalidArgument("unknown storage: %q", storage)
+}
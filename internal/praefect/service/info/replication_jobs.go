@@ -0,0 +1,179 @@
+package info
+
+import (
+	"context"
+	"errors"
+
+	"gitlab.com/gitlab-org/gitaly/v16/internal/helper/chunk"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/datastore"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/structerr"
+	"gitlab.com/gitlab-org/gitaly/v16/proto/go/gitalypb"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var errReplicationJobsUnsupported = structerr.NewFailedPrecondition("replication jobs can only be administered with the SQL replication queue")
+
+var jobStates = map[gitalypb.ReplicationJobState]datastore.JobState{
+	gitalypb.ReplicationJobState_REPLICATION_JOB_STATE_READY:       datastore.JobStateReady,
+	gitalypb.ReplicationJobState_REPLICATION_JOB_STATE_IN_PROGRESS: datastore.JobStateInProgress,
+	gitalypb.ReplicationJobState_REPLICATION_JOB_STATE_FAILED:      datastore.JobStateFailed,
+	gitalypb.ReplicationJobState_REPLICATION_JOB_STATE_DEAD:        datastore.JobStateDead,
+}
+
+func replicationJobFilter(filter *gitalypb.ReplicationJobFilter) (datastore.ReplicationJobFilter, error) {
+	states := make([]datastore.JobState, 0, len(filter.GetStates()))
+	for _, state := range filter.GetStates() {
+		jobState, ok := jobStates[state]
+		if !ok {
+			return datastore.ReplicationJobFilter{}, structerr.NewInvalidArgument("invalid job state: %q", state)
+		}
+
+		states = append(states, jobState)
+	}
+
+	return datastore.ReplicationJobFilter{
+		IDs:            filter.GetJobIds(),
+		VirtualStorage: filter.GetVirtualStorage(),
+		RelativePath:   filter.GetRelativePath(),
+		TargetStorage:  filter.GetTargetStorage(),
+		Change:         datastore.ChangeType(filter.GetChange()),
+		States:         states,
+	}, nil
+}
+
+func replicationJobState(state datastore.JobState) gitalypb.ReplicationJobState {
+	for protoState, jobState := range jobStates {
+		if jobState == state {
+			return protoState
+		}
+	}
+
+	return gitalypb.ReplicationJobState_REPLICATION_JOB_STATE_UNSPECIFIED
+}
+
+type jobSender struct {
+	jobs []*gitalypb.ReplicationJob
+	send func([]*gitalypb.ReplicationJob) error
+}
+
+func (t *jobSender) Reset() {
+	t.jobs = t.jobs[:0]
+}
+
+func (t *jobSender) Append(m proto.Message) {
+	t.jobs = append(t.jobs, m.(*gitalypb.ReplicationJob))
+}
+
+func (t *jobSender) Send() error {
+	return t.send(t.jobs)
+}
+
+// ListReplicationJobs lists the jobs in the replication queue matching the filter.
+func (s *Server) ListReplicationJobs(req *gitalypb.ListReplicationJobsRequest, stream gitalypb.PraefectInfoService_ListReplicationJobsServer) error {
+	if s.jobManager == nil {
+		return errReplicationJobsUnsupported
+	}
+
+	filter, err := replicationJobFilter(req.GetFilter())
+	if err != nil {
+		return err
+	}
+
+	jobs, err := s.jobManager.ListJobs(stream.Context(), filter, uint(req.GetLimit()))
+	if err != nil {
+		return structerr.NewInternal("list jobs: %w", err)
+	}
+
+	chunker := chunk.New(&jobSender{
+		send: func(jobs []*gitalypb.ReplicationJob) error {
+			return stream.Send(&gitalypb.ListReplicationJobsResponse{
+				Jobs: jobs,
+			})
+		},
+	})
+
+	for _, job := range jobs {
+		var updatedAt, lockTriggeredAt *timestamppb.Timestamp
+		if job.UpdatedAt != nil {
+			updatedAt = timestamppb.New(*job.UpdatedAt)
+		}
+
+		if job.LockTriggeredAt != nil {
+			lockTriggeredAt = timestamppb.New(*job.LockTriggeredAt)
+		}
+
+		correlationID, _ := job.Meta[datastore.CorrelationIDKey].(string)
+
+		if err := chunker.Send(&gitalypb.ReplicationJob{
+			Id:              job.ID,
+			State:           replicationJobState(job.State),
+			Change:          job.Job.Change.String(),
+			VirtualStorage:  job.Job.VirtualStorage,
+			RelativePath:    job.Job.RelativePath,
+			ReplicaPath:     job.Job.ReplicaPath,
+			RepositoryId:    job.Job.RepositoryID,
+			SourceStorage:   job.Job.SourceNodeStorage,
+			TargetStorage:   job.Job.TargetNodeStorage,
+			AttemptsLeft:    int32(job.Attempt),
+			CreatedAt:       timestamppb.New(job.CreatedAt),
+			UpdatedAt:       updatedAt,
+			Error:           job.Error,
+			CorrelationId:   correlationID,
+			LockId:          job.LockID,
+			LockAcquired:    job.LockAcquired,
+			LockTriggeredAt: lockTriggeredAt,
+		}); err != nil {
+			return structerr.NewInternal("sending job: %w", err)
+		}
+	}
+
+	if err := chunker.Flush(); err != nil {
+		return structerr.NewInternal("flushing jobs: %w", err)
+	}
+
+	return nil
+}
+
+// RetryReplicationJobs moves the dead jobs in the replication queue matching the filter back into
+// the ready state.
+func (s *Server) RetryReplicationJobs(ctx context.Context, req *gitalypb.RetryReplicationJobsRequest) (*gitalypb.RetryReplicationJobsResponse, error) {
+	if s.jobManager == nil {
+		return nil, errReplicationJobsUnsupported
+	}
+
+	filter, err := replicationJobFilter(req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
+
+	ids, err := s.jobManager.RetryJobs(ctx, filter)
+	if err != nil {
+		return nil, structerr.NewInternal("retry jobs: %w", err)
+	}
+
+	return &gitalypb.RetryReplicationJobsResponse{JobIds: ids}, nil
+}
+
+// CancelReplicationJobs removes the jobs in the replication queue matching the filter.
+func (s *Server) CancelReplicationJobs(ctx context.Context, req *gitalypb.CancelReplicationJobsRequest) (*gitalypb.CancelReplicationJobsResponse, error) {
+	if s.jobManager == nil {
+		return nil, errReplicationJobsUnsupported
+	}
+
+	filter, err := replicationJobFilter(req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
+
+	ids, err := s.jobManager.CancelJobs(ctx, filter)
+	if err != nil {
+		if errors.Is(err, datastore.ErrEmptyReplicationJobFilter) {
+			return nil, structerr.NewInvalidArgument("%w", err)
+		}
+
+		return nil, structerr.NewInternal("cancel jobs: %w", err)
+	}
+
+	return &gitalypb.CancelReplicationJobsResponse{JobIds: ids}, nil
+}
@@ -33,6 +33,17 @@ type PrimaryGetter interface {
 	GetPrimary(ctx context.Context, virtualStorage string, repositoryID int64) (string, error)
 }
 
+// ReplicationJobManager is an interface for administering the jobs in the replication queue.
+type ReplicationJobManager interface {
+	// ListJobs returns the replication jobs matching the filter. At most limit jobs are returned
+	// unless limit is 0.
+	ListJobs(ctx context.Context, filter datastore.ReplicationJobFilter, limit uint) ([]datastore.ReplicationJobDetails, error)
+	// RetryJobs moves the dead replication jobs matching the filter back into the ready state.
+	RetryJobs(ctx context.Context, filter datastore.ReplicationJobFilter) ([]uint64, error)
+	// CancelJobs removes the replication jobs matching the filter from the queue.
+	CancelJobs(ctx context.Context, filter datastore.ReplicationJobFilter) ([]uint64, error)
+}
+
 // Server is a InfoService server
 type Server struct {
 	gitalypb.UnimplementedPraefectInfoServiceServer
@@ -42,6 +53,8 @@ type Server struct {
 	assignmentStore AssignmentStore
 	conns           service.Connections
 	primaryGetter   PrimaryGetter
+	jobManager      ReplicationJobManager
+	drainStore      datastore.DrainStore
 }
 
 // NewServer creates a new instance of a grpc InfoServiceServer
@@ -52,6 +65,8 @@ func NewServer(
 	assignmentStore AssignmentStore,
 	conns service.Connections,
 	primaryGetter PrimaryGetter,
+	jobManager ReplicationJobManager,
+	drainStore datastore.DrainStore,
 ) gitalypb.PraefectInfoServiceServer {
 	return &Server{
 		conf:            conf,
@@ -60,6 +75,8 @@ func NewServer(
 		assignmentStore: assignmentStore,
 		conns:           conns,
 		primaryGetter:   primaryGetter,
+		jobManager:      jobManager,
+		drainStore:      drainStore,
 	}
 }
 
@@ -0,0 +1,122 @@
+package server
+
+import (
+	"context"
+	"sync"
+
+	"gitlab.com/gitlab-org/gitaly/v16/internal/command/accounting"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/grpc/metadata"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/structerr"
+	"gitlab.com/gitlab-org/gitaly/v16/proto/go/gitalypb"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// TopResourceConsumers sends the TopResourceConsumersRequest to all of a praefect server's internal
+// gitaly nodes and aggregates the resource usage of each consumer across all nodes.
+func (s *Server) TopResourceConsumers(ctx context.Context, in *gitalypb.TopResourceConsumersRequest) (*gitalypb.TopResourceConsumersResponse, error) {
+	var resource accounting.Resource
+	switch in.GetSortBy() {
+	case gitalypb.TopResourceConsumersRequest_RESOURCE_UNSPECIFIED, gitalypb.TopResourceConsumersRequest_RESOURCE_CPU:
+		resource = accounting.ResourceCPU
+	case gitalypb.TopResourceConsumersRequest_RESOURCE_MEMORY:
+		resource = accounting.ResourceMemory
+	case gitalypb.TopResourceConsumersRequest_RESOURCE_IO:
+		resource = accounting.ResourceIO
+	default:
+		return nil, structerr.NewInvalidArgument("unsupported resource: %q", in.GetSortBy())
+	}
+
+	// Multiple storages may be served by the same Gitaly node, which must only be accounted for
+	// once.
+	conns := map[string]*grpc.ClientConn{}
+	for _, storages := range s.conns {
+		for _, conn := range storages {
+			conns[conn.Target()] = conn
+		}
+	}
+
+	ctx = metadata.IncomingToOutgoing(ctx)
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	var responses []*gitalypb.TopResourceConsumersResponse
+	var firstErr error
+
+	for address, conn := range conns {
+		wg.Add(1)
+
+		go func(address string, conn *grpc.ClientConn) {
+			defer wg.Done()
+
+			// The limit is only applied after the usage of all nodes has been aggregated, as a
+			// consumer that isn't among the top consumers of any single node may still be among
+			// the top consumers overall.
+			resp, err := gitalypb.NewServerServiceClient(conn).TopResourceConsumers(ctx, &gitalypb.TopResourceConsumersRequest{
+				Dimension: in.GetDimension(),
+				SortBy:    in.GetSortBy(),
+			})
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if err != nil {
+				s.logger.WithField("address", address).WithError(err).ErrorContext(ctx, "error getting top resource consumers")
+				if firstErr == nil {
+					firstErr = err
+				}
+				return
+			}
+
+			responses = append(responses, resp)
+		}(address, conn)
+	}
+
+	wg.Wait()
+
+	// Nodes which failed are skipped, but there is nothing to aggregate if all of them failed.
+	if len(responses) == 0 && firstErr != nil {
+		return nil, firstErr
+	}
+
+	response := &gitalypb.TopResourceConsumersResponse{
+		Window: durationpb.New(0),
+	}
+
+	usages := map[string]accounting.Usage{}
+	for _, resp := range responses {
+		if resp.GetWindow().AsDuration() > response.GetWindow().AsDuration() {
+			response.Window = resp.GetWindow()
+		}
+
+		for _, consumer := range resp.GetConsumers() {
+			usage := usages[consumer.GetKey()]
+			usage.Add(accounting.Usage{
+				Commands:  consumer.GetCommandCount(),
+				CPUTime:   consumer.GetCpuTime().AsDuration(),
+				MaxRSS:    consumer.GetMaxRssBytes(),
+				InBlocks:  consumer.GetInputBlocks(),
+				OutBlocks: consumer.GetOutputBlocks(),
+			})
+			usages[consumer.GetKey()] = usage
+		}
+	}
+
+	consumers := make([]accounting.Consumer, 0, len(usages))
+	for key, usage := range usages {
+		consumers = append(consumers, accounting.Consumer{Key: key, Usage: usage})
+	}
+
+	for _, consumer := range accounting.Rank(consumers, resource, int(in.GetLimit())) {
+		response.Consumers = append(response.Consumers, &gitalypb.TopResourceConsumersResponse_Consumer{
+			Key:          consumer.Key,
+			CommandCount: consumer.Usage.Commands,
+			CpuTime:      durationpb.New(consumer.Usage.CPUTime),
+			MaxRssBytes:  consumer.Usage.MaxRSS,
+			InputBlocks:  consumer.Usage.InBlocks,
+			OutputBlocks: consumer.Usage.OutBlocks,
+		})
+	}
+
+	return response, nil
+}
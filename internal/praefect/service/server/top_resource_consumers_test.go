@@ -0,0 +1,228 @@
+package server_test
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/config"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/service"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/service/server"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/structerr"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/testhelper"
+	"gitlab.com/gitlab-org/gitaly/v16/proto/go/gitalypb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+type topResourceConsumersServer struct {
+	gitalypb.UnimplementedServerServiceServer
+	response *gitalypb.TopResourceConsumersResponse
+	err      error
+}
+
+func (s *topResourceConsumersServer) TopResourceConsumers(ctx context.Context, req *gitalypb.TopResourceConsumersRequest) (*gitalypb.TopResourceConsumersResponse, error) {
+	return s.response, s.err
+}
+
+func runTopResourceConsumersServer(t *testing.T, srv *topResourceConsumersServer) *grpc.ClientConn {
+	t.Helper()
+
+	ln, err := net.Listen("unix", filepath.Join(testhelper.TempDir(t), "gitaly.socket"))
+	require.NoError(t, err)
+
+	grpcServer := grpc.NewServer()
+	gitalypb.RegisterServerServiceServer(grpcServer, srv)
+	t.Cleanup(grpcServer.Stop)
+
+	go func() {
+		assert.NoError(t, grpcServer.Serve(ln))
+	}()
+
+	conn, err := grpc.Dial("unix://"+ln.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { testhelper.MustClose(t, conn) })
+
+	return conn
+}
+
+func consumer(key string, commands uint64, cpuTime time.Duration, maxRSS int64) *gitalypb.TopResourceConsumersResponse_Consumer {
+	return &gitalypb.TopResourceConsumersResponse_Consumer{
+		Key:          key,
+		CommandCount: commands,
+		CpuTime:      durationpb.New(cpuTime),
+		MaxRssBytes:  maxRSS,
+	}
+}
+
+func TestServer_TopResourceConsumers(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+
+	for _, tc := range []struct {
+		desc             string
+		servers          []*topResourceConsumersServer
+		request          *gitalypb.TopResourceConsumersRequest
+		expectedResponse *gitalypb.TopResourceConsumersResponse
+		expectedErr      error
+	}{
+		{
+			desc: "usage is aggregated across nodes",
+			servers: []*topResourceConsumersServer{
+				{
+					response: &gitalypb.TopResourceConsumersResponse{
+						Window: durationpb.New(time.Hour),
+						Consumers: []*gitalypb.TopResourceConsumersResponse_Consumer{
+							consumer("group/a", 2, 3*time.Second, 100),
+							consumer("group/b", 1, 2*time.Second, 300),
+						},
+					},
+				},
+				{
+					response: &gitalypb.TopResourceConsumersResponse{
+						Window: durationpb.New(time.Hour),
+						Consumers: []*gitalypb.TopResourceConsumersResponse_Consumer{
+							consumer("group/b", 3, 2*time.Second, 200),
+							consumer("group/c", 1, time.Second, 50),
+						},
+					},
+				},
+			},
+			request: &gitalypb.TopResourceConsumersRequest{},
+			expectedResponse: &gitalypb.TopResourceConsumersResponse{
+				Window: durationpb.New(time.Hour),
+				Consumers: []*gitalypb.TopResourceConsumersResponse_Consumer{
+					consumer("group/b", 4, 4*time.Second, 300),
+					consumer("group/a", 2, 3*time.Second, 100),
+					consumer("group/c", 1, time.Second, 50),
+				},
+			},
+		},
+		{
+			desc: "limit is applied after aggregation",
+			servers: []*topResourceConsumersServer{
+				{
+					response: &gitalypb.TopResourceConsumersResponse{
+						Window: durationpb.New(time.Hour),
+						Consumers: []*gitalypb.TopResourceConsumersResponse_Consumer{
+							consumer("group/a", 1, 3*time.Second, 100),
+							consumer("group/b", 1, 2*time.Second, 300),
+						},
+					},
+				},
+				{
+					response: &gitalypb.TopResourceConsumersResponse{
+						Window: durationpb.New(time.Hour),
+						Consumers: []*gitalypb.TopResourceConsumersResponse_Consumer{
+							consumer("group/c", 1, 3*time.Second, 100),
+							consumer("group/b", 1, 2*time.Second, 200),
+						},
+					},
+				},
+			},
+			request: &gitalypb.TopResourceConsumersRequest{
+				SortBy: gitalypb.TopResourceConsumersRequest_RESOURCE_CPU,
+				Limit:  1,
+			},
+			expectedResponse: &gitalypb.TopResourceConsumersResponse{
+				Window: durationpb.New(time.Hour),
+				Consumers: []*gitalypb.TopResourceConsumersResponse_Consumer{
+					consumer("group/b", 2, 4*time.Second, 300),
+				},
+			},
+		},
+		{
+			desc: "sorted by memory",
+			servers: []*topResourceConsumersServer{
+				{
+					response: &gitalypb.TopResourceConsumersResponse{
+						Window: durationpb.New(time.Hour),
+						Consumers: []*gitalypb.TopResourceConsumersResponse_Consumer{
+							consumer("group/a", 1, 3*time.Second, 100),
+							consumer("group/b", 1, 2*time.Second, 300),
+						},
+					},
+				},
+			},
+			request: &gitalypb.TopResourceConsumersRequest{
+				SortBy: gitalypb.TopResourceConsumersRequest_RESOURCE_MEMORY,
+			},
+			expectedResponse: &gitalypb.TopResourceConsumersResponse{
+				Window: durationpb.New(time.Hour),
+				Consumers: []*gitalypb.TopResourceConsumersResponse_Consumer{
+					consumer("group/b", 1, 2*time.Second, 300),
+					consumer("group/a", 1, 3*time.Second, 100),
+				},
+			},
+		},
+		{
+			desc: "failing nodes are skipped",
+			servers: []*topResourceConsumersServer{
+				{
+					response: &gitalypb.TopResourceConsumersResponse{
+						Window: durationpb.New(time.Hour),
+						Consumers: []*gitalypb.TopResourceConsumersResponse_Consumer{
+							consumer("group/a", 1, time.Second, 100),
+						},
+					},
+				},
+				{
+					err: structerr.NewFailedPrecondition("resource accounting is not enabled"),
+				},
+			},
+			request: &gitalypb.TopResourceConsumersRequest{},
+			expectedResponse: &gitalypb.TopResourceConsumersResponse{
+				Window: durationpb.New(time.Hour),
+				Consumers: []*gitalypb.TopResourceConsumersResponse_Consumer{
+					consumer("group/a", 1, time.Second, 100),
+				},
+			},
+		},
+		{
+			desc: "all nodes failing",
+			servers: []*topResourceConsumersServer{
+				{
+					err: structerr.NewFailedPrecondition("resource accounting is not enabled"),
+				},
+			},
+			request:     &gitalypb.TopResourceConsumersRequest{},
+			expectedErr: structerr.NewFailedPrecondition("resource accounting is not enabled"),
+		},
+		{
+			desc: "unsupported resource",
+			servers: []*topResourceConsumersServer{
+				{response: &gitalypb.TopResourceConsumersResponse{}},
+			},
+			request: &gitalypb.TopResourceConsumersRequest{
+				SortBy: 42,
+			},
+			expectedErr: structerr.NewInvalidArgument("unsupported resource: %q", gitalypb.TopResourceConsumersRequest_Resource(42)),
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			storages := map[string]*grpc.ClientConn{}
+			for i, srv := range tc.servers {
+				storages[fmt.Sprintf("gitaly-%d", i)] = runTopResourceConsumersServer(t, srv)
+			}
+
+			srv := server.NewServer(config.Config{}, testhelper.SharedLogger(t), service.Connections{
+				"default": storages,
+			}, nil)
+
+			response, err := srv.TopResourceConsumers(ctx, tc.request)
+			testhelper.RequireGrpcError(t, tc.expectedErr, err)
+			testhelper.ProtoEqual(t, tc.expectedResponse, response)
+		})
+	}
+}
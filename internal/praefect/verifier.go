@@ -10,6 +10,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/helper"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/log"
+	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/datastore"
 	"gitlab.com/gitlab-org/gitaly/v16/internal/praefect/datastore/glsql"
 	"gitlab.com/gitlab-org/gitaly/v16/proto/go/gitalypb"
 )
@@ -20,9 +21,15 @@ import (
 // the replica's metadata record is removed and the removal logged. The repository's record
 // is still left in place even if all of the replicas are lost to ensure the data loss doesn't
 // go unnoticed.
+//
+// If checksum verification is enabled, the verifier additionally compares the checksums of
+// existing replicas against the primary's. Replicas on the same generation as the primary whose
+// checksum differs have silently diverged. They are marked outdated by decrementing their generation
+// and a replication job is scheduled to repair them from the primary.
 type MetadataVerifier struct {
 	log                  log.Logger
 	db                   glsql.Querier
+	queue                datastore.ReplicationEventQueue
 	conns                Connections
 	batchSize            int
 	leaseDuration        time.Duration
@@ -34,9 +41,13 @@ type MetadataVerifier struct {
 	// allows the worker to proceed. The invalid replicas will be found again after the configured
 	// verificationInterval has passed.
 	performDeletions bool
+	// verifyChecksums determines whether the worker compares the checksums of the replicas
+	// against the primary's checksum.
+	verifyChecksums bool
 
 	dequeuedJobsTotal        *prometheus.CounterVec
 	completedJobsTotal       *prometheus.CounterVec
+	divergedReplicasTotal    *prometheus.CounterVec
 	staleLeasesReleasedTotal prometheus.Counter
 }
 
@@ -50,20 +61,24 @@ const (
 func NewMetadataVerifier(
 	log log.Logger,
 	db glsql.Querier,
+	queue datastore.ReplicationEventQueue,
 	conns Connections,
 	healthChecker HealthChecker,
 	verificationInterval time.Duration,
 	performDeletions bool,
+	verifyChecksums bool,
 ) *MetadataVerifier {
 	v := &MetadataVerifier{
 		log:                  log,
 		db:                   db,
+		queue:                queue,
 		conns:                conns,
 		batchSize:            25,
 		leaseDuration:        30 * time.Second,
 		healthChecker:        healthChecker,
 		verificationInterval: verificationInterval,
 		performDeletions:     performDeletions,
+		verifyChecksums:      verifyChecksums,
 		dequeuedJobsTotal: prometheus.NewCounterVec(
 			prometheus.CounterOpts{
 				Name: "gitaly_praefect_verification_jobs_dequeued_total",
@@ -78,6 +93,13 @@ func NewMetadataVerifier(
 			},
 			[]string{"virtual_storage", "storage", "result"},
 		),
+		divergedReplicasTotal: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "gitaly_praefect_verification_diverged_replicas_total",
+				Help: "Number of replicas found to have diverged from the primary.",
+			},
+			[]string{"virtual_storage", "storage"},
+		),
 		staleLeasesReleasedTotal: prometheus.NewCounter(
 			prometheus.CounterOpts{
 				Name: "gitaly_praefect_stale_verification_leases_released_total",
@@ -90,6 +112,7 @@ func NewMetadataVerifier(
 	for virtualStorage, storages := range conns {
 		for storage := range storages {
 			v.dequeuedJobsTotal.WithLabelValues(virtualStorage, storage)
+			v.divergedReplicasTotal.WithLabelValues(virtualStorage, storage)
 			for _, result := range []string{resultError, resultInvalid, resultValid} {
 				v.completedJobsTotal.WithLabelValues(virtualStorage, storage, result)
 			}
@@ -105,12 +128,18 @@ type verificationJob struct {
 	relativePath   string
 	storage        string
 	replicaPath    string
+	primary        string
+	// generation is the replica's generation at the time the job was picked.
+	generation int
+	// primaryGeneration is the primary's generation at the time the job was picked.
+	primaryGeneration int
 }
 
 type verificationResult struct {
-	job    verificationJob
-	exists bool
-	error  error
+	job      verificationJob
+	exists   bool
+	diverged bool
+	error    error
 }
 
 // Run runs the metadata verifier. It keeps running until the context is canceled.
@@ -234,10 +263,17 @@ func (v *MetadataVerifier) run(ctx context.Context) error {
 			v.dequeuedJobsTotal.WithLabelValues(job.virtualStorage, job.storage).Inc()
 
 			exists, err := v.verify(ctx, jobs[i])
+
+			var diverged bool
+			if err == nil && exists && v.verifyChecksums {
+				diverged, err = v.verifyChecksum(ctx, jobs[i])
+			}
+
 			results[i] = verificationResult{
-				job:    job,
-				exists: exists,
-				error:  err,
+				job:      job,
+				exists:   exists,
+				diverged: diverged,
+				error:    err,
 			}
 		}()
 	}
@@ -248,6 +284,10 @@ func (v *MetadataVerifier) run(ctx context.Context) error {
 		return fmt.Errorf("update metadata: %w", err)
 	}
 
+	if err := v.repairDiverged(ctx, results); err != nil {
+		return fmt.Errorf("repair diverged: %w", err)
+	}
+
 	for _, r := range results {
 		result := resultError
 		if r.error == nil {
@@ -266,8 +306,8 @@ func (v *MetadataVerifier) run(ctx context.Context) error {
 // logRecord is a helper type for gathering the removed replicas and logging them.
 type logRecord map[string]map[string][]string
 
-// markRemoved marks the given replica as removed.
-func (r logRecord) markRemoved(virtualStorage, relativePath, storage string) {
+// add records the given replica.
+func (r logRecord) add(virtualStorage, relativePath, storage string) {
 	relativePaths, ok := r[virtualStorage]
 	if !ok {
 		relativePaths = map[string][]string{}
@@ -279,6 +319,7 @@ func (r logRecord) markRemoved(virtualStorage, relativePath, storage string) {
 }
 
 func (v *MetadataVerifier) updateMetadata(ctx context.Context, results []verificationResult) error {
+	ctx = datastore.WithAuditActor(ctx, "verifier", "remove records of missing replicas")
 	repositoryIDs := make([]int64, len(results))
 	storages := make([]string, len(results))
 	successfullyVerifieds := make([]bool, len(results))
@@ -301,7 +342,7 @@ func (v *MetadataVerifier) updateMetadata(ctx context.Context, results []verific
 				"error":           result.error,
 			}).Error("failed to verify replica's existence")
 		} else if !result.exists {
-			logRecords.markRemoved(result.job.virtualStorage, result.job.relativePath, result.job.storage)
+			logRecords.add(result.job.virtualStorage, result.job.relativePath, result.job.storage)
 		}
 	}
 
@@ -312,8 +353,16 @@ func (v *MetadataVerifier) updateMetadata(ctx context.Context, results []verific
 		}).Info("removing metadata records of non-existent replicas")
 	}
 
+	actor, reason, notify := datastore.AuditSettings(ctx)
 	_, err := v.db.ExecContext(ctx, `
-WITH results AS (
+WITH audit_settings AS (
+	SELECT
+		set_config('praefect.audit_actor', $6, true),
+		set_config('praefect.audit_reason', $7, true),
+		set_config('praefect.audit_log_notify', $8, true)
+),
+
+results AS (
 	SELECT repository_id, storage, successfully_verified, exists
 	FROM (
 		SELECT unnest($1::bigint[]) AS repository_id,
@@ -345,7 +394,8 @@ AND   storage_repositories.storage       = results.storage
 AND   successfully_verified
 AND   NOT exists
 AND   $5
-	`, repositoryIDs, storages, successfullyVerifieds, exists, v.performDeletions)
+AND   ( SELECT true FROM audit_settings )
+	`, repositoryIDs, storages, successfullyVerifieds, exists, v.performDeletions, actor, reason, notify)
 	if err != nil {
 		return fmt.Errorf("query: %w", err)
 	}
@@ -364,9 +414,17 @@ func (v *MetadataVerifier) pickJobs(ctx context.Context) ([]verificationJob, err
 
 	rows, err := v.db.QueryContext(ctx, `
 WITH to_verify AS (
-	SELECT repository_id, relative_path, replica_path, virtual_storage, storage
+	SELECT repository_id, relative_path, replica_path, virtual_storage, storage,
+		COALESCE("primary", '') AS "primary",
+		need_verification.generation,
+		COALESCE((
+			SELECT primary_replica.generation
+			FROM storage_repositories AS primary_replica
+			WHERE primary_replica.repository_id = repositories.repository_id
+			AND   primary_replica.storage       = repositories."primary"
+		), -1) AS primary_generation
 	FROM (
-		SELECT repository_id, storage
+		SELECT repository_id, storage, generation
 		FROM storage_repositories
 		WHERE ( verified_at IS NULL OR verified_at < now() - $1 * '1 millisecond'::interval )
         AND verification_leased_until IS NULL
@@ -389,7 +447,7 @@ acquire_leases AS (
 	AND   storage_repositories.storage       = to_verify.storage
 )
 
-SELECT repository_id, replica_path, virtual_storage, relative_path, storage
+SELECT repository_id, replica_path, virtual_storage, relative_path, storage, "primary", generation, primary_generation
 FROM to_verify
 	`, v.verificationInterval.Milliseconds(), v.batchSize, v.leaseDuration.Milliseconds(), healthyVirtualStorages, healthyStorages)
 	if err != nil {
@@ -400,7 +458,16 @@ FROM to_verify
 	var jobs []verificationJob
 	for rows.Next() {
 		var job verificationJob
-		if err := rows.Scan(&job.repositoryID, &job.replicaPath, &job.virtualStorage, &job.relativePath, &job.storage); err != nil {
+		if err := rows.Scan(
+			&job.repositoryID,
+			&job.replicaPath,
+			&job.virtualStorage,
+			&job.relativePath,
+			&job.storage,
+			&job.primary,
+			&job.generation,
+			&job.primaryGeneration,
+		); err != nil {
 			return nil, fmt.Errorf("scan: %w", err)
 		}
 
@@ -433,6 +500,168 @@ func (v *MetadataVerifier) verify(ctx context.Context, job verificationJob) (boo
 	return resp.Exists, nil
 }
 
+// verifyChecksum compares the replica's checksum against the primary's checksum and returns whether
+// the replica has diverged from the primary. Only replicas that are on the same generation as the primary
+// are compared as outdated replicas are expected to differ and are repaired by the reconciler.
+func (v *MetadataVerifier) verifyChecksum(ctx context.Context, job verificationJob) (bool, error) {
+	if job.primary == "" || job.primary == job.storage || job.generation != job.primaryGeneration {
+		return false, nil
+	}
+
+	primaryChecksum, err := v.calculateChecksum(ctx, job.virtualStorage, job.primary, job.replicaPath)
+	if err != nil {
+		return false, fmt.Errorf("calculate primary checksum: %w", err)
+	}
+
+	replicaChecksum, err := v.calculateChecksum(ctx, job.virtualStorage, job.storage, job.replicaPath)
+	if err != nil {
+		return false, fmt.Errorf("calculate replica checksum: %w", err)
+	}
+
+	return primaryChecksum != replicaChecksum, nil
+}
+
+func (v *MetadataVerifier) calculateChecksum(ctx context.Context, virtualStorage, storage, replicaPath string) (string, error) {
+	conn, ok := v.conns[virtualStorage][storage]
+	if !ok {
+		return "", fmt.Errorf("no connection to %q/%q", virtualStorage, storage)
+	}
+
+	resp, err := gitalypb.NewRepositoryServiceClient(conn).CalculateChecksum(ctx, &gitalypb.CalculateChecksumRequest{
+		Repository: &gitalypb.Repository{
+			StorageName:  storage,
+			RelativePath: replicaPath,
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return resp.GetChecksum(), nil
+}
+
+// repairDiverged marks the diverged replicas as outdated and schedules replication jobs to repair them
+// from the primary. A replica is only marked outdated if its generation hasn't changed since the job was
+// picked. If it has, the replica has been written to in the meanwhile and the checksums are no longer
+// comparable. The replica is then compared again on its next verification. Replicas on generation 0
+// can't be moved to an earlier generation, so their records are deleted instead, which leaves their
+// generation unknown.
+func (v *MetadataVerifier) repairDiverged(ctx context.Context, results []verificationResult) error {
+	ctx = datastore.WithAuditActor(ctx, "verifier", "repair diverged replicas")
+	var repositoryIDs []int64
+	var storages []string
+	var generations []int64
+	jobs := map[int64]map[string]verificationJob{}
+	for _, result := range results {
+		if !result.diverged {
+			continue
+		}
+
+		v.divergedReplicasTotal.WithLabelValues(result.job.virtualStorage, result.job.storage).Inc()
+
+		repositoryIDs = append(repositoryIDs, result.job.repositoryID)
+		storages = append(storages, result.job.storage)
+		generations = append(generations, int64(result.job.generation))
+
+		if jobs[result.job.repositoryID] == nil {
+			jobs[result.job.repositoryID] = map[string]verificationJob{}
+		}
+
+		jobs[result.job.repositoryID][result.job.storage] = result.job
+	}
+
+	if len(repositoryIDs) == 0 {
+		return nil
+	}
+
+	actor, reason, notify := datastore.AuditSettings(ctx)
+	rows, err := v.db.QueryContext(ctx, `
+WITH audit_settings AS (
+	SELECT
+		set_config('praefect.audit_actor', $4, true),
+		set_config('praefect.audit_reason', $5, true),
+		set_config('praefect.audit_log_notify', $6, true)
+)
+
+diverged AS (
+	SELECT unnest($1::bigint[]) AS repository_id,
+	       unnest($2::text[]) AS storage,
+	       unnest($3::bigint[]) AS generation
+),
+
+outdated_replicas AS (
+	UPDATE storage_repositories
+	SET generation = storage_repositories.generation - 1
+	FROM diverged
+	WHERE storage_repositories.repository_id = diverged.repository_id
+	AND   storage_repositories.storage       = diverged.storage
+	AND   storage_repositories.generation    = diverged.generation
+	AND   storage_repositories.generation    > 0
+	AND   ( SELECT true FROM audit_settings )
+	RETURNING storage_repositories.repository_id, storage_repositories.storage
+),
+
+deleted_replicas AS (
+	DELETE FROM storage_repositories
+	USING diverged
+	WHERE storage_repositories.repository_id = diverged.repository_id
+	AND   storage_repositories.storage       = diverged.storage
+	AND   storage_repositories.generation    = diverged.generation
+	AND   storage_repositories.generation    = 0
+	AND   ( SELECT true FROM audit_settings )
+	RETURNING storage_repositories.repository_id, storage_repositories.storage
+)
+
+SELECT repository_id, storage FROM outdated_replicas
+UNION ALL
+SELECT repository_id, storage FROM deleted_replicas
+	`, repositoryIDs, storages, generations, actor, reason, notify)
+	if err != nil {
+		return fmt.Errorf("query: %w", err)
+	}
+	defer rows.Close()
+
+	var outdated []verificationJob
+	for rows.Next() {
+		var repositoryID int64
+		var storage string
+		if err := rows.Scan(&repositoryID, &storage); err != nil {
+			return fmt.Errorf("scan: %w", err)
+		}
+
+		outdated = append(outdated, jobs[repositoryID][storage])
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("rows: %w", err)
+	}
+
+	logRecords := logRecord{}
+	for _, job := range outdated {
+		logRecords.add(job.virtualStorage, job.relativePath, job.storage)
+
+		if _, err := v.queue.Enqueue(ctx, datastore.ReplicationEvent{
+			Job: datastore.ReplicationJob{
+				RepositoryID:      job.repositoryID,
+				Change:            datastore.UpdateRepo,
+				VirtualStorage:    job.virtualStorage,
+				RelativePath:      job.relativePath,
+				ReplicaPath:       job.replicaPath,
+				SourceNodeStorage: job.primary,
+				TargetNodeStorage: job.storage,
+			},
+		}); err != nil {
+			return fmt.Errorf("enqueue repair: %w", err)
+		}
+	}
+
+	if len(logRecords) > 0 {
+		v.log.WithField("replicas", logRecords).Info("scheduled repair of replicas diverged from the primary")
+	}
+
+	return nil
+}
+
 // Describe describes the collected metrics to Prometheus.
 func (v *MetadataVerifier) Describe(ch chan<- *prometheus.Desc) {
 	prometheus.DescribeByCollect(v, ch)
@@ -442,5 +671,6 @@ func (v *MetadataVerifier) Describe(ch chan<- *prometheus.Desc) {
 func (v *MetadataVerifier) Collect(ch chan<- prometheus.Metric) {
 	v.dequeuedJobsTotal.Collect(ch)
 	v.completedJobsTotal.Collect(ch)
+	v.divergedReplicasTotal.Collect(ch)
 	v.staleLeasesReleasedTotal.Collect(ch)
 }
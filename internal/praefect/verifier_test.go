@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"math/rand"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -529,9 +530,12 @@ func TestVerifier(t *testing.T) {
 					StaticHealthChecker(conf.StorageNames()),
 					NewLockedRandom(rand.New(rand.NewSource(0))),
 					rs,
-					datastore.NewAssignmentStore(db, conf.StorageNames()),
+					datastore.NewAssignmentStore(db, conf.StorageNames(), nil),
 					rs,
 					conf.DefaultReplicationFactors(),
+					nil,
+					nil,
+					nil,
 				),
 				WithRepoStore: rs,
 				WithTxMgr:     txManager,
@@ -635,7 +639,7 @@ func TestVerifier(t *testing.T) {
 				healthyStorages = tc.healthyStorages
 			}
 
-			verifier := NewMetadataVerifier(logger, db, conns, healthyStorages, 24*7*time.Hour, !tc.dontPerformDeletions)
+			verifier := NewMetadataVerifier(logger, db, datastore.NewPostgresReplicationEventQueue(db), conns, healthyStorages, 24*7*time.Hour, !tc.dontPerformDeletions, false)
 			if tc.batchSize > 0 {
 				verifier.batchSize = tc.batchSize
 			}
@@ -703,6 +707,11 @@ func TestVerifier(t *testing.T) {
 # HELP gitaly_praefect_stale_verification_leases_released_total Number of stale verification leases released.
 # TYPE gitaly_praefect_stale_verification_leases_released_total counter
 gitaly_praefect_stale_verification_leases_released_total 0
+# HELP gitaly_praefect_verification_diverged_replicas_total Number of replicas found to have diverged from the primary.
+# TYPE gitaly_praefect_verification_diverged_replicas_total counter
+gitaly_praefect_verification_diverged_replicas_total{storage="gitaly-0",virtual_storage="virtual-storage"} 0
+gitaly_praefect_verification_diverged_replicas_total{storage="gitaly-1",virtual_storage="virtual-storage"} 0
+gitaly_praefect_verification_diverged_replicas_total{storage="gitaly-2",virtual_storage="virtual-storage"} 0
 # HELP gitaly_praefect_verification_jobs_completed_total Number of verification jobs completed and their result
 # TYPE gitaly_praefect_verification_jobs_completed_total counter
 gitaly_praefect_verification_jobs_completed_total{result="error",storage="gitaly-0",virtual_storage="virtual-storage"} %d
@@ -800,7 +809,7 @@ func TestVerifier_runExpiredLeaseReleaser(t *testing.T) {
 	logger := testhelper.NewLogger(t)
 	hook := testhelper.AddLoggerHook(logger)
 
-	verifier := NewMetadataVerifier(logger, tx, nil, nil, 0, true)
+	verifier := NewMetadataVerifier(logger, tx, nil, nil, nil, 0, true, false)
 	// set batch size lower than the number of locked leases to ensure the batching works
 	verifier.batchSize = 2
 
@@ -855,3 +864,211 @@ func TestVerifier_runExpiredLeaseReleaser(t *testing.T) {
 gitaly_praefect_stale_verification_leases_released_total 3
 	`)))
 }
+
+func TestVerifier_verifyChecksums(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		desc string
+		// divergeReplica determines whether a secondary replica is written to directly on the Gitaly
+		// so it diverges from the primary.
+		divergeReplica bool
+		// outdateReplica determines whether the secondary is recorded to be on an older generation
+		// than the primary.
+		outdateReplica bool
+		// incrementGeneration determines whether the repository is written to through Praefect
+		// before the verification so its replicas are on generation 1.
+		incrementGeneration bool
+		expectedGeneration  int
+		expectedRepair      bool
+	}{
+		{
+			desc:               "identical replicas",
+			expectedGeneration: 0,
+		},
+		{
+			desc:               "diverged replica on the first generation is deleted and repaired",
+			divergeReplica:     true,
+			expectedGeneration: datastore.GenerationUnknown,
+			expectedRepair:     true,
+		},
+		{
+			desc:                "diverged replica is marked outdated and repaired",
+			divergeReplica:      true,
+			incrementGeneration: true,
+			expectedGeneration:  0,
+			expectedRepair:      true,
+		},
+		{
+			desc:               "outdated replica is not compared",
+			divergeReplica:     true,
+			outdateReplica:     true,
+			expectedGeneration: -1,
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := testhelper.Context(t)
+
+			conf := config.Config{
+				VirtualStorages: []*config.VirtualStorage{
+					{Name: "virtual-storage"},
+				},
+				Failover: config.Failover{ElectionStrategy: config.ElectionStrategyPerRepository},
+			}
+
+			gitalyCfgs := map[string]gitalyconfig.Cfg{}
+			for i := 0; i < 3; i++ {
+				storageName := fmt.Sprintf("gitaly-%d", i)
+
+				cfg := testcfg.Build(t, testcfg.WithStorages(storageName))
+				cfg.SocketPath = testserver.RunGitalyServer(t, cfg, setup.RegisterAll, testserver.WithDisablePraefect())
+				gitalyCfgs[storageName] = cfg
+				conf.VirtualStorages[0].Nodes = append(conf.VirtualStorages[0].Nodes, &config.Node{
+					Storage: storageName,
+					Address: cfg.SocketPath,
+				})
+			}
+
+			db := testdb.New(t)
+			logger := testhelper.SharedLogger(t)
+			sidechannelRegistry := sidechannel.NewRegistry()
+			txManager := transactions.NewManager(config.Config{})
+			nodeSet, err := DialNodes(
+				ctx,
+				conf.VirtualStorages,
+				protoregistry.GitalyProtoPreregistered,
+				nil,
+				backchannel.NewClientHandshaker(
+					logger,
+					NewBackchannelServerFactory(
+						logger,
+						transaction.NewServer(txManager),
+						sidechannelRegistry,
+					),
+					backchannel.DefaultConfiguration(),
+				),
+				sidechannelRegistry,
+				logger,
+			)
+			require.NoError(t, err)
+			t.Cleanup(nodeSet.Close)
+
+			tx := db.Begin(t)
+			t.Cleanup(func() { tx.Rollback(t) })
+			testdb.SetHealthyNodes(t, ctx, tx, map[string]map[string][]string{
+				"praefect-0": conf.StorageNames(),
+			})
+			elector := nodes.NewPerRepositoryElector(logger, tx)
+			conns := nodeSet.Connections()
+			rs := datastore.NewPostgresRepositoryStore(db, conf.StorageNames())
+
+			conn, _, cleanup := RunPraefectServer(t, ctx, conf, BuildOptions{
+				WithRouter: NewPerRepositoryRouter(
+					conns,
+					elector,
+					StaticHealthChecker(conf.StorageNames()),
+					NewLockedRandom(rand.New(rand.NewSource(0))),
+					rs,
+					datastore.NewAssignmentStore(db, conf.StorageNames(), nil),
+					rs,
+					conf.DefaultReplicationFactors(),
+					nil,
+					nil,
+					nil,
+				),
+				WithRepoStore: rs,
+				WithTxMgr:     txManager,
+			})
+			t.Cleanup(cleanup)
+
+			repo, _ := gittest.CreateRepository(t, ctx,
+				gitalyconfig.Cfg{Storages: []gitalyconfig.Storage{{Name: "virtual-storage"}}},
+				gittest.CreateRepositoryConfig{ClientConn: conn, RelativePath: "repository-1"},
+			)
+			replicaPath := gittest.GetReplicaPath(t, ctx, gitalyconfig.Cfg{}, repo, gittest.GetReplicaPathConfig{ClientConn: conn})
+
+			metadata, err := rs.GetRepositoryMetadata(ctx, 1)
+			require.NoError(t, err)
+
+			secondary := "gitaly-0"
+			if metadata.Primary == secondary {
+				secondary = "gitaly-1"
+			}
+
+			if tc.incrementGeneration {
+				var secondaries []string
+				for _, storage := range conf.StorageNames()["virtual-storage"] {
+					if storage != metadata.Primary {
+						secondaries = append(secondaries, storage)
+					}
+				}
+
+				_, err := rs.IncrementGeneration(ctx, 1, metadata.Primary, secondaries)
+				require.NoError(t, err)
+			}
+
+			if tc.divergeReplica {
+				cfg := gitalyCfgs[secondary]
+				gittest.WriteCommit(t, cfg, filepath.Join(cfg.Storages[0].Path, replicaPath), gittest.WithBranch("diverged"))
+			}
+
+			if tc.outdateReplica {
+				_, err := db.ExecContext(ctx, `
+					UPDATE storage_repositories
+					SET generation = -1
+					WHERE repository_id = 1 AND storage = $1`,
+					secondary,
+				)
+				require.NoError(t, err)
+			}
+
+			logger = testhelper.NewLogger(t)
+			hook := testhelper.AddLoggerHook(logger)
+
+			queue := datastore.NewPostgresReplicationEventQueue(db)
+			verifier := NewMetadataVerifier(logger, db, queue, conns, StaticHealthChecker(conf.StorageNames()), 24*7*time.Hour, true, true)
+
+			runCtx, cancelRun := context.WithCancel(ctx)
+			err = verifier.Run(runCtx, helper.NewCountTicker(1, cancelRun))
+			require.Equal(t, context.Canceled, err)
+
+			generation, err := rs.GetGeneration(ctx, 1, secondary)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedGeneration, generation)
+
+			events, err := queue.Dequeue(ctx, "virtual-storage", secondary, 10)
+			require.NoError(t, err)
+
+			expectedDiverged := 0
+			if tc.expectedRepair {
+				expectedDiverged = 1
+
+				require.Len(t, events, 1)
+				require.Equal(t, datastore.ReplicationJob{
+					RepositoryID:      1,
+					Change:            datastore.UpdateRepo,
+					VirtualStorage:    "virtual-storage",
+					RelativePath:      "repository-1",
+					ReplicaPath:       replicaPath,
+					SourceNodeStorage: metadata.Primary,
+					TargetNodeStorage: secondary,
+				}, events[0].Job)
+
+				require.Len(t, hook.AllEntries(), 1)
+				require.Equal(t, "scheduled repair of replicas diverged from the primary", hook.LastEntry().Message)
+				require.Equal(t, logRecord{"virtual-storage": {"repository-1": {secondary}}}, hook.LastEntry().Data["replicas"])
+			} else {
+				require.Empty(t, events)
+				require.Empty(t, hook.AllEntries())
+			}
+
+			require.Equal(t, float64(expectedDiverged), testutil.ToFloat64(
+				verifier.divergedReplicasTotal.WithLabelValues("virtual-storage", secondary),
+			))
+		})
+	}
+}
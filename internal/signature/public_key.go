@@ -0,0 +1,150 @@
+package signature
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	pgperrors "github.com/ProtonMail/go-crypto/openpgp/errors"
+	"github.com/ProtonMail/go-crypto/openpgp/packet"
+	"golang.org/x/crypto/ssh"
+)
+
+// ErrUnknownKey is returned when a signature has not been created by the key it is verified with.
+var ErrUnknownKey = errors.New("signature has not been created by this key")
+
+// PublicKey is the common interface of SSH and GPG public keys used to verify signatures
+type PublicKey interface {
+	// Verify verifies whether the signature has been created by this key. It returns
+	// ErrUnknownKey if the signature has been created by another key.
+	Verify(signature, signedText []byte) error
+	// Fingerprint returns the fingerprint of the key.
+	Fingerprint() string
+	// Identity returns the identity the key belongs to.
+	Identity() string
+}
+
+// ParsePublicKey parses an ASCII-armored GPG public key or an SSH public key in authorized_keys
+// format.
+func ParsePublicKey(key []byte) (PublicKey, error) {
+	if bytes.HasPrefix(bytes.TrimSpace(key), []byte("-----BEGIN PGP PUBLIC KEY BLOCK-----")) {
+		entities, err := openpgp.ReadArmoredKeyRing(bytes.NewReader(key))
+		if err != nil {
+			return nil, fmt.Errorf("read armored key ring: %w", err)
+		}
+
+		if len(entities) != 1 {
+			return nil, fmt.Errorf("expected exactly one key, got %d", len(entities))
+		}
+
+		return &GpgPublicKey{Entity: entities[0]}, nil
+	}
+
+	publicKey, comment, _, rest, err := ssh.ParseAuthorizedKey(key)
+	if err != nil {
+		return nil, fmt.Errorf("parse authorized key: %w", err)
+	}
+
+	if len(bytes.TrimSpace(rest)) > 0 {
+		return nil, fmt.Errorf("expected exactly one key")
+	}
+
+	return &SSHPublicKey{Key: publicKey, Comment: comment}, nil
+}
+
+// GpgPublicKey is a struct that implements PublicKey interface for GPG keys
+type GpgPublicKey struct {
+	Entity *openpgp.Entity
+}
+
+// Verify method verifies whether a signature has been created by this key
+func (pk *GpgPublicKey) Verify(signature, signedText []byte) error {
+	if !bytes.HasPrefix(signature, []byte("-----BEGIN PGP")) {
+		return ErrUnknownKey
+	}
+
+	if _, err := openpgp.CheckArmoredDetachedSignature(
+		openpgp.EntityList([]*openpgp.Entity{pk.Entity}),
+		bytes.NewReader(signedText),
+		bytes.NewReader(signature),
+		&packet.Config{},
+	); err != nil {
+		if errors.Is(err, pgperrors.ErrUnknownIssuer) {
+			return ErrUnknownKey
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// Fingerprint returns the upper-case hex-encoded fingerprint of the primary key
+func (pk *GpgPublicKey) Fingerprint() string {
+	return strings.ToUpper(hex.EncodeToString(pk.Entity.PrimaryKey.Fingerprint))
+}
+
+// Identity returns the primary user ID of the key
+func (pk *GpgPublicKey) Identity() string {
+	if identity := pk.Entity.PrimaryIdentity(); identity != nil {
+		return identity.Name
+	}
+
+	return ""
+}
+
+// SSHPublicKey is a struct that implements PublicKey interface for SSH keys
+type SSHPublicKey struct {
+	Key     ssh.PublicKey
+	Comment string
+}
+
+// Verify method verifies whether a signature has been created by this key
+func (pk *SSHPublicKey) Verify(signatureText, signedText []byte) error {
+	if !bytes.HasPrefix(signatureText, []byte("-----BEGIN "+sshSignatureType)) {
+		return ErrUnknownKey
+	}
+
+	sshSig, err := parseSSHSignature(signatureText)
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(sshSig.PublicKey, pk.Key.Marshal()) {
+		return ErrUnknownKey
+	}
+
+	return verifySSHSignature(pk.Key, sshSig, signedText)
+}
+
+// Fingerprint returns the SHA256 fingerprint of the key
+func (pk *SSHPublicKey) Fingerprint() string {
+	return ssh.FingerprintSHA256(pk.Key)
+}
+
+// Identity returns the comment of the key
+func (pk *SSHPublicKey) Identity() string {
+	return pk.Comment
+}
+
+// VerifyWithKeys verifies the signature with each of the keys and returns the key that created it.
+// ErrUnknownKey is returned if none of the keys has created the signature. Otherwise, the
+// verification error of the key that created the signature is returned.
+func VerifyWithKeys(keys []PublicKey, signature, signedText []byte) (PublicKey, error) {
+	verifyErr := ErrUnknownKey
+	for _, key := range keys {
+		err := key.Verify(signature, signedText)
+		if err == nil {
+			return key, nil
+		}
+
+		if !errors.Is(err, ErrUnknownKey) {
+			verifyErr = err
+		}
+	}
+
+	return nil, verifyErr
+}
@@ -0,0 +1,135 @@
+package signature
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePublicKey(t *testing.T) {
+	for _, tc := range []struct {
+		desc                string
+		path                string
+		expectedFingerprint string
+		expectedIdentity    string
+	}{
+		{
+			desc:                "ssh key",
+			path:                "testdata/signing_key.ssh.pub",
+			expectedFingerprint: "SHA256:AtktEnK7QyaUN5BKYe0yFhZwZm3oHeuEZp65Z6gjMS0",
+			expectedIdentity:    "igordrozdov@Igors-MacBook-Pro-2.local",
+		},
+		{
+			desc:                "gpg key",
+			path:                "testdata/signing_key.gpg.pub",
+			expectedFingerprint: "C6354357D1D003699DC9468FF25789A76408CC90",
+			expectedIdentity:    "GitLab",
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			key, err := os.ReadFile(tc.path)
+			require.NoError(t, err)
+
+			publicKey, err := ParsePublicKey(key)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedFingerprint, publicKey.Fingerprint())
+			require.Equal(t, tc.expectedIdentity, publicKey.Identity())
+		})
+	}
+
+	_, err := ParsePublicKey([]byte("garbage"))
+	require.Error(t, err)
+}
+
+func TestVerifyWithKeys(t *testing.T) {
+	var publicKeys []PublicKey
+	for _, path := range []string{"testdata/signing_key.ssh.pub", "testdata/signing_key.gpg.pub"} {
+		key, err := os.ReadFile(path)
+		require.NoError(t, err)
+
+		publicKey, err := ParsePublicKey(key)
+		require.NoError(t, err)
+		publicKeys = append(publicKeys, publicKey)
+	}
+	sshKey, gpgKey := publicKeys[0], publicKeys[1]
+
+	sshSignature, err := os.ReadFile("testdata/signing_key.ssh.sig")
+	require.NoError(t, err)
+
+	gpgSignature, err := os.ReadFile("testdata/signing_key.gpg.sig")
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		desc        string
+		keys        []PublicKey
+		signature   []byte
+		signedText  []byte
+		expectedKey PublicKey
+		expectedErr error
+	}{
+		{
+			desc:        "ssh signature",
+			keys:        publicKeys,
+			signature:   sshSignature,
+			signedText:  commit,
+			expectedKey: sshKey,
+		},
+		{
+			desc:        "gpg signature",
+			keys:        publicKeys,
+			signature:   gpgSignature,
+			signedText:  commit,
+			expectedKey: gpgKey,
+		},
+		{
+			desc:        "unknown ssh key",
+			keys:        []PublicKey{gpgKey},
+			signature:   sshSignature,
+			signedText:  commit,
+			expectedErr: ErrUnknownKey,
+		},
+		{
+			desc:        "unknown gpg key",
+			keys:        []PublicKey{sshKey},
+			signature:   gpgSignature,
+			signedText:  commit,
+			expectedErr: ErrUnknownKey,
+		},
+		{
+			desc:        "no keys",
+			signature:   sshSignature,
+			signedText:  commit,
+			expectedErr: ErrUnknownKey,
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			key, err := VerifyWithKeys(tc.keys, tc.signature, tc.signedText)
+			require.Equal(t, tc.expectedErr, err)
+			require.Equal(t, tc.expectedKey, key)
+		})
+	}
+
+	t.Run("modified signed text", func(t *testing.T) {
+		for _, signature := range [][]byte{sshSignature, gpgSignature} {
+			key, err := VerifyWithKeys(publicKeys, signature, append(append([]byte{}, commit...), "modified"...))
+			require.Error(t, err)
+			require.NotEqual(t, ErrUnknownKey, err)
+			require.Nil(t, key)
+		}
+	})
+}
+
+func TestSigningKeys_PublicKeys(t *testing.T) {
+	signingKeys, err := ParseSigningKeys("testdata/signing_key.ssh", "testdata/signing_key.gpg")
+	require.NoError(t, err)
+
+	publicKeys := signingKeys.PublicKeys()
+	require.Len(t, publicKeys, 2)
+	require.Equal(t, "SHA256:AtktEnK7QyaUN5BKYe0yFhZwZm3oHeuEZp65Z6gjMS0", publicKeys[0].Fingerprint())
+	require.Equal(t, "C6354357D1D003699DC9468FF25789A76408CC90", publicKeys[1].Fingerprint())
+}
@@ -78,3 +78,18 @@ func (s *SigningKeys) Verify(signature, signedText []byte) error {
 	}
 	return err
 }
+
+// PublicKeys returns the public keys of all signing keys, the primary key first.
+func (s *SigningKeys) PublicKeys() []PublicKey {
+	publicKeys := make([]PublicKey, 0, 1+len(s.secondaryKeys))
+	for _, signingKey := range append([]SigningKey{s.primaryKey}, s.secondaryKeys...) {
+		switch key := signingKey.(type) {
+		case *GpgSigningKey:
+			publicKeys = append(publicKeys, &GpgPublicKey{Entity: key.Entity})
+		case *SSHSigningKey:
+			publicKeys = append(publicKeys, &SSHPublicKey{Key: key.PrivateKey.PublicKey()})
+		}
+	}
+
+	return publicKeys
+}
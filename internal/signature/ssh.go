@@ -105,16 +105,29 @@ func (sk *SSHSigningKey) CreateSignature(contentToSign []byte) ([]byte, error) {
 
 // Verify method verifies whether a signature has been created by this signing key
 func (sk *SSHSigningKey) Verify(signatureText, signedText []byte) error {
+	sshSig, err := parseSSHSignature(signatureText)
+	if err != nil {
+		return err
+	}
+
+	return verifySSHSignature(sk.PrivateKey.PublicKey(), sshSig, signedText)
+}
+
+func parseSSHSignature(signatureText []byte) (*sshSignature, error) {
 	block, rest := pem.Decode(signatureText)
 	if block == nil || len(rest) > 0 || block.Type != sshSignatureType {
-		return fmt.Errorf("invalid signature text")
+		return nil, fmt.Errorf("invalid signature text")
 	}
 
 	sshSig := &sshSignature{}
 	if err := ssh.Unmarshal(block.Bytes, sshSig); err != nil {
-		return fmt.Errorf("parse signature text: %w", err)
+		return nil, fmt.Errorf("parse signature text: %w", err)
 	}
 
+	return sshSig, nil
+}
+
+func verifySSHSignature(publicKey ssh.PublicKey, sshSig *sshSignature, signedText []byte) error {
 	signature := &ssh.Signature{}
 	if err := ssh.Unmarshal(sshSig.Signature, signature); err != nil {
 		return fmt.Errorf("parse signature: %w", err)
@@ -132,5 +145,5 @@ func (sk *SSHSigningKey) Verify(signatureText, signedText []byte) error {
 		Hash:          h.Sum(nil),
 	}
 
-	return sk.PrivateKey.PublicKey().Verify(ssh.Marshal(signedData), signature)
+	return publicKey.Verify(ssh.Marshal(signedData), signature)
 }
@@ -100,6 +100,10 @@ func (db DB) TruncateAll(tb testing.TB) {
 		"virtual_storages",
 		"repository_assignments",
 		"storage_cleanups",
+		"drained_storages",
+		"unavailable_repositories",
+		"dataloss_recoveries",
+		"repository_audit_log",
 	)
 }
 
@@ -84,6 +84,12 @@ type CommitServiceClient interface {
 	// fromm the input that it found on the repository, and an array that contains all
 	// revisions from the input it did not find on the repository.
 	CheckObjectsExist(ctx context.Context, opts ...grpc.CallOption) (CommitService_CheckObjectsExistClient, error)
+	// VerifySignatures verifies the signatures of commits and annotated tags against a set of trusted
+	// public keys and the signing keys configured on the server. One response is sent per object.
+	VerifySignatures(ctx context.Context, in *VerifySignaturesRequest, opts ...grpc.CallOption) (CommitService_VerifySignaturesClient, error)
+	// ListNotes lists all notes of a notes reference together with their content. Notes are returned
+	// in the order of the notes tree. No notes are returned if the notes reference doesn't exist.
+	ListNotes(ctx context.Context, in *ListNotesRequest, opts ...grpc.CallOption) (CommitService_ListNotesClient, error)
 }
 
 type commitServiceClient struct {
@@ -667,6 +673,70 @@ func (x *commitServiceCheckObjectsExistClient) Recv() (*CheckObjectsExistRespons
 	return m, nil
 }
 
+func (c *commitServiceClient) VerifySignatures(ctx context.Context, in *VerifySignaturesRequest, opts ...grpc.CallOption) (CommitService_VerifySignaturesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &CommitService_ServiceDesc.Streams[16], "/gitaly.CommitService/VerifySignatures", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &commitServiceVerifySignaturesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CommitService_VerifySignaturesClient interface {
+	Recv() (*VerifySignaturesResponse, error)
+	grpc.ClientStream
+}
+
+type commitServiceVerifySignaturesClient struct {
+	grpc.ClientStream
+}
+
+func (x *commitServiceVerifySignaturesClient) Recv() (*VerifySignaturesResponse, error) {
+	m := new(VerifySignaturesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *commitServiceClient) ListNotes(ctx context.Context, in *ListNotesRequest, opts ...grpc.CallOption) (CommitService_ListNotesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &CommitService_ServiceDesc.Streams[17], "/gitaly.CommitService/ListNotes", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &commitServiceListNotesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CommitService_ListNotesClient interface {
+	Recv() (*ListNotesResponse, error)
+	grpc.ClientStream
+}
+
+type commitServiceListNotesClient struct {
+	grpc.ClientStream
+}
+
+func (x *commitServiceListNotesClient) Recv() (*ListNotesResponse, error) {
+	m := new(ListNotesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // CommitServiceServer is the server API for CommitService service.
 // All implementations must embed UnimplementedCommitServiceServer
 // for forward compatibility
@@ -733,6 +803,12 @@ type CommitServiceServer interface {
 	// fromm the input that it found on the repository, and an array that contains all
 	// revisions from the input it did not find on the repository.
 	CheckObjectsExist(CommitService_CheckObjectsExistServer) error
+	// VerifySignatures verifies the signatures of commits and annotated tags against a set of trusted
+	// public keys and the signing keys configured on the server. One response is sent per object.
+	VerifySignatures(*VerifySignaturesRequest, CommitService_VerifySignaturesServer) error
+	// ListNotes lists all notes of a notes reference together with their content. Notes are returned
+	// in the order of the notes tree. No notes are returned if the notes reference doesn't exist.
+	ListNotes(*ListNotesRequest, CommitService_ListNotesServer) error
 	mustEmbedUnimplementedCommitServiceServer()
 }
 
@@ -809,6 +885,12 @@ func (UnimplementedCommitServiceServer) GetCommitMessages(*GetCommitMessagesRequ
 func (UnimplementedCommitServiceServer) CheckObjectsExist(CommitService_CheckObjectsExistServer) error {
 	return status.Errorf(codes.Unimplemented, "method CheckObjectsExist not implemented")
 }
+func (UnimplementedCommitServiceServer) VerifySignatures(*VerifySignaturesRequest, CommitService_VerifySignaturesServer) error {
+	return status.Errorf(codes.Unimplemented, "method VerifySignatures not implemented")
+}
+func (UnimplementedCommitServiceServer) ListNotes(*ListNotesRequest, CommitService_ListNotesServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListNotes not implemented")
+}
 func (UnimplementedCommitServiceServer) mustEmbedUnimplementedCommitServiceServer() {}
 
 // UnsafeCommitServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -1294,6 +1376,48 @@ func (x *commitServiceCheckObjectsExistServer) Recv() (*CheckObjectsExistRequest
 	return m, nil
 }
 
+func _CommitService_VerifySignatures_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(VerifySignaturesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CommitServiceServer).VerifySignatures(m, &commitServiceVerifySignaturesServer{stream})
+}
+
+type CommitService_VerifySignaturesServer interface {
+	Send(*VerifySignaturesResponse) error
+	grpc.ServerStream
+}
+
+type commitServiceVerifySignaturesServer struct {
+	grpc.ServerStream
+}
+
+func (x *commitServiceVerifySignaturesServer) Send(m *VerifySignaturesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _CommitService_ListNotes_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListNotesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CommitServiceServer).ListNotes(m, &commitServiceListNotesServer{stream})
+}
+
+type CommitService_ListNotesServer interface {
+	Send(*ListNotesResponse) error
+	grpc.ServerStream
+}
+
+type commitServiceListNotesServer struct {
+	grpc.ServerStream
+}
+
+func (x *commitServiceListNotesServer) Send(m *ListNotesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // CommitService_ServiceDesc is the grpc.ServiceDesc for CommitService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1413,6 +1537,16 @@ var CommitService_ServiceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "VerifySignatures",
+			Handler:       _CommitService_VerifySignatures_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListNotes",
+			Handler:       _CommitService_ListNotes_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "commit.proto",
 }
@@ -21,6 +21,78 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Type is the type of a conflict.
+type PreviewMergeResponse_Conflict_Type int32
+
+const (
+	// TYPE_UNSPECIFIED is the default value and should not be used.
+	PreviewMergeResponse_Conflict_TYPE_UNSPECIFIED PreviewMergeResponse_Conflict_Type = 0
+	// TYPE_CONTENT denotes conflicting changes to the content of a text file.
+	PreviewMergeResponse_Conflict_TYPE_CONTENT PreviewMergeResponse_Conflict_Type = 1
+	// TYPE_BINARY denotes conflicting changes to a binary file.
+	PreviewMergeResponse_Conflict_TYPE_BINARY PreviewMergeResponse_Conflict_Type = 2
+	// TYPE_RENAME_DELETE denotes a file that has been renamed on one side and deleted on the
+	// other side.
+	PreviewMergeResponse_Conflict_TYPE_RENAME_DELETE PreviewMergeResponse_Conflict_Type = 3
+	// TYPE_MODIFY_DELETE denotes a file that has been modified on one side and deleted on the
+	// other side.
+	PreviewMergeResponse_Conflict_TYPE_MODIFY_DELETE PreviewMergeResponse_Conflict_Type = 4
+	// TYPE_DIRECTORY_FILE denotes a path that is a directory on one side and a file on the
+	// other side.
+	PreviewMergeResponse_Conflict_TYPE_DIRECTORY_FILE PreviewMergeResponse_Conflict_Type = 5
+	// TYPE_OTHER denotes any other kind of conflict, e.g. conflicting renames.
+	PreviewMergeResponse_Conflict_TYPE_OTHER PreviewMergeResponse_Conflict_Type = 6
+)
+
+// Enum value maps for PreviewMergeResponse_Conflict_Type.
+var (
+	PreviewMergeResponse_Conflict_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "TYPE_CONTENT",
+		2: "TYPE_BINARY",
+		3: "TYPE_RENAME_DELETE",
+		4: "TYPE_MODIFY_DELETE",
+		5: "TYPE_DIRECTORY_FILE",
+		6: "TYPE_OTHER",
+	}
+	PreviewMergeResponse_Conflict_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED":    0,
+		"TYPE_CONTENT":        1,
+		"TYPE_BINARY":         2,
+		"TYPE_RENAME_DELETE":  3,
+		"TYPE_MODIFY_DELETE":  4,
+		"TYPE_DIRECTORY_FILE": 5,
+		"TYPE_OTHER":          6,
+	}
+)
+
+func (x PreviewMergeResponse_Conflict_Type) Enum() *PreviewMergeResponse_Conflict_Type {
+	p := new(PreviewMergeResponse_Conflict_Type)
+	*p = x
+	return p
+}
+
+func (x PreviewMergeResponse_Conflict_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PreviewMergeResponse_Conflict_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_conflicts_proto_enumTypes[0].Descriptor()
+}
+
+func (PreviewMergeResponse_Conflict_Type) Type() protoreflect.EnumType {
+	return &file_conflicts_proto_enumTypes[0]
+}
+
+func (x PreviewMergeResponse_Conflict_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PreviewMergeResponse_Conflict_Type.Descriptor instead.
+func (PreviewMergeResponse_Conflict_Type) EnumDescriptor() ([]byte, []int) {
+	return file_conflicts_proto_rawDescGZIP(), []int{8, 0, 0}
+}
+
 // ListConflictFilesRequest is the request for the ListConflictFilesRequest rpc.
 type ListConflictFilesRequest struct {
 	state         protoimpl.MessageState
@@ -202,7 +274,6 @@ type ConflictFile struct {
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to ConflictFilePayload:
-	//
 	//	*ConflictFile_Header
 	//	*ConflictFile_Content
 	ConflictFilePayload isConflictFile_ConflictFilePayload `protobuf_oneof:"conflict_file_payload"`
@@ -467,7 +538,6 @@ type ResolveConflictsRequest struct {
 	// requests must be FilesJson requests.
 	//
 	// Types that are assignable to ResolveConflictsRequestPayload:
-	//
 	//	*ResolveConflictsRequest_Header
 	//	*ResolveConflictsRequest_FilesJson
 	ResolveConflictsRequestPayload isResolveConflictsRequest_ResolveConflictsRequestPayload `protobuf_oneof:"resolve_conflicts_request_payload"`
@@ -536,7 +606,12 @@ type ResolveConflictsRequest_Header struct {
 }
 
 type ResolveConflictsRequest_FilesJson struct {
-	// FilesJson is a JSON-encoded list of conflicts resolutions.
+	// FilesJson is a JSON-encoded list of conflicts resolutions. Each resolution
+	// identifies the conflicted file via "old_path" and "new_path" and resolves
+	// it via either "sections", "content" or "choice". "choice" is either "head"
+	// or "origin" and selects the version of the file in our or their commit,
+	// which allows resolving binary, add/add, modify/delete and rename/rename
+	// conflicts.
 	FilesJson []byte `protobuf:"bytes,2,opt,name=files_json,json=filesJson,proto3,oneof"`
 }
 
@@ -598,6 +673,210 @@ func (x *ResolveConflictsResponse) GetResolutionError() string {
 	return ""
 }
 
+// PreviewMergeRequest is the request for the PreviewMerge RPC.
+type PreviewMergeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Repository is the repository in which the merge shall be previewed.
+	Repository *Repository `protobuf:"bytes,1,opt,name=repository,proto3" json:"repository,omitempty"`
+	// OurCommitOid is the commit ID to merge into.
+	OurCommitOid string `protobuf:"bytes,2,opt,name=our_commit_oid,json=ourCommitOid,proto3" json:"our_commit_oid,omitempty"`
+	// TheirCommitOid is the commit ID to merge from.
+	TheirCommitOid string `protobuf:"bytes,3,opt,name=their_commit_oid,json=theirCommitOid,proto3" json:"their_commit_oid,omitempty"`
+}
+
+func (x *PreviewMergeRequest) Reset() {
+	*x = PreviewMergeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_conflicts_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PreviewMergeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewMergeRequest) ProtoMessage() {}
+
+func (x *PreviewMergeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_conflicts_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewMergeRequest.ProtoReflect.Descriptor instead.
+func (*PreviewMergeRequest) Descriptor() ([]byte, []int) {
+	return file_conflicts_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PreviewMergeRequest) GetRepository() *Repository {
+	if x != nil {
+		return x.Repository
+	}
+	return nil
+}
+
+func (x *PreviewMergeRequest) GetOurCommitOid() string {
+	if x != nil {
+		return x.OurCommitOid
+	}
+	return ""
+}
+
+func (x *PreviewMergeRequest) GetTheirCommitOid() string {
+	if x != nil {
+		return x.TheirCommitOid
+	}
+	return ""
+}
+
+// PreviewMergeResponse is the response for the PreviewMerge RPC.
+type PreviewMergeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Clean is set if the commits can be merged without conflicts.
+	Clean bool `protobuf:"varint,1,opt,name=clean,proto3" json:"clean,omitempty"`
+	// TreeId is the ID of the tree resulting from the merge. It is only set if the merge is clean.
+	// Note that the tree is not persisted in the repository.
+	TreeId string `protobuf:"bytes,2,opt,name=tree_id,json=treeId,proto3" json:"tree_id,omitempty"`
+	// Conflicts are the conflicts encountered while merging. It is only set if the merge is not
+	// clean.
+	Conflicts []*PreviewMergeResponse_Conflict `protobuf:"bytes,3,rep,name=conflicts,proto3" json:"conflicts,omitempty"`
+}
+
+func (x *PreviewMergeResponse) Reset() {
+	*x = PreviewMergeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_conflicts_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PreviewMergeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewMergeResponse) ProtoMessage() {}
+
+func (x *PreviewMergeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_conflicts_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewMergeResponse.ProtoReflect.Descriptor instead.
+func (*PreviewMergeResponse) Descriptor() ([]byte, []int) {
+	return file_conflicts_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PreviewMergeResponse) GetClean() bool {
+	if x != nil {
+		return x.Clean
+	}
+	return false
+}
+
+func (x *PreviewMergeResponse) GetTreeId() string {
+	if x != nil {
+		return x.TreeId
+	}
+	return ""
+}
+
+func (x *PreviewMergeResponse) GetConflicts() []*PreviewMergeResponse_Conflict {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+// Conflict is a single conflict encountered while merging.
+type PreviewMergeResponse_Conflict struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Paths are the paths involved in the conflict. The first path is the path of the conflicted
+	// file in the merged tree, the remaining paths are related paths like the source of a rename.
+	Paths [][]byte `protobuf:"bytes,1,rep,name=paths,proto3" json:"paths,omitempty"`
+	// Type is the type of the conflict.
+	Type PreviewMergeResponse_Conflict_Type `protobuf:"varint,2,opt,name=type,proto3,enum=gitaly.PreviewMergeResponse_Conflict_Type" json:"type,omitempty"`
+	// Message is the human-readable description of the conflict as reported by Git.
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *PreviewMergeResponse_Conflict) Reset() {
+	*x = PreviewMergeResponse_Conflict{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_conflicts_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PreviewMergeResponse_Conflict) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewMergeResponse_Conflict) ProtoMessage() {}
+
+func (x *PreviewMergeResponse_Conflict) ProtoReflect() protoreflect.Message {
+	mi := &file_conflicts_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewMergeResponse_Conflict.ProtoReflect.Descriptor instead.
+func (*PreviewMergeResponse_Conflict) Descriptor() ([]byte, []int) {
+	return file_conflicts_proto_rawDescGZIP(), []int{8, 0}
+}
+
+func (x *PreviewMergeResponse_Conflict) GetPaths() [][]byte {
+	if x != nil {
+		return x.Paths
+	}
+	return nil
+}
+
+func (x *PreviewMergeResponse_Conflict) GetType() PreviewMergeResponse_Conflict_Type {
+	if x != nil {
+		return x.Type
+	}
+	return PreviewMergeResponse_Conflict_TYPE_UNSPECIFIED
+}
+
+func (x *PreviewMergeResponse_Conflict) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
 var File_conflicts_proto protoreflect.FileDescriptor
 
 var file_conflicts_proto_rawDesc = []byte{
@@ -687,25 +966,66 @@ var file_conflicts_proto_rawDesc = []byte{
 	0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
 	0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f,
 	0x6e, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72,
-	0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x32, 0xd7,
-	0x01, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x12, 0x62, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x6c,
-	0x69, 0x63, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x20, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c,
-	0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x46, 0x69,
-	0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x67, 0x69, 0x74,
-	0x61, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74,
-	0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x06, 0xfa,
-	0x97, 0x28, 0x02, 0x08, 0x02, 0x30, 0x01, 0x12, 0x5f, 0x0a, 0x10, 0x52, 0x65, 0x73, 0x6f, 0x6c,
-	0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x12, 0x1f, 0x2e, 0x67, 0x69,
-	0x74, 0x61, 0x6c, 0x79, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66,
-	0x6c, 0x69, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x67,
-	0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x43, 0x6f, 0x6e,
-	0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x06,
-	0xfa, 0x97, 0x28, 0x02, 0x08, 0x01, 0x28, 0x01, 0x42, 0x34, 0x5a, 0x32, 0x67, 0x69, 0x74, 0x6c,
-	0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2d, 0x6f, 0x72,
-	0x67, 0x2f, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2f, 0x76, 0x31, 0x36, 0x2f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x2f, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x70, 0x62, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x9f,
+	0x01, 0x0a, 0x13, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x69, 0x74,
+	0x61, 0x6c, 0x79, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x42, 0x04,
+	0x98, 0xc6, 0x2c, 0x01, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79,
+	0x12, 0x24, 0x0a, 0x0e, 0x6f, 0x75, 0x72, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x6f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x75, 0x72, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x4f, 0x69, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x74, 0x68, 0x65, 0x69, 0x72, 0x5f,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x6f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x74, 0x68, 0x65, 0x69, 0x72, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4f, 0x69, 0x64,
+	0x22, 0xa2, 0x03, 0x0a, 0x14, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x4d, 0x65, 0x72, 0x67,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x65,
+	0x61, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x12,
+	0x17, 0x0a, 0x07, 0x74, 0x72, 0x65, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x74, 0x72, 0x65, 0x65, 0x49, 0x64, 0x12, 0x43, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x66,
+	0x6c, 0x69, 0x63, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x67, 0x69,
+	0x74, 0x61, 0x6c, 0x79, 0x2e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x4d, 0x65, 0x72, 0x67,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69,
+	0x63, 0x74, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x1a, 0x95, 0x02,
+	0x0a, 0x08, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61,
+	0x74, 0x68, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73,
+	0x12, 0x3e, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a,
+	0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x4d,
+	0x65, 0x72, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x6f, 0x6e,
+	0x66, 0x6c, 0x69, 0x63, 0x74, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x98, 0x01, 0x0a, 0x04, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x45, 0x4e, 0x54, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x42, 0x49, 0x4e, 0x41, 0x52, 0x59, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x44, 0x45, 0x4c, 0x45,
+	0x54, 0x45, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x4f, 0x44,
+	0x49, 0x46, 0x59, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x04, 0x12, 0x17, 0x0a, 0x13,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x4f, 0x52, 0x59, 0x5f, 0x46,
+	0x49, 0x4c, 0x45, 0x10, 0x05, 0x12, 0x0e, 0x0a, 0x0a, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4f, 0x54,
+	0x48, 0x45, 0x52, 0x10, 0x06, 0x32, 0xaa, 0x02, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69,
+	0x63, 0x74, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x62, 0x0a, 0x11, 0x4c, 0x69,
+	0x73, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12,
+	0x20, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e,
+	0x66, 0x6c, 0x69, 0x63, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x21, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43,
+	0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x06, 0xfa, 0x97, 0x28, 0x02, 0x08, 0x02, 0x30, 0x01, 0x12, 0x5f,
+	0x0a, 0x10, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63,
+	0x74, 0x73, 0x12, 0x1f, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x52, 0x65, 0x73, 0x6f,
+	0x6c, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x52, 0x65, 0x73,
+	0x6f, 0x6c, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x06, 0xfa, 0x97, 0x28, 0x02, 0x08, 0x01, 0x28, 0x01, 0x12,
+	0x51, 0x0a, 0x0c, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x12,
+	0x1b, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x4d, 0x65, 0x72, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x67,
+	0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x4d, 0x65, 0x72,
+	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x06, 0xfa, 0x97, 0x28, 0x02,
+	0x08, 0x02, 0x42, 0x34, 0x5a, 0x32, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2d, 0x6f, 0x72, 0x67, 0x2f, 0x67, 0x69, 0x74, 0x61,
+	0x6c, 0x79, 0x2f, 0x76, 0x31, 0x36, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x2f,
+	0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -720,37 +1040,47 @@ func file_conflicts_proto_rawDescGZIP() []byte {
 	return file_conflicts_proto_rawDescData
 }
 
-var file_conflicts_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_conflicts_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_conflicts_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_conflicts_proto_goTypes = []interface{}{
-	(*ListConflictFilesRequest)(nil),      // 0: gitaly.ListConflictFilesRequest
-	(*ConflictFileHeader)(nil),            // 1: gitaly.ConflictFileHeader
-	(*ConflictFile)(nil),                  // 2: gitaly.ConflictFile
-	(*ListConflictFilesResponse)(nil),     // 3: gitaly.ListConflictFilesResponse
-	(*ResolveConflictsRequestHeader)(nil), // 4: gitaly.ResolveConflictsRequestHeader
-	(*ResolveConflictsRequest)(nil),       // 5: gitaly.ResolveConflictsRequest
-	(*ResolveConflictsResponse)(nil),      // 6: gitaly.ResolveConflictsResponse
-	(*Repository)(nil),                    // 7: gitaly.Repository
-	(*User)(nil),                          // 8: gitaly.User
-	(*timestamppb.Timestamp)(nil),         // 9: google.protobuf.Timestamp
+	(PreviewMergeResponse_Conflict_Type)(0), // 0: gitaly.PreviewMergeResponse.Conflict.Type
+	(*ListConflictFilesRequest)(nil),        // 1: gitaly.ListConflictFilesRequest
+	(*ConflictFileHeader)(nil),              // 2: gitaly.ConflictFileHeader
+	(*ConflictFile)(nil),                    // 3: gitaly.ConflictFile
+	(*ListConflictFilesResponse)(nil),       // 4: gitaly.ListConflictFilesResponse
+	(*ResolveConflictsRequestHeader)(nil),   // 5: gitaly.ResolveConflictsRequestHeader
+	(*ResolveConflictsRequest)(nil),         // 6: gitaly.ResolveConflictsRequest
+	(*ResolveConflictsResponse)(nil),        // 7: gitaly.ResolveConflictsResponse
+	(*PreviewMergeRequest)(nil),             // 8: gitaly.PreviewMergeRequest
+	(*PreviewMergeResponse)(nil),            // 9: gitaly.PreviewMergeResponse
+	(*PreviewMergeResponse_Conflict)(nil),   // 10: gitaly.PreviewMergeResponse.Conflict
+	(*Repository)(nil),                      // 11: gitaly.Repository
+	(*User)(nil),                            // 12: gitaly.User
+	(*timestamppb.Timestamp)(nil),           // 13: google.protobuf.Timestamp
 }
 var file_conflicts_proto_depIdxs = []int32{
-	7,  // 0: gitaly.ListConflictFilesRequest.repository:type_name -> gitaly.Repository
-	1,  // 1: gitaly.ConflictFile.header:type_name -> gitaly.ConflictFileHeader
-	2,  // 2: gitaly.ListConflictFilesResponse.files:type_name -> gitaly.ConflictFile
-	7,  // 3: gitaly.ResolveConflictsRequestHeader.repository:type_name -> gitaly.Repository
-	7,  // 4: gitaly.ResolveConflictsRequestHeader.target_repository:type_name -> gitaly.Repository
-	8,  // 5: gitaly.ResolveConflictsRequestHeader.user:type_name -> gitaly.User
-	9,  // 6: gitaly.ResolveConflictsRequestHeader.timestamp:type_name -> google.protobuf.Timestamp
-	4,  // 7: gitaly.ResolveConflictsRequest.header:type_name -> gitaly.ResolveConflictsRequestHeader
-	0,  // 8: gitaly.ConflictsService.ListConflictFiles:input_type -> gitaly.ListConflictFilesRequest
-	5,  // 9: gitaly.ConflictsService.ResolveConflicts:input_type -> gitaly.ResolveConflictsRequest
-	3,  // 10: gitaly.ConflictsService.ListConflictFiles:output_type -> gitaly.ListConflictFilesResponse
-	6,  // 11: gitaly.ConflictsService.ResolveConflicts:output_type -> gitaly.ResolveConflictsResponse
-	10, // [10:12] is the sub-list for method output_type
-	8,  // [8:10] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	11, // 0: gitaly.ListConflictFilesRequest.repository:type_name -> gitaly.Repository
+	2,  // 1: gitaly.ConflictFile.header:type_name -> gitaly.ConflictFileHeader
+	3,  // 2: gitaly.ListConflictFilesResponse.files:type_name -> gitaly.ConflictFile
+	11, // 3: gitaly.ResolveConflictsRequestHeader.repository:type_name -> gitaly.Repository
+	11, // 4: gitaly.ResolveConflictsRequestHeader.target_repository:type_name -> gitaly.Repository
+	12, // 5: gitaly.ResolveConflictsRequestHeader.user:type_name -> gitaly.User
+	13, // 6: gitaly.ResolveConflictsRequestHeader.timestamp:type_name -> google.protobuf.Timestamp
+	5,  // 7: gitaly.ResolveConflictsRequest.header:type_name -> gitaly.ResolveConflictsRequestHeader
+	11, // 8: gitaly.PreviewMergeRequest.repository:type_name -> gitaly.Repository
+	10, // 9: gitaly.PreviewMergeResponse.conflicts:type_name -> gitaly.PreviewMergeResponse.Conflict
+	0,  // 10: gitaly.PreviewMergeResponse.Conflict.type:type_name -> gitaly.PreviewMergeResponse.Conflict.Type
+	1,  // 11: gitaly.ConflictsService.ListConflictFiles:input_type -> gitaly.ListConflictFilesRequest
+	6,  // 12: gitaly.ConflictsService.ResolveConflicts:input_type -> gitaly.ResolveConflictsRequest
+	8,  // 13: gitaly.ConflictsService.PreviewMerge:input_type -> gitaly.PreviewMergeRequest
+	4,  // 14: gitaly.ConflictsService.ListConflictFiles:output_type -> gitaly.ListConflictFilesResponse
+	7,  // 15: gitaly.ConflictsService.ResolveConflicts:output_type -> gitaly.ResolveConflictsResponse
+	9,  // 16: gitaly.ConflictsService.PreviewMerge:output_type -> gitaly.PreviewMergeResponse
+	14, // [14:17] is the sub-list for method output_type
+	11, // [11:14] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_conflicts_proto_init() }
@@ -845,6 +1175,42 @@ func file_conflicts_proto_init() {
 				return nil
 			}
 		}
+		file_conflicts_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreviewMergeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_conflicts_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreviewMergeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_conflicts_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreviewMergeResponse_Conflict); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_conflicts_proto_msgTypes[2].OneofWrappers = []interface{}{
 		(*ConflictFile_Header)(nil),
@@ -859,13 +1225,14 @@ func file_conflicts_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_conflicts_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   7,
+			NumEnums:      1,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_conflicts_proto_goTypes,
 		DependencyIndexes: file_conflicts_proto_depIdxs,
+		EnumInfos:         file_conflicts_proto_enumTypes,
 		MessageInfos:      file_conflicts_proto_msgTypes,
 	}.Build()
 	File_conflicts_proto = out.File
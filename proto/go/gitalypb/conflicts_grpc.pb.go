@@ -29,6 +29,10 @@ type ConflictsServiceClient interface {
 	// user-provided merge resolutions. If resolving the conflict succeeds, the
 	// result will be a new merge commit.
 	ResolveConflicts(ctx context.Context, opts ...grpc.CallOption) (ConflictsService_ResolveConflictsClient, error)
+	// PreviewMerge merges two commits in memory and returns whether the merge is clean. If it is,
+	// the ID of the resulting tree is returned. Otherwise, the conflicts of the merge are returned.
+	// Neither the merge result nor any other object is persisted in the repository.
+	PreviewMerge(ctx context.Context, in *PreviewMergeRequest, opts ...grpc.CallOption) (*PreviewMergeResponse, error)
 }
 
 type conflictsServiceClient struct {
@@ -105,6 +109,15 @@ func (x *conflictsServiceResolveConflictsClient) CloseAndRecv() (*ResolveConflic
 	return m, nil
 }
 
+func (c *conflictsServiceClient) PreviewMerge(ctx context.Context, in *PreviewMergeRequest, opts ...grpc.CallOption) (*PreviewMergeResponse, error) {
+	out := new(PreviewMergeResponse)
+	err := c.cc.Invoke(ctx, "/gitaly.ConflictsService/PreviewMerge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ConflictsServiceServer is the server API for ConflictsService service.
 // All implementations must embed UnimplementedConflictsServiceServer
 // for forward compatibility
@@ -116,6 +129,10 @@ type ConflictsServiceServer interface {
 	// user-provided merge resolutions. If resolving the conflict succeeds, the
 	// result will be a new merge commit.
 	ResolveConflicts(ConflictsService_ResolveConflictsServer) error
+	// PreviewMerge merges two commits in memory and returns whether the merge is clean. If it is,
+	// the ID of the resulting tree is returned. Otherwise, the conflicts of the merge are returned.
+	// Neither the merge result nor any other object is persisted in the repository.
+	PreviewMerge(context.Context, *PreviewMergeRequest) (*PreviewMergeResponse, error)
 	mustEmbedUnimplementedConflictsServiceServer()
 }
 
@@ -129,6 +146,9 @@ func (UnimplementedConflictsServiceServer) ListConflictFiles(*ListConflictFilesR
 func (UnimplementedConflictsServiceServer) ResolveConflicts(ConflictsService_ResolveConflictsServer) error {
 	return status.Errorf(codes.Unimplemented, "method ResolveConflicts not implemented")
 }
+func (UnimplementedConflictsServiceServer) PreviewMerge(context.Context, *PreviewMergeRequest) (*PreviewMergeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewMerge not implemented")
+}
 func (UnimplementedConflictsServiceServer) mustEmbedUnimplementedConflictsServiceServer() {}
 
 // UnsafeConflictsServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -189,13 +209,36 @@ func (x *conflictsServiceResolveConflictsServer) Recv() (*ResolveConflictsReques
 	return m, nil
 }
 
+func _ConflictsService_PreviewMerge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewMergeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConflictsServiceServer).PreviewMerge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gitaly.ConflictsService/PreviewMerge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConflictsServiceServer).PreviewMerge(ctx, req.(*PreviewMergeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ConflictsService_ServiceDesc is the grpc.ServiceDesc for ConflictsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
 var ConflictsService_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "gitaly.ConflictsService",
 	HandlerType: (*ConflictsServiceServer)(nil),
-	Methods:     []grpc.MethodDesc{},
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PreviewMerge",
+			Handler:    _ConflictsService_PreviewMerge_Handler,
+		},
+	},
 	Streams: []grpc.StreamDesc{
 		{
 			StreamName:    "ListConflictFiles",
@@ -30,16 +30,16 @@ type OperationServiceClient interface {
 	// hooks and contacts Rails to verify that the user is indeed allowed to delete that branch. The
 	// following known error conditions may happen:
 	//
-	//   - Returns `InvalidArgument` in case either the branch name or user are not set.
-	//   - Returns `FailedPrecondition` in case the branch does not exist.
-	//   - Returns `OK` with a `PreReceiveError` in case custom hooks refused the update. If the
-	//     `gitaly_user_delete_branch_structured_errors` feature flag is enabled this error case will
-	//     instead return `PermissionDenied` with either a `CustomHook` or AccessCheck` structured
-	//     error.
-	//   - Returns `FailedPrecondition` in case updating the reference fails because
-	//     of a concurrent write to the same reference. If the
-	//     `gitaly_user_delete_branch_structured_errors` feature flag is set this error case will
-	//     instead return `FailedPrecondition` with a `ReferenceUpdate` structured error.
+	// - Returns `InvalidArgument` in case either the branch name or user are not set.
+	// - Returns `FailedPrecondition` in case the branch does not exist.
+	// - Returns `OK` with a `PreReceiveError` in case custom hooks refused the update. If the
+	//   `gitaly_user_delete_branch_structured_errors` feature flag is enabled this error case will
+	//   instead return `PermissionDenied` with either a `CustomHook` or AccessCheck` structured
+	//   error.
+	// - Returns `FailedPrecondition` in case updating the reference fails because
+	//   of a concurrent write to the same reference. If the
+	//   `gitaly_user_delete_branch_structured_errors` feature flag is set this error case will
+	//   instead return `FailedPrecondition` with a `ReferenceUpdate` structured error.
 	UserDeleteBranch(ctx context.Context, in *UserDeleteBranchRequest, opts ...grpc.CallOption) (*UserDeleteBranchResponse, error)
 	// UserCreateTag creates a new tag. This RPC knows to create both lightweight and annotated tags
 	// depending on whether a message is set.
@@ -74,6 +74,11 @@ type OperationServiceClient interface {
 	// UserCherryPick tries to perform a cherry-pick of a given commit onto a
 	// branch.
 	UserCherryPick(ctx context.Context, in *UserCherryPickRequest, opts ...grpc.CallOption) (*UserCherryPickResponse, error)
+	// UserCherryPickCommits cherry-picks a list of commits onto a branch in the given order. The
+	// cherry-picked commits are created as a single chain on top of the branch, which is then updated
+	// with hooks once. If any of the commits fails to apply, the branch is left unchanged. Some errors
+	// contain an embedded UserCherryPickCommitsError.
+	UserCherryPickCommits(ctx context.Context, in *UserCherryPickCommitsRequest, opts ...grpc.CallOption) (*UserCherryPickCommitsResponse, error)
 	// UserCommitFiles builds a commit from a stream of actions and updates the target branch to point to it.
 	// UserCommitFilesRequest with a UserCommitFilesRequestHeader must be sent as the first message of the stream.
 	// Following that, a variable number of actions can be sent to build a new commit. Each action consists of
@@ -88,6 +93,13 @@ type OperationServiceClient interface {
 	// commit ID. Only if a second message with `apply = true` is sent will the
 	// rebase be applied.
 	UserRebaseConfirmable(ctx context.Context, opts ...grpc.CallOption) (OperationService_UserRebaseConfirmableClient, error)
+	// UserRebaseInteractive replays the commits of a branch on top of an upstream commit according to
+	// an explicit todo list and updates the branch to point to the result. Each step of the todo list
+	// picks, rewords, squashes, fixes up or drops one of the commits reachable from the branch but not
+	// from the upstream commit. The steps are applied in the given order, and commits of the range which
+	// are not part of the todo list are dropped. The rebase is computed without a worktree and the branch
+	// is updated with hooks. Some errors contain an embedded UserRebaseInteractiveError.
+	UserRebaseInteractive(ctx context.Context, in *UserRebaseInteractiveRequest, opts ...grpc.CallOption) (*UserRebaseInteractiveResponse, error)
 	// UserRevert tries to perform a revert of a given commit onto a branch.
 	UserRevert(ctx context.Context, in *UserRevertRequest, opts ...grpc.CallOption) (*UserRevertResponse, error)
 	// UserSquash squashes a range of commits into a single commit. If
@@ -100,6 +112,18 @@ type OperationServiceClient interface {
 	UserApplyPatch(ctx context.Context, opts ...grpc.CallOption) (OperationService_UserApplyPatchClient, error)
 	// UserUpdateSubmodule updates a submodule to point to a new commit.
 	UserUpdateSubmodule(ctx context.Context, in *UserUpdateSubmoduleRequest, opts ...grpc.CallOption) (*UserUpdateSubmoduleResponse, error)
+	// UserAddNote attaches a note to a commit. The note is written into a new commit of the notes
+	// reference, which is then updated with hooks. It fails with AlreadyExists if the commit already
+	// has a note in the notes reference. Some errors contain an embedded UserNoteError.
+	UserAddNote(ctx context.Context, in *UserAddNoteRequest, opts ...grpc.CallOption) (*UserAddNoteResponse, error)
+	// UserUpdateNote replaces the content of the note attached to a commit. The notes reference is
+	// updated with hooks. It fails with NotFound if the commit has no note in the notes reference.
+	// Some errors contain an embedded UserNoteError.
+	UserUpdateNote(ctx context.Context, in *UserUpdateNoteRequest, opts ...grpc.CallOption) (*UserUpdateNoteResponse, error)
+	// UserRemoveNote removes the note attached to a commit. The notes reference is updated with hooks.
+	// It fails with NotFound if the commit has no note in the notes reference. Some errors contain an
+	// embedded UserNoteError.
+	UserRemoveNote(ctx context.Context, in *UserRemoveNoteRequest, opts ...grpc.CallOption) (*UserRemoveNoteResponse, error)
 }
 
 type operationServiceClient struct {
@@ -222,6 +246,15 @@ func (c *operationServiceClient) UserCherryPick(ctx context.Context, in *UserChe
 	return out, nil
 }
 
+func (c *operationServiceClient) UserCherryPickCommits(ctx context.Context, in *UserCherryPickCommitsRequest, opts ...grpc.CallOption) (*UserCherryPickCommitsResponse, error) {
+	out := new(UserCherryPickCommitsResponse)
+	err := c.cc.Invoke(ctx, "/gitaly.OperationService/UserCherryPickCommits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *operationServiceClient) UserCommitFiles(ctx context.Context, opts ...grpc.CallOption) (OperationService_UserCommitFilesClient, error) {
 	stream, err := c.cc.NewStream(ctx, &OperationService_ServiceDesc.Streams[1], "/gitaly.OperationService/UserCommitFiles", opts...)
 	if err != nil {
@@ -287,6 +320,15 @@ func (x *operationServiceUserRebaseConfirmableClient) Recv() (*UserRebaseConfirm
 	return m, nil
 }
 
+func (c *operationServiceClient) UserRebaseInteractive(ctx context.Context, in *UserRebaseInteractiveRequest, opts ...grpc.CallOption) (*UserRebaseInteractiveResponse, error) {
+	out := new(UserRebaseInteractiveResponse)
+	err := c.cc.Invoke(ctx, "/gitaly.OperationService/UserRebaseInteractive", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *operationServiceClient) UserRevert(ctx context.Context, in *UserRevertRequest, opts ...grpc.CallOption) (*UserRevertResponse, error) {
 	out := new(UserRevertResponse)
 	err := c.cc.Invoke(ctx, "/gitaly.OperationService/UserRevert", in, out, opts...)
@@ -348,6 +390,33 @@ func (c *operationServiceClient) UserUpdateSubmodule(ctx context.Context, in *Us
 	return out, nil
 }
 
+func (c *operationServiceClient) UserAddNote(ctx context.Context, in *UserAddNoteRequest, opts ...grpc.CallOption) (*UserAddNoteResponse, error) {
+	out := new(UserAddNoteResponse)
+	err := c.cc.Invoke(ctx, "/gitaly.OperationService/UserAddNote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operationServiceClient) UserUpdateNote(ctx context.Context, in *UserUpdateNoteRequest, opts ...grpc.CallOption) (*UserUpdateNoteResponse, error) {
+	out := new(UserUpdateNoteResponse)
+	err := c.cc.Invoke(ctx, "/gitaly.OperationService/UserUpdateNote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operationServiceClient) UserRemoveNote(ctx context.Context, in *UserRemoveNoteRequest, opts ...grpc.CallOption) (*UserRemoveNoteResponse, error) {
+	out := new(UserRemoveNoteResponse)
+	err := c.cc.Invoke(ctx, "/gitaly.OperationService/UserRemoveNote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OperationServiceServer is the server API for OperationService service.
 // All implementations must embed UnimplementedOperationServiceServer
 // for forward compatibility
@@ -360,16 +429,16 @@ type OperationServiceServer interface {
 	// hooks and contacts Rails to verify that the user is indeed allowed to delete that branch. The
 	// following known error conditions may happen:
 	//
-	//   - Returns `InvalidArgument` in case either the branch name or user are not set.
-	//   - Returns `FailedPrecondition` in case the branch does not exist.
-	//   - Returns `OK` with a `PreReceiveError` in case custom hooks refused the update. If the
-	//     `gitaly_user_delete_branch_structured_errors` feature flag is enabled this error case will
-	//     instead return `PermissionDenied` with either a `CustomHook` or AccessCheck` structured
-	//     error.
-	//   - Returns `FailedPrecondition` in case updating the reference fails because
-	//     of a concurrent write to the same reference. If the
-	//     `gitaly_user_delete_branch_structured_errors` feature flag is set this error case will
-	//     instead return `FailedPrecondition` with a `ReferenceUpdate` structured error.
+	// - Returns `InvalidArgument` in case either the branch name or user are not set.
+	// - Returns `FailedPrecondition` in case the branch does not exist.
+	// - Returns `OK` with a `PreReceiveError` in case custom hooks refused the update. If the
+	//   `gitaly_user_delete_branch_structured_errors` feature flag is enabled this error case will
+	//   instead return `PermissionDenied` with either a `CustomHook` or AccessCheck` structured
+	//   error.
+	// - Returns `FailedPrecondition` in case updating the reference fails because
+	//   of a concurrent write to the same reference. If the
+	//   `gitaly_user_delete_branch_structured_errors` feature flag is set this error case will
+	//   instead return `FailedPrecondition` with a `ReferenceUpdate` structured error.
 	UserDeleteBranch(context.Context, *UserDeleteBranchRequest) (*UserDeleteBranchResponse, error)
 	// UserCreateTag creates a new tag. This RPC knows to create both lightweight and annotated tags
 	// depending on whether a message is set.
@@ -404,6 +473,11 @@ type OperationServiceServer interface {
 	// UserCherryPick tries to perform a cherry-pick of a given commit onto a
 	// branch.
 	UserCherryPick(context.Context, *UserCherryPickRequest) (*UserCherryPickResponse, error)
+	// UserCherryPickCommits cherry-picks a list of commits onto a branch in the given order. The
+	// cherry-picked commits are created as a single chain on top of the branch, which is then updated
+	// with hooks once. If any of the commits fails to apply, the branch is left unchanged. Some errors
+	// contain an embedded UserCherryPickCommitsError.
+	UserCherryPickCommits(context.Context, *UserCherryPickCommitsRequest) (*UserCherryPickCommitsResponse, error)
 	// UserCommitFiles builds a commit from a stream of actions and updates the target branch to point to it.
 	// UserCommitFilesRequest with a UserCommitFilesRequestHeader must be sent as the first message of the stream.
 	// Following that, a variable number of actions can be sent to build a new commit. Each action consists of
@@ -418,6 +492,13 @@ type OperationServiceServer interface {
 	// commit ID. Only if a second message with `apply = true` is sent will the
 	// rebase be applied.
 	UserRebaseConfirmable(OperationService_UserRebaseConfirmableServer) error
+	// UserRebaseInteractive replays the commits of a branch on top of an upstream commit according to
+	// an explicit todo list and updates the branch to point to the result. Each step of the todo list
+	// picks, rewords, squashes, fixes up or drops one of the commits reachable from the branch but not
+	// from the upstream commit. The steps are applied in the given order, and commits of the range which
+	// are not part of the todo list are dropped. The rebase is computed without a worktree and the branch
+	// is updated with hooks. Some errors contain an embedded UserRebaseInteractiveError.
+	UserRebaseInteractive(context.Context, *UserRebaseInteractiveRequest) (*UserRebaseInteractiveResponse, error)
 	// UserRevert tries to perform a revert of a given commit onto a branch.
 	UserRevert(context.Context, *UserRevertRequest) (*UserRevertResponse, error)
 	// UserSquash squashes a range of commits into a single commit. If
@@ -430,6 +511,18 @@ type OperationServiceServer interface {
 	UserApplyPatch(OperationService_UserApplyPatchServer) error
 	// UserUpdateSubmodule updates a submodule to point to a new commit.
 	UserUpdateSubmodule(context.Context, *UserUpdateSubmoduleRequest) (*UserUpdateSubmoduleResponse, error)
+	// UserAddNote attaches a note to a commit. The note is written into a new commit of the notes
+	// reference, which is then updated with hooks. It fails with AlreadyExists if the commit already
+	// has a note in the notes reference. Some errors contain an embedded UserNoteError.
+	UserAddNote(context.Context, *UserAddNoteRequest) (*UserAddNoteResponse, error)
+	// UserUpdateNote replaces the content of the note attached to a commit. The notes reference is
+	// updated with hooks. It fails with NotFound if the commit has no note in the notes reference.
+	// Some errors contain an embedded UserNoteError.
+	UserUpdateNote(context.Context, *UserUpdateNoteRequest) (*UserUpdateNoteResponse, error)
+	// UserRemoveNote removes the note attached to a commit. The notes reference is updated with hooks.
+	// It fails with NotFound if the commit has no note in the notes reference. Some errors contain an
+	// embedded UserNoteError.
+	UserRemoveNote(context.Context, *UserRemoveNoteRequest) (*UserRemoveNoteResponse, error)
 	mustEmbedUnimplementedOperationServiceServer()
 }
 
@@ -467,12 +560,18 @@ func (UnimplementedOperationServiceServer) UserFFBranch(context.Context, *UserFF
 func (UnimplementedOperationServiceServer) UserCherryPick(context.Context, *UserCherryPickRequest) (*UserCherryPickResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UserCherryPick not implemented")
 }
+func (UnimplementedOperationServiceServer) UserCherryPickCommits(context.Context, *UserCherryPickCommitsRequest) (*UserCherryPickCommitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserCherryPickCommits not implemented")
+}
 func (UnimplementedOperationServiceServer) UserCommitFiles(OperationService_UserCommitFilesServer) error {
 	return status.Errorf(codes.Unimplemented, "method UserCommitFiles not implemented")
 }
 func (UnimplementedOperationServiceServer) UserRebaseConfirmable(OperationService_UserRebaseConfirmableServer) error {
 	return status.Errorf(codes.Unimplemented, "method UserRebaseConfirmable not implemented")
 }
+func (UnimplementedOperationServiceServer) UserRebaseInteractive(context.Context, *UserRebaseInteractiveRequest) (*UserRebaseInteractiveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserRebaseInteractive not implemented")
+}
 func (UnimplementedOperationServiceServer) UserRevert(context.Context, *UserRevertRequest) (*UserRevertResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UserRevert not implemented")
 }
@@ -485,6 +584,15 @@ func (UnimplementedOperationServiceServer) UserApplyPatch(OperationService_UserA
 func (UnimplementedOperationServiceServer) UserUpdateSubmodule(context.Context, *UserUpdateSubmoduleRequest) (*UserUpdateSubmoduleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UserUpdateSubmodule not implemented")
 }
+func (UnimplementedOperationServiceServer) UserAddNote(context.Context, *UserAddNoteRequest) (*UserAddNoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserAddNote not implemented")
+}
+func (UnimplementedOperationServiceServer) UserUpdateNote(context.Context, *UserUpdateNoteRequest) (*UserUpdateNoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserUpdateNote not implemented")
+}
+func (UnimplementedOperationServiceServer) UserRemoveNote(context.Context, *UserRemoveNoteRequest) (*UserRemoveNoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserRemoveNote not implemented")
+}
 func (UnimplementedOperationServiceServer) mustEmbedUnimplementedOperationServiceServer() {}
 
 // UnsafeOperationServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -686,6 +794,24 @@ func _OperationService_UserCherryPick_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OperationService_UserCherryPickCommits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserCherryPickCommitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperationServiceServer).UserCherryPickCommits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gitaly.OperationService/UserCherryPickCommits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperationServiceServer).UserCherryPickCommits(ctx, req.(*UserCherryPickCommitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _OperationService_UserCommitFiles_Handler(srv interface{}, stream grpc.ServerStream) error {
 	return srv.(OperationServiceServer).UserCommitFiles(&operationServiceUserCommitFilesServer{stream})
 }
@@ -738,6 +864,24 @@ func (x *operationServiceUserRebaseConfirmableServer) Recv() (*UserRebaseConfirm
 	return m, nil
 }
 
+func _OperationService_UserRebaseInteractive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserRebaseInteractiveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperationServiceServer).UserRebaseInteractive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gitaly.OperationService/UserRebaseInteractive",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperationServiceServer).UserRebaseInteractive(ctx, req.(*UserRebaseInteractiveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _OperationService_UserRevert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UserRevertRequest)
 	if err := dec(in); err != nil {
@@ -818,6 +962,60 @@ func _OperationService_UserUpdateSubmodule_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OperationService_UserAddNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserAddNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperationServiceServer).UserAddNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gitaly.OperationService/UserAddNote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperationServiceServer).UserAddNote(ctx, req.(*UserAddNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OperationService_UserUpdateNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserUpdateNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperationServiceServer).UserUpdateNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gitaly.OperationService/UserUpdateNote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperationServiceServer).UserUpdateNote(ctx, req.(*UserUpdateNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OperationService_UserRemoveNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserRemoveNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperationServiceServer).UserRemoveNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gitaly.OperationService/UserRemoveNote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperationServiceServer).UserRemoveNote(ctx, req.(*UserRemoveNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // OperationService_ServiceDesc is the grpc.ServiceDesc for OperationService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -861,6 +1059,14 @@ var OperationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UserCherryPick",
 			Handler:    _OperationService_UserCherryPick_Handler,
 		},
+		{
+			MethodName: "UserCherryPickCommits",
+			Handler:    _OperationService_UserCherryPickCommits_Handler,
+		},
+		{
+			MethodName: "UserRebaseInteractive",
+			Handler:    _OperationService_UserRebaseInteractive_Handler,
+		},
 		{
 			MethodName: "UserRevert",
 			Handler:    _OperationService_UserRevert_Handler,
@@ -873,6 +1079,18 @@ var OperationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UserUpdateSubmodule",
 			Handler:    _OperationService_UserUpdateSubmodule_Handler,
 		},
+		{
+			MethodName: "UserAddNote",
+			Handler:    _OperationService_UserAddNote_Handler,
+		},
+		{
+			MethodName: "UserUpdateNote",
+			Handler:    _OperationService_UserUpdateNote_Handler,
+		},
+		{
+			MethodName: "UserRemoveNote",
+			Handler:    _OperationService_UserRemoveNote_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
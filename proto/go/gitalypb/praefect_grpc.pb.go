@@ -51,6 +51,31 @@ type PraefectInfoServiceClient interface {
 	SetReplicationFactor(ctx context.Context, in *SetReplicationFactorRequest, opts ...grpc.CallOption) (*SetReplicationFactorResponse, error)
 	// GetRepositoryMetadata returns the cluster metadata for a repository. Returns NotFound if the repository does not exist.
 	GetRepositoryMetadata(ctx context.Context, in *GetRepositoryMetadataRequest, opts ...grpc.CallOption) (*GetRepositoryMetadataResponse, error)
+	// ListReplicationJobs lists the jobs in the replication queue matching the filter ordered by their ID.
+	// Returns FailedPrecondition if Praefect is not configured to use the SQL replication queue.
+	ListReplicationJobs(ctx context.Context, in *ListReplicationJobsRequest, opts ...grpc.CallOption) (PraefectInfoService_ListReplicationJobsClient, error)
+	// RetryReplicationJobs moves the dead jobs in the replication queue matching the filter back into the ready
+	// state so they are processed again. Jobs in other states are not touched. Returns FailedPrecondition if
+	// Praefect is not configured to use the SQL replication queue.
+	RetryReplicationJobs(ctx context.Context, in *RetryReplicationJobsRequest, opts ...grpc.CallOption) (*RetryReplicationJobsResponse, error)
+	// CancelReplicationJobs removes the jobs in the replication queue matching the filter. Jobs which are being
+	// processed are not cancelled. Returns InvalidArgument if the filter is empty and FailedPrecondition if
+	// Praefect is not configured to use the SQL replication queue.
+	CancelReplicationJobs(ctx context.Context, in *CancelReplicationJobsRequest, opts ...grpc.CallOption) (*CancelReplicationJobsResponse, error)
+	// DrainStorage takes a storage out of rotation. A drained storage doesn't receive new reads and isn't
+	// eligible to become a repository's primary. The primaries it holds are moved to other up-to-date replicas
+	// by the elector as the repositories are accessed. The storage keeps participating in writes and replication
+	// so it stays up to date. Draining an already drained storage is a no-op. Returns InvalidArgument if the
+	// storage is not configured and FailedPrecondition if Praefect has no database to persist the drain state in.
+	DrainStorage(ctx context.Context, in *DrainStorageRequest, opts ...grpc.CallOption) (*DrainStorageResponse, error)
+	// UndrainStorage returns a drained storage back into rotation. Undraining a storage that is not drained is
+	// a no-op. Returns InvalidArgument if the storage is not configured and FailedPrecondition if Praefect has no
+	// database to persist the drain state in.
+	UndrainStorage(ctx context.Context, in *UndrainStorageRequest, opts ...grpc.CallOption) (*UndrainStorageResponse, error)
+	// ListRepositoryAuditLog lists the entries of the repository audit log matching the filter ordered by their ID.
+	// The audit log records every change to the repositories' generations, primaries, assignments and existence.
+	// Returns FailedPrecondition if Praefect is not using a Postgres database.
+	ListRepositoryAuditLog(ctx context.Context, in *ListRepositoryAuditLogRequest, opts ...grpc.CallOption) (PraefectInfoService_ListRepositoryAuditLogClient, error)
 }
 
 type praefectInfoServiceClient struct {
@@ -148,6 +173,106 @@ func (c *praefectInfoServiceClient) GetRepositoryMetadata(ctx context.Context, i
 	return out, nil
 }
 
+func (c *praefectInfoServiceClient) ListReplicationJobs(ctx context.Context, in *ListReplicationJobsRequest, opts ...grpc.CallOption) (PraefectInfoService_ListReplicationJobsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &PraefectInfoService_ServiceDesc.Streams[1], "/gitaly.PraefectInfoService/ListReplicationJobs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &praefectInfoServiceListReplicationJobsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PraefectInfoService_ListReplicationJobsClient interface {
+	Recv() (*ListReplicationJobsResponse, error)
+	grpc.ClientStream
+}
+
+type praefectInfoServiceListReplicationJobsClient struct {
+	grpc.ClientStream
+}
+
+func (x *praefectInfoServiceListReplicationJobsClient) Recv() (*ListReplicationJobsResponse, error) {
+	m := new(ListReplicationJobsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *praefectInfoServiceClient) RetryReplicationJobs(ctx context.Context, in *RetryReplicationJobsRequest, opts ...grpc.CallOption) (*RetryReplicationJobsResponse, error) {
+	out := new(RetryReplicationJobsResponse)
+	err := c.cc.Invoke(ctx, "/gitaly.PraefectInfoService/RetryReplicationJobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *praefectInfoServiceClient) CancelReplicationJobs(ctx context.Context, in *CancelReplicationJobsRequest, opts ...grpc.CallOption) (*CancelReplicationJobsResponse, error) {
+	out := new(CancelReplicationJobsResponse)
+	err := c.cc.Invoke(ctx, "/gitaly.PraefectInfoService/CancelReplicationJobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *praefectInfoServiceClient) DrainStorage(ctx context.Context, in *DrainStorageRequest, opts ...grpc.CallOption) (*DrainStorageResponse, error) {
+	out := new(DrainStorageResponse)
+	err := c.cc.Invoke(ctx, "/gitaly.PraefectInfoService/DrainStorage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *praefectInfoServiceClient) UndrainStorage(ctx context.Context, in *UndrainStorageRequest, opts ...grpc.CallOption) (*UndrainStorageResponse, error) {
+	out := new(UndrainStorageResponse)
+	err := c.cc.Invoke(ctx, "/gitaly.PraefectInfoService/UndrainStorage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *praefectInfoServiceClient) ListRepositoryAuditLog(ctx context.Context, in *ListRepositoryAuditLogRequest, opts ...grpc.CallOption) (PraefectInfoService_ListRepositoryAuditLogClient, error) {
+	stream, err := c.cc.NewStream(ctx, &PraefectInfoService_ServiceDesc.Streams[2], "/gitaly.PraefectInfoService/ListRepositoryAuditLog", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &praefectInfoServiceListRepositoryAuditLogClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PraefectInfoService_ListRepositoryAuditLogClient interface {
+	Recv() (*ListRepositoryAuditLogResponse, error)
+	grpc.ClientStream
+}
+
+type praefectInfoServiceListRepositoryAuditLogClient struct {
+	grpc.ClientStream
+}
+
+func (x *praefectInfoServiceListRepositoryAuditLogClient) Recv() (*ListRepositoryAuditLogResponse, error) {
+	m := new(ListRepositoryAuditLogResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // PraefectInfoServiceServer is the server API for PraefectInfoService service.
 // All implementations must embed UnimplementedPraefectInfoServiceServer
 // for forward compatibility
@@ -181,6 +306,31 @@ type PraefectInfoServiceServer interface {
 	SetReplicationFactor(context.Context, *SetReplicationFactorRequest) (*SetReplicationFactorResponse, error)
 	// GetRepositoryMetadata returns the cluster metadata for a repository. Returns NotFound if the repository does not exist.
 	GetRepositoryMetadata(context.Context, *GetRepositoryMetadataRequest) (*GetRepositoryMetadataResponse, error)
+	// ListReplicationJobs lists the jobs in the replication queue matching the filter ordered by their ID.
+	// Returns FailedPrecondition if Praefect is not configured to use the SQL replication queue.
+	ListReplicationJobs(*ListReplicationJobsRequest, PraefectInfoService_ListReplicationJobsServer) error
+	// RetryReplicationJobs moves the dead jobs in the replication queue matching the filter back into the ready
+	// state so they are processed again. Jobs in other states are not touched. Returns FailedPrecondition if
+	// Praefect is not configured to use the SQL replication queue.
+	RetryReplicationJobs(context.Context, *RetryReplicationJobsRequest) (*RetryReplicationJobsResponse, error)
+	// CancelReplicationJobs removes the jobs in the replication queue matching the filter. Jobs which are being
+	// processed are not cancelled. Returns InvalidArgument if the filter is empty and FailedPrecondition if
+	// Praefect is not configured to use the SQL replication queue.
+	CancelReplicationJobs(context.Context, *CancelReplicationJobsRequest) (*CancelReplicationJobsResponse, error)
+	// DrainStorage takes a storage out of rotation. A drained storage doesn't receive new reads and isn't
+	// eligible to become a repository's primary. The primaries it holds are moved to other up-to-date replicas
+	// by the elector as the repositories are accessed. The storage keeps participating in writes and replication
+	// so it stays up to date. Draining an already drained storage is a no-op. Returns InvalidArgument if the
+	// storage is not configured and FailedPrecondition if Praefect has no database to persist the drain state in.
+	DrainStorage(context.Context, *DrainStorageRequest) (*DrainStorageResponse, error)
+	// UndrainStorage returns a drained storage back into rotation. Undraining a storage that is not drained is
+	// a no-op. Returns InvalidArgument if the storage is not configured and FailedPrecondition if Praefect has no
+	// database to persist the drain state in.
+	UndrainStorage(context.Context, *UndrainStorageRequest) (*UndrainStorageResponse, error)
+	// ListRepositoryAuditLog lists the entries of the repository audit log matching the filter ordered by their ID.
+	// The audit log records every change to the repositories' generations, primaries, assignments and existence.
+	// Returns FailedPrecondition if Praefect is not using a Postgres database.
+	ListRepositoryAuditLog(*ListRepositoryAuditLogRequest, PraefectInfoService_ListRepositoryAuditLogServer) error
 	mustEmbedUnimplementedPraefectInfoServiceServer()
 }
 
@@ -209,6 +359,24 @@ func (UnimplementedPraefectInfoServiceServer) SetReplicationFactor(context.Conte
 func (UnimplementedPraefectInfoServiceServer) GetRepositoryMetadata(context.Context, *GetRepositoryMetadataRequest) (*GetRepositoryMetadataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRepositoryMetadata not implemented")
 }
+func (UnimplementedPraefectInfoServiceServer) ListReplicationJobs(*ListReplicationJobsRequest, PraefectInfoService_ListReplicationJobsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListReplicationJobs not implemented")
+}
+func (UnimplementedPraefectInfoServiceServer) RetryReplicationJobs(context.Context, *RetryReplicationJobsRequest) (*RetryReplicationJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryReplicationJobs not implemented")
+}
+func (UnimplementedPraefectInfoServiceServer) CancelReplicationJobs(context.Context, *CancelReplicationJobsRequest) (*CancelReplicationJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelReplicationJobs not implemented")
+}
+func (UnimplementedPraefectInfoServiceServer) DrainStorage(context.Context, *DrainStorageRequest) (*DrainStorageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DrainStorage not implemented")
+}
+func (UnimplementedPraefectInfoServiceServer) UndrainStorage(context.Context, *UndrainStorageRequest) (*UndrainStorageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndrainStorage not implemented")
+}
+func (UnimplementedPraefectInfoServiceServer) ListRepositoryAuditLog(*ListRepositoryAuditLogRequest, PraefectInfoService_ListRepositoryAuditLogServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListRepositoryAuditLog not implemented")
+}
 func (UnimplementedPraefectInfoServiceServer) mustEmbedUnimplementedPraefectInfoServiceServer() {}
 
 // UnsafePraefectInfoServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -351,6 +519,120 @@ func _PraefectInfoService_GetRepositoryMetadata_Handler(srv interface{}, ctx con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PraefectInfoService_ListReplicationJobs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListReplicationJobsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PraefectInfoServiceServer).ListReplicationJobs(m, &praefectInfoServiceListReplicationJobsServer{stream})
+}
+
+type PraefectInfoService_ListReplicationJobsServer interface {
+	Send(*ListReplicationJobsResponse) error
+	grpc.ServerStream
+}
+
+type praefectInfoServiceListReplicationJobsServer struct {
+	grpc.ServerStream
+}
+
+func (x *praefectInfoServiceListReplicationJobsServer) Send(m *ListReplicationJobsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _PraefectInfoService_RetryReplicationJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryReplicationJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PraefectInfoServiceServer).RetryReplicationJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gitaly.PraefectInfoService/RetryReplicationJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PraefectInfoServiceServer).RetryReplicationJobs(ctx, req.(*RetryReplicationJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PraefectInfoService_CancelReplicationJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelReplicationJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PraefectInfoServiceServer).CancelReplicationJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gitaly.PraefectInfoService/CancelReplicationJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PraefectInfoServiceServer).CancelReplicationJobs(ctx, req.(*CancelReplicationJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PraefectInfoService_DrainStorage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DrainStorageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PraefectInfoServiceServer).DrainStorage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gitaly.PraefectInfoService/DrainStorage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PraefectInfoServiceServer).DrainStorage(ctx, req.(*DrainStorageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PraefectInfoService_UndrainStorage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UndrainStorageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PraefectInfoServiceServer).UndrainStorage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gitaly.PraefectInfoService/UndrainStorage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PraefectInfoServiceServer).UndrainStorage(ctx, req.(*UndrainStorageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PraefectInfoService_ListRepositoryAuditLog_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListRepositoryAuditLogRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PraefectInfoServiceServer).ListRepositoryAuditLog(m, &praefectInfoServiceListRepositoryAuditLogServer{stream})
+}
+
+type PraefectInfoService_ListRepositoryAuditLogServer interface {
+	Send(*ListRepositoryAuditLogResponse) error
+	grpc.ServerStream
+}
+
+type praefectInfoServiceListRepositoryAuditLogServer struct {
+	grpc.ServerStream
+}
+
+func (x *praefectInfoServiceListRepositoryAuditLogServer) Send(m *ListRepositoryAuditLogResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // PraefectInfoService_ServiceDesc is the grpc.ServiceDesc for PraefectInfoService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -382,6 +664,22 @@ var PraefectInfoService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetRepositoryMetadata",
 			Handler:    _PraefectInfoService_GetRepositoryMetadata_Handler,
 		},
+		{
+			MethodName: "RetryReplicationJobs",
+			Handler:    _PraefectInfoService_RetryReplicationJobs_Handler,
+		},
+		{
+			MethodName: "CancelReplicationJobs",
+			Handler:    _PraefectInfoService_CancelReplicationJobs_Handler,
+		},
+		{
+			MethodName: "DrainStorage",
+			Handler:    _PraefectInfoService_DrainStorage_Handler,
+		},
+		{
+			MethodName: "UndrainStorage",
+			Handler:    _PraefectInfoService_UndrainStorage_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -389,6 +687,16 @@ var PraefectInfoService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _PraefectInfoService_Dataloss_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ListReplicationJobs",
+			Handler:       _PraefectInfoService_ListReplicationJobs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListRepositoryAuditLog",
+			Handler:       _PraefectInfoService_ListRepositoryAuditLog_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "praefect.proto",
 }
@@ -21,6 +21,116 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Dimension is the dimension by which resource usage is aggregated.
+type TopResourceConsumersRequest_Dimension int32
+
+const (
+	// DIMENSION_UNSPECIFIED aggregates resource usage by project.
+	TopResourceConsumersRequest_DIMENSION_UNSPECIFIED TopResourceConsumersRequest_Dimension = 0
+	// DIMENSION_PROJECT aggregates resource usage by the GitLab project path of the repository.
+	TopResourceConsumersRequest_DIMENSION_PROJECT TopResourceConsumersRequest_Dimension = 1
+	// DIMENSION_USER aggregates resource usage by the GitLab ID of the user.
+	TopResourceConsumersRequest_DIMENSION_USER TopResourceConsumersRequest_Dimension = 2
+)
+
+// Enum value maps for TopResourceConsumersRequest_Dimension.
+var (
+	TopResourceConsumersRequest_Dimension_name = map[int32]string{
+		0: "DIMENSION_UNSPECIFIED",
+		1: "DIMENSION_PROJECT",
+		2: "DIMENSION_USER",
+	}
+	TopResourceConsumersRequest_Dimension_value = map[string]int32{
+		"DIMENSION_UNSPECIFIED": 0,
+		"DIMENSION_PROJECT":     1,
+		"DIMENSION_USER":        2,
+	}
+)
+
+func (x TopResourceConsumersRequest_Dimension) Enum() *TopResourceConsumersRequest_Dimension {
+	p := new(TopResourceConsumersRequest_Dimension)
+	*p = x
+	return p
+}
+
+func (x TopResourceConsumersRequest_Dimension) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TopResourceConsumersRequest_Dimension) Descriptor() protoreflect.EnumDescriptor {
+	return file_server_proto_enumTypes[0].Descriptor()
+}
+
+func (TopResourceConsumersRequest_Dimension) Type() protoreflect.EnumType {
+	return &file_server_proto_enumTypes[0]
+}
+
+func (x TopResourceConsumersRequest_Dimension) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TopResourceConsumersRequest_Dimension.Descriptor instead.
+func (TopResourceConsumersRequest_Dimension) EnumDescriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{8, 0}
+}
+
+// Resource is the resource by which consumers are ranked.
+type TopResourceConsumersRequest_Resource int32
+
+const (
+	// RESOURCE_UNSPECIFIED ranks consumers by CPU time.
+	TopResourceConsumersRequest_RESOURCE_UNSPECIFIED TopResourceConsumersRequest_Resource = 0
+	// RESOURCE_CPU ranks consumers by the sum of user and system time of their commands.
+	TopResourceConsumersRequest_RESOURCE_CPU TopResourceConsumersRequest_Resource = 1
+	// RESOURCE_MEMORY ranks consumers by the maximum resident set size of any of their commands.
+	TopResourceConsumersRequest_RESOURCE_MEMORY TopResourceConsumersRequest_Resource = 2
+	// RESOURCE_IO ranks consumers by the number of blocks read and written by their commands.
+	TopResourceConsumersRequest_RESOURCE_IO TopResourceConsumersRequest_Resource = 3
+)
+
+// Enum value maps for TopResourceConsumersRequest_Resource.
+var (
+	TopResourceConsumersRequest_Resource_name = map[int32]string{
+		0: "RESOURCE_UNSPECIFIED",
+		1: "RESOURCE_CPU",
+		2: "RESOURCE_MEMORY",
+		3: "RESOURCE_IO",
+	}
+	TopResourceConsumersRequest_Resource_value = map[string]int32{
+		"RESOURCE_UNSPECIFIED": 0,
+		"RESOURCE_CPU":         1,
+		"RESOURCE_MEMORY":      2,
+		"RESOURCE_IO":          3,
+	}
+)
+
+func (x TopResourceConsumersRequest_Resource) Enum() *TopResourceConsumersRequest_Resource {
+	p := new(TopResourceConsumersRequest_Resource)
+	*p = x
+	return p
+}
+
+func (x TopResourceConsumersRequest_Resource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TopResourceConsumersRequest_Resource) Descriptor() protoreflect.EnumDescriptor {
+	return file_server_proto_enumTypes[1].Descriptor()
+}
+
+func (TopResourceConsumersRequest_Resource) Type() protoreflect.EnumType {
+	return &file_server_proto_enumTypes[1]
+}
+
+func (x TopResourceConsumersRequest_Resource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TopResourceConsumersRequest_Resource.Descriptor instead.
+func (TopResourceConsumersRequest_Resource) EnumDescriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{8, 1}
+}
+
 // This comment is left unintentionally blank.
 type ServerInfoRequest struct {
 	state         protoimpl.MessageState
@@ -380,7 +490,6 @@ type ReadinessCheckResponse struct {
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to Result:
-	//
 	//	*ReadinessCheckResponse_OkResponse
 	//	*ReadinessCheckResponse_FailureResponse
 	Result isReadinessCheckResponse_Result `protobuf_oneof:"Result"`
@@ -457,6 +566,131 @@ func (*ReadinessCheckResponse_OkResponse) isReadinessCheckResponse_Result() {}
 
 func (*ReadinessCheckResponse_FailureResponse) isReadinessCheckResponse_Result() {}
 
+// TopResourceConsumersRequest is a request for the TopResourceConsumers RPC.
+type TopResourceConsumersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Dimension is the dimension by which resource usage is aggregated.
+	Dimension TopResourceConsumersRequest_Dimension `protobuf:"varint,1,opt,name=dimension,proto3,enum=gitaly.TopResourceConsumersRequest_Dimension" json:"dimension,omitempty"`
+	// SortBy is the resource by which consumers are ranked.
+	SortBy TopResourceConsumersRequest_Resource `protobuf:"varint,2,opt,name=sort_by,json=sortBy,proto3,enum=gitaly.TopResourceConsumersRequest_Resource" json:"sort_by,omitempty"`
+	// Limit is the maximum number of consumers to return. All consumers are returned if unset.
+	Limit uint32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *TopResourceConsumersRequest) Reset() {
+	*x = TopResourceConsumersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TopResourceConsumersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopResourceConsumersRequest) ProtoMessage() {}
+
+func (x *TopResourceConsumersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopResourceConsumersRequest.ProtoReflect.Descriptor instead.
+func (*TopResourceConsumersRequest) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *TopResourceConsumersRequest) GetDimension() TopResourceConsumersRequest_Dimension {
+	if x != nil {
+		return x.Dimension
+	}
+	return TopResourceConsumersRequest_DIMENSION_UNSPECIFIED
+}
+
+func (x *TopResourceConsumersRequest) GetSortBy() TopResourceConsumersRequest_Resource {
+	if x != nil {
+		return x.SortBy
+	}
+	return TopResourceConsumersRequest_RESOURCE_UNSPECIFIED
+}
+
+func (x *TopResourceConsumersRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// TopResourceConsumersResponse is a response for the TopResourceConsumers RPC.
+type TopResourceConsumersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Window is the duration over which resource usage has been aggregated.
+	Window *durationpb.Duration `protobuf:"bytes,1,opt,name=window,proto3" json:"window,omitempty"`
+	// Consumers are the top consumers, ordered by the requested resource in descending order.
+	Consumers []*TopResourceConsumersResponse_Consumer `protobuf:"bytes,2,rep,name=consumers,proto3" json:"consumers,omitempty"`
+}
+
+func (x *TopResourceConsumersResponse) Reset() {
+	*x = TopResourceConsumersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TopResourceConsumersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopResourceConsumersResponse) ProtoMessage() {}
+
+func (x *TopResourceConsumersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopResourceConsumersResponse.ProtoReflect.Descriptor instead.
+func (*TopResourceConsumersResponse) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *TopResourceConsumersResponse) GetWindow() *durationpb.Duration {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+func (x *TopResourceConsumersResponse) GetConsumers() []*TopResourceConsumersResponse_Consumer {
+	if x != nil {
+		return x.Consumers
+	}
+	return nil
+}
+
 // This comment is left unintentionally blank.
 type ServerInfoResponse_StorageStatus struct {
 	state         protoimpl.MessageState
@@ -480,7 +714,7 @@ type ServerInfoResponse_StorageStatus struct {
 func (x *ServerInfoResponse_StorageStatus) Reset() {
 	*x = ServerInfoResponse_StorageStatus{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_server_proto_msgTypes[8]
+		mi := &file_server_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -493,7 +727,7 @@ func (x *ServerInfoResponse_StorageStatus) String() string {
 func (*ServerInfoResponse_StorageStatus) ProtoMessage() {}
 
 func (x *ServerInfoResponse_StorageStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[8]
+	mi := &file_server_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -569,7 +803,7 @@ type DiskStatisticsResponse_StorageStatus struct {
 func (x *DiskStatisticsResponse_StorageStatus) Reset() {
 	*x = DiskStatisticsResponse_StorageStatus{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_server_proto_msgTypes[9]
+		mi := &file_server_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -582,7 +816,7 @@ func (x *DiskStatisticsResponse_StorageStatus) String() string {
 func (*DiskStatisticsResponse_StorageStatus) ProtoMessage() {}
 
 func (x *DiskStatisticsResponse_StorageStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[9]
+	mi := &file_server_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -629,7 +863,7 @@ type ReadinessCheckResponse_Ok struct {
 func (x *ReadinessCheckResponse_Ok) Reset() {
 	*x = ReadinessCheckResponse_Ok{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_server_proto_msgTypes[10]
+		mi := &file_server_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -642,7 +876,7 @@ func (x *ReadinessCheckResponse_Ok) String() string {
 func (*ReadinessCheckResponse_Ok) ProtoMessage() {}
 
 func (x *ReadinessCheckResponse_Ok) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[10]
+	mi := &file_server_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -671,7 +905,7 @@ type ReadinessCheckResponse_Failure struct {
 func (x *ReadinessCheckResponse_Failure) Reset() {
 	*x = ReadinessCheckResponse_Failure{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_server_proto_msgTypes[11]
+		mi := &file_server_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -684,7 +918,7 @@ func (x *ReadinessCheckResponse_Failure) String() string {
 func (*ReadinessCheckResponse_Failure) ProtoMessage() {}
 
 func (x *ReadinessCheckResponse_Failure) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[11]
+	mi := &file_server_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -722,7 +956,7 @@ type ReadinessCheckResponse_Failure_Response struct {
 func (x *ReadinessCheckResponse_Failure_Response) Reset() {
 	*x = ReadinessCheckResponse_Failure_Response{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_server_proto_msgTypes[12]
+		mi := &file_server_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -735,7 +969,7 @@ func (x *ReadinessCheckResponse_Failure_Response) String() string {
 func (*ReadinessCheckResponse_Failure_Response) ProtoMessage() {}
 
 func (x *ReadinessCheckResponse_Failure_Response) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_msgTypes[12]
+	mi := &file_server_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -765,6 +999,100 @@ func (x *ReadinessCheckResponse_Failure_Response) GetErrorMessage() string {
 	return ""
 }
 
+// Consumer is the resource usage of a single project or user.
+type TopResourceConsumersResponse_Consumer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Key is the GitLab project path or the GitLab ID of the user, depending on the requested dimension.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// CommandCount is the number of commands that have finished.
+	CommandCount uint64 `protobuf:"varint,2,opt,name=command_count,json=commandCount,proto3" json:"command_count,omitempty"`
+	// CpuTime is the sum of user and system time of all commands.
+	CpuTime *durationpb.Duration `protobuf:"bytes,3,opt,name=cpu_time,json=cpuTime,proto3" json:"cpu_time,omitempty"`
+	// MaxRssBytes is the maximum resident set size of any single command.
+	MaxRssBytes int64 `protobuf:"varint,4,opt,name=max_rss_bytes,json=maxRssBytes,proto3" json:"max_rss_bytes,omitempty"`
+	// InputBlocks is the number of blocks read from the file system.
+	InputBlocks int64 `protobuf:"varint,5,opt,name=input_blocks,json=inputBlocks,proto3" json:"input_blocks,omitempty"`
+	// OutputBlocks is the number of blocks written to the file system.
+	OutputBlocks int64 `protobuf:"varint,6,opt,name=output_blocks,json=outputBlocks,proto3" json:"output_blocks,omitempty"`
+}
+
+func (x *TopResourceConsumersResponse_Consumer) Reset() {
+	*x = TopResourceConsumersResponse_Consumer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TopResourceConsumersResponse_Consumer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopResourceConsumersResponse_Consumer) ProtoMessage() {}
+
+func (x *TopResourceConsumersResponse_Consumer) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopResourceConsumersResponse_Consumer.ProtoReflect.Descriptor instead.
+func (*TopResourceConsumersResponse_Consumer) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{9, 0}
+}
+
+func (x *TopResourceConsumersResponse_Consumer) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *TopResourceConsumersResponse_Consumer) GetCommandCount() uint64 {
+	if x != nil {
+		return x.CommandCount
+	}
+	return 0
+}
+
+func (x *TopResourceConsumersResponse_Consumer) GetCpuTime() *durationpb.Duration {
+	if x != nil {
+		return x.CpuTime
+	}
+	return nil
+}
+
+func (x *TopResourceConsumersResponse_Consumer) GetMaxRssBytes() int64 {
+	if x != nil {
+		return x.MaxRssBytes
+	}
+	return 0
+}
+
+func (x *TopResourceConsumersResponse_Consumer) GetInputBlocks() int64 {
+	if x != nil {
+		return x.InputBlocks
+	}
+	return 0
+}
+
+func (x *TopResourceConsumersResponse_Consumer) GetOutputBlocks() int64 {
+	if x != nil {
+		return x.OutputBlocks
+	}
+	return 0
+}
+
 var File_server_proto protoreflect.FileDescriptor
 
 var file_server_proto_rawDesc = []byte{
@@ -853,31 +1181,86 @@ var file_server_proto_rawDesc = []byte{
 	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x72, 0x72,
 	0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x08,
-	0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x32, 0xc4, 0x02, 0x0a, 0x0d, 0x53, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x43, 0x0a, 0x0a, 0x53, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x19, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c,
-	0x79, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x53, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x4f, 0x0a, 0x0e, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63,
-	0x73, 0x12, 0x1d, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x53,
-	0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1e, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x74,
-	0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x46, 0x0a, 0x0b, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x79, 0x6e, 0x63, 0x65, 0x64, 0x12,
-	0x1a, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x79,
-	0x6e, 0x63, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x67, 0x69,
-	0x74, 0x61, 0x6c, 0x79, 0x2e, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x79, 0x6e, 0x63, 0x65, 0x64,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x0e, 0x52, 0x65, 0x61, 0x64,
-	0x69, 0x6e, 0x65, 0x73, 0x73, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x1d, 0x2e, 0x67, 0x69, 0x74,
-	0x61, 0x6c, 0x79, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x43, 0x68, 0x65,
-	0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x67, 0x69, 0x74, 0x61,
-	0x6c, 0x79, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x43, 0x68, 0x65, 0x63,
-	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x04, 0xf0, 0x97, 0x28, 0x01, 0x42,
-	0x34, 0x5a, 0x32, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x69,
-	0x74, 0x6c, 0x61, 0x62, 0x2d, 0x6f, 0x72, 0x67, 0x2f, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2f,
-	0x76, 0x31, 0x36, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x2f, 0x67, 0x69, 0x74,
-	0x61, 0x6c, 0x79, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0xf8, 0x02, 0x0a, 0x1b, 0x54, 0x6f, 0x70,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4b, 0x0a, 0x09, 0x64, 0x69, 0x6d, 0x65,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x67, 0x69,
+	0x74, 0x61, 0x6c, 0x79, 0x2e, 0x54, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x2e, 0x44, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x64, 0x69, 0x6d, 0x65,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x45, 0x0a, 0x07, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x62, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e,
+	0x54, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x75,
+	0x6d, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x52, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x22, 0x51, 0x0a, 0x09, 0x44, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x19, 0x0a, 0x15, 0x44, 0x49, 0x4d, 0x45, 0x4e, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x44, 0x49,
+	0x4d, 0x45, 0x4e, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x4a, 0x45, 0x43, 0x54, 0x10,
+	0x01, 0x12, 0x12, 0x0a, 0x0e, 0x44, 0x49, 0x4d, 0x45, 0x4e, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x55,
+	0x53, 0x45, 0x52, 0x10, 0x02, 0x22, 0x5c, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x12, 0x18, 0x0a, 0x14, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x52,
+	0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x43, 0x50, 0x55, 0x10, 0x01, 0x12, 0x13, 0x0a,
+	0x0f, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x4d, 0x45, 0x4d, 0x4f, 0x52, 0x59,
+	0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x49,
+	0x4f, 0x10, 0x03, 0x22, 0x84, 0x03, 0x0a, 0x1c, 0x54, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x4b, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x73, 0x75,
+	0x6d, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x67, 0x69, 0x74,
+	0x61, 0x6c, 0x79, 0x2e, 0x54, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43,
+	0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x73, 0x75,
+	0x6d, 0x65, 0x72, 0x73, 0x1a, 0xe3, 0x01, 0x0a, 0x08, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65,
+	0x72, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x63, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x08, 0x63, 0x70, 0x75, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x63, 0x70, 0x75, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x22,
+	0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x73, 0x73, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x52, 0x73, 0x73, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x32, 0xa7, 0x03, 0x0a, 0x0d, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x43, 0x0a, 0x0a,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x19, 0x2e, 0x67, 0x69, 0x74,
+	0x61, 0x6c, 0x79, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x4f, 0x0a, 0x0e, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74,
+	0x69, 0x63, 0x73, 0x12, 0x1d, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x44, 0x69, 0x73,
+	0x6b, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x44, 0x69, 0x73, 0x6b,
+	0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x46, 0x0a, 0x0b, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x79, 0x6e, 0x63, 0x65,
+	0x64, 0x12, 0x1a, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x43, 0x6c, 0x6f, 0x63, 0x6b,
+	0x53, 0x79, 0x6e, 0x63, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e,
+	0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x79, 0x6e, 0x63,
+	0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x0e, 0x52, 0x65,
+	0x61, 0x64, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x1d, 0x2e, 0x67,
+	0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x67, 0x69,
+	0x74, 0x61, 0x6c, 0x79, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a, 0x14, 0x54,
+	0x6f, 0x70, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d,
+	0x65, 0x72, 0x73, 0x12, 0x23, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x54, 0x6f, 0x70,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c,
+	0x79, 0x2e, 0x54, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e,
+	0x73, 0x75, 0x6d, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x04,
+	0xf0, 0x97, 0x28, 0x01, 0x42, 0x34, 0x5a, 0x32, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2d, 0x6f, 0x72, 0x67, 0x2f, 0x67, 0x69,
+	0x74, 0x61, 0x6c, 0x79, 0x2f, 0x76, 0x31, 0x36, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67,
+	0x6f, 0x2f, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -892,44 +1275,57 @@ func file_server_proto_rawDescGZIP() []byte {
 	return file_server_proto_rawDescData
 }
 
-var file_server_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_server_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_server_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_server_proto_goTypes = []interface{}{
-	(*ServerInfoRequest)(nil),                       // 0: gitaly.ServerInfoRequest
-	(*ServerInfoResponse)(nil),                      // 1: gitaly.ServerInfoResponse
-	(*DiskStatisticsRequest)(nil),                   // 2: gitaly.DiskStatisticsRequest
-	(*DiskStatisticsResponse)(nil),                  // 3: gitaly.DiskStatisticsResponse
-	(*ClockSyncedRequest)(nil),                      // 4: gitaly.ClockSyncedRequest
-	(*ClockSyncedResponse)(nil),                     // 5: gitaly.ClockSyncedResponse
-	(*ReadinessCheckRequest)(nil),                   // 6: gitaly.ReadinessCheckRequest
-	(*ReadinessCheckResponse)(nil),                  // 7: gitaly.ReadinessCheckResponse
-	(*ServerInfoResponse_StorageStatus)(nil),        // 8: gitaly.ServerInfoResponse.StorageStatus
-	(*DiskStatisticsResponse_StorageStatus)(nil),    // 9: gitaly.DiskStatisticsResponse.StorageStatus
-	(*ReadinessCheckResponse_Ok)(nil),               // 10: gitaly.ReadinessCheckResponse.Ok
-	(*ReadinessCheckResponse_Failure)(nil),          // 11: gitaly.ReadinessCheckResponse.Failure
-	(*ReadinessCheckResponse_Failure_Response)(nil), // 12: gitaly.ReadinessCheckResponse.Failure.Response
-	(*durationpb.Duration)(nil),                     // 13: google.protobuf.Duration
+	(TopResourceConsumersRequest_Dimension)(0),      // 0: gitaly.TopResourceConsumersRequest.Dimension
+	(TopResourceConsumersRequest_Resource)(0),       // 1: gitaly.TopResourceConsumersRequest.Resource
+	(*ServerInfoRequest)(nil),                       // 2: gitaly.ServerInfoRequest
+	(*ServerInfoResponse)(nil),                      // 3: gitaly.ServerInfoResponse
+	(*DiskStatisticsRequest)(nil),                   // 4: gitaly.DiskStatisticsRequest
+	(*DiskStatisticsResponse)(nil),                  // 5: gitaly.DiskStatisticsResponse
+	(*ClockSyncedRequest)(nil),                      // 6: gitaly.ClockSyncedRequest
+	(*ClockSyncedResponse)(nil),                     // 7: gitaly.ClockSyncedResponse
+	(*ReadinessCheckRequest)(nil),                   // 8: gitaly.ReadinessCheckRequest
+	(*ReadinessCheckResponse)(nil),                  // 9: gitaly.ReadinessCheckResponse
+	(*TopResourceConsumersRequest)(nil),             // 10: gitaly.TopResourceConsumersRequest
+	(*TopResourceConsumersResponse)(nil),            // 11: gitaly.TopResourceConsumersResponse
+	(*ServerInfoResponse_StorageStatus)(nil),        // 12: gitaly.ServerInfoResponse.StorageStatus
+	(*DiskStatisticsResponse_StorageStatus)(nil),    // 13: gitaly.DiskStatisticsResponse.StorageStatus
+	(*ReadinessCheckResponse_Ok)(nil),               // 14: gitaly.ReadinessCheckResponse.Ok
+	(*ReadinessCheckResponse_Failure)(nil),          // 15: gitaly.ReadinessCheckResponse.Failure
+	(*ReadinessCheckResponse_Failure_Response)(nil), // 16: gitaly.ReadinessCheckResponse.Failure.Response
+	(*TopResourceConsumersResponse_Consumer)(nil),   // 17: gitaly.TopResourceConsumersResponse.Consumer
+	(*durationpb.Duration)(nil),                     // 18: google.protobuf.Duration
 }
 var file_server_proto_depIdxs = []int32{
-	8,  // 0: gitaly.ServerInfoResponse.storage_statuses:type_name -> gitaly.ServerInfoResponse.StorageStatus
-	9,  // 1: gitaly.DiskStatisticsResponse.storage_statuses:type_name -> gitaly.DiskStatisticsResponse.StorageStatus
-	13, // 2: gitaly.ClockSyncedRequest.drift_threshold:type_name -> google.protobuf.Duration
-	13, // 3: gitaly.ReadinessCheckRequest.timeout:type_name -> google.protobuf.Duration
-	10, // 4: gitaly.ReadinessCheckResponse.ok_response:type_name -> gitaly.ReadinessCheckResponse.Ok
-	11, // 5: gitaly.ReadinessCheckResponse.failure_response:type_name -> gitaly.ReadinessCheckResponse.Failure
-	12, // 6: gitaly.ReadinessCheckResponse.Failure.failed_checks:type_name -> gitaly.ReadinessCheckResponse.Failure.Response
-	0,  // 7: gitaly.ServerService.ServerInfo:input_type -> gitaly.ServerInfoRequest
-	2,  // 8: gitaly.ServerService.DiskStatistics:input_type -> gitaly.DiskStatisticsRequest
-	4,  // 9: gitaly.ServerService.ClockSynced:input_type -> gitaly.ClockSyncedRequest
-	6,  // 10: gitaly.ServerService.ReadinessCheck:input_type -> gitaly.ReadinessCheckRequest
-	1,  // 11: gitaly.ServerService.ServerInfo:output_type -> gitaly.ServerInfoResponse
-	3,  // 12: gitaly.ServerService.DiskStatistics:output_type -> gitaly.DiskStatisticsResponse
-	5,  // 13: gitaly.ServerService.ClockSynced:output_type -> gitaly.ClockSyncedResponse
-	7,  // 14: gitaly.ServerService.ReadinessCheck:output_type -> gitaly.ReadinessCheckResponse
-	11, // [11:15] is the sub-list for method output_type
-	7,  // [7:11] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	12, // 0: gitaly.ServerInfoResponse.storage_statuses:type_name -> gitaly.ServerInfoResponse.StorageStatus
+	13, // 1: gitaly.DiskStatisticsResponse.storage_statuses:type_name -> gitaly.DiskStatisticsResponse.StorageStatus
+	18, // 2: gitaly.ClockSyncedRequest.drift_threshold:type_name -> google.protobuf.Duration
+	18, // 3: gitaly.ReadinessCheckRequest.timeout:type_name -> google.protobuf.Duration
+	14, // 4: gitaly.ReadinessCheckResponse.ok_response:type_name -> gitaly.ReadinessCheckResponse.Ok
+	15, // 5: gitaly.ReadinessCheckResponse.failure_response:type_name -> gitaly.ReadinessCheckResponse.Failure
+	0,  // 6: gitaly.TopResourceConsumersRequest.dimension:type_name -> gitaly.TopResourceConsumersRequest.Dimension
+	1,  // 7: gitaly.TopResourceConsumersRequest.sort_by:type_name -> gitaly.TopResourceConsumersRequest.Resource
+	18, // 8: gitaly.TopResourceConsumersResponse.window:type_name -> google.protobuf.Duration
+	17, // 9: gitaly.TopResourceConsumersResponse.consumers:type_name -> gitaly.TopResourceConsumersResponse.Consumer
+	16, // 10: gitaly.ReadinessCheckResponse.Failure.failed_checks:type_name -> gitaly.ReadinessCheckResponse.Failure.Response
+	18, // 11: gitaly.TopResourceConsumersResponse.Consumer.cpu_time:type_name -> google.protobuf.Duration
+	2,  // 12: gitaly.ServerService.ServerInfo:input_type -> gitaly.ServerInfoRequest
+	4,  // 13: gitaly.ServerService.DiskStatistics:input_type -> gitaly.DiskStatisticsRequest
+	6,  // 14: gitaly.ServerService.ClockSynced:input_type -> gitaly.ClockSyncedRequest
+	8,  // 15: gitaly.ServerService.ReadinessCheck:input_type -> gitaly.ReadinessCheckRequest
+	10, // 16: gitaly.ServerService.TopResourceConsumers:input_type -> gitaly.TopResourceConsumersRequest
+	3,  // 17: gitaly.ServerService.ServerInfo:output_type -> gitaly.ServerInfoResponse
+	5,  // 18: gitaly.ServerService.DiskStatistics:output_type -> gitaly.DiskStatisticsResponse
+	7,  // 19: gitaly.ServerService.ClockSynced:output_type -> gitaly.ClockSyncedResponse
+	9,  // 20: gitaly.ServerService.ReadinessCheck:output_type -> gitaly.ReadinessCheckResponse
+	11, // 21: gitaly.ServerService.TopResourceConsumers:output_type -> gitaly.TopResourceConsumersResponse
+	17, // [17:22] is the sub-list for method output_type
+	12, // [12:17] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_server_proto_init() }
@@ -1036,7 +1432,7 @@ func file_server_proto_init() {
 			}
 		}
 		file_server_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ServerInfoResponse_StorageStatus); i {
+			switch v := v.(*TopResourceConsumersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1048,7 +1444,7 @@ func file_server_proto_init() {
 			}
 		}
 		file_server_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DiskStatisticsResponse_StorageStatus); i {
+			switch v := v.(*TopResourceConsumersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1060,7 +1456,7 @@ func file_server_proto_init() {
 			}
 		}
 		file_server_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadinessCheckResponse_Ok); i {
+			switch v := v.(*ServerInfoResponse_StorageStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1072,7 +1468,7 @@ func file_server_proto_init() {
 			}
 		}
 		file_server_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadinessCheckResponse_Failure); i {
+			switch v := v.(*DiskStatisticsResponse_StorageStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1084,6 +1480,30 @@ func file_server_proto_init() {
 			}
 		}
 		file_server_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadinessCheckResponse_Ok); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadinessCheckResponse_Failure); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReadinessCheckResponse_Failure_Response); i {
 			case 0:
 				return &v.state
@@ -1095,6 +1515,18 @@ func file_server_proto_init() {
 				return nil
 			}
 		}
+		file_server_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TopResourceConsumersResponse_Consumer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_server_proto_msgTypes[7].OneofWrappers = []interface{}{
 		(*ReadinessCheckResponse_OkResponse)(nil),
@@ -1105,13 +1537,14 @@ func file_server_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_server_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   13,
+			NumEnums:      2,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_server_proto_goTypes,
 		DependencyIndexes: file_server_proto_depIdxs,
+		EnumInfos:         file_server_proto_enumTypes,
 		MessageInfos:      file_server_proto_msgTypes,
 	}.Build()
 	File_server_proto = out.File